@@ -41,10 +41,14 @@ const (
 type EventsClient interface {
 	// Start watching events.
 	//
-	// Note that the server doesn't make any guarantee about the delivery or order of these events. In particular events
-	// that happen while the client is disconnected will not be delivered. Clients should consider using other mechanisms
-	// to ensure that they process objects correctly. For example, they can combine this watch mechanism with periodic
-	// redconciliation of all the objects.
+	// Events are sent in the order that they are committed, which may differ from the order of their sequence numbers.
+	// Events that happen while the client is disconnected will be delivered only if the client uses the `since` parameter
+	// when it connects again, and only if they are still retained by the server. Clients should consider using other mechanisms to ensure that they process objects
+	// correctly. For example, they can combine this watch mechanism with periodic reconciliation of all the objects.
+	//
+	// Clients that don't receive events as fast as they are generated will be disconnected with a `RESOURCE_EXHAUSTED`
+	// error. When that happens the client can watch again using the `since` parameter to continue from the last event or
+	// bookmark that it received.
 	Watch(ctx context.Context, in *EventsWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventsWatchResponse], error)
 }
 
@@ -81,10 +85,14 @@ type Events_WatchClient = grpc.ServerStreamingClient[EventsWatchResponse]
 type EventsServer interface {
 	// Start watching events.
 	//
-	// Note that the server doesn't make any guarantee about the delivery or order of these events. In particular events
-	// that happen while the client is disconnected will not be delivered. Clients should consider using other mechanisms
-	// to ensure that they process objects correctly. For example, they can combine this watch mechanism with periodic
-	// redconciliation of all the objects.
+	// Events are sent in the order that they are committed, which may differ from the order of their sequence numbers.
+	// Events that happen while the client is disconnected will be delivered only if the client uses the `since` parameter
+	// when it connects again, and only if they are still retained by the server. Clients should consider using other mechanisms to ensure that they process objects
+	// correctly. For example, they can combine this watch mechanism with periodic reconciliation of all the objects.
+	//
+	// Clients that don't receive events as fast as they are generated will be disconnected with a `RESOURCE_EXHAUSTED`
+	// error. When that happens the client can watch again using the `since` parameter to continue from the last event or
+	// bookmark that it received.
 	Watch(*EventsWatchRequest, grpc.ServerStreamingServer[EventsWatchResponse]) error
 	mustEmbedUnimplementedEventsServer()
 }
@@ -0,0 +1,675 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: fulfillment/v1/virtual_machine_snapshot_type.proto
+
+//go:build protoopaque
+
+package fulfillmentv1
+
+import (
+	v1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents the overall state of a snapshot.
+type VirtualMachineSnapshotState int32
+
+const (
+	// Unspecified indicates that the state is unknown.
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED VirtualMachineSnapshotState = 0
+	// Indicates that the snapshot is being taken.
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING VirtualMachineSnapshotState = 1
+	// Indicates that the snapshot has been taken and can be restored.
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY VirtualMachineSnapshotState = 2
+	// Indicates that the snapshot couldn't be taken.
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED VirtualMachineSnapshotState = 3
+)
+
+// Enum value maps for VirtualMachineSnapshotState.
+var (
+	VirtualMachineSnapshotState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING",
+		2: "VIRTUAL_MACHINE_SNAPSHOT_STATE_READY",
+		3: "VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED",
+	}
+	VirtualMachineSnapshotState_value = map[string]int32{
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING": 1,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_READY":       2,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED":      3,
+	}
+)
+
+func (x VirtualMachineSnapshotState) Enum() *VirtualMachineSnapshotState {
+	p := new(VirtualMachineSnapshotState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineSnapshotState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineSnapshotState) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes[0].Descriptor()
+}
+
+func (VirtualMachineSnapshotState) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes[0]
+}
+
+func (x VirtualMachineSnapshotState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the state of the restore of a snapshot.
+type VirtualMachineSnapshotRestoreState int32
+
+const (
+	// Unspecified indicates that the snapshot has never been restored, or that the state is unknown.
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED VirtualMachineSnapshotRestoreState = 0
+	// Indicates that the virtual machine is being reverted to the state captured by the snapshot.
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING VirtualMachineSnapshotRestoreState = 1
+	// Indicates that the virtual machine has been reverted to the state captured by the snapshot.
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED VirtualMachineSnapshotRestoreState = 2
+	// Indicates that the virtual machine couldn't be reverted to the state captured by the snapshot.
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED VirtualMachineSnapshotRestoreState = 3
+)
+
+// Enum value maps for VirtualMachineSnapshotRestoreState.
+var (
+	VirtualMachineSnapshotRestoreState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING",
+		2: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED",
+		3: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED",
+	}
+	VirtualMachineSnapshotRestoreState_value = map[string]int32{
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING": 1,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED":   2,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED":      3,
+	}
+)
+
+func (x VirtualMachineSnapshotRestoreState) Enum() *VirtualMachineSnapshotRestoreState {
+	p := new(VirtualMachineSnapshotRestoreState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineSnapshotRestoreState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineSnapshotRestoreState) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes[1].Descriptor()
+}
+
+func (VirtualMachineSnapshotRestoreState) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes[1]
+}
+
+func (x VirtualMachineSnapshotRestoreState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Contains the details of a snapshot of a virtual machine.
+//
+// A snapshot captures the state of the disks of a virtual machine at a point in time, so that the virtual machine can
+// later be reverted to that state. The `spec` contains the desired details, and may be modified by the user. The
+// `status` contains the current status of the snapshot, is provided by the system and can't be modified by the user.
+type VirtualMachineSnapshot struct {
+	state               protoimpl.MessageState        `protogen:"opaque.v1"`
+	xxx_hidden_Id       string                        `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Metadata *v1.Metadata                  `protobuf:"bytes,2,opt,name=metadata,proto3"`
+	xxx_hidden_Spec     *VirtualMachineSnapshotSpec   `protobuf:"bytes,3,opt,name=spec,proto3"`
+	xxx_hidden_Status   *VirtualMachineSnapshotStatus `protobuf:"bytes,4,opt,name=status,proto3"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshot) Reset() {
+	*x = VirtualMachineSnapshot{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshot) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshot) GetId() string {
+	if x != nil {
+		return x.xxx_hidden_Id
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshot) GetMetadata() *v1.Metadata {
+	if x != nil {
+		return x.xxx_hidden_Metadata
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) GetSpec() *VirtualMachineSnapshotSpec {
+	if x != nil {
+		return x.xxx_hidden_Spec
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) GetStatus() *VirtualMachineSnapshotStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) SetId(v string) {
+	x.xxx_hidden_Id = v
+}
+
+func (x *VirtualMachineSnapshot) SetMetadata(v *v1.Metadata) {
+	x.xxx_hidden_Metadata = v
+}
+
+func (x *VirtualMachineSnapshot) SetSpec(v *VirtualMachineSnapshotSpec) {
+	x.xxx_hidden_Spec = v
+}
+
+func (x *VirtualMachineSnapshot) SetStatus(v *VirtualMachineSnapshotStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *VirtualMachineSnapshot) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Metadata != nil
+}
+
+func (x *VirtualMachineSnapshot) HasSpec() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Spec != nil
+}
+
+func (x *VirtualMachineSnapshot) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
+func (x *VirtualMachineSnapshot) ClearMetadata() {
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *VirtualMachineSnapshot) ClearSpec() {
+	x.xxx_hidden_Spec = nil
+}
+
+func (x *VirtualMachineSnapshot) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
+type VirtualMachineSnapshot_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the snapshot.
+	Id       string
+	Metadata *v1.Metadata
+	Spec     *VirtualMachineSnapshotSpec
+	Status   *VirtualMachineSnapshotStatus
+}
+
+func (b0 VirtualMachineSnapshot_builder) Build() *VirtualMachineSnapshot {
+	m0 := &VirtualMachineSnapshot{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Id = b.Id
+	x.xxx_hidden_Metadata = b.Metadata
+	x.xxx_hidden_Spec = b.Spec
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+// The spec contains the details of a snapshot as desired by the user.
+type VirtualMachineSnapshotSpec struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_VirtualMachine     string                 `protobuf:"bytes,1,opt,name=virtual_machine,json=virtualMachine,proto3"`
+	xxx_hidden_RestoreRequestTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=restore_request_time,json=restoreRequestTime,proto3"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotSpec) Reset() {
+	*x = VirtualMachineSnapshotSpec{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotSpec) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotSpec) GetVirtualMachine() string {
+	if x != nil {
+		return x.xxx_hidden_VirtualMachine
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotSpec) GetRestoreRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_RestoreRequestTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotSpec) SetVirtualMachine(v string) {
+	x.xxx_hidden_VirtualMachine = v
+}
+
+func (x *VirtualMachineSnapshotSpec) SetRestoreRequestTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_RestoreRequestTime = v
+}
+
+func (x *VirtualMachineSnapshotSpec) HasRestoreRequestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_RestoreRequestTime != nil
+}
+
+func (x *VirtualMachineSnapshotSpec) ClearRestoreRequestTime() {
+	x.xxx_hidden_RestoreRequestTime = nil
+}
+
+type VirtualMachineSnapshotSpec_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Reference to the virtual machine.
+	//
+	// This is mandatory, and must be the value of the `id` field of one of the virtual machines.
+	//
+	// This can't be modified after the snapshot is created.
+	VirtualMachine string
+	// Time of the last restore requested for the snapshot.
+	//
+	// Changing this to a time later than the previous value reverts the virtual machine to the state captured by the
+	// snapshot. Usually this will not be modified directly, but using the `Restore` method of the virtual machine
+	// snapshots service.
+	RestoreRequestTime *timestamppb.Timestamp
+}
+
+func (b0 VirtualMachineSnapshotSpec_builder) Build() *VirtualMachineSnapshotSpec {
+	m0 := &VirtualMachineSnapshotSpec{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_VirtualMachine = b.VirtualMachine
+	x.xxx_hidden_RestoreRequestTime = b.RestoreRequestTime
+	return m0
+}
+
+// The status contains the details of the snapshot provided by the system.
+type VirtualMachineSnapshotStatus struct {
+	state                      protoimpl.MessageState             `protogen:"opaque.v1"`
+	xxx_hidden_State           VirtualMachineSnapshotState        `protobuf:"varint,1,opt,name=state,proto3,enum=fulfillment.v1.VirtualMachineSnapshotState"`
+	xxx_hidden_CreationTime    *timestamppb.Timestamp             `protobuf:"bytes,2,opt,name=creation_time,json=creationTime,proto3"`
+	xxx_hidden_RestoreState    VirtualMachineSnapshotRestoreState `protobuf:"varint,3,opt,name=restore_state,json=restoreState,proto3,enum=fulfillment.v1.VirtualMachineSnapshotRestoreState"`
+	xxx_hidden_LastRestoreTime *timestamppb.Timestamp             `protobuf:"bytes,4,opt,name=last_restore_time,json=lastRestoreTime,proto3"`
+	xxx_hidden_Message         string                             `protobuf:"bytes,5,opt,name=message,proto3"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotStatus) Reset() {
+	*x = VirtualMachineSnapshotStatus{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotStatus) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotStatus) GetState() VirtualMachineSnapshotState {
+	if x != nil {
+		return x.xxx_hidden_State
+	}
+	return VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED
+}
+
+func (x *VirtualMachineSnapshotStatus) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreationTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotStatus) GetRestoreState() VirtualMachineSnapshotRestoreState {
+	if x != nil {
+		return x.xxx_hidden_RestoreState
+	}
+	return VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED
+}
+
+func (x *VirtualMachineSnapshotStatus) GetLastRestoreTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastRestoreTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotStatus) GetMessage() string {
+	if x != nil {
+		return x.xxx_hidden_Message
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotStatus) SetState(v VirtualMachineSnapshotState) {
+	x.xxx_hidden_State = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetCreationTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreationTime = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetRestoreState(v VirtualMachineSnapshotRestoreState) {
+	x.xxx_hidden_RestoreState = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetLastRestoreTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastRestoreTime = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetMessage(v string) {
+	x.xxx_hidden_Message = v
+}
+
+func (x *VirtualMachineSnapshotStatus) HasCreationTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreationTime != nil
+}
+
+func (x *VirtualMachineSnapshotStatus) HasLastRestoreTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastRestoreTime != nil
+}
+
+func (x *VirtualMachineSnapshotStatus) ClearCreationTime() {
+	x.xxx_hidden_CreationTime = nil
+}
+
+func (x *VirtualMachineSnapshotStatus) ClearLastRestoreTime() {
+	x.xxx_hidden_LastRestoreTime = nil
+}
+
+type VirtualMachineSnapshotStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Indicates the overall state of the snapshot.
+	State VirtualMachineSnapshotState
+	// Time when the snapshot was taken.
+	//
+	// This will be empty if the snapshot isn't ready.
+	CreationTime *timestamppb.Timestamp
+	// Indicates the state of the last restore of the snapshot.
+	//
+	// This will be `UNSPECIFIED` if the snapshot has never been restored.
+	RestoreState VirtualMachineSnapshotRestoreState
+	// Value of the `spec.restore_request_time` field when the last restore was started.
+	LastRestoreTime *timestamppb.Timestamp
+	// Contains a text giving more details of the state of the snapshot, for example the reason why it failed.
+	//
+	// This is intended for use by humans, to debug problems.
+	Message string
+}
+
+func (b0 VirtualMachineSnapshotStatus_builder) Build() *VirtualMachineSnapshotStatus {
+	m0 := &VirtualMachineSnapshotStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_State = b.State
+	x.xxx_hidden_CreationTime = b.CreationTime
+	x.xxx_hidden_RestoreState = b.RestoreState
+	x.xxx_hidden_LastRestoreTime = b.LastRestoreTime
+	x.xxx_hidden_Message = b.Message
+	return m0
+}
+
+var File_fulfillment_v1_virtual_machine_snapshot_type_proto protoreflect.FileDescriptor
+
+var file_fulfillment_v1_virtual_machine_snapshot_type_proto_rawDesc = string([]byte{
+	0x0a, 0x32, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x76, 0x31,
+	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdf, 0x01, 0x0a, 0x16, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x3e, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x4c,
+	0x0a, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xdd, 0x02, 0x0a,
+	0x1c, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x57, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xd2, 0x01, 0x0a,
+	0x1b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x2a,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41,
+	0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48,
+	0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0xfd, 0x01, 0x0a, 0x22, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x32, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x36, 0x0a, 0x32, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48,
+	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
+	0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x34, 0x0a, 0x30, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x31,
+	0x0a, 0x2d, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
+	0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f,
+	0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x42, 0xe0, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x1f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x46, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_fulfillment_v1_virtual_machine_snapshot_type_proto_goTypes = []any{
+	(VirtualMachineSnapshotState)(0),        // 0: fulfillment.v1.VirtualMachineSnapshotState
+	(VirtualMachineSnapshotRestoreState)(0), // 1: fulfillment.v1.VirtualMachineSnapshotRestoreState
+	(*VirtualMachineSnapshot)(nil),          // 2: fulfillment.v1.VirtualMachineSnapshot
+	(*VirtualMachineSnapshotSpec)(nil),      // 3: fulfillment.v1.VirtualMachineSnapshotSpec
+	(*VirtualMachineSnapshotStatus)(nil),    // 4: fulfillment.v1.VirtualMachineSnapshotStatus
+	(*v1.Metadata)(nil),                     // 5: shared.v1.Metadata
+	(*timestamppb.Timestamp)(nil),           // 6: google.protobuf.Timestamp
+}
+var file_fulfillment_v1_virtual_machine_snapshot_type_proto_depIdxs = []int32{
+	5, // 0: fulfillment.v1.VirtualMachineSnapshot.metadata:type_name -> shared.v1.Metadata
+	3, // 1: fulfillment.v1.VirtualMachineSnapshot.spec:type_name -> fulfillment.v1.VirtualMachineSnapshotSpec
+	4, // 2: fulfillment.v1.VirtualMachineSnapshot.status:type_name -> fulfillment.v1.VirtualMachineSnapshotStatus
+	6, // 3: fulfillment.v1.VirtualMachineSnapshotSpec.restore_request_time:type_name -> google.protobuf.Timestamp
+	0, // 4: fulfillment.v1.VirtualMachineSnapshotStatus.state:type_name -> fulfillment.v1.VirtualMachineSnapshotState
+	6, // 5: fulfillment.v1.VirtualMachineSnapshotStatus.creation_time:type_name -> google.protobuf.Timestamp
+	1, // 6: fulfillment.v1.VirtualMachineSnapshotStatus.restore_state:type_name -> fulfillment.v1.VirtualMachineSnapshotRestoreState
+	6, // 7: fulfillment.v1.VirtualMachineSnapshotStatus.last_restore_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_virtual_machine_snapshot_type_proto_init() }
+func file_fulfillment_v1_virtual_machine_snapshot_type_proto_init() {
+	if File_fulfillment_v1_virtual_machine_snapshot_type_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_virtual_machine_snapshot_type_proto_rawDesc), len(file_fulfillment_v1_virtual_machine_snapshot_type_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fulfillment_v1_virtual_machine_snapshot_type_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_virtual_machine_snapshot_type_proto_depIdxs,
+		EnumInfos:         file_fulfillment_v1_virtual_machine_snapshot_type_proto_enumTypes,
+		MessageInfos:      file_fulfillment_v1_virtual_machine_snapshot_type_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_virtual_machine_snapshot_type_proto = out.File
+	file_fulfillment_v1_virtual_machine_snapshot_type_proto_goTypes = nil
+	file_fulfillment_v1_virtual_machine_snapshot_type_proto_depIdxs = nil
+}
@@ -0,0 +1,1151 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: fulfillment/v1/virtual_machine_snapshots_service.proto
+
+//go:build !protoopaque
+
+package fulfillmentv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VirtualMachineSnapshotsListRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Index of the first result. If not specified the default value will be zero.
+	Offset *int32 `protobuf:"varint,1,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// Maximum number of results to be returned by the server. When not specified all the results will be returned. Note
+	// that there may not be enough results to return, and that the server may decide, for performance reasons, to return
+	// less results than requested.
+	Limit *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Filter criteria.
+	//
+	// The syntax of this parameter is similar to the syntax of the _where_ clause of a SQL statement, but using the names
+	// of the attributes of the snapshot instead of the names of the columns of a table. For example, in order to retrieve
+	// all the snapshots of the virtual machine with identifier `123` the value should be:
+	//
+	//	this.spec.virtual_machine == '123'
+	//
+	// If this isn't provided, or if the value is empty, then all the snapshots that the user has permission to see will be
+	// returned.
+	Filter *string `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Order criteria.
+	//
+	// The syntax of this parameter is similar to the syntax of the _order by_ clause of a SQL statement, but using the
+	// names of the attributes of the snapshot instead of the names of the columns of a table. For example, in order to
+	// sort the snapshots descending by creation time the value should be:
+	//
+	//	this.status.creation_time desc
+	//
+	// Results are always sorted by identifier after the requested criteria, so that the order is stable. If the parameter
+	// isn't provided, or if the value is empty, then the results will be sorted only by identifier.
+	Order *string `protobuf:"bytes,4,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	// Token of the page to retrieve. This should be the value of the `next_page_token` field of the response to a
+	// previous request with the same filter and order criteria, otherwise the request will be rejected. When this is
+	// used the `offset` parameter is ignored, and the results start right after the last item of the previous page,
+	// even if other items have been added or removed since then.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// Indicates if the server should skip calculating the total number of items. Calculating the total may be
+	// expensive for large collections, so clients that don't need it can set this to `true`. In that case the `total`
+	// field of the response will not be populated.
+	SkipTotal     *bool `protobuf:"varint,6,opt,name=skip_total,json=skipTotal,proto3,oneof" json:"skip_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsListRequest) Reset() {
+	*x = VirtualMachineSnapshotsListRequest{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsListRequest) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetOrder() string {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsListRequest) GetSkipTotal() bool {
+	if x != nil && x.SkipTotal != nil {
+		return *x.SkipTotal
+	}
+	return false
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetOffset(v int32) {
+	x.Offset = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetLimit(v int32) {
+	x.Limit = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetFilter(v string) {
+	x.Filter = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetOrder(v string) {
+	x.Order = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetPageToken(v string) {
+	x.PageToken = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) SetSkipTotal(v bool) {
+	x.SkipTotal = &v
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return x.Offset != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return x.Limit != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.Filter != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasOrder() bool {
+	if x == nil {
+		return false
+	}
+	return x.Order != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return x.PageToken != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) HasSkipTotal() bool {
+	if x == nil {
+		return false
+	}
+	return x.SkipTotal != nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearOffset() {
+	x.Offset = nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearLimit() {
+	x.Limit = nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearFilter() {
+	x.Filter = nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearOrder() {
+	x.Order = nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearPageToken() {
+	x.PageToken = nil
+}
+
+func (x *VirtualMachineSnapshotsListRequest) ClearSkipTotal() {
+	x.SkipTotal = nil
+}
+
+type VirtualMachineSnapshotsListRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Index of the first result. If not specified the default value will be zero.
+	Offset *int32
+	// Maximum number of results to be returned by the server. When not specified all the results will be returned. Note
+	// that there may not be enough results to return, and that the server may decide, for performance reasons, to return
+	// less results than requested.
+	Limit *int32
+	// Filter criteria.
+	//
+	// The syntax of this parameter is similar to the syntax of the _where_ clause of a SQL statement, but using the names
+	// of the attributes of the snapshot instead of the names of the columns of a table. For example, in order to retrieve
+	// all the snapshots of the virtual machine with identifier `123` the value should be:
+	//
+	//	this.spec.virtual_machine == '123'
+	//
+	// If this isn't provided, or if the value is empty, then all the snapshots that the user has permission to see will be
+	// returned.
+	Filter *string
+	// Order criteria.
+	//
+	// The syntax of this parameter is similar to the syntax of the _order by_ clause of a SQL statement, but using the
+	// names of the attributes of the snapshot instead of the names of the columns of a table. For example, in order to
+	// sort the snapshots descending by creation time the value should be:
+	//
+	//	this.status.creation_time desc
+	//
+	// Results are always sorted by identifier after the requested criteria, so that the order is stable. If the parameter
+	// isn't provided, or if the value is empty, then the results will be sorted only by identifier.
+	Order *string
+	// Token of the page to retrieve. This should be the value of the `next_page_token` field of the response to a
+	// previous request with the same filter and order criteria, otherwise the request will be rejected. When this is
+	// used the `offset` parameter is ignored, and the results start right after the last item of the previous page,
+	// even if other items have been added or removed since then.
+	PageToken *string
+	// Indicates if the server should skip calculating the total number of items. Calculating the total may be
+	// expensive for large collections, so clients that don't need it can set this to `true`. In that case the `total`
+	// field of the response will not be populated.
+	SkipTotal *bool
+}
+
+func (b0 VirtualMachineSnapshotsListRequest_builder) Build() *VirtualMachineSnapshotsListRequest {
+	m0 := &VirtualMachineSnapshotsListRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Offset = b.Offset
+	x.Limit = b.Limit
+	x.Filter = b.Filter
+	x.Order = b.Order
+	x.PageToken = b.PageToken
+	x.SkipTotal = b.SkipTotal
+	return m0
+}
+
+type VirtualMachineSnapshotsListResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Actual number of items returned. Note that this may be smaller than the value requested in the `limit` parameter
+	// of the request if there are not enough items, or of the system decides that returning that number of items isn't
+	// feasible or convenient for performance reasons.
+	Size *int32 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	// Total number of items of the collection that match the search criteria, regardless of the number of results
+	// requested with the `limit` parameter.
+	Total *int32 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	// List of results.
+	Items []*VirtualMachineSnapshot `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// Token that can be used in the `page_token` parameter of the request to retrieve the next page of results. This
+	// will be empty when there are no more results.
+	NextPageToken *string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsListResponse) Reset() {
+	*x = VirtualMachineSnapshotsListResponse{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsListResponse) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsListResponse) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *VirtualMachineSnapshotsListResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *VirtualMachineSnapshotsListResponse) GetItems() []*VirtualMachineSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsListResponse) SetSize(v int32) {
+	x.Size = &v
+}
+
+func (x *VirtualMachineSnapshotsListResponse) SetTotal(v int32) {
+	x.Total = &v
+}
+
+func (x *VirtualMachineSnapshotsListResponse) SetItems(v []*VirtualMachineSnapshot) {
+	x.Items = v
+}
+
+func (x *VirtualMachineSnapshotsListResponse) SetNextPageToken(v string) {
+	x.NextPageToken = &v
+}
+
+func (x *VirtualMachineSnapshotsListResponse) HasSize() bool {
+	if x == nil {
+		return false
+	}
+	return x.Size != nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) HasTotal() bool {
+	if x == nil {
+		return false
+	}
+	return x.Total != nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return x.NextPageToken != nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) ClearSize() {
+	x.Size = nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) ClearTotal() {
+	x.Total = nil
+}
+
+func (x *VirtualMachineSnapshotsListResponse) ClearNextPageToken() {
+	x.NextPageToken = nil
+}
+
+type VirtualMachineSnapshotsListResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Actual number of items returned. Note that this may be smaller than the value requested in the `limit` parameter
+	// of the request if there are not enough items, or of the system decides that returning that number of items isn't
+	// feasible or convenient for performance reasons.
+	Size *int32
+	// Total number of items of the collection that match the search criteria, regardless of the number of results
+	// requested with the `limit` parameter.
+	Total *int32
+	// List of results.
+	Items []*VirtualMachineSnapshot
+	// Token that can be used in the `page_token` parameter of the request to retrieve the next page of results. This
+	// will be empty when there are no more results.
+	NextPageToken *string
+}
+
+func (b0 VirtualMachineSnapshotsListResponse_builder) Build() *VirtualMachineSnapshotsListResponse {
+	m0 := &VirtualMachineSnapshotsListResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Size = b.Size
+	x.Total = b.Total
+	x.Items = b.Items
+	x.NextPageToken = b.NextPageToken
+	return m0
+}
+
+type VirtualMachineSnapshotsGetRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsGetRequest) Reset() {
+	*x = VirtualMachineSnapshotsGetRequest{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsGetRequest) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsGetRequest) SetId(v string) {
+	x.Id = v
+}
+
+type VirtualMachineSnapshotsGetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 VirtualMachineSnapshotsGetRequest_builder) Build() *VirtualMachineSnapshotsGetRequest {
+	m0 := &VirtualMachineSnapshotsGetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type VirtualMachineSnapshotsGetResponse struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Object        *VirtualMachineSnapshot `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) Reset() {
+	*x = VirtualMachineSnapshotsGetResponse{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsGetResponse) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) GetObject() *VirtualMachineSnapshot {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) SetObject(v *VirtualMachineSnapshot) {
+	x.Object = v
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *VirtualMachineSnapshotsGetResponse) ClearObject() {
+	x.Object = nil
+}
+
+type VirtualMachineSnapshotsGetResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *VirtualMachineSnapshot
+}
+
+func (b0 VirtualMachineSnapshotsGetResponse_builder) Build() *VirtualMachineSnapshotsGetResponse {
+	m0 := &VirtualMachineSnapshotsGetResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type VirtualMachineSnapshotsCreateRequest struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Object        *VirtualMachineSnapshot `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) Reset() {
+	*x = VirtualMachineSnapshotsCreateRequest{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsCreateRequest) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) GetObject() *VirtualMachineSnapshot {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) SetObject(v *VirtualMachineSnapshot) {
+	x.Object = v
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *VirtualMachineSnapshotsCreateRequest) ClearObject() {
+	x.Object = nil
+}
+
+type VirtualMachineSnapshotsCreateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *VirtualMachineSnapshot
+}
+
+func (b0 VirtualMachineSnapshotsCreateRequest_builder) Build() *VirtualMachineSnapshotsCreateRequest {
+	m0 := &VirtualMachineSnapshotsCreateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type VirtualMachineSnapshotsCreateResponse struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Object        *VirtualMachineSnapshot `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) Reset() {
+	*x = VirtualMachineSnapshotsCreateResponse{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsCreateResponse) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) GetObject() *VirtualMachineSnapshot {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) SetObject(v *VirtualMachineSnapshot) {
+	x.Object = v
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *VirtualMachineSnapshotsCreateResponse) ClearObject() {
+	x.Object = nil
+}
+
+type VirtualMachineSnapshotsCreateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *VirtualMachineSnapshot
+}
+
+func (b0 VirtualMachineSnapshotsCreateResponse_builder) Build() *VirtualMachineSnapshotsCreateResponse {
+	m0 := &VirtualMachineSnapshotsCreateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type VirtualMachineSnapshotsDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsDeleteRequest) Reset() {
+	*x = VirtualMachineSnapshotsDeleteRequest{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsDeleteRequest) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsDeleteRequest) SetId(v string) {
+	x.Id = v
+}
+
+type VirtualMachineSnapshotsDeleteRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 VirtualMachineSnapshotsDeleteRequest_builder) Build() *VirtualMachineSnapshotsDeleteRequest {
+	m0 := &VirtualMachineSnapshotsDeleteRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type VirtualMachineSnapshotsDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsDeleteResponse) Reset() {
+	*x = VirtualMachineSnapshotsDeleteResponse{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsDeleteResponse) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type VirtualMachineSnapshotsDeleteResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 VirtualMachineSnapshotsDeleteResponse_builder) Build() *VirtualMachineSnapshotsDeleteResponse {
+	m0 := &VirtualMachineSnapshotsDeleteResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type VirtualMachineSnapshotsRestoreRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsRestoreRequest) Reset() {
+	*x = VirtualMachineSnapshotsRestoreRequest{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsRestoreRequest) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsRestoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotsRestoreRequest) SetId(v string) {
+	x.Id = v
+}
+
+type VirtualMachineSnapshotsRestoreRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 VirtualMachineSnapshotsRestoreRequest_builder) Build() *VirtualMachineSnapshotsRestoreRequest {
+	m0 := &VirtualMachineSnapshotsRestoreRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type VirtualMachineSnapshotsRestoreResponse struct {
+	state         protoimpl.MessageState  `protogen:"hybrid.v1"`
+	Object        *VirtualMachineSnapshot `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) Reset() {
+	*x = VirtualMachineSnapshotsRestoreResponse{}
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotsRestoreResponse) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) GetObject() *VirtualMachineSnapshot {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) SetObject(v *VirtualMachineSnapshot) {
+	x.Object = v
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *VirtualMachineSnapshotsRestoreResponse) ClearObject() {
+	x.Object = nil
+}
+
+type VirtualMachineSnapshotsRestoreResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *VirtualMachineSnapshot
+}
+
+func (b0 VirtualMachineSnapshotsRestoreResponse_builder) Build() *VirtualMachineSnapshotsRestoreResponse {
+	m0 := &VirtualMachineSnapshotsRestoreResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+var File_fulfillment_v1_virtual_machine_snapshots_service_proto protoreflect.FileDescriptor
+
+var file_fulfillment_v1_virtual_machine_snapshots_service_proto_rawDesc = string([]byte{
+	0x0a, 0x36, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x32, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x02, 0x0a, 0x22, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x09, 0x73, 0x6b, 0x69,
+	0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0xeb, 0x01, 0x0a, 0x23, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x33, 0x0a, 0x21, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x22, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x24, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x22, 0x67, 0x0a, 0x25, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x36, 0x0a, 0x24, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x25, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x25,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x68, 0x0a, 0x26, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x32,
+	0xaf, 0x07, 0x0a, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x31, 0x2e, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x62, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbc, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x34, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x3a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x62,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0xb1, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x34, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc4, 0x01, 0x0a, 0x07, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x62, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x42, 0xe4, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x23, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61,
+	0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x46, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x46, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_fulfillment_v1_virtual_machine_snapshots_service_proto_goTypes = []any{
+	(*VirtualMachineSnapshotsListRequest)(nil),     // 0: fulfillment.v1.VirtualMachineSnapshotsListRequest
+	(*VirtualMachineSnapshotsListResponse)(nil),    // 1: fulfillment.v1.VirtualMachineSnapshotsListResponse
+	(*VirtualMachineSnapshotsGetRequest)(nil),      // 2: fulfillment.v1.VirtualMachineSnapshotsGetRequest
+	(*VirtualMachineSnapshotsGetResponse)(nil),     // 3: fulfillment.v1.VirtualMachineSnapshotsGetResponse
+	(*VirtualMachineSnapshotsCreateRequest)(nil),   // 4: fulfillment.v1.VirtualMachineSnapshotsCreateRequest
+	(*VirtualMachineSnapshotsCreateResponse)(nil),  // 5: fulfillment.v1.VirtualMachineSnapshotsCreateResponse
+	(*VirtualMachineSnapshotsDeleteRequest)(nil),   // 6: fulfillment.v1.VirtualMachineSnapshotsDeleteRequest
+	(*VirtualMachineSnapshotsDeleteResponse)(nil),  // 7: fulfillment.v1.VirtualMachineSnapshotsDeleteResponse
+	(*VirtualMachineSnapshotsRestoreRequest)(nil),  // 8: fulfillment.v1.VirtualMachineSnapshotsRestoreRequest
+	(*VirtualMachineSnapshotsRestoreResponse)(nil), // 9: fulfillment.v1.VirtualMachineSnapshotsRestoreResponse
+	(*VirtualMachineSnapshot)(nil),                 // 10: fulfillment.v1.VirtualMachineSnapshot
+}
+var file_fulfillment_v1_virtual_machine_snapshots_service_proto_depIdxs = []int32{
+	10, // 0: fulfillment.v1.VirtualMachineSnapshotsListResponse.items:type_name -> fulfillment.v1.VirtualMachineSnapshot
+	10, // 1: fulfillment.v1.VirtualMachineSnapshotsGetResponse.object:type_name -> fulfillment.v1.VirtualMachineSnapshot
+	10, // 2: fulfillment.v1.VirtualMachineSnapshotsCreateRequest.object:type_name -> fulfillment.v1.VirtualMachineSnapshot
+	10, // 3: fulfillment.v1.VirtualMachineSnapshotsCreateResponse.object:type_name -> fulfillment.v1.VirtualMachineSnapshot
+	10, // 4: fulfillment.v1.VirtualMachineSnapshotsRestoreResponse.object:type_name -> fulfillment.v1.VirtualMachineSnapshot
+	0,  // 5: fulfillment.v1.VirtualMachineSnapshots.List:input_type -> fulfillment.v1.VirtualMachineSnapshotsListRequest
+	2,  // 6: fulfillment.v1.VirtualMachineSnapshots.Get:input_type -> fulfillment.v1.VirtualMachineSnapshotsGetRequest
+	4,  // 7: fulfillment.v1.VirtualMachineSnapshots.Create:input_type -> fulfillment.v1.VirtualMachineSnapshotsCreateRequest
+	6,  // 8: fulfillment.v1.VirtualMachineSnapshots.Delete:input_type -> fulfillment.v1.VirtualMachineSnapshotsDeleteRequest
+	8,  // 9: fulfillment.v1.VirtualMachineSnapshots.Restore:input_type -> fulfillment.v1.VirtualMachineSnapshotsRestoreRequest
+	1,  // 10: fulfillment.v1.VirtualMachineSnapshots.List:output_type -> fulfillment.v1.VirtualMachineSnapshotsListResponse
+	3,  // 11: fulfillment.v1.VirtualMachineSnapshots.Get:output_type -> fulfillment.v1.VirtualMachineSnapshotsGetResponse
+	5,  // 12: fulfillment.v1.VirtualMachineSnapshots.Create:output_type -> fulfillment.v1.VirtualMachineSnapshotsCreateResponse
+	7,  // 13: fulfillment.v1.VirtualMachineSnapshots.Delete:output_type -> fulfillment.v1.VirtualMachineSnapshotsDeleteResponse
+	9,  // 14: fulfillment.v1.VirtualMachineSnapshots.Restore:output_type -> fulfillment.v1.VirtualMachineSnapshotsRestoreResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_fulfillment_v1_virtual_machine_snapshots_service_proto_init() }
+func file_fulfillment_v1_virtual_machine_snapshots_service_proto_init() {
+	if File_fulfillment_v1_virtual_machine_snapshots_service_proto != nil {
+		return
+	}
+	file_fulfillment_v1_virtual_machine_snapshot_type_proto_init()
+	file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_virtual_machine_snapshots_service_proto_rawDesc), len(file_fulfillment_v1_virtual_machine_snapshots_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fulfillment_v1_virtual_machine_snapshots_service_proto_goTypes,
+		DependencyIndexes: file_fulfillment_v1_virtual_machine_snapshots_service_proto_depIdxs,
+		MessageInfos:      file_fulfillment_v1_virtual_machine_snapshots_service_proto_msgTypes,
+	}.Build()
+	File_fulfillment_v1_virtual_machine_snapshots_service_proto = out.File
+	file_fulfillment_v1_virtual_machine_snapshots_service_proto_goTypes = nil
+	file_fulfillment_v1_virtual_machine_snapshots_service_proto_depIdxs = nil
+}
@@ -90,6 +90,124 @@ func (x VirtualMachineState) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+// Indicates when a virtual machine should be running.
+type VirtualMachineRunStrategy int32
+
+const (
+	// Unspecified indicates that the run strategy defined by the template will be used.
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED VirtualMachineRunStrategy = 0
+	// Indicates that the virtual machine should always be running. If it stops, or if it is shut down from inside
+	// the guest operating system, it will be started again.
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS VirtualMachineRunStrategy = 1
+	// Indicates that the virtual machine should be stopped.
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED VirtualMachineRunStrategy = 2
+	// Indicates that the virtual machine will not be started or stopped automatically.
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL VirtualMachineRunStrategy = 3
+	// Indicates that the virtual machine should be started again if it fails, but not if it is shut down from inside
+	// the guest operating system.
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE VirtualMachineRunStrategy = 4
+)
+
+// Enum value maps for VirtualMachineRunStrategy.
+var (
+	VirtualMachineRunStrategy_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS",
+		2: "VIRTUAL_MACHINE_RUN_STRATEGY_HALTED",
+		3: "VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL",
+		4: "VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE",
+	}
+	VirtualMachineRunStrategy_value = map[string]int32{
+		"VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED":      0,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS":           1,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_HALTED":           2,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL":           3,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE": 4,
+	}
+)
+
+func (x VirtualMachineRunStrategy) Enum() *VirtualMachineRunStrategy {
+	p := new(VirtualMachineRunStrategy)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineRunStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineRunStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_virtual_machine_type_proto_enumTypes[1].Descriptor()
+}
+
+func (VirtualMachineRunStrategy) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_virtual_machine_type_proto_enumTypes[1]
+}
+
+func (x VirtualMachineRunStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Represents the power state of a virtual machine.
+type VirtualMachinePowerState int32
+
+const (
+	// Unspecified indicates that the power state is unknown.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED VirtualMachinePowerState = 0
+	// Indicates that the virtual machine is being started.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STARTING VirtualMachinePowerState = 1
+	// Indicates that the virtual machine is running.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_RUNNING VirtualMachinePowerState = 2
+	// Indicates that the virtual machine is running, but its execution has been paused.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_PAUSED VirtualMachinePowerState = 3
+	// Indicates that the virtual machine is being stopped.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPING VirtualMachinePowerState = 4
+	// Indicates that the virtual machine is stopped.
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPED VirtualMachinePowerState = 5
+)
+
+// Enum value maps for VirtualMachinePowerState.
+var (
+	VirtualMachinePowerState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_POWER_STATE_STARTING",
+		2: "VIRTUAL_MACHINE_POWER_STATE_RUNNING",
+		3: "VIRTUAL_MACHINE_POWER_STATE_PAUSED",
+		4: "VIRTUAL_MACHINE_POWER_STATE_STOPPING",
+		5: "VIRTUAL_MACHINE_POWER_STATE_STOPPED",
+	}
+	VirtualMachinePowerState_value = map[string]int32{
+		"VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_POWER_STATE_STARTING":    1,
+		"VIRTUAL_MACHINE_POWER_STATE_RUNNING":     2,
+		"VIRTUAL_MACHINE_POWER_STATE_PAUSED":      3,
+		"VIRTUAL_MACHINE_POWER_STATE_STOPPING":    4,
+		"VIRTUAL_MACHINE_POWER_STATE_STOPPED":     5,
+	}
+)
+
+func (x VirtualMachinePowerState) Enum() *VirtualMachinePowerState {
+	p := new(VirtualMachinePowerState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachinePowerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachinePowerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_fulfillment_v1_virtual_machine_type_proto_enumTypes[2].Descriptor()
+}
+
+func (VirtualMachinePowerState) Type() protoreflect.EnumType {
+	return &file_fulfillment_v1_virtual_machine_type_proto_enumTypes[2]
+}
+
+func (x VirtualMachinePowerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // Types of conditions used to describe the status of virtual machine.
 type VirtualMachineConditionType int32
 
@@ -143,11 +261,11 @@ func (x VirtualMachineConditionType) String() string {
 }
 
 func (VirtualMachineConditionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_fulfillment_v1_virtual_machine_type_proto_enumTypes[1].Descriptor()
+	return file_fulfillment_v1_virtual_machine_type_proto_enumTypes[3].Descriptor()
 }
 
 func (VirtualMachineConditionType) Type() protoreflect.EnumType {
-	return &file_fulfillment_v1_virtual_machine_type_proto_enumTypes[1]
+	return &file_fulfillment_v1_virtual_machine_type_proto_enumTypes[3]
 }
 
 func (x VirtualMachineConditionType) Number() protoreflect.EnumNumber {
@@ -340,8 +458,26 @@ type VirtualMachineSpec struct {
 	//
 	// These parameters can't be modified after the virtual machine is created.
 	TemplateParameters map[string]*anypb.Any `protobuf:"bytes,2,rep,name=template_parameters,json=templateParameters,proto3" json:"template_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Indicates when the virtual machine should be running.
+	//
+	// If this isn't specified the virtual machine will use the run strategy defined by the template, usually `ALWAYS`.
+	//
+	// This can be modified at any time in order to start or stop the virtual machine. The `Start` and `Stop` methods of
+	// the virtual machines service are a shortcut for that.
+	RunStrategy VirtualMachineRunStrategy `protobuf:"varint,3,opt,name=run_strategy,json=runStrategy,proto3,enum=fulfillment.v1.VirtualMachineRunStrategy" json:"run_strategy,omitempty"`
+	// Time of the last restart requested for the virtual machine.
+	//
+	// Changing this to a time later than the previous value restarts the virtual machine. Usually this will not be
+	// modified directly, but using the `Restart` method of the virtual machines service.
+	RestartRequestTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=restart_request_time,json=restartRequestTime,proto3" json:"restart_request_time,omitempty"`
+	// Indicates if the execution of the virtual machine should be paused.
+	//
+	// A paused virtual machine keeps its memory and its devices, but doesn't run. This only has effect while the virtual
+	// machine is running. Usually this will not be modified directly, but using the `Pause` and `Unpause` methods of
+	// the virtual machines service.
+	Paused        bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VirtualMachineSpec) Reset() {
@@ -383,6 +519,27 @@ func (x *VirtualMachineSpec) GetTemplateParameters() map[string]*anypb.Any {
 	return nil
 }
 
+func (x *VirtualMachineSpec) GetRunStrategy() VirtualMachineRunStrategy {
+	if x != nil {
+		return x.RunStrategy
+	}
+	return VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED
+}
+
+func (x *VirtualMachineSpec) GetRestartRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestartRequestTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSpec) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
 func (x *VirtualMachineSpec) SetTemplate(v string) {
 	x.Template = v
 }
@@ -391,6 +548,29 @@ func (x *VirtualMachineSpec) SetTemplateParameters(v map[string]*anypb.Any) {
 	x.TemplateParameters = v
 }
 
+func (x *VirtualMachineSpec) SetRunStrategy(v VirtualMachineRunStrategy) {
+	x.RunStrategy = v
+}
+
+func (x *VirtualMachineSpec) SetRestartRequestTime(v *timestamppb.Timestamp) {
+	x.RestartRequestTime = v
+}
+
+func (x *VirtualMachineSpec) SetPaused(v bool) {
+	x.Paused = v
+}
+
+func (x *VirtualMachineSpec) HasRestartRequestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.RestartRequestTime != nil
+}
+
+func (x *VirtualMachineSpec) ClearRestartRequestTime() {
+	x.RestartRequestTime = nil
+}
+
 type VirtualMachineSpec_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -439,6 +619,24 @@ type VirtualMachineSpec_builder struct {
 	//
 	// These parameters can't be modified after the virtual machine is created.
 	TemplateParameters map[string]*anypb.Any
+	// Indicates when the virtual machine should be running.
+	//
+	// If this isn't specified the virtual machine will use the run strategy defined by the template, usually `ALWAYS`.
+	//
+	// This can be modified at any time in order to start or stop the virtual machine. The `Start` and `Stop` methods of
+	// the virtual machines service are a shortcut for that.
+	RunStrategy VirtualMachineRunStrategy
+	// Time of the last restart requested for the virtual machine.
+	//
+	// Changing this to a time later than the previous value restarts the virtual machine. Usually this will not be
+	// modified directly, but using the `Restart` method of the virtual machines service.
+	RestartRequestTime *timestamppb.Timestamp
+	// Indicates if the execution of the virtual machine should be paused.
+	//
+	// A paused virtual machine keeps its memory and its devices, but doesn't run. This only has effect while the virtual
+	// machine is running. Usually this will not be modified directly, but using the `Pause` and `Unpause` methods of
+	// the virtual machines service.
+	Paused bool
 }
 
 func (b0 VirtualMachineSpec_builder) Build() *VirtualMachineSpec {
@@ -447,6 +645,9 @@ func (b0 VirtualMachineSpec_builder) Build() *VirtualMachineSpec {
 	_, _ = b, x
 	x.Template = b.Template
 	x.TemplateParameters = b.TemplateParameters
+	x.RunStrategy = b.RunStrategy
+	x.RestartRequestTime = b.RestartRequestTime
+	x.Paused = b.Paused
 	return m0
 }
 
@@ -495,7 +696,9 @@ type VirtualMachineStatus struct {
 	// IP address of the virtual machine.
 	//
 	// This will be empty if the virtual machine isn't ready.
-	IpAddress     string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Indicates the power state of the virtual machine as observed by the system.
+	PowerState    VirtualMachinePowerState `protobuf:"varint,4,opt,name=power_state,json=powerState,proto3,enum=fulfillment.v1.VirtualMachinePowerState" json:"power_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -546,6 +749,13 @@ func (x *VirtualMachineStatus) GetIpAddress() string {
 	return ""
 }
 
+func (x *VirtualMachineStatus) GetPowerState() VirtualMachinePowerState {
+	if x != nil {
+		return x.PowerState
+	}
+	return VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED
+}
+
 func (x *VirtualMachineStatus) SetState(v VirtualMachineState) {
 	x.State = v
 }
@@ -558,6 +768,10 @@ func (x *VirtualMachineStatus) SetIpAddress(v string) {
 	x.IpAddress = v
 }
 
+func (x *VirtualMachineStatus) SetPowerState(v VirtualMachinePowerState) {
+	x.PowerState = v
+}
+
 type VirtualMachineStatus_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -604,6 +818,8 @@ type VirtualMachineStatus_builder struct {
 	//
 	// This will be empty if the virtual machine isn't ready.
 	IpAddress string
+	// Indicates the power state of the virtual machine as observed by the system.
+	PowerState VirtualMachinePowerState
 }
 
 func (b0 VirtualMachineStatus_builder) Build() *VirtualMachineStatus {
@@ -613,6 +829,7 @@ func (b0 VirtualMachineStatus_builder) Build() *VirtualMachineStatus {
 	x.State = b.State
 	x.Conditions = b.Conditions
 	x.IpAddress = b.IpAddress
+	x.PowerState = b.PowerState
 	return m0
 }
 
@@ -808,7 +1025,7 @@ var file_fulfillment_v1_virtual_machine_type_proto_rawDesc = string([]byte{
 	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x75, 0x6c, 0x66,
 	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75,
 	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x12, 0x56, 0x69, 0x72, 0x74,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x03, 0x0a, 0x12, 0x56, 0x69, 0x72, 0x74,
 	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1a,
 	0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x6b, 0x0a, 0x13, 0x74, 0x65,
@@ -818,117 +1035,172 @@ var file_fulfillment_v1_virtual_machine_type_proto_rawDesc = string([]byte{
 	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x54, 0x65, 0x6d, 0x70,
 	0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x5b, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9, 0x01, 0x0a, 0x14, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0xaf, 0x02, 0x0a, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x75, 0x6c,
-	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2a, 0xa6, 0x01, 0x0a, 0x13, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
-	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x49,
-	0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43,
-	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
-	0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x49, 0x52, 0x54,
-	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x49, 0x52,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x5f, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x12, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x5b, 0x0a, 0x17, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x02, 0x0a, 0x14, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xaf, 0x02, 0x0a, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x66, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2a, 0xa6, 0x01, 0x0a, 0x13, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x49, 0x52,
 	0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xff, 0x01, 0x0a, 0x1b,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x2a, 0x56,
-	0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43,
-	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x56,
-	0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43,
-	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
-	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x56,
-	0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43,
-	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
-	0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03,
-	0x12, 0x2b, 0x0a, 0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48,
-	0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x04, 0x42, 0xd8, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61,
-	0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x76,
-	0x31, 0xa2, 0x02, 0x03, 0x46, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
-	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69,
-	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x46, 0x75, 0x6c, 0x66,
-	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
-	0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x25, 0x0a, 0x21, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48,
+	0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x49, 0x52, 0x54, 0x55,
+	0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xf7, 0x01, 0x0a, 0x19, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6e,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x28, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41,
+	0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12,
+	0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49,
+	0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
+	0x48, 0x41, 0x4c, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10,
+	0x03, 0x12, 0x31, 0x0a, 0x2d, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43,
+	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x52, 0x45, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x04, 0x2a, 0x95, 0x02, 0x0a, 0x18, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43,
+	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28,
+	0x0a, 0x24, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
+	0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45,
+	0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x26, 0x0a, 0x22, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43,
+	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x49, 0x52,
+	0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d,
+	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xff, 0x01, 0x0a,
+	0x1b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x2a,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41,
+	0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43,
+	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x04, 0x42, 0xd8,
+	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e,
+	0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66, 0x69,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x46, 0x75, 0x6c, 0x66,
+	0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x46, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x46, 0x75, 0x6c, 0x66, 0x69, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
-var file_fulfillment_v1_virtual_machine_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fulfillment_v1_virtual_machine_type_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_fulfillment_v1_virtual_machine_type_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_fulfillment_v1_virtual_machine_type_proto_goTypes = []any{
 	(VirtualMachineState)(0),         // 0: fulfillment.v1.VirtualMachineState
-	(VirtualMachineConditionType)(0), // 1: fulfillment.v1.VirtualMachineConditionType
-	(*VirtualMachine)(nil),           // 2: fulfillment.v1.VirtualMachine
-	(*VirtualMachineSpec)(nil),       // 3: fulfillment.v1.VirtualMachineSpec
-	(*VirtualMachineStatus)(nil),     // 4: fulfillment.v1.VirtualMachineStatus
-	(*VirtualMachineCondition)(nil),  // 5: fulfillment.v1.VirtualMachineCondition
-	nil,                              // 6: fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry
-	(*v1.Metadata)(nil),              // 7: shared.v1.Metadata
-	(v1.ConditionStatus)(0),          // 8: shared.v1.ConditionStatus
-	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                // 10: google.protobuf.Any
+	(VirtualMachineRunStrategy)(0),   // 1: fulfillment.v1.VirtualMachineRunStrategy
+	(VirtualMachinePowerState)(0),    // 2: fulfillment.v1.VirtualMachinePowerState
+	(VirtualMachineConditionType)(0), // 3: fulfillment.v1.VirtualMachineConditionType
+	(*VirtualMachine)(nil),           // 4: fulfillment.v1.VirtualMachine
+	(*VirtualMachineSpec)(nil),       // 5: fulfillment.v1.VirtualMachineSpec
+	(*VirtualMachineStatus)(nil),     // 6: fulfillment.v1.VirtualMachineStatus
+	(*VirtualMachineCondition)(nil),  // 7: fulfillment.v1.VirtualMachineCondition
+	nil,                              // 8: fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry
+	(*v1.Metadata)(nil),              // 9: shared.v1.Metadata
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(v1.ConditionStatus)(0),          // 11: shared.v1.ConditionStatus
+	(*anypb.Any)(nil),                // 12: google.protobuf.Any
 }
 var file_fulfillment_v1_virtual_machine_type_proto_depIdxs = []int32{
-	7,  // 0: fulfillment.v1.VirtualMachine.metadata:type_name -> shared.v1.Metadata
-	3,  // 1: fulfillment.v1.VirtualMachine.spec:type_name -> fulfillment.v1.VirtualMachineSpec
-	4,  // 2: fulfillment.v1.VirtualMachine.status:type_name -> fulfillment.v1.VirtualMachineStatus
-	6,  // 3: fulfillment.v1.VirtualMachineSpec.template_parameters:type_name -> fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry
-	0,  // 4: fulfillment.v1.VirtualMachineStatus.state:type_name -> fulfillment.v1.VirtualMachineState
-	5,  // 5: fulfillment.v1.VirtualMachineStatus.conditions:type_name -> fulfillment.v1.VirtualMachineCondition
-	1,  // 6: fulfillment.v1.VirtualMachineCondition.type:type_name -> fulfillment.v1.VirtualMachineConditionType
-	8,  // 7: fulfillment.v1.VirtualMachineCondition.status:type_name -> shared.v1.ConditionStatus
-	9,  // 8: fulfillment.v1.VirtualMachineCondition.last_transition_time:type_name -> google.protobuf.Timestamp
-	10, // 9: fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry.value:type_name -> google.protobuf.Any
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 0: fulfillment.v1.VirtualMachine.metadata:type_name -> shared.v1.Metadata
+	5,  // 1: fulfillment.v1.VirtualMachine.spec:type_name -> fulfillment.v1.VirtualMachineSpec
+	6,  // 2: fulfillment.v1.VirtualMachine.status:type_name -> fulfillment.v1.VirtualMachineStatus
+	8,  // 3: fulfillment.v1.VirtualMachineSpec.template_parameters:type_name -> fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry
+	1,  // 4: fulfillment.v1.VirtualMachineSpec.run_strategy:type_name -> fulfillment.v1.VirtualMachineRunStrategy
+	10, // 5: fulfillment.v1.VirtualMachineSpec.restart_request_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: fulfillment.v1.VirtualMachineStatus.state:type_name -> fulfillment.v1.VirtualMachineState
+	7,  // 7: fulfillment.v1.VirtualMachineStatus.conditions:type_name -> fulfillment.v1.VirtualMachineCondition
+	2,  // 8: fulfillment.v1.VirtualMachineStatus.power_state:type_name -> fulfillment.v1.VirtualMachinePowerState
+	3,  // 9: fulfillment.v1.VirtualMachineCondition.type:type_name -> fulfillment.v1.VirtualMachineConditionType
+	11, // 10: fulfillment.v1.VirtualMachineCondition.status:type_name -> shared.v1.ConditionStatus
+	10, // 11: fulfillment.v1.VirtualMachineCondition.last_transition_time:type_name -> google.protobuf.Timestamp
+	12, // 12: fulfillment.v1.VirtualMachineSpec.TemplateParametersEntry.value:type_name -> google.protobuf.Any
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_fulfillment_v1_virtual_machine_type_proto_init() }
@@ -942,7 +1214,7 @@ func file_fulfillment_v1_virtual_machine_type_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fulfillment_v1_virtual_machine_type_proto_rawDesc), len(file_fulfillment_v1_virtual_machine_type_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      4,
 			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
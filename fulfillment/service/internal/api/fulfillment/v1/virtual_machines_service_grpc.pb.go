@@ -32,11 +32,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VirtualMachines_List_FullMethodName   = "/fulfillment.v1.VirtualMachines/List"
-	VirtualMachines_Get_FullMethodName    = "/fulfillment.v1.VirtualMachines/Get"
-	VirtualMachines_Create_FullMethodName = "/fulfillment.v1.VirtualMachines/Create"
-	VirtualMachines_Update_FullMethodName = "/fulfillment.v1.VirtualMachines/Update"
-	VirtualMachines_Delete_FullMethodName = "/fulfillment.v1.VirtualMachines/Delete"
+	VirtualMachines_List_FullMethodName    = "/fulfillment.v1.VirtualMachines/List"
+	VirtualMachines_Get_FullMethodName     = "/fulfillment.v1.VirtualMachines/Get"
+	VirtualMachines_Create_FullMethodName  = "/fulfillment.v1.VirtualMachines/Create"
+	VirtualMachines_Update_FullMethodName  = "/fulfillment.v1.VirtualMachines/Update"
+	VirtualMachines_Delete_FullMethodName  = "/fulfillment.v1.VirtualMachines/Delete"
+	VirtualMachines_Start_FullMethodName   = "/fulfillment.v1.VirtualMachines/Start"
+	VirtualMachines_Stop_FullMethodName    = "/fulfillment.v1.VirtualMachines/Stop"
+	VirtualMachines_Restart_FullMethodName = "/fulfillment.v1.VirtualMachines/Restart"
+	VirtualMachines_Pause_FullMethodName   = "/fulfillment.v1.VirtualMachines/Pause"
+	VirtualMachines_Unpause_FullMethodName = "/fulfillment.v1.VirtualMachines/Unpause"
+	VirtualMachines_Console_FullMethodName = "/fulfillment.v1.VirtualMachines/Console"
 )
 
 // VirtualMachinesClient is the client API for VirtualMachines service.
@@ -78,6 +84,52 @@ type VirtualMachinesClient interface {
 	Update(ctx context.Context, in *VirtualMachinesUpdateRequest, opts ...grpc.CallOption) (*VirtualMachinesUpdateResponse, error)
 	// Delete a virtual machine.
 	Delete(ctx context.Context, in *VirtualMachinesDeleteRequest, opts ...grpc.CallOption) (*VirtualMachinesDeleteResponse, error)
+	// Starts a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `ALWAYS` and the `spec.paused` field to
+	// `false`. The virtual machine will be started asynchronously, check the `status.power_state` field to find out when
+	// it is running.
+	Start(ctx context.Context, in *VirtualMachinesStartRequest, opts ...grpc.CallOption) (*VirtualMachinesStartResponse, error)
+	// Stops a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `HALTED` and the `spec.paused` field to
+	// `false`. The virtual machine will be stopped asynchronously, check the `status.power_state` field to find out when
+	// it is stopped.
+	Stop(ctx context.Context, in *VirtualMachinesStopRequest, opts ...grpc.CallOption) (*VirtualMachinesStopResponse, error)
+	// Restarts a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `ALWAYS`, the `spec.restart_request_time`
+	// field to the current time and the `spec.paused` field to `false`. The virtual machine will be restarted
+	// asynchronously.
+	Restart(ctx context.Context, in *VirtualMachinesRestartRequest, opts ...grpc.CallOption) (*VirtualMachinesRestartResponse, error)
+	// Pauses a virtual machine.
+	//
+	// This changes the `spec.paused` field of the virtual machine to `true`. The execution of the virtual machine will be
+	// paused asynchronously, check the `status.power_state` field to find out when it is paused. Virtual machines that
+	// have been stopped can't be paused.
+	Pause(ctx context.Context, in *VirtualMachinesPauseRequest, opts ...grpc.CallOption) (*VirtualMachinesPauseResponse, error)
+	// Resumes the execution of a paused virtual machine.
+	//
+	// This changes the `spec.paused` field of the virtual machine to `false`. The execution of the virtual machine will
+	// be resumed asynchronously, check the `status.power_state` field to find out when it is running again.
+	Unpause(ctx context.Context, in *VirtualMachinesUnpauseRequest, opts ...grpc.CallOption) (*VirtualMachinesUnpauseResponse, error)
+	// Connects to the console of a virtual machine.
+	//
+	// The first message sent by the client must contain the identifier of the virtual machine and the type of console.
+	// After that the data sent by the client is written to the console, and the data written by the console is sent to
+	// the client. The stream ends when the client closes it or when the console is disconnected.
+	//
+	// The virtual machine must be running, otherwise the console will not be available.
+	//
+	// In the HTTP+JSON version of the API this is available as a WebSocket endpoint:
+	//
+	// ```http
+	// GET /api/fulfillment/v1/virtual_machines/123/console?type=serial
+	// ```
+	//
+	// The `type` query parameter can be `serial` (the default) or `vnc`. The data is sent and received as binary
+	// WebSocket messages.
+	Console(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse], error)
 }
 
 type virtualMachinesClient struct {
@@ -138,6 +190,69 @@ func (c *virtualMachinesClient) Delete(ctx context.Context, in *VirtualMachinesD
 	return out, nil
 }
 
+func (c *virtualMachinesClient) Start(ctx context.Context, in *VirtualMachinesStartRequest, opts ...grpc.CallOption) (*VirtualMachinesStartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesStartResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Start_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Stop(ctx context.Context, in *VirtualMachinesStopRequest, opts ...grpc.CallOption) (*VirtualMachinesStopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesStopResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Restart(ctx context.Context, in *VirtualMachinesRestartRequest, opts ...grpc.CallOption) (*VirtualMachinesRestartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesRestartResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Restart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Pause(ctx context.Context, in *VirtualMachinesPauseRequest, opts ...grpc.CallOption) (*VirtualMachinesPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesPauseResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Unpause(ctx context.Context, in *VirtualMachinesUnpauseRequest, opts ...grpc.CallOption) (*VirtualMachinesUnpauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesUnpauseResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Unpause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Console(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VirtualMachines_ServiceDesc.Streams[0], VirtualMachines_Console_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VirtualMachines_ConsoleClient = grpc.BidiStreamingClient[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]
+
 // VirtualMachinesServer is the server API for VirtualMachines service.
 // All implementations must embed UnimplementedVirtualMachinesServer
 // for forward compatibility.
@@ -177,6 +292,52 @@ type VirtualMachinesServer interface {
 	Update(context.Context, *VirtualMachinesUpdateRequest) (*VirtualMachinesUpdateResponse, error)
 	// Delete a virtual machine.
 	Delete(context.Context, *VirtualMachinesDeleteRequest) (*VirtualMachinesDeleteResponse, error)
+	// Starts a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `ALWAYS` and the `spec.paused` field to
+	// `false`. The virtual machine will be started asynchronously, check the `status.power_state` field to find out when
+	// it is running.
+	Start(context.Context, *VirtualMachinesStartRequest) (*VirtualMachinesStartResponse, error)
+	// Stops a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `HALTED` and the `spec.paused` field to
+	// `false`. The virtual machine will be stopped asynchronously, check the `status.power_state` field to find out when
+	// it is stopped.
+	Stop(context.Context, *VirtualMachinesStopRequest) (*VirtualMachinesStopResponse, error)
+	// Restarts a virtual machine.
+	//
+	// This changes the `spec.run_strategy` field of the virtual machine to `ALWAYS`, the `spec.restart_request_time`
+	// field to the current time and the `spec.paused` field to `false`. The virtual machine will be restarted
+	// asynchronously.
+	Restart(context.Context, *VirtualMachinesRestartRequest) (*VirtualMachinesRestartResponse, error)
+	// Pauses a virtual machine.
+	//
+	// This changes the `spec.paused` field of the virtual machine to `true`. The execution of the virtual machine will be
+	// paused asynchronously, check the `status.power_state` field to find out when it is paused. Virtual machines that
+	// have been stopped can't be paused.
+	Pause(context.Context, *VirtualMachinesPauseRequest) (*VirtualMachinesPauseResponse, error)
+	// Resumes the execution of a paused virtual machine.
+	//
+	// This changes the `spec.paused` field of the virtual machine to `false`. The execution of the virtual machine will
+	// be resumed asynchronously, check the `status.power_state` field to find out when it is running again.
+	Unpause(context.Context, *VirtualMachinesUnpauseRequest) (*VirtualMachinesUnpauseResponse, error)
+	// Connects to the console of a virtual machine.
+	//
+	// The first message sent by the client must contain the identifier of the virtual machine and the type of console.
+	// After that the data sent by the client is written to the console, and the data written by the console is sent to
+	// the client. The stream ends when the client closes it or when the console is disconnected.
+	//
+	// The virtual machine must be running, otherwise the console will not be available.
+	//
+	// In the HTTP+JSON version of the API this is available as a WebSocket endpoint:
+	//
+	// ```http
+	// GET /api/fulfillment/v1/virtual_machines/123/console?type=serial
+	// ```
+	//
+	// The `type` query parameter can be `serial` (the default) or `vnc`. The data is sent and received as binary
+	// WebSocket messages.
+	Console(grpc.BidiStreamingServer[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]) error
 	mustEmbedUnimplementedVirtualMachinesServer()
 }
 
@@ -202,6 +363,24 @@ func (UnimplementedVirtualMachinesServer) Update(context.Context, *VirtualMachin
 func (UnimplementedVirtualMachinesServer) Delete(context.Context, *VirtualMachinesDeleteRequest) (*VirtualMachinesDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedVirtualMachinesServer) Start(context.Context, *VirtualMachinesStartRequest) (*VirtualMachinesStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Stop(context.Context, *VirtualMachinesStopRequest) (*VirtualMachinesStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Restart(context.Context, *VirtualMachinesRestartRequest) (*VirtualMachinesRestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Pause(context.Context, *VirtualMachinesPauseRequest) (*VirtualMachinesPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Unpause(context.Context, *VirtualMachinesUnpauseRequest) (*VirtualMachinesUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Console(grpc.BidiStreamingServer[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Console not implemented")
+}
 func (UnimplementedVirtualMachinesServer) mustEmbedUnimplementedVirtualMachinesServer() {}
 func (UnimplementedVirtualMachinesServer) testEmbeddedByValue()                         {}
 
@@ -313,6 +492,103 @@ func _VirtualMachines_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VirtualMachines_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Start_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Start(ctx, req.(*VirtualMachinesStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Stop(ctx, req.(*VirtualMachinesStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesRestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Restart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Restart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Restart(ctx, req.(*VirtualMachinesRestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Pause(ctx, req.(*VirtualMachinesPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesUnpauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Unpause(ctx, req.(*VirtualMachinesUnpauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Console_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VirtualMachinesServer).Console(&grpc.GenericServerStream[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VirtualMachines_ConsoleServer = grpc.BidiStreamingServer[VirtualMachinesConsoleRequest, VirtualMachinesConsoleResponse]
+
 // VirtualMachines_ServiceDesc is the grpc.ServiceDesc for VirtualMachines service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -340,7 +616,34 @@ var VirtualMachines_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _VirtualMachines_Delete_Handler,
 		},
+		{
+			MethodName: "Start",
+			Handler:    _VirtualMachines_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _VirtualMachines_Stop_Handler,
+		},
+		{
+			MethodName: "Restart",
+			Handler:    _VirtualMachines_Restart_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _VirtualMachines_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _VirtualMachines_Unpause_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Console",
+			Handler:       _VirtualMachines_Console_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "fulfillment/v1/virtual_machines_service.proto",
 }
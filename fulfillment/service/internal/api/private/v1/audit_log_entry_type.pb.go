@@ -0,0 +1,471 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: private/v1/audit_log_entry_type.proto
+
+//go:build !protoopaque
+
+package privatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Actions recorded in the audit log.
+type AuditLogAction int32
+
+const (
+	// Unspecified action.
+	AuditLogAction_AUDIT_LOG_ACTION_UNSPECIFIED AuditLogAction = 0
+	// The object was created.
+	AuditLogAction_AUDIT_LOG_ACTION_CREATE AuditLogAction = 1
+	// The object was updated.
+	AuditLogAction_AUDIT_LOG_ACTION_UPDATE AuditLogAction = 2
+	// The object was deleted.
+	AuditLogAction_AUDIT_LOG_ACTION_DELETE AuditLogAction = 3
+	// The deletion of the object was cancelled.
+	AuditLogAction_AUDIT_LOG_ACTION_UNDELETE AuditLogAction = 4
+)
+
+// Enum value maps for AuditLogAction.
+var (
+	AuditLogAction_name = map[int32]string{
+		0: "AUDIT_LOG_ACTION_UNSPECIFIED",
+		1: "AUDIT_LOG_ACTION_CREATE",
+		2: "AUDIT_LOG_ACTION_UPDATE",
+		3: "AUDIT_LOG_ACTION_DELETE",
+		4: "AUDIT_LOG_ACTION_UNDELETE",
+	}
+	AuditLogAction_value = map[string]int32{
+		"AUDIT_LOG_ACTION_UNSPECIFIED": 0,
+		"AUDIT_LOG_ACTION_CREATE":      1,
+		"AUDIT_LOG_ACTION_UPDATE":      2,
+		"AUDIT_LOG_ACTION_DELETE":      3,
+		"AUDIT_LOG_ACTION_UNDELETE":    4,
+	}
+)
+
+func (x AuditLogAction) Enum() *AuditLogAction {
+	p := new(AuditLogAction)
+	*p = x
+	return p
+}
+
+func (x AuditLogAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_audit_log_entry_type_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditLogAction) Type() protoreflect.EnumType {
+	return &file_private_v1_audit_log_entry_type_proto_enumTypes[0]
+}
+
+func (x AuditLogAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Contains the details of a change made to an object using the API.
+type AuditLogEntry struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Unique identifier of the entry.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Metadata of the entry. The creation timestamp is the time when the change was made, and the tenants are the tenants
+	// of the changed object.
+	Metadata *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name of the user that made the change.
+	User string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// Groups of the user that made the change.
+	Groups []string `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Full name of the gRPC method that was called, for example `/fulfillment.v1.Clusters/Update`.
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// Action performed.
+	Action AuditLogAction `protobuf:"varint,6,opt,name=action,proto3,enum=private.v1.AuditLogAction" json:"action,omitempty"`
+	// Identifier of the changed object.
+	ObjectId string `protobuf:"bytes,7,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// Fully qualified name of the type of the changed object, for example `private.v1.Cluster`.
+	ObjectType string `protobuf:"bytes,8,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// Paths of the field mask of the update request. Empty if the request didn't have a field mask.
+	Paths []string `protobuf:"bytes,9,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Paths of the fields that have been changed.
+	Changes []string `protobuf:"bytes,10,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Representation of the object before the change. Empty for creation.
+	Before *anypb.Any `protobuf:"bytes,11,opt,name=before,proto3" json:"before,omitempty"`
+	// Representation of the object after the change. Empty for deletion.
+	After         *anypb.Any `protobuf:"bytes,12,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_private_v1_audit_log_entry_type_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_audit_log_entry_type_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() AuditLogAction {
+	if x != nil {
+		return x.Action
+	}
+	return AuditLogAction_AUDIT_LOG_ACTION_UNSPECIFIED
+}
+
+func (x *AuditLogEntry) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetBefore() *anypb.Any {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetAfter() *anypb.Any {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) SetId(v string) {
+	x.Id = v
+}
+
+func (x *AuditLogEntry) SetMetadata(v *Metadata) {
+	x.Metadata = v
+}
+
+func (x *AuditLogEntry) SetUser(v string) {
+	x.User = v
+}
+
+func (x *AuditLogEntry) SetGroups(v []string) {
+	x.Groups = v
+}
+
+func (x *AuditLogEntry) SetMethod(v string) {
+	x.Method = v
+}
+
+func (x *AuditLogEntry) SetAction(v AuditLogAction) {
+	x.Action = v
+}
+
+func (x *AuditLogEntry) SetObjectId(v string) {
+	x.ObjectId = v
+}
+
+func (x *AuditLogEntry) SetObjectType(v string) {
+	x.ObjectType = v
+}
+
+func (x *AuditLogEntry) SetPaths(v []string) {
+	x.Paths = v
+}
+
+func (x *AuditLogEntry) SetChanges(v []string) {
+	x.Changes = v
+}
+
+func (x *AuditLogEntry) SetBefore(v *anypb.Any) {
+	x.Before = v
+}
+
+func (x *AuditLogEntry) SetAfter(v *anypb.Any) {
+	x.After = v
+}
+
+func (x *AuditLogEntry) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.Metadata != nil
+}
+
+func (x *AuditLogEntry) HasBefore() bool {
+	if x == nil {
+		return false
+	}
+	return x.Before != nil
+}
+
+func (x *AuditLogEntry) HasAfter() bool {
+	if x == nil {
+		return false
+	}
+	return x.After != nil
+}
+
+func (x *AuditLogEntry) ClearMetadata() {
+	x.Metadata = nil
+}
+
+func (x *AuditLogEntry) ClearBefore() {
+	x.Before = nil
+}
+
+func (x *AuditLogEntry) ClearAfter() {
+	x.After = nil
+}
+
+type AuditLogEntry_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Unique identifier of the entry.
+	Id string
+	// Metadata of the entry. The creation timestamp is the time when the change was made, and the tenants are the tenants
+	// of the changed object.
+	Metadata *Metadata
+	// Name of the user that made the change.
+	User string
+	// Groups of the user that made the change.
+	Groups []string
+	// Full name of the gRPC method that was called, for example `/fulfillment.v1.Clusters/Update`.
+	Method string
+	// Action performed.
+	Action AuditLogAction
+	// Identifier of the changed object.
+	ObjectId string
+	// Fully qualified name of the type of the changed object, for example `private.v1.Cluster`.
+	ObjectType string
+	// Paths of the field mask of the update request. Empty if the request didn't have a field mask.
+	Paths []string
+	// Paths of the fields that have been changed.
+	Changes []string
+	// Representation of the object before the change. Empty for creation.
+	Before *anypb.Any
+	// Representation of the object after the change. Empty for deletion.
+	After *anypb.Any
+}
+
+func (b0 AuditLogEntry_builder) Build() *AuditLogEntry {
+	m0 := &AuditLogEntry{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Metadata = b.Metadata
+	x.User = b.User
+	x.Groups = b.Groups
+	x.Method = b.Method
+	x.Action = b.Action
+	x.ObjectId = b.ObjectId
+	x.ObjectType = b.ObjectType
+	x.Paths = b.Paths
+	x.Changes = b.Changes
+	x.Before = b.Before
+	x.After = b.After
+	return m0
+}
+
+var File_private_v1_audit_log_entry_type_proto protoreflect.FileDescriptor
+
+var file_private_v1_audit_log_entry_type_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91,
+	0x03, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x2a, 0xa8, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c,
+	0x4f, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f,
+	0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x42, 0xbd, 0x01,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x42, 0x16, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66,
+	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_private_v1_audit_log_entry_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_private_v1_audit_log_entry_type_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_v1_audit_log_entry_type_proto_goTypes = []any{
+	(AuditLogAction)(0),   // 0: private.v1.AuditLogAction
+	(*AuditLogEntry)(nil), // 1: private.v1.AuditLogEntry
+	(*Metadata)(nil),      // 2: private.v1.Metadata
+	(*anypb.Any)(nil),     // 3: google.protobuf.Any
+}
+var file_private_v1_audit_log_entry_type_proto_depIdxs = []int32{
+	2, // 0: private.v1.AuditLogEntry.metadata:type_name -> private.v1.Metadata
+	0, // 1: private.v1.AuditLogEntry.action:type_name -> private.v1.AuditLogAction
+	3, // 2: private.v1.AuditLogEntry.before:type_name -> google.protobuf.Any
+	3, // 3: private.v1.AuditLogEntry.after:type_name -> google.protobuf.Any
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_private_v1_audit_log_entry_type_proto_init() }
+func file_private_v1_audit_log_entry_type_proto_init() {
+	if File_private_v1_audit_log_entry_type_proto != nil {
+		return
+	}
+	file_private_v1_metadata_type_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_audit_log_entry_type_proto_rawDesc), len(file_private_v1_audit_log_entry_type_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_v1_audit_log_entry_type_proto_goTypes,
+		DependencyIndexes: file_private_v1_audit_log_entry_type_proto_depIdxs,
+		EnumInfos:         file_private_v1_audit_log_entry_type_proto_enumTypes,
+		MessageInfos:      file_private_v1_audit_log_entry_type_proto_msgTypes,
+	}.Build()
+	File_private_v1_audit_log_entry_type_proto = out.File
+	file_private_v1_audit_log_entry_type_proto_goTypes = nil
+	file_private_v1_audit_log_entry_type_proto_depIdxs = nil
+}
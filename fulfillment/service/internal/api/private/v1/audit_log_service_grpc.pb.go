@@ -0,0 +1,178 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: private/v1/audit_log_service.proto
+
+package privatev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditLog_List_FullMethodName = "/private.v1.AuditLog/List"
+	AuditLog_Get_FullMethodName  = "/private.v1.AuditLog/Get"
+)
+
+// AuditLogClient is the client API for AuditLog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Gives read access to the audit log, which records the changes made to objects using the API. Entries are written by
+// the server in the same transaction as the change, and are deleted when they are older than the retention time.
+type AuditLogClient interface {
+	List(ctx context.Context, in *AuditLogListRequest, opts ...grpc.CallOption) (*AuditLogListResponse, error)
+	Get(ctx context.Context, in *AuditLogGetRequest, opts ...grpc.CallOption) (*AuditLogGetResponse, error)
+}
+
+type auditLogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditLogClient(cc grpc.ClientConnInterface) AuditLogClient {
+	return &auditLogClient{cc}
+}
+
+func (c *auditLogClient) List(ctx context.Context, in *AuditLogListRequest, opts ...grpc.CallOption) (*AuditLogListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogListResponse)
+	err := c.cc.Invoke(ctx, AuditLog_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditLogClient) Get(ctx context.Context, in *AuditLogGetRequest, opts ...grpc.CallOption) (*AuditLogGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogGetResponse)
+	err := c.cc.Invoke(ctx, AuditLog_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditLogServer is the server API for AuditLog service.
+// All implementations must embed UnimplementedAuditLogServer
+// for forward compatibility.
+//
+// Gives read access to the audit log, which records the changes made to objects using the API. Entries are written by
+// the server in the same transaction as the change, and are deleted when they are older than the retention time.
+type AuditLogServer interface {
+	List(context.Context, *AuditLogListRequest) (*AuditLogListResponse, error)
+	Get(context.Context, *AuditLogGetRequest) (*AuditLogGetResponse, error)
+	mustEmbedUnimplementedAuditLogServer()
+}
+
+// UnimplementedAuditLogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditLogServer struct{}
+
+func (UnimplementedAuditLogServer) List(context.Context, *AuditLogListRequest) (*AuditLogListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAuditLogServer) Get(context.Context, *AuditLogGetRequest) (*AuditLogGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAuditLogServer) mustEmbedUnimplementedAuditLogServer() {}
+func (UnimplementedAuditLogServer) testEmbeddedByValue()                  {}
+
+// UnsafeAuditLogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditLogServer will
+// result in compilation errors.
+type UnsafeAuditLogServer interface {
+	mustEmbedUnimplementedAuditLogServer()
+}
+
+func RegisterAuditLogServer(s grpc.ServiceRegistrar, srv AuditLogServer) {
+	// If the following call pancis, it indicates UnimplementedAuditLogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditLog_ServiceDesc, srv)
+}
+
+func _AuditLog_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLog_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServer).List(ctx, req.(*AuditLogListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditLog_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditLog_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServer).Get(ctx, req.(*AuditLogGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditLog_ServiceDesc is the grpc.ServiceDesc for AuditLog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditLog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "private.v1.AuditLog",
+	HandlerType: (*AuditLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _AuditLog_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AuditLog_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "private/v1/audit_log_service.proto",
+}
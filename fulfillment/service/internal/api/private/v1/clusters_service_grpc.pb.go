@@ -32,11 +32,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Clusters_List_FullMethodName   = "/private.v1.Clusters/List"
-	Clusters_Get_FullMethodName    = "/private.v1.Clusters/Get"
-	Clusters_Create_FullMethodName = "/private.v1.Clusters/Create"
-	Clusters_Delete_FullMethodName = "/private.v1.Clusters/Delete"
-	Clusters_Update_FullMethodName = "/private.v1.Clusters/Update"
+	Clusters_List_FullMethodName         = "/private.v1.Clusters/List"
+	Clusters_Get_FullMethodName          = "/private.v1.Clusters/Get"
+	Clusters_Create_FullMethodName       = "/private.v1.Clusters/Create"
+	Clusters_Delete_FullMethodName       = "/private.v1.Clusters/Delete"
+	Clusters_Update_FullMethodName       = "/private.v1.Clusters/Update"
+	Clusters_ListArchived_FullMethodName = "/private.v1.Clusters/ListArchived"
+	Clusters_GetArchived_FullMethodName  = "/private.v1.Clusters/GetArchived"
+	Clusters_Undelete_FullMethodName     = "/private.v1.Clusters/Undelete"
 )
 
 // ClustersClient is the client API for Clusters service.
@@ -48,6 +51,14 @@ type ClustersClient interface {
 	Create(ctx context.Context, in *ClustersCreateRequest, opts ...grpc.CallOption) (*ClustersCreateResponse, error)
 	Delete(ctx context.Context, in *ClustersDeleteRequest, opts ...grpc.CallOption) (*ClustersDeleteResponse, error)
 	Update(ctx context.Context, in *ClustersUpdateRequest, opts ...grpc.CallOption) (*ClustersUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(ctx context.Context, in *ClustersListArchivedRequest, opts ...grpc.CallOption) (*ClustersListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(ctx context.Context, in *ClustersGetArchivedRequest, opts ...grpc.CallOption) (*ClustersGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(ctx context.Context, in *ClustersUndeleteRequest, opts ...grpc.CallOption) (*ClustersUndeleteResponse, error)
 }
 
 type clustersClient struct {
@@ -108,6 +119,36 @@ func (c *clustersClient) Update(ctx context.Context, in *ClustersUpdateRequest,
 	return out, nil
 }
 
+func (c *clustersClient) ListArchived(ctx context.Context, in *ClustersListArchivedRequest, opts ...grpc.CallOption) (*ClustersListArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClustersListArchivedResponse)
+	err := c.cc.Invoke(ctx, Clusters_ListArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersClient) GetArchived(ctx context.Context, in *ClustersGetArchivedRequest, opts ...grpc.CallOption) (*ClustersGetArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClustersGetArchivedResponse)
+	err := c.cc.Invoke(ctx, Clusters_GetArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersClient) Undelete(ctx context.Context, in *ClustersUndeleteRequest, opts ...grpc.CallOption) (*ClustersUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClustersUndeleteResponse)
+	err := c.cc.Invoke(ctx, Clusters_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClustersServer is the server API for Clusters service.
 // All implementations must embed UnimplementedClustersServer
 // for forward compatibility.
@@ -117,6 +158,14 @@ type ClustersServer interface {
 	Create(context.Context, *ClustersCreateRequest) (*ClustersCreateResponse, error)
 	Delete(context.Context, *ClustersDeleteRequest) (*ClustersDeleteResponse, error)
 	Update(context.Context, *ClustersUpdateRequest) (*ClustersUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(context.Context, *ClustersListArchivedRequest) (*ClustersListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(context.Context, *ClustersGetArchivedRequest) (*ClustersGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(context.Context, *ClustersUndeleteRequest) (*ClustersUndeleteResponse, error)
 	mustEmbedUnimplementedClustersServer()
 }
 
@@ -142,6 +191,15 @@ func (UnimplementedClustersServer) Delete(context.Context, *ClustersDeleteReques
 func (UnimplementedClustersServer) Update(context.Context, *ClustersUpdateRequest) (*ClustersUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedClustersServer) ListArchived(context.Context, *ClustersListArchivedRequest) (*ClustersListArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchived not implemented")
+}
+func (UnimplementedClustersServer) GetArchived(context.Context, *ClustersGetArchivedRequest) (*ClustersGetArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchived not implemented")
+}
+func (UnimplementedClustersServer) Undelete(context.Context, *ClustersUndeleteRequest) (*ClustersUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
 func (UnimplementedClustersServer) mustEmbedUnimplementedClustersServer() {}
 func (UnimplementedClustersServer) testEmbeddedByValue()                  {}
 
@@ -253,6 +311,60 @@ func _Clusters_Update_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Clusters_ListArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClustersListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServer).ListArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Clusters_ListArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServer).ListArchived(ctx, req.(*ClustersListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Clusters_GetArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClustersGetArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServer).GetArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Clusters_GetArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServer).GetArchived(ctx, req.(*ClustersGetArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Clusters_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClustersUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Clusters_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServer).Undelete(ctx, req.(*ClustersUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Clusters_ServiceDesc is the grpc.ServiceDesc for Clusters service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -280,6 +392,18 @@ var Clusters_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _Clusters_Update_Handler,
 		},
+		{
+			MethodName: "ListArchived",
+			Handler:    _Clusters_ListArchived_Handler,
+		},
+		{
+			MethodName: "GetArchived",
+			Handler:    _Clusters_GetArchived_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Clusters_Undelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "private/v1/clusters_service.proto",
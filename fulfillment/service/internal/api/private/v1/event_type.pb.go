@@ -100,6 +100,10 @@ type Event struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Type of event.
 	Type EventType `protobuf:"varint,2,opt,name=type,proto3,enum=private.v1.EventType" json:"type,omitempty"`
+	// Sequence number of the event. Sequence numbers are unique, but events may be delivered out of sequence order when
+	// their transactions are committed in a different order. The greatest sequence number processed can be used to
+	// resume watching events from a known position.
+	Sequence int64 `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	// Payload of the event.
 	//
 	// Types that are valid to be assigned to Payload:
@@ -110,7 +114,16 @@ type Event struct {
 	//	*Event_Hub
 	//	*Event_VirtualMachineTemplate
 	//	*Event_VirtualMachine
-	Payload       isEvent_Payload `protobuf_oneof:"payload"`
+	//	*Event_Quota
+	//	*Event_VirtualMachineSnapshot
+	Payload isEvent_Payload `protobuf_oneof:"payload"`
+	// Trace context of the request that caused the event, using the W3C trace context format, for example:
+	//
+	//   traceparent: 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+	//
+	// Consumers of the event can use it to link the work that they do to the request. It is empty if tracing isn't
+	// enabled.
+	TraceContext  map[string]string `protobuf:"bytes,12,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -154,6 +167,13 @@ func (x *Event) GetType() EventType {
 	return EventType_EVENT_TYPE_UNSPECIFIED
 }
 
+func (x *Event) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 func (x *Event) GetPayload() isEvent_Payload {
 	if x != nil {
 		return x.Payload
@@ -215,6 +235,31 @@ func (x *Event) GetVirtualMachine() *VirtualMachine {
 	return nil
 }
 
+func (x *Event) GetQuota() *Quota {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_Quota); ok {
+			return x.Quota
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetVirtualMachineSnapshot() *VirtualMachineSnapshot {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_VirtualMachineSnapshot); ok {
+			return x.VirtualMachineSnapshot
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetTraceContext() map[string]string {
+	if x != nil {
+		return x.TraceContext
+	}
+	return nil
+}
+
 func (x *Event) SetId(v string) {
 	x.Id = v
 }
@@ -223,6 +268,10 @@ func (x *Event) SetType(v EventType) {
 	x.Type = v
 }
 
+func (x *Event) SetSequence(v int64) {
+	x.Sequence = v
+}
+
 func (x *Event) SetCluster(v *Cluster) {
 	if v == nil {
 		x.Payload = nil
@@ -271,6 +320,26 @@ func (x *Event) SetVirtualMachine(v *VirtualMachine) {
 	x.Payload = &Event_VirtualMachine{v}
 }
 
+func (x *Event) SetQuota(v *Quota) {
+	if v == nil {
+		x.Payload = nil
+		return
+	}
+	x.Payload = &Event_Quota{v}
+}
+
+func (x *Event) SetVirtualMachineSnapshot(v *VirtualMachineSnapshot) {
+	if v == nil {
+		x.Payload = nil
+		return
+	}
+	x.Payload = &Event_VirtualMachineSnapshot{v}
+}
+
+func (x *Event) SetTraceContext(v map[string]string) {
+	x.TraceContext = v
+}
+
 func (x *Event) HasPayload() bool {
 	if x == nil {
 		return false
@@ -326,6 +395,22 @@ func (x *Event) HasVirtualMachine() bool {
 	return ok
 }
 
+func (x *Event) HasQuota() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Payload.(*Event_Quota)
+	return ok
+}
+
+func (x *Event) HasVirtualMachineSnapshot() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.Payload.(*Event_VirtualMachineSnapshot)
+	return ok
+}
+
 func (x *Event) ClearPayload() {
 	x.Payload = nil
 }
@@ -366,6 +451,18 @@ func (x *Event) ClearVirtualMachine() {
 	}
 }
 
+func (x *Event) ClearQuota() {
+	if _, ok := x.Payload.(*Event_Quota); ok {
+		x.Payload = nil
+	}
+}
+
+func (x *Event) ClearVirtualMachineSnapshot() {
+	if _, ok := x.Payload.(*Event_VirtualMachineSnapshot); ok {
+		x.Payload = nil
+	}
+}
+
 const Event_Payload_not_set_case case_Event_Payload = 0
 const Event_Cluster_case case_Event_Payload = 3
 const Event_ClusterTemplate_case case_Event_Payload = 4
@@ -373,6 +470,8 @@ const Event_HostClass_case case_Event_Payload = 5
 const Event_Hub_case case_Event_Payload = 6
 const Event_VirtualMachineTemplate_case case_Event_Payload = 7
 const Event_VirtualMachine_case case_Event_Payload = 8
+const Event_Quota_case case_Event_Payload = 10
+const Event_VirtualMachineSnapshot_case case_Event_Payload = 11
 
 func (x *Event) WhichPayload() case_Event_Payload {
 	if x == nil {
@@ -391,6 +490,10 @@ func (x *Event) WhichPayload() case_Event_Payload {
 		return Event_VirtualMachineTemplate_case
 	case *Event_VirtualMachine:
 		return Event_VirtualMachine_case
+	case *Event_Quota:
+		return Event_Quota_case
+	case *Event_VirtualMachineSnapshot:
+		return Event_VirtualMachineSnapshot_case
 	default:
 		return Event_Payload_not_set_case
 	}
@@ -403,6 +506,10 @@ type Event_builder struct {
 	Id string
 	// Type of event.
 	Type EventType
+	// Sequence number of the event. Sequence numbers are unique, but events may be delivered out of sequence order when
+	// their transactions are committed in a different order. The greatest sequence number processed can be used to
+	// resume watching events from a known position.
+	Sequence int64
 	// Payload of the event.
 
 	// Fields of oneof Payload:
@@ -412,7 +519,16 @@ type Event_builder struct {
 	Hub                    *Hub
 	VirtualMachineTemplate *VirtualMachineTemplate
 	VirtualMachine         *VirtualMachine
+	Quota                  *Quota
+	VirtualMachineSnapshot *VirtualMachineSnapshot
 	// -- end of Payload
+	// Trace context of the request that caused the event, using the W3C trace context format, for example:
+	//
+	//   traceparent: 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+	//
+	// Consumers of the event can use it to link the work that they do to the request. It is empty if tracing isn't
+	// enabled.
+	TraceContext map[string]string
 }
 
 func (b0 Event_builder) Build() *Event {
@@ -421,6 +537,7 @@ func (b0 Event_builder) Build() *Event {
 	_, _ = b, x
 	x.Id = b.Id
 	x.Type = b.Type
+	x.Sequence = b.Sequence
 	if b.Cluster != nil {
 		x.Payload = &Event_Cluster{b.Cluster}
 	}
@@ -439,6 +556,13 @@ func (b0 Event_builder) Build() *Event {
 	if b.VirtualMachine != nil {
 		x.Payload = &Event_VirtualMachine{b.VirtualMachine}
 	}
+	if b.Quota != nil {
+		x.Payload = &Event_Quota{b.Quota}
+	}
+	if b.VirtualMachineSnapshot != nil {
+		x.Payload = &Event_VirtualMachineSnapshot{b.VirtualMachineSnapshot}
+	}
+	x.TraceContext = b.TraceContext
 	return m0
 }
 
@@ -480,6 +604,14 @@ type Event_VirtualMachine struct {
 	VirtualMachine *VirtualMachine `protobuf:"bytes,8,opt,name=virtual_machine,json=virtualMachine,proto3,oneof"`
 }
 
+type Event_Quota struct {
+	Quota *Quota `protobuf:"bytes,10,opt,name=quota,proto3,oneof"`
+}
+
+type Event_VirtualMachineSnapshot struct {
+	VirtualMachineSnapshot *VirtualMachineSnapshot `protobuf:"bytes,11,opt,name=virtual_machine_snapshot,json=virtualMachineSnapshot,proto3,oneof"`
+}
+
 func (*Event_Cluster) isEvent_Payload() {}
 
 func (*Event_ClusterTemplate) isEvent_Payload() {}
@@ -492,6 +624,10 @@ func (*Event_VirtualMachineTemplate) isEvent_Payload() {}
 
 func (*Event_VirtualMachine) isEvent_Payload() {}
 
+func (*Event_Quota) isEvent_Payload() {}
+
+func (*Event_VirtualMachineSnapshot) isEvent_Payload() {}
+
 var File_private_v1_event_type_proto protoreflect.FileDescriptor
 
 var file_private_v1_event_type_proto_rawDesc = string([]byte{
@@ -507,89 +643,119 @@ var file_private_v1_event_type_proto_rawDesc = string([]byte{
 	0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x61,
 	0x73, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70,
 	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x62, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xcc, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
-	0x36, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x48, 0x00, 0x52, 0x09, 0x68, 0x6f,
-	0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x68, 0x75, 0x62, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x48, 0x75, 0x62, 0x48, 0x00, 0x52, 0x03, 0x68, 0x75, 0x62, 0x12, 0x5e, 0x0a, 0x18,
-	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f,
-	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x05, 0x0a,
+	0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x48,
+	0x0a, 0x10, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x48, 0x00, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x03, 0x68, 0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x62, 0x48, 0x00,
+	0x52, 0x03, 0x68, 0x75, 0x62, 0x12, 0x5e, 0x0a, 0x18, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x16, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x48, 0x00, 0x52, 0x16, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0f,
-	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x84,
-	0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x52,
-	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45,
-	0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0xb5, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66,
-	0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x76, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x18, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52,
+	0x16, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x84, 0x01,
+	0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x42, 0xb5, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75,
+	0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_private_v1_event_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_private_v1_event_type_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_v1_event_type_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_private_v1_event_type_proto_goTypes = []any{
 	(EventType)(0),                 // 0: private.v1.EventType
 	(*Event)(nil),                  // 1: private.v1.Event
-	(*Cluster)(nil),                // 2: private.v1.Cluster
-	(*ClusterTemplate)(nil),        // 3: private.v1.ClusterTemplate
-	(*HostClass)(nil),              // 4: private.v1.HostClass
-	(*Hub)(nil),                    // 5: private.v1.Hub
-	(*VirtualMachineTemplate)(nil), // 6: private.v1.VirtualMachineTemplate
-	(*VirtualMachine)(nil),         // 7: private.v1.VirtualMachine
+	nil,                            // 2: private.v1.Event.TraceContextEntry
+	(*Cluster)(nil),                // 3: private.v1.Cluster
+	(*ClusterTemplate)(nil),        // 4: private.v1.ClusterTemplate
+	(*HostClass)(nil),              // 5: private.v1.HostClass
+	(*Hub)(nil),                    // 6: private.v1.Hub
+	(*VirtualMachineTemplate)(nil), // 7: private.v1.VirtualMachineTemplate
+	(*VirtualMachine)(nil),         // 8: private.v1.VirtualMachine
+	(*Quota)(nil),                  // 9: private.v1.Quota
+	(*VirtualMachineSnapshot)(nil), // 10: private.v1.VirtualMachineSnapshot
 }
 var file_private_v1_event_type_proto_depIdxs = []int32{
-	0, // 0: private.v1.Event.type:type_name -> private.v1.EventType
-	2, // 1: private.v1.Event.cluster:type_name -> private.v1.Cluster
-	3, // 2: private.v1.Event.cluster_template:type_name -> private.v1.ClusterTemplate
-	4, // 3: private.v1.Event.host_class:type_name -> private.v1.HostClass
-	5, // 4: private.v1.Event.hub:type_name -> private.v1.Hub
-	6, // 5: private.v1.Event.virtual_machine_template:type_name -> private.v1.VirtualMachineTemplate
-	7, // 6: private.v1.Event.virtual_machine:type_name -> private.v1.VirtualMachine
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: private.v1.Event.type:type_name -> private.v1.EventType
+	3,  // 1: private.v1.Event.cluster:type_name -> private.v1.Cluster
+	4,  // 2: private.v1.Event.cluster_template:type_name -> private.v1.ClusterTemplate
+	5,  // 3: private.v1.Event.host_class:type_name -> private.v1.HostClass
+	6,  // 4: private.v1.Event.hub:type_name -> private.v1.Hub
+	7,  // 5: private.v1.Event.virtual_machine_template:type_name -> private.v1.VirtualMachineTemplate
+	8,  // 6: private.v1.Event.virtual_machine:type_name -> private.v1.VirtualMachine
+	9,  // 7: private.v1.Event.quota:type_name -> private.v1.Quota
+	10, // 8: private.v1.Event.virtual_machine_snapshot:type_name -> private.v1.VirtualMachineSnapshot
+	2,  // 9: private.v1.Event.trace_context:type_name -> private.v1.Event.TraceContextEntry
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_private_v1_event_type_proto_init() }
@@ -601,6 +767,8 @@ func file_private_v1_event_type_proto_init() {
 	file_private_v1_cluster_type_proto_init()
 	file_private_v1_host_class_type_proto_init()
 	file_private_v1_hub_type_proto_init()
+	file_private_v1_quota_type_proto_init()
+	file_private_v1_virtual_machine_snapshot_type_proto_init()
 	file_private_v1_virtual_machine_template_type_proto_init()
 	file_private_v1_virtual_machine_type_proto_init()
 	file_private_v1_event_type_proto_msgTypes[0].OneofWrappers = []any{
@@ -610,6 +778,8 @@ func file_private_v1_event_type_proto_init() {
 		(*Event_Hub)(nil),
 		(*Event_VirtualMachineTemplate)(nil),
 		(*Event_VirtualMachine)(nil),
+		(*Event_Quota)(nil),
+		(*Event_VirtualMachineSnapshot)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -617,7 +787,7 @@ func file_private_v1_event_type_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_event_type_proto_rawDesc), len(file_private_v1_event_type_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,8 +22,10 @@
 package privatev1
 
 import (
+	v1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
 )
@@ -35,15 +37,75 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HubConditionType int32
+
+const (
+	HubConditionType_HUB_CONDITION_TYPE_UNSPECIFIED HubConditionType = 0
+	// Summary of the other conditions, true only when all of them are true.
+	HubConditionType_HUB_CONDITION_TYPE_HEALTHY HubConditionType = 1
+	// The API server of the hub is reachable with the kubeconfig of the hub.
+	HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE HubConditionType = 2
+	// The custom resource definitions for cluster orders and virtual machines are installed in the hub.
+	HubConditionType_HUB_CONDITION_TYPE_CRDS_INSTALLED HubConditionType = 3
+	// The namespace of the hub exists.
+	HubConditionType_HUB_CONDITION_TYPE_NAMESPACE_EXISTS HubConditionType = 4
+)
+
+// Enum value maps for HubConditionType.
+var (
+	HubConditionType_name = map[int32]string{
+		0: "HUB_CONDITION_TYPE_UNSPECIFIED",
+		1: "HUB_CONDITION_TYPE_HEALTHY",
+		2: "HUB_CONDITION_TYPE_API_REACHABLE",
+		3: "HUB_CONDITION_TYPE_CRDS_INSTALLED",
+		4: "HUB_CONDITION_TYPE_NAMESPACE_EXISTS",
+	}
+	HubConditionType_value = map[string]int32{
+		"HUB_CONDITION_TYPE_UNSPECIFIED":      0,
+		"HUB_CONDITION_TYPE_HEALTHY":          1,
+		"HUB_CONDITION_TYPE_API_REACHABLE":    2,
+		"HUB_CONDITION_TYPE_CRDS_INSTALLED":   3,
+		"HUB_CONDITION_TYPE_NAMESPACE_EXISTS": 4,
+	}
+)
+
+func (x HubConditionType) Enum() *HubConditionType {
+	p := new(HubConditionType)
+	*p = x
+	return p
+}
+
+func (x HubConditionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HubConditionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_hub_type_proto_enumTypes[0].Descriptor()
+}
+
+func (HubConditionType) Type() protoreflect.EnumType {
+	return &file_private_v1_hub_type_proto_enumTypes[0]
+}
+
+func (x HubConditionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // Contains the details of a hub.
 type Hub struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id         string                 `protobuf:"bytes,1,opt,name=id,proto3"`
-	xxx_hidden_Metadata   *Metadata              `protobuf:"bytes,2,opt,name=metadata,proto3"`
-	xxx_hidden_Kubeconfig []byte                 `protobuf:"bytes,3,opt,name=kubeconfig,proto3"`
-	xxx_hidden_Namespace  string                 `protobuf:"bytes,4,opt,name=namespace,proto3"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          string                 `protobuf:"bytes,1,opt,name=id,proto3"`
+	xxx_hidden_Metadata    *Metadata              `protobuf:"bytes,2,opt,name=metadata,proto3"`
+	xxx_hidden_Kubeconfig  []byte                 `protobuf:"bytes,3,opt,name=kubeconfig,proto3"`
+	xxx_hidden_Namespace   string                 `protobuf:"bytes,4,opt,name=namespace,proto3"`
+	xxx_hidden_Zone        string                 `protobuf:"bytes,5,opt,name=zone,proto3"`
+	xxx_hidden_Labels      map[string]string      `protobuf:"bytes,6,rep,name=labels,proto3" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	xxx_hidden_MaxClusters int32                  `protobuf:"varint,7,opt,name=max_clusters,json=maxClusters,proto3"`
+	xxx_hidden_HostClasses []string               `protobuf:"bytes,8,rep,name=host_classes,json=hostClasses,proto3"`
+	xxx_hidden_Cordoned    bool                   `protobuf:"varint,9,opt,name=cordoned,proto3"`
+	xxx_hidden_Status      *HubStatus             `protobuf:"bytes,10,opt,name=status,proto3"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Hub) Reset() {
@@ -99,6 +161,48 @@ func (x *Hub) GetNamespace() string {
 	return ""
 }
 
+func (x *Hub) GetZone() string {
+	if x != nil {
+		return x.xxx_hidden_Zone
+	}
+	return ""
+}
+
+func (x *Hub) GetLabels() map[string]string {
+	if x != nil {
+		return x.xxx_hidden_Labels
+	}
+	return nil
+}
+
+func (x *Hub) GetMaxClusters() int32 {
+	if x != nil {
+		return x.xxx_hidden_MaxClusters
+	}
+	return 0
+}
+
+func (x *Hub) GetHostClasses() []string {
+	if x != nil {
+		return x.xxx_hidden_HostClasses
+	}
+	return nil
+}
+
+func (x *Hub) GetCordoned() bool {
+	if x != nil {
+		return x.xxx_hidden_Cordoned
+	}
+	return false
+}
+
+func (x *Hub) GetStatus() *HubStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return nil
+}
+
 func (x *Hub) SetId(v string) {
 	x.xxx_hidden_Id = v
 }
@@ -118,6 +222,30 @@ func (x *Hub) SetNamespace(v string) {
 	x.xxx_hidden_Namespace = v
 }
 
+func (x *Hub) SetZone(v string) {
+	x.xxx_hidden_Zone = v
+}
+
+func (x *Hub) SetLabels(v map[string]string) {
+	x.xxx_hidden_Labels = v
+}
+
+func (x *Hub) SetMaxClusters(v int32) {
+	x.xxx_hidden_MaxClusters = v
+}
+
+func (x *Hub) SetHostClasses(v []string) {
+	x.xxx_hidden_HostClasses = v
+}
+
+func (x *Hub) SetCordoned(v bool) {
+	x.xxx_hidden_Cordoned = v
+}
+
+func (x *Hub) SetStatus(v *HubStatus) {
+	x.xxx_hidden_Status = v
+}
+
 func (x *Hub) HasMetadata() bool {
 	if x == nil {
 		return false
@@ -125,10 +253,21 @@ func (x *Hub) HasMetadata() bool {
 	return x.xxx_hidden_Metadata != nil
 }
 
+func (x *Hub) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Status != nil
+}
+
 func (x *Hub) ClearMetadata() {
 	x.xxx_hidden_Metadata = nil
 }
 
+func (x *Hub) ClearStatus() {
+	x.xxx_hidden_Status = nil
+}
+
 type Hub_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -138,9 +277,25 @@ type Hub_builder struct {
 	Id       string
 	Metadata *Metadata
 	// The Kubeconfig containing the address and credentials that the fulfillment service will use to connect to the hub.
+	//
+	// This is sensitive: it is encrypted when stored in the database, and it is never written to logs.
 	Kubeconfig []byte
 	// Namespace where the cluster orders will be created.
 	Namespace string
+	// Zone where the hub is located. Clusters that request a zone will only be placed in hubs of that zone.
+	Zone string
+	// Labels of the hub. Clusters can use a hub selector to restrict the hubs where they can be placed.
+	Labels map[string]string
+	// Maximum number of clusters that can be placed in this hub. Zero means that there is no limit.
+	MaxClusters int32
+	// Host classes that are available in this hub. Clusters that need host classes that aren't in this list will not be
+	// placed in this hub. If the list is empty it is assumed that the hub supports all host classes.
+	HostClasses []string
+	// Indicates if the hub is cordoned. New clusters and virtual machines will not be placed in cordoned hubs, but the
+	// ones that are already placed there will continue to be reconciled.
+	Cordoned bool
+	// Status of the hub. This is calculated periodically by the hub health checker.
+	Status *HubStatus
 }
 
 func (b0 Hub_builder) Build() *Hub {
@@ -151,6 +306,237 @@ func (b0 Hub_builder) Build() *Hub {
 	x.xxx_hidden_Metadata = b.Metadata
 	x.xxx_hidden_Kubeconfig = b.Kubeconfig
 	x.xxx_hidden_Namespace = b.Namespace
+	x.xxx_hidden_Zone = b.Zone
+	x.xxx_hidden_Labels = b.Labels
+	x.xxx_hidden_MaxClusters = b.MaxClusters
+	x.xxx_hidden_HostClasses = b.HostClasses
+	x.xxx_hidden_Cordoned = b.Cordoned
+	x.xxx_hidden_Status = b.Status
+	return m0
+}
+
+type HubStatus struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Conditions *[]*HubCondition       `protobuf:"bytes,1,rep,name=conditions,proto3"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HubStatus) Reset() {
+	*x = HubStatus{}
+	mi := &file_private_v1_hub_type_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubStatus) ProtoMessage() {}
+
+func (x *HubStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_hub_type_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HubStatus) GetConditions() []*HubCondition {
+	if x != nil {
+		if x.xxx_hidden_Conditions != nil {
+			return *x.xxx_hidden_Conditions
+		}
+	}
+	return nil
+}
+
+func (x *HubStatus) SetConditions(v []*HubCondition) {
+	x.xxx_hidden_Conditions = &v
+}
+
+type HubStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Conditions describing the results of the last health check. New clusters and virtual machines will not be placed
+	// in hubs where the healthy condition is false.
+	Conditions []*HubCondition
+}
+
+func (b0 HubStatus_builder) Build() *HubStatus {
+	m0 := &HubStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Conditions = &b.Conditions
+	return m0
+}
+
+type HubCondition struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type               HubConditionType       `protobuf:"varint,1,opt,name=type,proto3,enum=private.v1.HubConditionType"`
+	xxx_hidden_Status             v1.ConditionStatus     `protobuf:"varint,2,opt,name=status,proto3,enum=shared.v1.ConditionStatus"`
+	xxx_hidden_LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition_time,json=lastTransitionTime,proto3"`
+	xxx_hidden_Reason             *string                `protobuf:"bytes,4,opt,name=reason,proto3,oneof"`
+	xxx_hidden_Message            *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *HubCondition) Reset() {
+	*x = HubCondition{}
+	mi := &file_private_v1_hub_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubCondition) ProtoMessage() {}
+
+func (x *HubCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_hub_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *HubCondition) GetType() HubConditionType {
+	if x != nil {
+		return x.xxx_hidden_Type
+	}
+	return HubConditionType_HUB_CONDITION_TYPE_UNSPECIFIED
+}
+
+func (x *HubCondition) GetStatus() v1.ConditionStatus {
+	if x != nil {
+		return x.xxx_hidden_Status
+	}
+	return v1.ConditionStatus(0)
+}
+
+func (x *HubCondition) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastTransitionTime
+	}
+	return nil
+}
+
+func (x *HubCondition) GetReason() string {
+	if x != nil {
+		if x.xxx_hidden_Reason != nil {
+			return *x.xxx_hidden_Reason
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *HubCondition) GetMessage() string {
+	if x != nil {
+		if x.xxx_hidden_Message != nil {
+			return *x.xxx_hidden_Message
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *HubCondition) SetType(v HubConditionType) {
+	x.xxx_hidden_Type = v
+}
+
+func (x *HubCondition) SetStatus(v v1.ConditionStatus) {
+	x.xxx_hidden_Status = v
+}
+
+func (x *HubCondition) SetLastTransitionTime(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastTransitionTime = v
+}
+
+func (x *HubCondition) SetReason(v string) {
+	x.xxx_hidden_Reason = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
+}
+
+func (x *HubCondition) SetMessage(v string) {
+	x.xxx_hidden_Message = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 5)
+}
+
+func (x *HubCondition) HasLastTransitionTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastTransitionTime != nil
+}
+
+func (x *HubCondition) HasReason() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *HubCondition) HasMessage() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *HubCondition) ClearLastTransitionTime() {
+	x.xxx_hidden_LastTransitionTime = nil
+}
+
+func (x *HubCondition) ClearReason() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Reason = nil
+}
+
+func (x *HubCondition) ClearMessage() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Message = nil
+}
+
+type HubCondition_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type               HubConditionType
+	Status             v1.ConditionStatus
+	LastTransitionTime *timestamppb.Timestamp
+	Reason             *string
+	Message            *string
+}
+
+func (b0 HubCondition_builder) Build() *HubCondition {
+	m0 := &HubCondition{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Type = b.Type
+	x.xxx_hidden_Status = b.Status
+	x.xxx_hidden_LastTransitionTime = b.LastTransitionTime
+	if b.Reason != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Reason = b.Reason
+	}
+	if b.Message != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 5)
+		x.xxx_hidden_Message = b.Message
+	}
 	return m0
 }
 
@@ -159,17 +545,74 @@ var File_private_v1_hub_type_proto protoreflect.FileDescriptor
 var file_private_v1_hub_type_proto_rawDesc = string([]byte{
 	0x0a, 0x19, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x62,
 	0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x01, 0x0a, 0x03, 0x48, 0x75, 0x62, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x9f, 0x03, 0x0a, 0x03, 0x48, 0x75, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0a, 0x6b, 0x75, 0x62,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x03, 0x80,
+	0x01, 0x01, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x33, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75,
+	0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x68, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63,
+	0x6f, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x45, 0x0a, 0x09, 0x48, 0x75, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x0c, 0x48, 0x75, 0x62,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2a, 0xcc, 0x01, 0x0a, 0x10, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x55, 0x42, 0x5f, 0x43, 0x4f, 0x4e,
+	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x55, 0x42,
+	0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x48, 0x55, 0x42,
+	0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x41, 0x50, 0x49, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x12,
+	0x25, 0x0a, 0x21, 0x48, 0x55, 0x42, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x44, 0x53, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x48, 0x55, 0x42, 0x5f, 0x43, 0x4f,
+	0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x04, 0x42,
 	0xb3, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
 	0x76, 0x31, 0x42, 0x0c, 0x48, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
@@ -184,18 +627,31 @@ var file_private_v1_hub_type_proto_rawDesc = string([]byte{
 	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
-var file_private_v1_hub_type_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_private_v1_hub_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_private_v1_hub_type_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_private_v1_hub_type_proto_goTypes = []any{
-	(*Hub)(nil),      // 0: private.v1.Hub
-	(*Metadata)(nil), // 1: private.v1.Metadata
+	(HubConditionType)(0),         // 0: private.v1.HubConditionType
+	(*Hub)(nil),                   // 1: private.v1.Hub
+	(*HubStatus)(nil),             // 2: private.v1.HubStatus
+	(*HubCondition)(nil),          // 3: private.v1.HubCondition
+	nil,                           // 4: private.v1.Hub.LabelsEntry
+	(*Metadata)(nil),              // 5: private.v1.Metadata
+	(v1.ConditionStatus)(0),       // 6: shared.v1.ConditionStatus
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_private_v1_hub_type_proto_depIdxs = []int32{
-	1, // 0: private.v1.Hub.metadata:type_name -> private.v1.Metadata
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 0: private.v1.Hub.metadata:type_name -> private.v1.Metadata
+	4, // 1: private.v1.Hub.labels:type_name -> private.v1.Hub.LabelsEntry
+	2, // 2: private.v1.Hub.status:type_name -> private.v1.HubStatus
+	3, // 3: private.v1.HubStatus.conditions:type_name -> private.v1.HubCondition
+	0, // 4: private.v1.HubCondition.type:type_name -> private.v1.HubConditionType
+	6, // 5: private.v1.HubCondition.status:type_name -> shared.v1.ConditionStatus
+	7, // 6: private.v1.HubCondition.last_transition_time:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_private_v1_hub_type_proto_init() }
@@ -204,18 +660,20 @@ func file_private_v1_hub_type_proto_init() {
 		return
 	}
 	file_private_v1_metadata_type_proto_init()
+	file_private_v1_hub_type_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_hub_type_proto_rawDesc), len(file_private_v1_hub_type_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_private_v1_hub_type_proto_goTypes,
 		DependencyIndexes: file_private_v1_hub_type_proto_depIdxs,
+		EnumInfos:         file_private_v1_hub_type_proto_enumTypes,
 		MessageInfos:      file_private_v1_hub_type_proto_msgTypes,
 	}.Build()
 	File_private_v1_hub_type_proto = out.File
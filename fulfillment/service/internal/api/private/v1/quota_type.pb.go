@@ -0,0 +1,479 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: private/v1/quota_type.proto
+
+//go:build !protoopaque
+
+package privatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Quota defines the maximum amount of resources that a tenant can use. Tenants that don't have a quota aren't
+// limited.
+type Quota struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata      *Metadata              `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *QuotaSpec             `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_private_v1_quota_type_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quota_type_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Quota) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Quota) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Quota) GetSpec() *QuotaSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Quota) SetId(v string) {
+	x.Id = v
+}
+
+func (x *Quota) SetMetadata(v *Metadata) {
+	x.Metadata = v
+}
+
+func (x *Quota) SetSpec(v *QuotaSpec) {
+	x.Spec = v
+}
+
+func (x *Quota) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.Metadata != nil
+}
+
+func (x *Quota) HasSpec() bool {
+	if x == nil {
+		return false
+	}
+	return x.Spec != nil
+}
+
+func (x *Quota) ClearMetadata() {
+	x.Metadata = nil
+}
+
+func (x *Quota) ClearSpec() {
+	x.Spec = nil
+}
+
+type Quota_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id       string
+	Metadata *Metadata
+	Spec     *QuotaSpec
+}
+
+func (b0 Quota_builder) Build() *Quota {
+	m0 := &Quota{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Metadata = b.Metadata
+	x.Spec = b.Spec
+	return m0
+}
+
+type QuotaSpec struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Name of the tenant that the limits apply to. This is mandatory, and there can be only one quota per tenant.
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Maximum number of clusters. If not set the number of clusters isn't limited.
+	Clusters *int32 `protobuf:"varint,2,opt,name=clusters,proto3,oneof" json:"clusters,omitempty"`
+	// Maximum total number of nodes per host class, summed across the node sets of all the clusters of the tenant. The
+	// key is the identifier of the host class. Host classes that aren't in this map aren't limited.
+	HostClassNodes map[string]int32 `protobuf:"bytes,3,rep,name=host_class_nodes,json=hostClassNodes,proto3" json:"host_class_nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Maximum number of virtual machines. If not set the number of virtual machines isn't limited.
+	VirtualMachines *int32 `protobuf:"varint,4,opt,name=virtual_machines,json=virtualMachines,proto3,oneof" json:"virtual_machines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaSpec) Reset() {
+	*x = QuotaSpec{}
+	mi := &file_private_v1_quota_type_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaSpec) ProtoMessage() {}
+
+func (x *QuotaSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quota_type_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotaSpec) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *QuotaSpec) GetClusters() int32 {
+	if x != nil && x.Clusters != nil {
+		return *x.Clusters
+	}
+	return 0
+}
+
+func (x *QuotaSpec) GetHostClassNodes() map[string]int32 {
+	if x != nil {
+		return x.HostClassNodes
+	}
+	return nil
+}
+
+func (x *QuotaSpec) GetVirtualMachines() int32 {
+	if x != nil && x.VirtualMachines != nil {
+		return *x.VirtualMachines
+	}
+	return 0
+}
+
+func (x *QuotaSpec) SetTenant(v string) {
+	x.Tenant = v
+}
+
+func (x *QuotaSpec) SetClusters(v int32) {
+	x.Clusters = &v
+}
+
+func (x *QuotaSpec) SetHostClassNodes(v map[string]int32) {
+	x.HostClassNodes = v
+}
+
+func (x *QuotaSpec) SetVirtualMachines(v int32) {
+	x.VirtualMachines = &v
+}
+
+func (x *QuotaSpec) HasClusters() bool {
+	if x == nil {
+		return false
+	}
+	return x.Clusters != nil
+}
+
+func (x *QuotaSpec) HasVirtualMachines() bool {
+	if x == nil {
+		return false
+	}
+	return x.VirtualMachines != nil
+}
+
+func (x *QuotaSpec) ClearClusters() {
+	x.Clusters = nil
+}
+
+func (x *QuotaSpec) ClearVirtualMachines() {
+	x.VirtualMachines = nil
+}
+
+type QuotaSpec_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the tenant that the limits apply to. This is mandatory, and there can be only one quota per tenant.
+	Tenant string
+	// Maximum number of clusters. If not set the number of clusters isn't limited.
+	Clusters *int32
+	// Maximum total number of nodes per host class, summed across the node sets of all the clusters of the tenant. The
+	// key is the identifier of the host class. Host classes that aren't in this map aren't limited.
+	HostClassNodes map[string]int32
+	// Maximum number of virtual machines. If not set the number of virtual machines isn't limited.
+	VirtualMachines *int32
+}
+
+func (b0 QuotaSpec_builder) Build() *QuotaSpec {
+	m0 := &QuotaSpec{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Tenant = b.Tenant
+	x.Clusters = b.Clusters
+	x.HostClassNodes = b.HostClassNodes
+	x.VirtualMachines = b.VirtualMachines
+	return m0
+}
+
+// QuotaUsage contains the amount of resources that a tenant is currently using. Objects that have been deleted but
+// that still have pending finalizers are included, as they still consume resources.
+type QuotaUsage struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Number of clusters.
+	Clusters int32 `protobuf:"varint,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	// Total number of nodes per host class, summed across the node sets of all the clusters.
+	HostClassNodes map[string]int32 `protobuf:"bytes,2,rep,name=host_class_nodes,json=hostClassNodes,proto3" json:"host_class_nodes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Number of virtual machines.
+	VirtualMachines int32 `protobuf:"varint,3,opt,name=virtual_machines,json=virtualMachines,proto3" json:"virtual_machines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_private_v1_quota_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quota_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotaUsage) GetClusters() int32 {
+	if x != nil {
+		return x.Clusters
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetHostClassNodes() map[string]int32 {
+	if x != nil {
+		return x.HostClassNodes
+	}
+	return nil
+}
+
+func (x *QuotaUsage) GetVirtualMachines() int32 {
+	if x != nil {
+		return x.VirtualMachines
+	}
+	return 0
+}
+
+func (x *QuotaUsage) SetClusters(v int32) {
+	x.Clusters = v
+}
+
+func (x *QuotaUsage) SetHostClassNodes(v map[string]int32) {
+	x.HostClassNodes = v
+}
+
+func (x *QuotaUsage) SetVirtualMachines(v int32) {
+	x.VirtualMachines = v
+}
+
+type QuotaUsage_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Number of clusters.
+	Clusters int32
+	// Total number of nodes per host class, summed across the node sets of all the clusters.
+	HostClassNodes map[string]int32
+	// Number of virtual machines.
+	VirtualMachines int32
+}
+
+func (b0 QuotaUsage_builder) Build() *QuotaUsage {
+	m0 := &QuotaUsage{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Clusters = b.Clusters
+	x.HostClassNodes = b.HostClassNodes
+	x.VirtualMachines = b.VirtualMachines
+	return m0
+}
+
+var File_private_v1_quota_type_proto protoreflect.FileDescriptor
+
+var file_private_v1_quota_type_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x05, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
+	0xae, 0x02, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x68, 0x6f, 0x73,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x10, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x48,
+	0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73,
+	0x22, 0xec, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x10, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x73, 0x1a, 0x41, 0x0a, 0x13,
+	0x48, 0x6f, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0xb5, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x0e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_private_v1_quota_type_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_private_v1_quota_type_proto_goTypes = []any{
+	(*Quota)(nil),      // 0: private.v1.Quota
+	(*QuotaSpec)(nil),  // 1: private.v1.QuotaSpec
+	(*QuotaUsage)(nil), // 2: private.v1.QuotaUsage
+	nil,                // 3: private.v1.QuotaSpec.HostClassNodesEntry
+	nil,                // 4: private.v1.QuotaUsage.HostClassNodesEntry
+	(*Metadata)(nil),   // 5: private.v1.Metadata
+}
+var file_private_v1_quota_type_proto_depIdxs = []int32{
+	5, // 0: private.v1.Quota.metadata:type_name -> private.v1.Metadata
+	1, // 1: private.v1.Quota.spec:type_name -> private.v1.QuotaSpec
+	3, // 2: private.v1.QuotaSpec.host_class_nodes:type_name -> private.v1.QuotaSpec.HostClassNodesEntry
+	4, // 3: private.v1.QuotaUsage.host_class_nodes:type_name -> private.v1.QuotaUsage.HostClassNodesEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_private_v1_quota_type_proto_init() }
+func file_private_v1_quota_type_proto_init() {
+	if File_private_v1_quota_type_proto != nil {
+		return
+	}
+	file_private_v1_metadata_type_proto_init()
+	file_private_v1_quota_type_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_quota_type_proto_rawDesc), len(file_private_v1_quota_type_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_v1_quota_type_proto_goTypes,
+		DependencyIndexes: file_private_v1_quota_type_proto_depIdxs,
+		MessageInfos:      file_private_v1_quota_type_proto_msgTypes,
+	}.Build()
+	File_private_v1_quota_type_proto = out.File
+	file_private_v1_quota_type_proto_goTypes = nil
+	file_private_v1_quota_type_proto_depIdxs = nil
+}
@@ -0,0 +1,1239 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: private/v1/quotas_service.proto
+
+//go:build !protoopaque
+
+package privatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuotasListRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Offset        *int32                 `protobuf:"varint,1,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Filter        *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Order         *string                `protobuf:"bytes,4,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	PageToken     *string                `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	SkipTotal     *bool                  `protobuf:"varint,6,opt,name=skip_total,json=skipTotal,proto3,oneof" json:"skip_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasListRequest) Reset() {
+	*x = QuotasListRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasListRequest) ProtoMessage() {}
+
+func (x *QuotasListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasListRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *QuotasListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *QuotasListRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *QuotasListRequest) GetOrder() string {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return ""
+}
+
+func (x *QuotasListRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *QuotasListRequest) GetSkipTotal() bool {
+	if x != nil && x.SkipTotal != nil {
+		return *x.SkipTotal
+	}
+	return false
+}
+
+func (x *QuotasListRequest) SetOffset(v int32) {
+	x.Offset = &v
+}
+
+func (x *QuotasListRequest) SetLimit(v int32) {
+	x.Limit = &v
+}
+
+func (x *QuotasListRequest) SetFilter(v string) {
+	x.Filter = &v
+}
+
+func (x *QuotasListRequest) SetOrder(v string) {
+	x.Order = &v
+}
+
+func (x *QuotasListRequest) SetPageToken(v string) {
+	x.PageToken = &v
+}
+
+func (x *QuotasListRequest) SetSkipTotal(v bool) {
+	x.SkipTotal = &v
+}
+
+func (x *QuotasListRequest) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return x.Offset != nil
+}
+
+func (x *QuotasListRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return x.Limit != nil
+}
+
+func (x *QuotasListRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.Filter != nil
+}
+
+func (x *QuotasListRequest) HasOrder() bool {
+	if x == nil {
+		return false
+	}
+	return x.Order != nil
+}
+
+func (x *QuotasListRequest) HasPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return x.PageToken != nil
+}
+
+func (x *QuotasListRequest) HasSkipTotal() bool {
+	if x == nil {
+		return false
+	}
+	return x.SkipTotal != nil
+}
+
+func (x *QuotasListRequest) ClearOffset() {
+	x.Offset = nil
+}
+
+func (x *QuotasListRequest) ClearLimit() {
+	x.Limit = nil
+}
+
+func (x *QuotasListRequest) ClearFilter() {
+	x.Filter = nil
+}
+
+func (x *QuotasListRequest) ClearOrder() {
+	x.Order = nil
+}
+
+func (x *QuotasListRequest) ClearPageToken() {
+	x.PageToken = nil
+}
+
+func (x *QuotasListRequest) ClearSkipTotal() {
+	x.SkipTotal = nil
+}
+
+type QuotasListRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Offset    *int32
+	Limit     *int32
+	Filter    *string
+	Order     *string
+	PageToken *string
+	SkipTotal *bool
+}
+
+func (b0 QuotasListRequest_builder) Build() *QuotasListRequest {
+	m0 := &QuotasListRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Offset = b.Offset
+	x.Limit = b.Limit
+	x.Filter = b.Filter
+	x.Order = b.Order
+	x.PageToken = b.PageToken
+	x.SkipTotal = b.SkipTotal
+	return m0
+}
+
+type QuotasListResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Size          *int32                 `protobuf:"varint,1,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Items         []*Quota               `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken *string                `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasListResponse) Reset() {
+	*x = QuotasListResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasListResponse) ProtoMessage() {}
+
+func (x *QuotasListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasListResponse) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *QuotasListResponse) GetTotal() int32 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *QuotasListResponse) GetItems() []*Quota {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *QuotasListResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+func (x *QuotasListResponse) SetSize(v int32) {
+	x.Size = &v
+}
+
+func (x *QuotasListResponse) SetTotal(v int32) {
+	x.Total = &v
+}
+
+func (x *QuotasListResponse) SetItems(v []*Quota) {
+	x.Items = v
+}
+
+func (x *QuotasListResponse) SetNextPageToken(v string) {
+	x.NextPageToken = &v
+}
+
+func (x *QuotasListResponse) HasSize() bool {
+	if x == nil {
+		return false
+	}
+	return x.Size != nil
+}
+
+func (x *QuotasListResponse) HasTotal() bool {
+	if x == nil {
+		return false
+	}
+	return x.Total != nil
+}
+
+func (x *QuotasListResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return x.NextPageToken != nil
+}
+
+func (x *QuotasListResponse) ClearSize() {
+	x.Size = nil
+}
+
+func (x *QuotasListResponse) ClearTotal() {
+	x.Total = nil
+}
+
+func (x *QuotasListResponse) ClearNextPageToken() {
+	x.NextPageToken = nil
+}
+
+type QuotasListResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Size          *int32
+	Total         *int32
+	Items         []*Quota
+	NextPageToken *string
+}
+
+func (b0 QuotasListResponse_builder) Build() *QuotasListResponse {
+	m0 := &QuotasListResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Size = b.Size
+	x.Total = b.Total
+	x.Items = b.Items
+	x.NextPageToken = b.NextPageToken
+	return m0
+}
+
+type QuotasGetRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasGetRequest) Reset() {
+	*x = QuotasGetRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasGetRequest) ProtoMessage() {}
+
+func (x *QuotasGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasGetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuotasGetRequest) SetId(v string) {
+	x.Id = v
+}
+
+type QuotasGetRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 QuotasGetRequest_builder) Build() *QuotasGetRequest {
+	m0 := &QuotasGetRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type QuotasGetResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Object        *Quota                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasGetResponse) Reset() {
+	*x = QuotasGetResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasGetResponse) ProtoMessage() {}
+
+func (x *QuotasGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasGetResponse) GetObject() *Quota {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *QuotasGetResponse) SetObject(v *Quota) {
+	x.Object = v
+}
+
+func (x *QuotasGetResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *QuotasGetResponse) ClearObject() {
+	x.Object = nil
+}
+
+type QuotasGetResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *Quota
+}
+
+func (b0 QuotasGetResponse_builder) Build() *QuotasGetResponse {
+	m0 := &QuotasGetResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type QuotasCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Object        *Quota                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasCreateRequest) Reset() {
+	*x = QuotasCreateRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasCreateRequest) ProtoMessage() {}
+
+func (x *QuotasCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasCreateRequest) GetObject() *Quota {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *QuotasCreateRequest) SetObject(v *Quota) {
+	x.Object = v
+}
+
+func (x *QuotasCreateRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *QuotasCreateRequest) ClearObject() {
+	x.Object = nil
+}
+
+type QuotasCreateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *Quota
+}
+
+func (b0 QuotasCreateRequest_builder) Build() *QuotasCreateRequest {
+	m0 := &QuotasCreateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type QuotasCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Object        *Quota                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasCreateResponse) Reset() {
+	*x = QuotasCreateResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasCreateResponse) ProtoMessage() {}
+
+func (x *QuotasCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasCreateResponse) GetObject() *Quota {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *QuotasCreateResponse) SetObject(v *Quota) {
+	x.Object = v
+}
+
+func (x *QuotasCreateResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *QuotasCreateResponse) ClearObject() {
+	x.Object = nil
+}
+
+type QuotasCreateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *Quota
+}
+
+func (b0 QuotasCreateResponse_builder) Build() *QuotasCreateResponse {
+	m0 := &QuotasCreateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type QuotasUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Object        *Quota                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasUpdateRequest) Reset() {
+	*x = QuotasUpdateRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasUpdateRequest) ProtoMessage() {}
+
+func (x *QuotasUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasUpdateRequest) GetObject() *Quota {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *QuotasUpdateRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *QuotasUpdateRequest) SetObject(v *Quota) {
+	x.Object = v
+}
+
+func (x *QuotasUpdateRequest) SetUpdateMask(v *fieldmaskpb.FieldMask) {
+	x.UpdateMask = v
+}
+
+func (x *QuotasUpdateRequest) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *QuotasUpdateRequest) HasUpdateMask() bool {
+	if x == nil {
+		return false
+	}
+	return x.UpdateMask != nil
+}
+
+func (x *QuotasUpdateRequest) ClearObject() {
+	x.Object = nil
+}
+
+func (x *QuotasUpdateRequest) ClearUpdateMask() {
+	x.UpdateMask = nil
+}
+
+type QuotasUpdateRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object     *Quota
+	UpdateMask *fieldmaskpb.FieldMask
+}
+
+func (b0 QuotasUpdateRequest_builder) Build() *QuotasUpdateRequest {
+	m0 := &QuotasUpdateRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	x.UpdateMask = b.UpdateMask
+	return m0
+}
+
+type QuotasUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Object        *Quota                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasUpdateResponse) Reset() {
+	*x = QuotasUpdateResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasUpdateResponse) ProtoMessage() {}
+
+func (x *QuotasUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasUpdateResponse) GetObject() *Quota {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *QuotasUpdateResponse) SetObject(v *Quota) {
+	x.Object = v
+}
+
+func (x *QuotasUpdateResponse) HasObject() bool {
+	if x == nil {
+		return false
+	}
+	return x.Object != nil
+}
+
+func (x *QuotasUpdateResponse) ClearObject() {
+	x.Object = nil
+}
+
+type QuotasUpdateResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Object *Quota
+}
+
+func (b0 QuotasUpdateResponse_builder) Build() *QuotasUpdateResponse {
+	m0 := &QuotasUpdateResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Object = b.Object
+	return m0
+}
+
+type QuotasDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasDeleteRequest) Reset() {
+	*x = QuotasDeleteRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasDeleteRequest) ProtoMessage() {}
+
+func (x *QuotasDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuotasDeleteRequest) SetId(v string) {
+	x.Id = v
+}
+
+type QuotasDeleteRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id string
+}
+
+func (b0 QuotasDeleteRequest_builder) Build() *QuotasDeleteRequest {
+	m0 := &QuotasDeleteRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	return m0
+}
+
+type QuotasDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"hybrid.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasDeleteResponse) Reset() {
+	*x = QuotasDeleteResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasDeleteResponse) ProtoMessage() {}
+
+func (x *QuotasDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type QuotasDeleteResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 QuotasDeleteResponse_builder) Build() *QuotasDeleteResponse {
+	m0 := &QuotasDeleteResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+type QuotasGetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Name of the tenant.
+	Tenant        string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasGetUsageRequest) Reset() {
+	*x = QuotasGetUsageRequest{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasGetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasGetUsageRequest) ProtoMessage() {}
+
+func (x *QuotasGetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasGetUsageRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *QuotasGetUsageRequest) SetTenant(v string) {
+	x.Tenant = v
+}
+
+type QuotasGetUsageRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Name of the tenant.
+	Tenant string
+}
+
+func (b0 QuotasGetUsageRequest_builder) Build() *QuotasGetUsageRequest {
+	m0 := &QuotasGetUsageRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Tenant = b.Tenant
+	return m0
+}
+
+type QuotasGetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Resources currently used by the tenant.
+	Usage *QuotaUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Quota of the tenant. Empty if the tenant doesn't have a quota.
+	Quota         *Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotasGetUsageResponse) Reset() {
+	*x = QuotasGetUsageResponse{}
+	mi := &file_private_v1_quotas_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotasGetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotasGetUsageResponse) ProtoMessage() {}
+
+func (x *QuotasGetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_quotas_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *QuotasGetUsageResponse) GetUsage() *QuotaUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *QuotasGetUsageResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *QuotasGetUsageResponse) SetUsage(v *QuotaUsage) {
+	x.Usage = v
+}
+
+func (x *QuotasGetUsageResponse) SetQuota(v *Quota) {
+	x.Quota = v
+}
+
+func (x *QuotasGetUsageResponse) HasUsage() bool {
+	if x == nil {
+		return false
+	}
+	return x.Usage != nil
+}
+
+func (x *QuotasGetUsageResponse) HasQuota() bool {
+	if x == nil {
+		return false
+	}
+	return x.Quota != nil
+}
+
+func (x *QuotasGetUsageResponse) ClearUsage() {
+	x.Usage = nil
+}
+
+func (x *QuotasGetUsageResponse) ClearQuota() {
+	x.Quota = nil
+}
+
+type QuotasGetUsageResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Resources currently used by the tenant.
+	Usage *QuotaUsage
+	// Quota of the tenant. Empty if the tenant doesn't have a quota.
+	Quota *Quota
+}
+
+func (b0 QuotasGetUsageResponse_builder) Build() *QuotasGetUsageResponse {
+	m0 := &QuotasGetUsageResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Usage = b.Usage
+	x.Quota = b.Quota
+	return m0
+}
+
+var File_private_v1_quotas_service_proto protoreflect.FileDescriptor
+
+var file_private_v1_quotas_service_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x02, 0x0a,
+	0x11, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x09, 0x73, 0x6b,
+	0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0xc5, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x10, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e,
+	0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x40,
+	0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x22, 0x41, 0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x7d, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0x41, 0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x16, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x32, 0xd9, 0x03, 0x0a, 0x06, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x73, 0x12, 0x47, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x03, 0x47, 0x65,
+	0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4d, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4d, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0xb9, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_private_v1_quotas_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_private_v1_quotas_service_proto_goTypes = []any{
+	(*QuotasListRequest)(nil),      // 0: private.v1.QuotasListRequest
+	(*QuotasListResponse)(nil),     // 1: private.v1.QuotasListResponse
+	(*QuotasGetRequest)(nil),       // 2: private.v1.QuotasGetRequest
+	(*QuotasGetResponse)(nil),      // 3: private.v1.QuotasGetResponse
+	(*QuotasCreateRequest)(nil),    // 4: private.v1.QuotasCreateRequest
+	(*QuotasCreateResponse)(nil),   // 5: private.v1.QuotasCreateResponse
+	(*QuotasUpdateRequest)(nil),    // 6: private.v1.QuotasUpdateRequest
+	(*QuotasUpdateResponse)(nil),   // 7: private.v1.QuotasUpdateResponse
+	(*QuotasDeleteRequest)(nil),    // 8: private.v1.QuotasDeleteRequest
+	(*QuotasDeleteResponse)(nil),   // 9: private.v1.QuotasDeleteResponse
+	(*QuotasGetUsageRequest)(nil),  // 10: private.v1.QuotasGetUsageRequest
+	(*QuotasGetUsageResponse)(nil), // 11: private.v1.QuotasGetUsageResponse
+	(*Quota)(nil),                  // 12: private.v1.Quota
+	(*fieldmaskpb.FieldMask)(nil),  // 13: google.protobuf.FieldMask
+	(*QuotaUsage)(nil),             // 14: private.v1.QuotaUsage
+}
+var file_private_v1_quotas_service_proto_depIdxs = []int32{
+	12, // 0: private.v1.QuotasListResponse.items:type_name -> private.v1.Quota
+	12, // 1: private.v1.QuotasGetResponse.object:type_name -> private.v1.Quota
+	12, // 2: private.v1.QuotasCreateRequest.object:type_name -> private.v1.Quota
+	12, // 3: private.v1.QuotasCreateResponse.object:type_name -> private.v1.Quota
+	12, // 4: private.v1.QuotasUpdateRequest.object:type_name -> private.v1.Quota
+	13, // 5: private.v1.QuotasUpdateRequest.update_mask:type_name -> google.protobuf.FieldMask
+	12, // 6: private.v1.QuotasUpdateResponse.object:type_name -> private.v1.Quota
+	14, // 7: private.v1.QuotasGetUsageResponse.usage:type_name -> private.v1.QuotaUsage
+	12, // 8: private.v1.QuotasGetUsageResponse.quota:type_name -> private.v1.Quota
+	0,  // 9: private.v1.Quotas.List:input_type -> private.v1.QuotasListRequest
+	2,  // 10: private.v1.Quotas.Get:input_type -> private.v1.QuotasGetRequest
+	4,  // 11: private.v1.Quotas.Create:input_type -> private.v1.QuotasCreateRequest
+	6,  // 12: private.v1.Quotas.Update:input_type -> private.v1.QuotasUpdateRequest
+	8,  // 13: private.v1.Quotas.Delete:input_type -> private.v1.QuotasDeleteRequest
+	10, // 14: private.v1.Quotas.GetUsage:input_type -> private.v1.QuotasGetUsageRequest
+	1,  // 15: private.v1.Quotas.List:output_type -> private.v1.QuotasListResponse
+	3,  // 16: private.v1.Quotas.Get:output_type -> private.v1.QuotasGetResponse
+	5,  // 17: private.v1.Quotas.Create:output_type -> private.v1.QuotasCreateResponse
+	7,  // 18: private.v1.Quotas.Update:output_type -> private.v1.QuotasUpdateResponse
+	9,  // 19: private.v1.Quotas.Delete:output_type -> private.v1.QuotasDeleteResponse
+	11, // 20: private.v1.Quotas.GetUsage:output_type -> private.v1.QuotasGetUsageResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_private_v1_quotas_service_proto_init() }
+func file_private_v1_quotas_service_proto_init() {
+	if File_private_v1_quotas_service_proto != nil {
+		return
+	}
+	file_private_v1_quota_type_proto_init()
+	file_private_v1_quotas_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_private_v1_quotas_service_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_quotas_service_proto_rawDesc), len(file_private_v1_quotas_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_private_v1_quotas_service_proto_goTypes,
+		DependencyIndexes: file_private_v1_quotas_service_proto_depIdxs,
+		MessageInfos:      file_private_v1_quotas_service_proto_msgTypes,
+	}.Build()
+	File_private_v1_quotas_service_proto = out.File
+	file_private_v1_quotas_service_proto_goTypes = nil
+	file_private_v1_quotas_service_proto_depIdxs = nil
+}
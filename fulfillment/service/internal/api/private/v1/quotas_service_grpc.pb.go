@@ -0,0 +1,326 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: private/v1/quotas_service.proto
+
+package privatev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Quotas_List_FullMethodName     = "/private.v1.Quotas/List"
+	Quotas_Get_FullMethodName      = "/private.v1.Quotas/Get"
+	Quotas_Create_FullMethodName   = "/private.v1.Quotas/Create"
+	Quotas_Update_FullMethodName   = "/private.v1.Quotas/Update"
+	Quotas_Delete_FullMethodName   = "/private.v1.Quotas/Delete"
+	Quotas_GetUsage_FullMethodName = "/private.v1.Quotas/GetUsage"
+)
+
+// QuotasClient is the client API for Quotas service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotasClient interface {
+	List(ctx context.Context, in *QuotasListRequest, opts ...grpc.CallOption) (*QuotasListResponse, error)
+	Get(ctx context.Context, in *QuotasGetRequest, opts ...grpc.CallOption) (*QuotasGetResponse, error)
+	Create(ctx context.Context, in *QuotasCreateRequest, opts ...grpc.CallOption) (*QuotasCreateResponse, error)
+	Update(ctx context.Context, in *QuotasUpdateRequest, opts ...grpc.CallOption) (*QuotasUpdateResponse, error)
+	Delete(ctx context.Context, in *QuotasDeleteRequest, opts ...grpc.CallOption) (*QuotasDeleteResponse, error)
+	// Returns the resources currently used by a tenant, together with its quota.
+	GetUsage(ctx context.Context, in *QuotasGetUsageRequest, opts ...grpc.CallOption) (*QuotasGetUsageResponse, error)
+}
+
+type quotasClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotasClient(cc grpc.ClientConnInterface) QuotasClient {
+	return &quotasClient{cc}
+}
+
+func (c *quotasClient) List(ctx context.Context, in *QuotasListRequest, opts ...grpc.CallOption) (*QuotasListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasListResponse)
+	err := c.cc.Invoke(ctx, Quotas_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotasClient) Get(ctx context.Context, in *QuotasGetRequest, opts ...grpc.CallOption) (*QuotasGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasGetResponse)
+	err := c.cc.Invoke(ctx, Quotas_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotasClient) Create(ctx context.Context, in *QuotasCreateRequest, opts ...grpc.CallOption) (*QuotasCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasCreateResponse)
+	err := c.cc.Invoke(ctx, Quotas_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotasClient) Update(ctx context.Context, in *QuotasUpdateRequest, opts ...grpc.CallOption) (*QuotasUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasUpdateResponse)
+	err := c.cc.Invoke(ctx, Quotas_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotasClient) Delete(ctx context.Context, in *QuotasDeleteRequest, opts ...grpc.CallOption) (*QuotasDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasDeleteResponse)
+	err := c.cc.Invoke(ctx, Quotas_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotasClient) GetUsage(ctx context.Context, in *QuotasGetUsageRequest, opts ...grpc.CallOption) (*QuotasGetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotasGetUsageResponse)
+	err := c.cc.Invoke(ctx, Quotas_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotasServer is the server API for Quotas service.
+// All implementations must embed UnimplementedQuotasServer
+// for forward compatibility.
+type QuotasServer interface {
+	List(context.Context, *QuotasListRequest) (*QuotasListResponse, error)
+	Get(context.Context, *QuotasGetRequest) (*QuotasGetResponse, error)
+	Create(context.Context, *QuotasCreateRequest) (*QuotasCreateResponse, error)
+	Update(context.Context, *QuotasUpdateRequest) (*QuotasUpdateResponse, error)
+	Delete(context.Context, *QuotasDeleteRequest) (*QuotasDeleteResponse, error)
+	// Returns the resources currently used by a tenant, together with its quota.
+	GetUsage(context.Context, *QuotasGetUsageRequest) (*QuotasGetUsageResponse, error)
+	mustEmbedUnimplementedQuotasServer()
+}
+
+// UnimplementedQuotasServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuotasServer struct{}
+
+func (UnimplementedQuotasServer) List(context.Context, *QuotasListRequest) (*QuotasListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedQuotasServer) Get(context.Context, *QuotasGetRequest) (*QuotasGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedQuotasServer) Create(context.Context, *QuotasCreateRequest) (*QuotasCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedQuotasServer) Update(context.Context, *QuotasUpdateRequest) (*QuotasUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedQuotasServer) Delete(context.Context, *QuotasDeleteRequest) (*QuotasDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedQuotasServer) GetUsage(context.Context, *QuotasGetUsageRequest) (*QuotasGetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedQuotasServer) mustEmbedUnimplementedQuotasServer() {}
+func (UnimplementedQuotasServer) testEmbeddedByValue()                {}
+
+// UnsafeQuotasServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotasServer will
+// result in compilation errors.
+type UnsafeQuotasServer interface {
+	mustEmbedUnimplementedQuotasServer()
+}
+
+func RegisterQuotasServer(s grpc.ServiceRegistrar, srv QuotasServer) {
+	// If the following call pancis, it indicates UnimplementedQuotasServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Quotas_ServiceDesc, srv)
+}
+
+func _Quotas_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).List(ctx, req.(*QuotasListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quotas_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).Get(ctx, req.(*QuotasGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quotas_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).Create(ctx, req.(*QuotasCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quotas_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).Update(ctx, req.(*QuotasUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quotas_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).Delete(ctx, req.(*QuotasDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quotas_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasGetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotasServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quotas_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotasServer).GetUsage(ctx, req.(*QuotasGetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Quotas_ServiceDesc is the grpc.ServiceDesc for Quotas service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Quotas_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "private.v1.Quotas",
+	HandlerType: (*QuotasServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Quotas_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Quotas_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Quotas_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Quotas_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Quotas_Delete_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Quotas_GetUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "private/v1/quotas_service.proto",
+}
@@ -0,0 +1,657 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: private/v1/virtual_machine_snapshot_type.proto
+
+//go:build !protoopaque
+
+package privatev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VirtualMachineSnapshotState int32
+
+const (
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED VirtualMachineSnapshotState = 0
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING VirtualMachineSnapshotState = 1
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY       VirtualMachineSnapshotState = 2
+	VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED      VirtualMachineSnapshotState = 3
+)
+
+// Enum value maps for VirtualMachineSnapshotState.
+var (
+	VirtualMachineSnapshotState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING",
+		2: "VIRTUAL_MACHINE_SNAPSHOT_STATE_READY",
+		3: "VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED",
+	}
+	VirtualMachineSnapshotState_value = map[string]int32{
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING": 1,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_READY":       2,
+		"VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED":      3,
+	}
+)
+
+func (x VirtualMachineSnapshotState) Enum() *VirtualMachineSnapshotState {
+	p := new(VirtualMachineSnapshotState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineSnapshotState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineSnapshotState) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_virtual_machine_snapshot_type_proto_enumTypes[0].Descriptor()
+}
+
+func (VirtualMachineSnapshotState) Type() protoreflect.EnumType {
+	return &file_private_v1_virtual_machine_snapshot_type_proto_enumTypes[0]
+}
+
+func (x VirtualMachineSnapshotState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type VirtualMachineSnapshotRestoreState int32
+
+const (
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED VirtualMachineSnapshotRestoreState = 0
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING VirtualMachineSnapshotRestoreState = 1
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED   VirtualMachineSnapshotRestoreState = 2
+	VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED      VirtualMachineSnapshotRestoreState = 3
+)
+
+// Enum value maps for VirtualMachineSnapshotRestoreState.
+var (
+	VirtualMachineSnapshotRestoreState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING",
+		2: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED",
+		3: "VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED",
+	}
+	VirtualMachineSnapshotRestoreState_value = map[string]int32{
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING": 1,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED":   2,
+		"VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED":      3,
+	}
+)
+
+func (x VirtualMachineSnapshotRestoreState) Enum() *VirtualMachineSnapshotRestoreState {
+	p := new(VirtualMachineSnapshotRestoreState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineSnapshotRestoreState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineSnapshotRestoreState) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_virtual_machine_snapshot_type_proto_enumTypes[1].Descriptor()
+}
+
+func (VirtualMachineSnapshotRestoreState) Type() protoreflect.EnumType {
+	return &file_private_v1_virtual_machine_snapshot_type_proto_enumTypes[1]
+}
+
+func (x VirtualMachineSnapshotRestoreState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Contains the details about the virtual machine snapshot that are available only for the system.
+type VirtualMachineSnapshot struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Public data.
+	Id            string                        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata      *Metadata                     `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec          *VirtualMachineSnapshotSpec   `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status        *VirtualMachineSnapshotStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshot) Reset() {
+	*x = VirtualMachineSnapshot{}
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshot) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshot) GetMetadata() *Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) GetSpec() *VirtualMachineSnapshotSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) GetStatus() *VirtualMachineSnapshotStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshot) SetId(v string) {
+	x.Id = v
+}
+
+func (x *VirtualMachineSnapshot) SetMetadata(v *Metadata) {
+	x.Metadata = v
+}
+
+func (x *VirtualMachineSnapshot) SetSpec(v *VirtualMachineSnapshotSpec) {
+	x.Spec = v
+}
+
+func (x *VirtualMachineSnapshot) SetStatus(v *VirtualMachineSnapshotStatus) {
+	x.Status = v
+}
+
+func (x *VirtualMachineSnapshot) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return x.Metadata != nil
+}
+
+func (x *VirtualMachineSnapshot) HasSpec() bool {
+	if x == nil {
+		return false
+	}
+	return x.Spec != nil
+}
+
+func (x *VirtualMachineSnapshot) HasStatus() bool {
+	if x == nil {
+		return false
+	}
+	return x.Status != nil
+}
+
+func (x *VirtualMachineSnapshot) ClearMetadata() {
+	x.Metadata = nil
+}
+
+func (x *VirtualMachineSnapshot) ClearSpec() {
+	x.Spec = nil
+}
+
+func (x *VirtualMachineSnapshot) ClearStatus() {
+	x.Status = nil
+}
+
+type VirtualMachineSnapshot_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Public data.
+	Id       string
+	Metadata *Metadata
+	Spec     *VirtualMachineSnapshotSpec
+	Status   *VirtualMachineSnapshotStatus
+}
+
+func (b0 VirtualMachineSnapshot_builder) Build() *VirtualMachineSnapshot {
+	m0 := &VirtualMachineSnapshot{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.Id = b.Id
+	x.Metadata = b.Metadata
+	x.Spec = b.Spec
+	x.Status = b.Status
+	return m0
+}
+
+type VirtualMachineSnapshotSpec struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Copies of the public fields.
+	VirtualMachine     string                 `protobuf:"bytes,1,opt,name=virtual_machine,json=virtualMachine,proto3" json:"virtual_machine,omitempty"`
+	RestoreRequestTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=restore_request_time,json=restoreRequestTime,proto3" json:"restore_request_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotSpec) Reset() {
+	*x = VirtualMachineSnapshotSpec{}
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotSpec) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotSpec) GetVirtualMachine() string {
+	if x != nil {
+		return x.VirtualMachine
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotSpec) GetRestoreRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestoreRequestTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotSpec) SetVirtualMachine(v string) {
+	x.VirtualMachine = v
+}
+
+func (x *VirtualMachineSnapshotSpec) SetRestoreRequestTime(v *timestamppb.Timestamp) {
+	x.RestoreRequestTime = v
+}
+
+func (x *VirtualMachineSnapshotSpec) HasRestoreRequestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.RestoreRequestTime != nil
+}
+
+func (x *VirtualMachineSnapshotSpec) ClearRestoreRequestTime() {
+	x.RestoreRequestTime = nil
+}
+
+type VirtualMachineSnapshotSpec_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Copies of the public fields.
+	VirtualMachine     string
+	RestoreRequestTime *timestamppb.Timestamp
+}
+
+func (b0 VirtualMachineSnapshotSpec_builder) Build() *VirtualMachineSnapshotSpec {
+	m0 := &VirtualMachineSnapshotSpec{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.VirtualMachine = b.VirtualMachine
+	x.RestoreRequestTime = b.RestoreRequestTime
+	return m0
+}
+
+type VirtualMachineSnapshotStatus struct {
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Copies of the public fields.
+	State           VirtualMachineSnapshotState        `protobuf:"varint,1,opt,name=state,proto3,enum=private.v1.VirtualMachineSnapshotState" json:"state,omitempty"`
+	CreationTime    *timestamppb.Timestamp             `protobuf:"bytes,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	RestoreState    VirtualMachineSnapshotRestoreState `protobuf:"varint,3,opt,name=restore_state,json=restoreState,proto3,enum=private.v1.VirtualMachineSnapshotRestoreState" json:"restore_state,omitempty"`
+	LastRestoreTime *timestamppb.Timestamp             `protobuf:"bytes,4,opt,name=last_restore_time,json=lastRestoreTime,proto3" json:"last_restore_time,omitempty"`
+	Message         string                             `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// Identifier of the hub where the snapshot has been created. This is the hub of the virtual machine.
+	Hub           string `protobuf:"bytes,6,opt,name=hub,proto3" json:"hub,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualMachineSnapshotStatus) Reset() {
+	*x = VirtualMachineSnapshotStatus{}
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualMachineSnapshotStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMachineSnapshotStatus) ProtoMessage() {}
+
+func (x *VirtualMachineSnapshotStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_private_v1_virtual_machine_snapshot_type_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VirtualMachineSnapshotStatus) GetState() VirtualMachineSnapshotState {
+	if x != nil {
+		return x.State
+	}
+	return VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED
+}
+
+func (x *VirtualMachineSnapshotStatus) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotStatus) GetRestoreState() VirtualMachineSnapshotRestoreState {
+	if x != nil {
+		return x.RestoreState
+	}
+	return VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED
+}
+
+func (x *VirtualMachineSnapshotStatus) GetLastRestoreTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRestoreTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSnapshotStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotStatus) GetHub() string {
+	if x != nil {
+		return x.Hub
+	}
+	return ""
+}
+
+func (x *VirtualMachineSnapshotStatus) SetState(v VirtualMachineSnapshotState) {
+	x.State = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetCreationTime(v *timestamppb.Timestamp) {
+	x.CreationTime = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetRestoreState(v VirtualMachineSnapshotRestoreState) {
+	x.RestoreState = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetLastRestoreTime(v *timestamppb.Timestamp) {
+	x.LastRestoreTime = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetMessage(v string) {
+	x.Message = v
+}
+
+func (x *VirtualMachineSnapshotStatus) SetHub(v string) {
+	x.Hub = v
+}
+
+func (x *VirtualMachineSnapshotStatus) HasCreationTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.CreationTime != nil
+}
+
+func (x *VirtualMachineSnapshotStatus) HasLastRestoreTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.LastRestoreTime != nil
+}
+
+func (x *VirtualMachineSnapshotStatus) ClearCreationTime() {
+	x.CreationTime = nil
+}
+
+func (x *VirtualMachineSnapshotStatus) ClearLastRestoreTime() {
+	x.LastRestoreTime = nil
+}
+
+type VirtualMachineSnapshotStatus_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	// Copies of the public fields.
+	State           VirtualMachineSnapshotState
+	CreationTime    *timestamppb.Timestamp
+	RestoreState    VirtualMachineSnapshotRestoreState
+	LastRestoreTime *timestamppb.Timestamp
+	Message         string
+	// Identifier of the hub where the snapshot has been created. This is the hub of the virtual machine.
+	Hub string
+}
+
+func (b0 VirtualMachineSnapshotStatus_builder) Build() *VirtualMachineSnapshotStatus {
+	m0 := &VirtualMachineSnapshotStatus{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.State = b.State
+	x.CreationTime = b.CreationTime
+	x.RestoreState = b.RestoreState
+	x.LastRestoreTime = b.LastRestoreTime
+	x.Message = b.Message
+	x.Hub = b.Hub
+	return m0
+}
+
+var File_private_v1_virtual_machine_snapshot_type_proto protoreflect.FileDescriptor
+
+var file_private_v1_virtual_machine_snapshot_type_proto_rawDesc = string([]byte{
+	0x0a, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x01,
+	0x0a, 0x16, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x1a, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe7,
+	0x02, 0x0a, 0x1c, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f,
+	0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x53, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x75, 0x62, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x75, 0x62, 0x2a, 0xd2, 0x01, 0x0a, 0x1b, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
+	0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50,
+	0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xfd, 0x01,
+	0x0a, 0x22, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x32, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x36, 0x0a, 0x32,
+	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x34, 0x0a, 0x30, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x31, 0x0a, 0x2d, 0x56, 0x49,
+	0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x4e,
+	0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0xc6, 0x01,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x42, 0x1f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var file_private_v1_virtual_machine_snapshot_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_private_v1_virtual_machine_snapshot_type_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_private_v1_virtual_machine_snapshot_type_proto_goTypes = []any{
+	(VirtualMachineSnapshotState)(0),        // 0: private.v1.VirtualMachineSnapshotState
+	(VirtualMachineSnapshotRestoreState)(0), // 1: private.v1.VirtualMachineSnapshotRestoreState
+	(*VirtualMachineSnapshot)(nil),          // 2: private.v1.VirtualMachineSnapshot
+	(*VirtualMachineSnapshotSpec)(nil),      // 3: private.v1.VirtualMachineSnapshotSpec
+	(*VirtualMachineSnapshotStatus)(nil),    // 4: private.v1.VirtualMachineSnapshotStatus
+	(*Metadata)(nil),                        // 5: private.v1.Metadata
+	(*timestamppb.Timestamp)(nil),           // 6: google.protobuf.Timestamp
+}
+var file_private_v1_virtual_machine_snapshot_type_proto_depIdxs = []int32{
+	5, // 0: private.v1.VirtualMachineSnapshot.metadata:type_name -> private.v1.Metadata
+	3, // 1: private.v1.VirtualMachineSnapshot.spec:type_name -> private.v1.VirtualMachineSnapshotSpec
+	4, // 2: private.v1.VirtualMachineSnapshot.status:type_name -> private.v1.VirtualMachineSnapshotStatus
+	6, // 3: private.v1.VirtualMachineSnapshotSpec.restore_request_time:type_name -> google.protobuf.Timestamp
+	0, // 4: private.v1.VirtualMachineSnapshotStatus.state:type_name -> private.v1.VirtualMachineSnapshotState
+	6, // 5: private.v1.VirtualMachineSnapshotStatus.creation_time:type_name -> google.protobuf.Timestamp
+	1, // 6: private.v1.VirtualMachineSnapshotStatus.restore_state:type_name -> private.v1.VirtualMachineSnapshotRestoreState
+	6, // 7: private.v1.VirtualMachineSnapshotStatus.last_restore_time:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_private_v1_virtual_machine_snapshot_type_proto_init() }
+func file_private_v1_virtual_machine_snapshot_type_proto_init() {
+	if File_private_v1_virtual_machine_snapshot_type_proto != nil {
+		return
+	}
+	file_private_v1_metadata_type_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_virtual_machine_snapshot_type_proto_rawDesc), len(file_private_v1_virtual_machine_snapshot_type_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_private_v1_virtual_machine_snapshot_type_proto_goTypes,
+		DependencyIndexes: file_private_v1_virtual_machine_snapshot_type_proto_depIdxs,
+		EnumInfos:         file_private_v1_virtual_machine_snapshot_type_proto_enumTypes,
+		MessageInfos:      file_private_v1_virtual_machine_snapshot_type_proto_msgTypes,
+	}.Build()
+	File_private_v1_virtual_machine_snapshot_type_proto = out.File
+	file_private_v1_virtual_machine_snapshot_type_proto_goTypes = nil
+	file_private_v1_virtual_machine_snapshot_type_proto_depIdxs = nil
+}
@@ -0,0 +1,451 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: private/v1/virtual_machine_snapshots_service.proto
+
+package privatev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VirtualMachineSnapshots_List_FullMethodName         = "/private.v1.VirtualMachineSnapshots/List"
+	VirtualMachineSnapshots_Get_FullMethodName          = "/private.v1.VirtualMachineSnapshots/Get"
+	VirtualMachineSnapshots_Create_FullMethodName       = "/private.v1.VirtualMachineSnapshots/Create"
+	VirtualMachineSnapshots_Delete_FullMethodName       = "/private.v1.VirtualMachineSnapshots/Delete"
+	VirtualMachineSnapshots_Update_FullMethodName       = "/private.v1.VirtualMachineSnapshots/Update"
+	VirtualMachineSnapshots_ListArchived_FullMethodName = "/private.v1.VirtualMachineSnapshots/ListArchived"
+	VirtualMachineSnapshots_GetArchived_FullMethodName  = "/private.v1.VirtualMachineSnapshots/GetArchived"
+	VirtualMachineSnapshots_Undelete_FullMethodName     = "/private.v1.VirtualMachineSnapshots/Undelete"
+	VirtualMachineSnapshots_Restore_FullMethodName      = "/private.v1.VirtualMachineSnapshots/Restore"
+)
+
+// VirtualMachineSnapshotsClient is the client API for VirtualMachineSnapshots service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VirtualMachineSnapshotsClient interface {
+	List(ctx context.Context, in *VirtualMachineSnapshotsListRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListResponse, error)
+	Get(ctx context.Context, in *VirtualMachineSnapshotsGetRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetResponse, error)
+	Create(ctx context.Context, in *VirtualMachineSnapshotsCreateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsCreateResponse, error)
+	Delete(ctx context.Context, in *VirtualMachineSnapshotsDeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsDeleteResponse, error)
+	Update(ctx context.Context, in *VirtualMachineSnapshotsUpdateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(ctx context.Context, in *VirtualMachineSnapshotsListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(ctx context.Context, in *VirtualMachineSnapshotsGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(ctx context.Context, in *VirtualMachineSnapshotsUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsUndeleteResponse, error)
+	// Sets the restore request time of the snapshot to the current time.
+	Restore(ctx context.Context, in *VirtualMachineSnapshotsRestoreRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsRestoreResponse, error)
+}
+
+type virtualMachineSnapshotsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVirtualMachineSnapshotsClient(cc grpc.ClientConnInterface) VirtualMachineSnapshotsClient {
+	return &virtualMachineSnapshotsClient{cc}
+}
+
+func (c *virtualMachineSnapshotsClient) List(ctx context.Context, in *VirtualMachineSnapshotsListRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsListResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Get(ctx context.Context, in *VirtualMachineSnapshotsGetRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsGetResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Create(ctx context.Context, in *VirtualMachineSnapshotsCreateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsCreateResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Delete(ctx context.Context, in *VirtualMachineSnapshotsDeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsDeleteResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Update(ctx context.Context, in *VirtualMachineSnapshotsUpdateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsUpdateResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) ListArchived(ctx context.Context, in *VirtualMachineSnapshotsListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsListArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_ListArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) GetArchived(ctx context.Context, in *VirtualMachineSnapshotsGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsGetArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_GetArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Undelete(ctx context.Context, in *VirtualMachineSnapshotsUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsUndeleteResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Restore(ctx context.Context, in *VirtualMachineSnapshotsRestoreRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsRestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsRestoreResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VirtualMachineSnapshotsServer is the server API for VirtualMachineSnapshots service.
+// All implementations must embed UnimplementedVirtualMachineSnapshotsServer
+// for forward compatibility.
+type VirtualMachineSnapshotsServer interface {
+	List(context.Context, *VirtualMachineSnapshotsListRequest) (*VirtualMachineSnapshotsListResponse, error)
+	Get(context.Context, *VirtualMachineSnapshotsGetRequest) (*VirtualMachineSnapshotsGetResponse, error)
+	Create(context.Context, *VirtualMachineSnapshotsCreateRequest) (*VirtualMachineSnapshotsCreateResponse, error)
+	Delete(context.Context, *VirtualMachineSnapshotsDeleteRequest) (*VirtualMachineSnapshotsDeleteResponse, error)
+	Update(context.Context, *VirtualMachineSnapshotsUpdateRequest) (*VirtualMachineSnapshotsUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(context.Context, *VirtualMachineSnapshotsListArchivedRequest) (*VirtualMachineSnapshotsListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(context.Context, *VirtualMachineSnapshotsGetArchivedRequest) (*VirtualMachineSnapshotsGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(context.Context, *VirtualMachineSnapshotsUndeleteRequest) (*VirtualMachineSnapshotsUndeleteResponse, error)
+	// Sets the restore request time of the snapshot to the current time.
+	Restore(context.Context, *VirtualMachineSnapshotsRestoreRequest) (*VirtualMachineSnapshotsRestoreResponse, error)
+	mustEmbedUnimplementedVirtualMachineSnapshotsServer()
+}
+
+// UnimplementedVirtualMachineSnapshotsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVirtualMachineSnapshotsServer struct{}
+
+func (UnimplementedVirtualMachineSnapshotsServer) List(context.Context, *VirtualMachineSnapshotsListRequest) (*VirtualMachineSnapshotsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Get(context.Context, *VirtualMachineSnapshotsGetRequest) (*VirtualMachineSnapshotsGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Create(context.Context, *VirtualMachineSnapshotsCreateRequest) (*VirtualMachineSnapshotsCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Delete(context.Context, *VirtualMachineSnapshotsDeleteRequest) (*VirtualMachineSnapshotsDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Update(context.Context, *VirtualMachineSnapshotsUpdateRequest) (*VirtualMachineSnapshotsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) ListArchived(context.Context, *VirtualMachineSnapshotsListArchivedRequest) (*VirtualMachineSnapshotsListArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchived not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) GetArchived(context.Context, *VirtualMachineSnapshotsGetArchivedRequest) (*VirtualMachineSnapshotsGetArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchived not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Undelete(context.Context, *VirtualMachineSnapshotsUndeleteRequest) (*VirtualMachineSnapshotsUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Restore(context.Context, *VirtualMachineSnapshotsRestoreRequest) (*VirtualMachineSnapshotsRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) mustEmbedUnimplementedVirtualMachineSnapshotsServer() {
+}
+func (UnimplementedVirtualMachineSnapshotsServer) testEmbeddedByValue() {}
+
+// UnsafeVirtualMachineSnapshotsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VirtualMachineSnapshotsServer will
+// result in compilation errors.
+type UnsafeVirtualMachineSnapshotsServer interface {
+	mustEmbedUnimplementedVirtualMachineSnapshotsServer()
+}
+
+func RegisterVirtualMachineSnapshotsServer(s grpc.ServiceRegistrar, srv VirtualMachineSnapshotsServer) {
+	// If the following call pancis, it indicates UnimplementedVirtualMachineSnapshotsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VirtualMachineSnapshots_ServiceDesc, srv)
+}
+
+func _VirtualMachineSnapshots_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).List(ctx, req.(*VirtualMachineSnapshotsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Get(ctx, req.(*VirtualMachineSnapshotsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Create(ctx, req.(*VirtualMachineSnapshotsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Delete(ctx, req.(*VirtualMachineSnapshotsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Update(ctx, req.(*VirtualMachineSnapshotsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_ListArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).ListArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_ListArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).ListArchived(ctx, req.(*VirtualMachineSnapshotsListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_GetArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsGetArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).GetArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_GetArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).GetArchived(ctx, req.(*VirtualMachineSnapshotsGetArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Undelete(ctx, req.(*VirtualMachineSnapshotsUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Restore(ctx, req.(*VirtualMachineSnapshotsRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VirtualMachineSnapshots_ServiceDesc is the grpc.ServiceDesc for VirtualMachineSnapshots service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VirtualMachineSnapshots_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "private.v1.VirtualMachineSnapshots",
+	HandlerType: (*VirtualMachineSnapshotsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _VirtualMachineSnapshots_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _VirtualMachineSnapshots_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _VirtualMachineSnapshots_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VirtualMachineSnapshots_Delete_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _VirtualMachineSnapshots_Update_Handler,
+		},
+		{
+			MethodName: "ListArchived",
+			Handler:    _VirtualMachineSnapshots_ListArchived_Handler,
+		},
+		{
+			MethodName: "GetArchived",
+			Handler:    _VirtualMachineSnapshots_GetArchived_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _VirtualMachineSnapshots_Undelete_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _VirtualMachineSnapshots_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "private/v1/virtual_machine_snapshots_service.proto",
+}
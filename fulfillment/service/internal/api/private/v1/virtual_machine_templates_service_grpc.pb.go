@@ -32,11 +32,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VirtualMachineTemplates_List_FullMethodName   = "/private.v1.VirtualMachineTemplates/List"
-	VirtualMachineTemplates_Get_FullMethodName    = "/private.v1.VirtualMachineTemplates/Get"
-	VirtualMachineTemplates_Create_FullMethodName = "/private.v1.VirtualMachineTemplates/Create"
-	VirtualMachineTemplates_Delete_FullMethodName = "/private.v1.VirtualMachineTemplates/Delete"
-	VirtualMachineTemplates_Update_FullMethodName = "/private.v1.VirtualMachineTemplates/Update"
+	VirtualMachineTemplates_List_FullMethodName         = "/private.v1.VirtualMachineTemplates/List"
+	VirtualMachineTemplates_Get_FullMethodName          = "/private.v1.VirtualMachineTemplates/Get"
+	VirtualMachineTemplates_Create_FullMethodName       = "/private.v1.VirtualMachineTemplates/Create"
+	VirtualMachineTemplates_Delete_FullMethodName       = "/private.v1.VirtualMachineTemplates/Delete"
+	VirtualMachineTemplates_Update_FullMethodName       = "/private.v1.VirtualMachineTemplates/Update"
+	VirtualMachineTemplates_ListArchived_FullMethodName = "/private.v1.VirtualMachineTemplates/ListArchived"
+	VirtualMachineTemplates_GetArchived_FullMethodName  = "/private.v1.VirtualMachineTemplates/GetArchived"
+	VirtualMachineTemplates_Undelete_FullMethodName     = "/private.v1.VirtualMachineTemplates/Undelete"
 )
 
 // VirtualMachineTemplatesClient is the client API for VirtualMachineTemplates service.
@@ -48,6 +51,14 @@ type VirtualMachineTemplatesClient interface {
 	Create(ctx context.Context, in *VirtualMachineTemplatesCreateRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesCreateResponse, error)
 	Delete(ctx context.Context, in *VirtualMachineTemplatesDeleteRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesDeleteResponse, error)
 	Update(ctx context.Context, in *VirtualMachineTemplatesUpdateRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(ctx context.Context, in *VirtualMachineTemplatesListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(ctx context.Context, in *VirtualMachineTemplatesGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(ctx context.Context, in *VirtualMachineTemplatesUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesUndeleteResponse, error)
 }
 
 type virtualMachineTemplatesClient struct {
@@ -108,6 +119,36 @@ func (c *virtualMachineTemplatesClient) Update(ctx context.Context, in *VirtualM
 	return out, nil
 }
 
+func (c *virtualMachineTemplatesClient) ListArchived(ctx context.Context, in *VirtualMachineTemplatesListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesListArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineTemplatesListArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineTemplates_ListArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineTemplatesClient) GetArchived(ctx context.Context, in *VirtualMachineTemplatesGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesGetArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineTemplatesGetArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineTemplates_GetArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineTemplatesClient) Undelete(ctx context.Context, in *VirtualMachineTemplatesUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachineTemplatesUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineTemplatesUndeleteResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineTemplates_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VirtualMachineTemplatesServer is the server API for VirtualMachineTemplates service.
 // All implementations must embed UnimplementedVirtualMachineTemplatesServer
 // for forward compatibility.
@@ -117,6 +158,14 @@ type VirtualMachineTemplatesServer interface {
 	Create(context.Context, *VirtualMachineTemplatesCreateRequest) (*VirtualMachineTemplatesCreateResponse, error)
 	Delete(context.Context, *VirtualMachineTemplatesDeleteRequest) (*VirtualMachineTemplatesDeleteResponse, error)
 	Update(context.Context, *VirtualMachineTemplatesUpdateRequest) (*VirtualMachineTemplatesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(context.Context, *VirtualMachineTemplatesListArchivedRequest) (*VirtualMachineTemplatesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(context.Context, *VirtualMachineTemplatesGetArchivedRequest) (*VirtualMachineTemplatesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(context.Context, *VirtualMachineTemplatesUndeleteRequest) (*VirtualMachineTemplatesUndeleteResponse, error)
 	mustEmbedUnimplementedVirtualMachineTemplatesServer()
 }
 
@@ -142,6 +191,15 @@ func (UnimplementedVirtualMachineTemplatesServer) Delete(context.Context, *Virtu
 func (UnimplementedVirtualMachineTemplatesServer) Update(context.Context, *VirtualMachineTemplatesUpdateRequest) (*VirtualMachineTemplatesUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedVirtualMachineTemplatesServer) ListArchived(context.Context, *VirtualMachineTemplatesListArchivedRequest) (*VirtualMachineTemplatesListArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchived not implemented")
+}
+func (UnimplementedVirtualMachineTemplatesServer) GetArchived(context.Context, *VirtualMachineTemplatesGetArchivedRequest) (*VirtualMachineTemplatesGetArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchived not implemented")
+}
+func (UnimplementedVirtualMachineTemplatesServer) Undelete(context.Context, *VirtualMachineTemplatesUndeleteRequest) (*VirtualMachineTemplatesUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
 func (UnimplementedVirtualMachineTemplatesServer) mustEmbedUnimplementedVirtualMachineTemplatesServer() {
 }
 func (UnimplementedVirtualMachineTemplatesServer) testEmbeddedByValue() {}
@@ -254,6 +312,60 @@ func _VirtualMachineTemplates_Update_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VirtualMachineTemplates_ListArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineTemplatesListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineTemplatesServer).ListArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineTemplates_ListArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineTemplatesServer).ListArchived(ctx, req.(*VirtualMachineTemplatesListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineTemplates_GetArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineTemplatesGetArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineTemplatesServer).GetArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineTemplates_GetArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineTemplatesServer).GetArchived(ctx, req.(*VirtualMachineTemplatesGetArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineTemplates_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineTemplatesUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineTemplatesServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineTemplates_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineTemplatesServer).Undelete(ctx, req.(*VirtualMachineTemplatesUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VirtualMachineTemplates_ServiceDesc is the grpc.ServiceDesc for VirtualMachineTemplates service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +393,18 @@ var VirtualMachineTemplates_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _VirtualMachineTemplates_Update_Handler,
 		},
+		{
+			MethodName: "ListArchived",
+			Handler:    _VirtualMachineTemplates_ListArchived_Handler,
+		},
+		{
+			MethodName: "GetArchived",
+			Handler:    _VirtualMachineTemplates_GetArchived_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _VirtualMachineTemplates_Undelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "private/v1/virtual_machine_templates_service.proto",
@@ -85,6 +85,109 @@ func (x VirtualMachineState) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+type VirtualMachineRunStrategy int32
+
+const (
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED      VirtualMachineRunStrategy = 0
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS           VirtualMachineRunStrategy = 1
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED           VirtualMachineRunStrategy = 2
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL           VirtualMachineRunStrategy = 3
+	VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE VirtualMachineRunStrategy = 4
+)
+
+// Enum value maps for VirtualMachineRunStrategy.
+var (
+	VirtualMachineRunStrategy_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS",
+		2: "VIRTUAL_MACHINE_RUN_STRATEGY_HALTED",
+		3: "VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL",
+		4: "VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE",
+	}
+	VirtualMachineRunStrategy_value = map[string]int32{
+		"VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED":      0,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS":           1,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_HALTED":           2,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL":           3,
+		"VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE": 4,
+	}
+)
+
+func (x VirtualMachineRunStrategy) Enum() *VirtualMachineRunStrategy {
+	p := new(VirtualMachineRunStrategy)
+	*p = x
+	return p
+}
+
+func (x VirtualMachineRunStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachineRunStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_virtual_machine_type_proto_enumTypes[1].Descriptor()
+}
+
+func (VirtualMachineRunStrategy) Type() protoreflect.EnumType {
+	return &file_private_v1_virtual_machine_type_proto_enumTypes[1]
+}
+
+func (x VirtualMachineRunStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+type VirtualMachinePowerState int32
+
+const (
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED VirtualMachinePowerState = 0
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STARTING    VirtualMachinePowerState = 1
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_RUNNING     VirtualMachinePowerState = 2
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_PAUSED      VirtualMachinePowerState = 3
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPING    VirtualMachinePowerState = 4
+	VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPED     VirtualMachinePowerState = 5
+)
+
+// Enum value maps for VirtualMachinePowerState.
+var (
+	VirtualMachinePowerState_name = map[int32]string{
+		0: "VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED",
+		1: "VIRTUAL_MACHINE_POWER_STATE_STARTING",
+		2: "VIRTUAL_MACHINE_POWER_STATE_RUNNING",
+		3: "VIRTUAL_MACHINE_POWER_STATE_PAUSED",
+		4: "VIRTUAL_MACHINE_POWER_STATE_STOPPING",
+		5: "VIRTUAL_MACHINE_POWER_STATE_STOPPED",
+	}
+	VirtualMachinePowerState_value = map[string]int32{
+		"VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED": 0,
+		"VIRTUAL_MACHINE_POWER_STATE_STARTING":    1,
+		"VIRTUAL_MACHINE_POWER_STATE_RUNNING":     2,
+		"VIRTUAL_MACHINE_POWER_STATE_PAUSED":      3,
+		"VIRTUAL_MACHINE_POWER_STATE_STOPPING":    4,
+		"VIRTUAL_MACHINE_POWER_STATE_STOPPED":     5,
+	}
+)
+
+func (x VirtualMachinePowerState) Enum() *VirtualMachinePowerState {
+	p := new(VirtualMachinePowerState)
+	*p = x
+	return p
+}
+
+func (x VirtualMachinePowerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualMachinePowerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_private_v1_virtual_machine_type_proto_enumTypes[2].Descriptor()
+}
+
+func (VirtualMachinePowerState) Type() protoreflect.EnumType {
+	return &file_private_v1_virtual_machine_type_proto_enumTypes[2]
+}
+
+func (x VirtualMachinePowerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 type VirtualMachineConditionType int32
 
 const (
@@ -124,11 +227,11 @@ func (x VirtualMachineConditionType) String() string {
 }
 
 func (VirtualMachineConditionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_private_v1_virtual_machine_type_proto_enumTypes[1].Descriptor()
+	return file_private_v1_virtual_machine_type_proto_enumTypes[3].Descriptor()
 }
 
 func (VirtualMachineConditionType) Type() protoreflect.EnumType {
-	return &file_private_v1_virtual_machine_type_proto_enumTypes[1]
+	return &file_private_v1_virtual_machine_type_proto_enumTypes[3]
 }
 
 func (x VirtualMachineConditionType) Number() protoreflect.EnumNumber {
@@ -273,8 +376,11 @@ func (b0 VirtualMachine_builder) Build() *VirtualMachine {
 type VirtualMachineSpec struct {
 	state protoimpl.MessageState `protogen:"hybrid.v1"`
 	// Copies of the public fields.
-	Template           string                `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	TemplateParameters map[string]*anypb.Any `protobuf:"bytes,2,rep,name=template_parameters,json=templateParameters,proto3" json:"template_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Template           string                    `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	TemplateParameters map[string]*anypb.Any     `protobuf:"bytes,2,rep,name=template_parameters,json=templateParameters,proto3" json:"template_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	RunStrategy        VirtualMachineRunStrategy `protobuf:"varint,3,opt,name=run_strategy,json=runStrategy,proto3,enum=private.v1.VirtualMachineRunStrategy" json:"run_strategy,omitempty"`
+	RestartRequestTime *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=restart_request_time,json=restartRequestTime,proto3" json:"restart_request_time,omitempty"`
+	Paused             bool                      `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -318,6 +424,27 @@ func (x *VirtualMachineSpec) GetTemplateParameters() map[string]*anypb.Any {
 	return nil
 }
 
+func (x *VirtualMachineSpec) GetRunStrategy() VirtualMachineRunStrategy {
+	if x != nil {
+		return x.RunStrategy
+	}
+	return VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_UNSPECIFIED
+}
+
+func (x *VirtualMachineSpec) GetRestartRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestartRequestTime
+	}
+	return nil
+}
+
+func (x *VirtualMachineSpec) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
 func (x *VirtualMachineSpec) SetTemplate(v string) {
 	x.Template = v
 }
@@ -326,12 +453,38 @@ func (x *VirtualMachineSpec) SetTemplateParameters(v map[string]*anypb.Any) {
 	x.TemplateParameters = v
 }
 
+func (x *VirtualMachineSpec) SetRunStrategy(v VirtualMachineRunStrategy) {
+	x.RunStrategy = v
+}
+
+func (x *VirtualMachineSpec) SetRestartRequestTime(v *timestamppb.Timestamp) {
+	x.RestartRequestTime = v
+}
+
+func (x *VirtualMachineSpec) SetPaused(v bool) {
+	x.Paused = v
+}
+
+func (x *VirtualMachineSpec) HasRestartRequestTime() bool {
+	if x == nil {
+		return false
+	}
+	return x.RestartRequestTime != nil
+}
+
+func (x *VirtualMachineSpec) ClearRestartRequestTime() {
+	x.RestartRequestTime = nil
+}
+
 type VirtualMachineSpec_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	// Copies of the public fields.
 	Template           string
 	TemplateParameters map[string]*anypb.Any
+	RunStrategy        VirtualMachineRunStrategy
+	RestartRequestTime *timestamppb.Timestamp
+	Paused             bool
 }
 
 func (b0 VirtualMachineSpec_builder) Build() *VirtualMachineSpec {
@@ -340,6 +493,9 @@ func (b0 VirtualMachineSpec_builder) Build() *VirtualMachineSpec {
 	_, _ = b, x
 	x.Template = b.Template
 	x.TemplateParameters = b.TemplateParameters
+	x.RunStrategy = b.RunStrategy
+	x.RestartRequestTime = b.RestartRequestTime
+	x.Paused = b.Paused
 	return m0
 }
 
@@ -350,7 +506,9 @@ type VirtualMachineStatus struct {
 	Conditions []*VirtualMachineCondition `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty"`
 	IpAddress  string                     `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
 	// Identifier of the hub that was selected for this virtual machine.
-	Hub           string `protobuf:"bytes,4,opt,name=hub,proto3" json:"hub,omitempty"`
+	Hub string `protobuf:"bytes,4,opt,name=hub,proto3" json:"hub,omitempty"`
+	// Copies of the public fields.
+	PowerState    VirtualMachinePowerState `protobuf:"varint,5,opt,name=power_state,json=powerState,proto3,enum=private.v1.VirtualMachinePowerState" json:"power_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -408,6 +566,13 @@ func (x *VirtualMachineStatus) GetHub() string {
 	return ""
 }
 
+func (x *VirtualMachineStatus) GetPowerState() VirtualMachinePowerState {
+	if x != nil {
+		return x.PowerState
+	}
+	return VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED
+}
+
 func (x *VirtualMachineStatus) SetState(v VirtualMachineState) {
 	x.State = v
 }
@@ -424,6 +589,10 @@ func (x *VirtualMachineStatus) SetHub(v string) {
 	x.Hub = v
 }
 
+func (x *VirtualMachineStatus) SetPowerState(v VirtualMachinePowerState) {
+	x.PowerState = v
+}
+
 type VirtualMachineStatus_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -433,6 +602,8 @@ type VirtualMachineStatus_builder struct {
 	IpAddress  string
 	// Identifier of the hub that was selected for this virtual machine.
 	Hub string
+	// Copies of the public fields.
+	PowerState VirtualMachinePowerState
 }
 
 func (b0 VirtualMachineStatus_builder) Build() *VirtualMachineStatus {
@@ -443,6 +614,7 @@ func (b0 VirtualMachineStatus_builder) Build() *VirtualMachineStatus {
 	x.Conditions = b.Conditions
 	x.IpAddress = b.IpAddress
 	x.Hub = b.Hub
+	x.PowerState = b.PowerState
 	return m0
 }
 
@@ -618,7 +790,7 @@ var file_private_v1_virtual_machine_type_proto_rawDesc = string([]byte{
 	0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x20, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72,
 	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x56, 0x69,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa6, 0x03, 0x0a, 0x12, 0x56, 0x69,
 	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63,
 	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x67, 0x0a, 0x13,
@@ -628,13 +800,24 @@ var file_private_v1_virtual_machine_type_proto_rawDesc = string([]byte{
 	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
 	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x5b, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x4c, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x1a, 0x5b, 0x0a, 0x17, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
 	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
 	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xc3, 0x01, 0x0a, 0x14, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
+	0x38, 0x01, 0x22, 0x8a, 0x02, 0x0a, 0x14, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
 	0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x73,
 	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x69,
 	0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d,
@@ -646,98 +829,141 @@ var file_private_v1_virtual_machine_type_proto_rawDesc = string([]byte{
 	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x75, 0x62, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x75, 0x62, 0x22, 0xab, 0x02, 0x0a, 0x17, 0x56, 0x69, 0x72,
-	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xa6, 0x01, 0x0a, 0x13, 0x56, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25,
-	0x0a, 0x21, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e,
-	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
-	0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50,
-	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x75, 0x62, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xab, 0x02, 0x0a, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x14,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xa6, 0x01,
+	0x0a, 0x13, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
+	0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21,
 	0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a,
-	0x1c, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
-	0xff, 0x01, 0x0a, 0x1b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49,
-	0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49,
-	0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x28, 0x0a, 0x24, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49,
-	0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52,
-	0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
-	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
-	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10,
-	0x04, 0x42, 0xbe, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61,
-	0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa,
-	0x02, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d,
+	0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xf7, 0x01, 0x0a, 0x19, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x28, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x56,
+	0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52,
+	0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x48, 0x41, 0x4c, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41,
+	0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x31, 0x0a,
+	0x2d, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x52, 0x45,
+	0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x04,
+	0x2a, 0x95, 0x02, 0x0a, 0x18, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a,
+	0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x49,
+	0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f,
+	0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f,
+	0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x26, 0x0a,
+	0x22, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c,
+	0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12,
+	0x27, 0x0a, 0x23, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49,
+	0x4e, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53,
+	0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x05, 0x2a, 0xff, 0x01, 0x0a, 0x1b, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
+	0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x56, 0x49, 0x52, 0x54,
+	0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41,
+	0x43, 0x48, 0x49, 0x4e, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2b, 0x0a,
+	0x27, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x5f, 0x4d, 0x41, 0x43, 0x48, 0x49, 0x4e, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x04, 0x42, 0xbe, 0x01, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c,
+	0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x17, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 })
 
-var file_private_v1_virtual_machine_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_private_v1_virtual_machine_type_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_private_v1_virtual_machine_type_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_private_v1_virtual_machine_type_proto_goTypes = []any{
 	(VirtualMachineState)(0),         // 0: private.v1.VirtualMachineState
-	(VirtualMachineConditionType)(0), // 1: private.v1.VirtualMachineConditionType
-	(*VirtualMachine)(nil),           // 2: private.v1.VirtualMachine
-	(*VirtualMachineSpec)(nil),       // 3: private.v1.VirtualMachineSpec
-	(*VirtualMachineStatus)(nil),     // 4: private.v1.VirtualMachineStatus
-	(*VirtualMachineCondition)(nil),  // 5: private.v1.VirtualMachineCondition
-	nil,                              // 6: private.v1.VirtualMachineSpec.TemplateParametersEntry
-	(*Metadata)(nil),                 // 7: private.v1.Metadata
-	(v1.ConditionStatus)(0),          // 8: shared.v1.ConditionStatus
-	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
-	(*anypb.Any)(nil),                // 10: google.protobuf.Any
+	(VirtualMachineRunStrategy)(0),   // 1: private.v1.VirtualMachineRunStrategy
+	(VirtualMachinePowerState)(0),    // 2: private.v1.VirtualMachinePowerState
+	(VirtualMachineConditionType)(0), // 3: private.v1.VirtualMachineConditionType
+	(*VirtualMachine)(nil),           // 4: private.v1.VirtualMachine
+	(*VirtualMachineSpec)(nil),       // 5: private.v1.VirtualMachineSpec
+	(*VirtualMachineStatus)(nil),     // 6: private.v1.VirtualMachineStatus
+	(*VirtualMachineCondition)(nil),  // 7: private.v1.VirtualMachineCondition
+	nil,                              // 8: private.v1.VirtualMachineSpec.TemplateParametersEntry
+	(*Metadata)(nil),                 // 9: private.v1.Metadata
+	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(v1.ConditionStatus)(0),          // 11: shared.v1.ConditionStatus
+	(*anypb.Any)(nil),                // 12: google.protobuf.Any
 }
 var file_private_v1_virtual_machine_type_proto_depIdxs = []int32{
-	7,  // 0: private.v1.VirtualMachine.metadata:type_name -> private.v1.Metadata
-	3,  // 1: private.v1.VirtualMachine.spec:type_name -> private.v1.VirtualMachineSpec
-	4,  // 2: private.v1.VirtualMachine.status:type_name -> private.v1.VirtualMachineStatus
-	6,  // 3: private.v1.VirtualMachineSpec.template_parameters:type_name -> private.v1.VirtualMachineSpec.TemplateParametersEntry
-	0,  // 4: private.v1.VirtualMachineStatus.state:type_name -> private.v1.VirtualMachineState
-	5,  // 5: private.v1.VirtualMachineStatus.conditions:type_name -> private.v1.VirtualMachineCondition
-	1,  // 6: private.v1.VirtualMachineCondition.type:type_name -> private.v1.VirtualMachineConditionType
-	8,  // 7: private.v1.VirtualMachineCondition.status:type_name -> shared.v1.ConditionStatus
-	9,  // 8: private.v1.VirtualMachineCondition.last_transition_time:type_name -> google.protobuf.Timestamp
-	10, // 9: private.v1.VirtualMachineSpec.TemplateParametersEntry.value:type_name -> google.protobuf.Any
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	9,  // 0: private.v1.VirtualMachine.metadata:type_name -> private.v1.Metadata
+	5,  // 1: private.v1.VirtualMachine.spec:type_name -> private.v1.VirtualMachineSpec
+	6,  // 2: private.v1.VirtualMachine.status:type_name -> private.v1.VirtualMachineStatus
+	8,  // 3: private.v1.VirtualMachineSpec.template_parameters:type_name -> private.v1.VirtualMachineSpec.TemplateParametersEntry
+	1,  // 4: private.v1.VirtualMachineSpec.run_strategy:type_name -> private.v1.VirtualMachineRunStrategy
+	10, // 5: private.v1.VirtualMachineSpec.restart_request_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: private.v1.VirtualMachineStatus.state:type_name -> private.v1.VirtualMachineState
+	7,  // 7: private.v1.VirtualMachineStatus.conditions:type_name -> private.v1.VirtualMachineCondition
+	2,  // 8: private.v1.VirtualMachineStatus.power_state:type_name -> private.v1.VirtualMachinePowerState
+	3,  // 9: private.v1.VirtualMachineCondition.type:type_name -> private.v1.VirtualMachineConditionType
+	11, // 10: private.v1.VirtualMachineCondition.status:type_name -> shared.v1.ConditionStatus
+	10, // 11: private.v1.VirtualMachineCondition.last_transition_time:type_name -> google.protobuf.Timestamp
+	12, // 12: private.v1.VirtualMachineSpec.TemplateParametersEntry.value:type_name -> google.protobuf.Any
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_private_v1_virtual_machine_type_proto_init() }
@@ -752,7 +978,7 @@ func file_private_v1_virtual_machine_type_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_private_v1_virtual_machine_type_proto_rawDesc), len(file_private_v1_virtual_machine_type_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      4,
 			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -44,7 +44,24 @@ type Metadata struct {
 	// Time of deletion of the object.
 	DeletionTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deletion_timestamp,json=deletionTimestamp,proto3" json:"deletion_timestamp,omitempty"`
 	// Names of the creators of the object.
-	Creators      []string `protobuf:"bytes,3,rep,name=creators,proto3" json:"creators,omitempty"`
+	Creators []string `protobuf:"bytes,3,rep,name=creators,proto3" json:"creators,omitempty"`
+	// Version of the object. This is incremented by the server every time that the object is modified. When an update
+	// request contains a non zero version the server will check that it matches the current version of the object, and
+	// will reject the update if it doesn't. That can be used by clients to detect concurrent modifications.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Labels are key value pairs that can be used to organize and select objects, for example to attach the cost
+	// center, owner or environment. Lists can be filtered using the labels, for example with a filter like this:
+	//
+	//   this.metadata.labels['environment'] == 'production'
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations are key value pairs that can be used to attach arbitrary non identifying information to objects.
+	// Unlike labels they can't be used to filter lists.
+	Annotations map[string]string `protobuf:"bytes,6,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Name is an optional human friendly name for the object. When it is set it must be unique within the tenant and the
+	// kind of object, and it can be used instead of the identifier to get, update and delete the object. It must be a
+	// valid DNS label: at most 63 lower case alphanumeric characters or '-', starting and ending with an alphanumeric
+	// character.
+	Name          string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -95,6 +112,34 @@ func (x *Metadata) GetCreators() []string {
 	return nil
 }
 
+func (x *Metadata) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Metadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Metadata) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 func (x *Metadata) SetCreationTimestamp(v *timestamppb.Timestamp) {
 	x.CreationTimestamp = v
 }
@@ -107,6 +152,22 @@ func (x *Metadata) SetCreators(v []string) {
 	x.Creators = v
 }
 
+func (x *Metadata) SetVersion(v int64) {
+	x.Version = v
+}
+
+func (x *Metadata) SetLabels(v map[string]string) {
+	x.Labels = v
+}
+
+func (x *Metadata) SetAnnotations(v map[string]string) {
+	x.Annotations = v
+}
+
+func (x *Metadata) SetName(v string) {
+	x.Name = v
+}
+
 func (x *Metadata) HasCreationTimestamp() bool {
 	if x == nil {
 		return false
@@ -138,6 +199,23 @@ type Metadata_builder struct {
 	DeletionTimestamp *timestamppb.Timestamp
 	// Names of the creators of the object.
 	Creators []string
+	// Version of the object. This is incremented by the server every time that the object is modified. When an update
+	// request contains a non zero version the server will check that it matches the current version of the object, and
+	// will reject the update if it doesn't. That can be used by clients to detect concurrent modifications.
+	Version int64
+	// Labels are key value pairs that can be used to organize and select objects, for example to attach the cost
+	// center, owner or environment. Lists can be filtered using the labels, for example with a filter like this:
+	//
+	//   this.metadata.labels['environment'] == 'production'
+	Labels map[string]string
+	// Annotations are key value pairs that can be used to attach arbitrary non identifying information to objects.
+	// Unlike labels they can't be used to filter lists.
+	Annotations map[string]string
+	// Name is an optional human friendly name for the object. When it is set it must be unique within the tenant and the
+	// kind of object, and it can be used instead of the identifier to get, update and delete the object. It must be a
+	// valid DNS label: at most 63 lower case alphanumeric characters or '-', starting and ending with an alphanumeric
+	// character.
+	Name string
 }
 
 func (b0 Metadata_builder) Build() *Metadata {
@@ -147,6 +225,10 @@ func (b0 Metadata_builder) Build() *Metadata {
 	x.CreationTimestamp = b.CreationTimestamp
 	x.DeletionTimestamp = b.DeletionTimestamp
 	x.Creators = b.Creators
+	x.Version = b.Version
+	x.Labels = b.Labels
+	x.Annotations = b.Annotations
+	x.Name = b.Name
 	return m0
 }
 
@@ -157,7 +239,7 @@ var file_shared_v1_metadata_type_proto_rawDesc = string([]byte{
 	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x03, 0x0a, 0x08,
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
@@ -169,34 +251,56 @@ var file_shared_v1_metadata_type_proto_rawDesc = string([]byte{
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x64, 0x65, 0x6c,
 	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a,
 	0x0a, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x42, 0xaf, 0x01, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e,
-	0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
-	0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x76, 0x31,
-	0x3b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58, 0xaa,
-	0x02, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x46, 0x0a,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0xaf, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f,
+	0x66, 0x75, 0x6c, 0x66, 0x69, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
-var file_shared_v1_metadata_type_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_shared_v1_metadata_type_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_shared_v1_metadata_type_proto_goTypes = []any{
 	(*Metadata)(nil),              // 0: shared.v1.Metadata
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	nil,                           // 1: shared.v1.Metadata.LabelsEntry
+	nil,                           // 2: shared.v1.Metadata.AnnotationsEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_shared_v1_metadata_type_proto_depIdxs = []int32{
-	1, // 0: shared.v1.Metadata.creation_timestamp:type_name -> google.protobuf.Timestamp
-	1, // 1: shared.v1.Metadata.deletion_timestamp:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: shared.v1.Metadata.creation_timestamp:type_name -> google.protobuf.Timestamp
+	3, // 1: shared.v1.Metadata.deletion_timestamp:type_name -> google.protobuf.Timestamp
+	1, // 2: shared.v1.Metadata.labels:type_name -> shared.v1.Metadata.LabelsEntry
+	2, // 3: shared.v1.Metadata.annotations:type_name -> shared.v1.Metadata.AnnotationsEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_shared_v1_metadata_type_proto_init() }
@@ -210,7 +314,7 @@ func file_shared_v1_metadata_type_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_v1_metadata_type_proto_rawDesc), len(file_shared_v1_metadata_type_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,4 +16,8 @@ package auth
 // Names of frequently used authentication and authorization headers.
 const (
 	Authorization = "Authorization"
+
+	// Tenant is the header that users that belong to multiple tenants can use to select the tenant that will be
+	// assigned to the objects that they create.
+	Tenant = "X-Tenant"
 )
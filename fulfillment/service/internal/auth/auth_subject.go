@@ -20,4 +20,8 @@ type Subject struct {
 
 	// Groups are the names of the groups that the subject belongs to.
 	Groups []string `json:"groups"`
+
+	// Tenants are the names of the tenants that the subject belongs to, when they are provided directly by the
+	// authentication mechanism, for example extracted from a token claim.
+	Tenants []string `json:"tenants,omitempty"`
 }
@@ -19,6 +19,9 @@ import (
 	"log/slog"
 )
 
+// DefaultTenancyLogicType is the name of the default tenancy logic.
+const DefaultTenancyLogicType = "default"
+
 // DefaultTenancyLogicBuilder contains the data and logic needed to create default tenancy logic.
 type DefaultTenancyLogicBuilder struct {
 	logger *slog.Logger
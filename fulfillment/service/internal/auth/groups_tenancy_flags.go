@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// AddGroupsTenancyFlags adds to the given flag set the flags needed to configure the tenancy logic based on groups.
+// For example:
+//
+//	auth.AddGroupsTenancyFlags(flags)
+//
+// Will add the following flags:
+//
+//	--tenancy-group-prefix string     Prefix of the groups that represent tenants.
+//	--tenancy-mapping-file string     YAML file that maps groups and users to tenants.
+//	--tenancy-admin-groups strings    Groups whose members can see all tenants.
+func AddGroupsTenancyFlags(flags *pflag.FlagSet) {
+	_ = flags.String(
+		groupsTenancyGroupPrefixFlagName,
+		"",
+		"Prefix of the groups that represent tenants. For example, if the prefix is 'tenant:' then members of "+
+			"the group 'tenant:blue' will belong to the tenant 'blue'.",
+	)
+	_ = flags.String(
+		groupsTenancyMappingFileFlagName,
+		"",
+		"YAML file that maps groups and users to tenants, containing 'groups' and 'users' maps where the "+
+			"values are lists of tenant names.",
+	)
+	_ = flags.StringSlice(
+		groupsTenancyAdminGroupsFlagName,
+		[]string{},
+		"Groups whose members can see the objects of all tenants.",
+	)
+}
+
+// Names of the flags:
+const (
+	groupsTenancyGroupPrefixFlagName = "tenancy-group-prefix"
+	groupsTenancyMappingFileFlagName = "tenancy-mapping-file"
+	groupsTenancyAdminGroupsFlagName = "tenancy-admin-groups"
+)
@@ -0,0 +1,331 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// GroupsTenancyLogicType is the name of the tenancy logic based on groups and claims.
+const GroupsTenancyLogicType = "groups"
+
+// GroupsTenancyLogicBuilder contains the data and logic needed to create tenancy logic based on groups and claims.
+type GroupsTenancyLogicBuilder struct {
+	logger      *slog.Logger
+	groupPrefix string
+	mappingFile string
+	adminGroups []string
+}
+
+// GroupsTenancyLogic is an implementation of TenancyLogic that calculates the tenants of the subject from the tenants
+// provided by the authentication mechanism, from the groups that have a configurable prefix, and from a mapping file
+// that associates groups and users to tenants. Members of the admin groups can see the objects of all tenants.
+type GroupsTenancyLogic struct {
+	logger      *slog.Logger
+	groupPrefix string
+	groupsMap   map[string][]string
+	usersMap    map[string][]string
+	adminGroups []string
+}
+
+// groupsTenancyMapping is the structure of the mapping file.
+type groupsTenancyMapping struct {
+	Groups map[string][]string `yaml:"groups"`
+	Users  map[string][]string `yaml:"users"`
+}
+
+// NewGroupsTenancyLogic creates a new builder for tenancy logic based on groups and claims.
+func NewGroupsTenancyLogic() *GroupsTenancyLogicBuilder {
+	return &GroupsTenancyLogicBuilder{}
+}
+
+// SetLogger sets the logger that will be used by the tenancy logic. This is mandatory.
+func (b *GroupsTenancyLogicBuilder) SetLogger(value *slog.Logger) *GroupsTenancyLogicBuilder {
+	b.logger = value
+	return b
+}
+
+// SetGroupPrefix sets the prefix of the groups that represent tenants. For example, if the prefix is 'tenant:' then
+// a subject that belongs to the group 'tenant:blue' will belong to the tenant 'blue'. This is optional, and by
+// default groups aren't translated into tenants directly.
+func (b *GroupsTenancyLogicBuilder) SetGroupPrefix(value string) *GroupsTenancyLogicBuilder {
+	b.groupPrefix = value
+	return b
+}
+
+// SetMappingFile sets the YAML file that maps groups and users to tenants. For example:
+//
+//	groups:
+//	  developers:
+//	  - blue
+//	  - green
+//	users:
+//	  jane:
+//	  - red
+//
+// This is optional.
+func (b *GroupsTenancyLogicBuilder) SetMappingFile(value string) *GroupsTenancyLogicBuilder {
+	b.mappingFile = value
+	return b
+}
+
+// AddAdminGroups adds groups whose members can see the objects of all tenants, and can assign any tenant to the
+// objects that they create.
+func (b *GroupsTenancyLogicBuilder) AddAdminGroups(values ...string) *GroupsTenancyLogicBuilder {
+	b.adminGroups = append(b.adminGroups, values...)
+	return b
+}
+
+// SetFlags sets the command line flags that should be used to configure the tenancy logic. This is optional.
+func (b *GroupsTenancyLogicBuilder) SetFlags(flags *pflag.FlagSet) *GroupsTenancyLogicBuilder {
+	if flags == nil {
+		return b
+	}
+
+	var (
+		flag string
+		err  error
+	)
+	failure := func() {
+		b.logger.Error(
+			"Failed to get flag value",
+			slog.String("flag", flag),
+			slog.Any("error", err),
+		)
+	}
+
+	// Group prefix:
+	flag = groupsTenancyGroupPrefixFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetGroupPrefix(value)
+		}
+	}
+
+	// Mapping file:
+	flag = groupsTenancyMappingFileFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetMappingFile(value)
+		}
+	}
+
+	// Admin groups:
+	flag = groupsTenancyAdminGroupsFlagName
+	if flags.Changed(flag) {
+		var values []string
+		values, err = flags.GetStringSlice(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.AddAdminGroups(values...)
+		}
+	}
+
+	return b
+}
+
+// Build uses the data stored in the builder to create the tenancy logic.
+func (b *GroupsTenancyLogicBuilder) Build() (result *GroupsTenancyLogic, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Load the mapping file:
+	var mapping groupsTenancyMapping
+	if b.mappingFile != "" {
+		var data []byte
+		data, err = os.ReadFile(b.mappingFile)
+		if err != nil {
+			err = fmt.Errorf("failed to read tenancy mapping file '%s': %w", b.mappingFile, err)
+			return
+		}
+		err = yaml.Unmarshal(data, &mapping)
+		if err != nil {
+			err = fmt.Errorf("failed to parse tenancy mapping file '%s': %w", b.mappingFile, err)
+			return
+		}
+	}
+
+	// Create and populate the object:
+	result = &GroupsTenancyLogic{
+		logger:      b.logger,
+		groupPrefix: b.groupPrefix,
+		groupsMap:   mapping.Groups,
+		usersMap:    mapping.Users,
+		adminGroups: slices.Clone(b.adminGroups),
+	}
+	return
+}
+
+// DetermineAssignedTenants returns the tenant that will be assigned to the objects created by the subject. If the
+// subject belongs to exactly one tenant that is the result. If it belongs to more than one then the tenant has to be
+// selected explicitly using the 'X-Tenant' header.
+func (l *GroupsTenancyLogic) DetermineAssignedTenants(ctx context.Context) (result []string, err error) {
+	subject, err := l.subject(ctx)
+	if err != nil {
+		return
+	}
+	admin := l.isAdmin(subject)
+	tenants := l.tenants(subject)
+
+	// If the tenant has been explicitly selected then check that the subject belongs to it:
+	selected, err := l.selected(ctx)
+	if err != nil {
+		return
+	}
+	if selected != "" {
+		if !admin && !slices.Contains(tenants, selected) {
+			err = grpcstatus.Errorf(
+				grpccodes.PermissionDenied,
+				"user '%s' doesn't belong to tenant '%s'",
+				subject.User, selected,
+			)
+			return
+		}
+		result = []string{selected}
+		return
+	}
+
+	// Otherwise the tenant can only be selected automatically if there is exactly one:
+	switch {
+	case len(tenants) == 1:
+		result = tenants
+	case len(tenants) == 0 && admin:
+		result = []string{groupsTenancySharedTenant}
+	case len(tenants) == 0:
+		err = grpcstatus.Errorf(
+			grpccodes.PermissionDenied,
+			"user '%s' doesn't belong to any tenant",
+			subject.User,
+		)
+	default:
+		err = grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"user '%s' belongs to multiple tenants ('%s'), select one using the '%s' header",
+			subject.User, strings.Join(tenants, "', '"), Tenant,
+		)
+	}
+	return
+}
+
+// DetermineVisibleTenants returns the tenants that the subject can see. For members of the admin groups this will be
+// an empty list, meaning that objects will not be filtered. For other subjects it will be the tenants they belong to,
+// and the shared tenant.
+func (l *GroupsTenancyLogic) DetermineVisibleTenants(ctx context.Context) (result []string, err error) {
+	subject, err := l.subject(ctx)
+	if err != nil {
+		return
+	}
+	if l.isAdmin(subject) {
+		return
+	}
+	result = l.tenants(subject)
+	if !slices.Contains(result, groupsTenancySharedTenant) {
+		result = append(result, groupsTenancySharedTenant)
+	}
+	return
+}
+
+// subject returns the subject from the context, or an error if there is no subject.
+func (l *GroupsTenancyLogic) subject(ctx context.Context) (result *Subject, err error) {
+	result, ok := ctx.Value(subjectContextKey).(*Subject)
+	if !ok {
+		l.logger.ErrorContext(ctx, "Failed to get subject from context")
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to determine tenants")
+	}
+	return
+}
+
+// selected returns the tenant explicitly selected with the 'X-Tenant' header, or an empty string if it hasn't been
+// selected.
+func (l *GroupsTenancyLogic) selected(ctx context.Context) (result string, err error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return
+	}
+	values := md.Get(Tenant)
+	switch len(values) {
+	case 0:
+	case 1:
+		result = strings.TrimSpace(values[0])
+	default:
+		err = grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"expected at most one '%s' header, but got %d",
+			Tenant, len(values),
+		)
+	}
+	return
+}
+
+// isAdmin checks if the subject belongs to any of the admin groups.
+func (l *GroupsTenancyLogic) isAdmin(subject *Subject) bool {
+	for _, group := range subject.Groups {
+		if slices.Contains(l.adminGroups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// tenants calculates the sorted list of tenants that the subject belongs to.
+func (l *GroupsTenancyLogic) tenants(subject *Subject) []string {
+	var result []string
+	add := func(values ...string) {
+		for _, value := range values {
+			if value != "" && !slices.Contains(result, value) {
+				result = append(result, value)
+			}
+		}
+	}
+	add(subject.Tenants...)
+	for _, group := range subject.Groups {
+		if l.groupPrefix != "" {
+			tenant, ok := strings.CutPrefix(group, l.groupPrefix)
+			if ok {
+				add(tenant)
+			}
+		}
+		add(l.groupsMap[group]...)
+	}
+	add(l.usersMap[subject.User]...)
+	slices.Sort(result)
+	return result
+}
+
+// groupsTenancySharedTenant is the tenant that represents objects accessible to all users.
+const groupsTenancySharedTenant = "shared"
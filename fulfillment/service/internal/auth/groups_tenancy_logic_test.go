@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+var _ = Describe("Groups tenancy logic", func() {
+	var (
+		ctx         context.Context
+		mappingFile string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		// Write the mapping file:
+		mappingFile = filepath.Join(GinkgoT().TempDir(), "mapping.yaml")
+		err := os.WriteFile(mappingFile, []byte(`
+groups:
+  developers:
+  - blue
+  - green
+users:
+  jane:
+  - red
+`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// makeLogic creates tenancy logic with the prefix, the mapping file and the admin group.
+	makeLogic := func() *GroupsTenancyLogic {
+		logic, err := NewGroupsTenancyLogic().
+			SetLogger(logger).
+			SetGroupPrefix("tenant:").
+			SetMappingFile(mappingFile).
+			AddAdminGroups("admins").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return logic
+	}
+
+	// makeContext creates a context containing the given subject and the optional selected tenant.
+	makeContext := func(subject *Subject, tenant string) context.Context {
+		result := ContextWithSubject(ctx, subject)
+		if tenant != "" {
+			result = metadata.NewIncomingContext(result, metadata.Pairs(Tenant, tenant))
+		}
+		return result
+	}
+
+	// expectCode checks that the error is a gRPC status with the given code.
+	expectCode := func(err error, code grpccodes.Code) {
+		Expect(err).To(HaveOccurred())
+		status, ok := grpcstatus.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(status.Code()).To(Equal(code))
+	}
+
+	Describe("Building", func() {
+		It("Can't be built without a logger", func() {
+			_, err := NewGroupsTenancyLogic().Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+
+		It("Can't be built if the mapping file doesn't exist", func() {
+			_, err := NewGroupsTenancyLogic().
+				SetLogger(logger).
+				SetMappingFile(filepath.Join(GinkgoT().TempDir(), "junk.yaml")).
+				Build()
+			Expect(err).To(MatchError(ContainSubstring("failed to read tenancy mapping file")))
+		})
+
+		It("Takes the configuration from the flags", func() {
+			flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+			AddGroupsTenancyFlags(flags)
+			err := flags.Parse([]string{
+				"--tenancy-group-prefix", "tenant:",
+				"--tenancy-mapping-file", mappingFile,
+				"--tenancy-admin-groups", "admins",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			logic, err := NewGroupsTenancyLogic().
+				SetLogger(logger).
+				SetFlags(flags).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			ctx = makeContext(&Subject{
+				User:   "jane",
+				Groups: []string{"tenant:yellow"},
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("red", "yellow", "shared"))
+		})
+	})
+
+	Describe("Visible tenants", func() {
+		It("Translates groups with the prefix", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"tenant:yellow", "other"},
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("yellow", "shared"))
+		})
+
+		It("Uses the mapping file", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "jane",
+				Groups: []string{"developers"},
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("blue", "green", "red", "shared"))
+		})
+
+		It("Uses the tenants of the subject", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:    "joe",
+				Tenants: []string{"purple"},
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("purple", "shared"))
+		})
+
+		It("Returns only the shared tenant for users without tenants", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User: "joe",
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("shared"))
+		})
+
+		It("Doesn't filter for admins", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"admins", "tenant:yellow"},
+			}, "")
+			result, err := logic.DetermineVisibleTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeEmpty())
+		})
+
+		It("Fails if there is no subject", func() {
+			logic := makeLogic()
+			_, err := logic.DetermineVisibleTenants(ctx)
+			expectCode(err, grpccodes.Internal)
+		})
+	})
+
+	Describe("Assigned tenants", func() {
+		It("Assigns the only tenant", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"tenant:yellow"},
+			}, "")
+			result, err := logic.DetermineAssignedTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("yellow"))
+		})
+
+		It("Assigns the selected tenant", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"developers"},
+			}, "green")
+			result, err := logic.DetermineAssignedTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("green"))
+		})
+
+		It("Fails if the user belongs to multiple tenants and doesn't select one", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"developers"},
+			}, "")
+			_, err := logic.DetermineAssignedTenants(ctx)
+			expectCode(err, grpccodes.InvalidArgument)
+			Expect(err).To(MatchError(ContainSubstring("'X-Tenant'")))
+		})
+
+		It("Fails if the user selects a tenant that it doesn't belong to", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"developers"},
+			}, "red")
+			_, err := logic.DetermineAssignedTenants(ctx)
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Fails if the user doesn't belong to any tenant", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User: "joe",
+			}, "")
+			_, err := logic.DetermineAssignedTenants(ctx)
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Lets admins select any tenant", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"admins"},
+			}, "red")
+			result, err := logic.DetermineAssignedTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("red"))
+		})
+
+		It("Assigns the shared tenant to admins without tenants", func() {
+			logic := makeLogic()
+			ctx = makeContext(&Subject{
+				User:   "joe",
+				Groups: []string{"admins"},
+			}, "")
+			result, err := logic.DetermineAssignedTenants(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(ConsistOf("shared"))
+		})
+	})
+})
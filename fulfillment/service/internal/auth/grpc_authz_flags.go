@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// AddGrpcAuthzFlags adds to the given flag set the flags needed to configure the authorization interceptor. For
+// example:
+//
+//	auth.AddGrpcAuthzFlags(flags)
+//
+// Will add the following flags:
+//
+//	--grpc-authz-policy-file string    File containing the Rego authorization policy.
+//	--grpc-authz-dry-run               Log authorization decisions without rejecting requests.
+func AddGrpcAuthzFlags(flags *pflag.FlagSet) {
+	_ = flags.String(
+		grpcAuthzPolicyFileFlagName,
+		"",
+		"File containing the Rego authorization policy. The policy must be in the 'authz' package and define "+
+			"an 'allow' rule. If not specified the built-in policy will be used.",
+	)
+	_ = flags.Bool(
+		grpcAuthzDryRunFlagName,
+		false,
+		"Write authorization decisions to the log, but don't reject requests. This is intended to check a "+
+			"policy before enforcing it.",
+	)
+}
+
+// Names of the flags:
+const (
+	grpcAuthzPolicyFileFlagName = "grpc-authz-policy-file"
+	grpcAuthzDryRunFlagName     = "grpc-authz-dry-run"
+)
@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// GrpcAuthzTenantsFunc is the type of the functions that return the tenants of the object targeted by a request. It
+// should return an empty list if the request doesn't target an existing object, or if the object doesn't exist.
+type GrpcAuthzTenantsFunc func(ctx context.Context, method string, request any) (tenants []string, err error)
+
+// GrpcAuthzInterceptorBuilder contains the data and logic needed to build an interceptor that checks authorization.
+// Don't create instances of this type directly, use the NewGrpcAuthzInterceptor function instead.
+type GrpcAuthzInterceptorBuilder struct {
+	logger       *slog.Logger
+	policyFile   string
+	dryRun       bool
+	tenancyLogic TenancyLogic
+	tenantsFunc  GrpcAuthzTenantsFunc
+}
+
+// GrpcAuthzInterceptor is an interceptor that evaluates a Rego policy to decide if a request is allowed. The policy
+// must be in the 'authz' package, and must define an 'allow' rule. The input of the policy contains the 'method',
+// 'subject', 'tenants' and 'visible_tenants' fields. See the default policy in the 'grpc_authz_policy.rego' file for
+// details.
+type GrpcAuthzInterceptor struct {
+	logger       *slog.Logger
+	dryRun       bool
+	tenancyLogic TenancyLogic
+	tenantsFunc  GrpcAuthzTenantsFunc
+	query        rego.PreparedEvalQuery
+}
+
+// grpcAuthzDefaultPolicy is the policy used when no policy file is explicitly configured.
+//
+//go:embed grpc_authz_policy.rego
+var grpcAuthzDefaultPolicy string
+
+// NewGrpcAuthzInterceptor creates a builder that can then be used to configure and create an authorization
+// interceptor.
+func NewGrpcAuthzInterceptor() *GrpcAuthzInterceptorBuilder {
+	return &GrpcAuthzInterceptorBuilder{}
+}
+
+// SetLogger sets the logger that will be used to write to the log. This is mandatory.
+func (b *GrpcAuthzInterceptorBuilder) SetLogger(value *slog.Logger) *GrpcAuthzInterceptorBuilder {
+	b.logger = value
+	return b
+}
+
+// SetPolicyFile sets the file containing the Rego policy. This is optional, and by default the built-in policy will
+// be used.
+func (b *GrpcAuthzInterceptorBuilder) SetPolicyFile(value string) *GrpcAuthzInterceptorBuilder {
+	b.policyFile = value
+	return b
+}
+
+// SetDryRun enables or disables the dry run mode. In this mode the decisions of the policy are written to the log,
+// but requests are never rejected. This is intended to check a new policy before enforcing it. The default is false.
+func (b *GrpcAuthzInterceptorBuilder) SetDryRun(value bool) *GrpcAuthzInterceptorBuilder {
+	b.dryRun = value
+	return b
+}
+
+// SetTenancyLogic sets the tenancy logic used to calculate the tenants that the subject can see, and the tenants
+// that will be assigned to created objects. This is optional, and if not set these tenants will be empty in the
+// input of the policy.
+func (b *GrpcAuthzInterceptorBuilder) SetTenancyLogic(value TenancyLogic) *GrpcAuthzInterceptorBuilder {
+	b.tenancyLogic = value
+	return b
+}
+
+// SetTenantsFunc sets the function used to find the tenants of the existing object targeted by a request. This is
+// optional, and if not set the tenants of existing objects will be empty in the input of the policy.
+func (b *GrpcAuthzInterceptorBuilder) SetTenantsFunc(value GrpcAuthzTenantsFunc) *GrpcAuthzInterceptorBuilder {
+	b.tenantsFunc = value
+	return b
+}
+
+// SetFlags sets the command line flags that should be used to configure the interceptor. This is optional.
+func (b *GrpcAuthzInterceptorBuilder) SetFlags(flags *pflag.FlagSet) *GrpcAuthzInterceptorBuilder {
+	if flags == nil {
+		return b
+	}
+
+	var (
+		flag string
+		err  error
+	)
+	failure := func() {
+		b.logger.Error(
+			"Failed to get flag value",
+			slog.String("flag", flag),
+			slog.Any("error", err),
+		)
+	}
+
+	// Policy file:
+	flag = grpcAuthzPolicyFileFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetPolicyFile(value)
+		}
+	}
+
+	// Dry run:
+	flag = grpcAuthzDryRunFlagName
+	if flags.Changed(flag) {
+		var value bool
+		value, err = flags.GetBool(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetDryRun(value)
+		}
+	}
+
+	return b
+}
+
+// Build uses the data stored in the builder to create and configure a new interceptor.
+func (b *GrpcAuthzInterceptorBuilder) Build() (result *GrpcAuthzInterceptor, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Load the policy:
+	policyName := "grpc_authz_policy.rego"
+	policyText := grpcAuthzDefaultPolicy
+	if b.policyFile != "" {
+		var data []byte
+		data, err = os.ReadFile(b.policyFile)
+		if err != nil {
+			err = fmt.Errorf("failed to read authorization policy file '%s': %w", b.policyFile, err)
+			return
+		}
+		policyName = b.policyFile
+		policyText = string(data)
+	}
+
+	// Compile the policy:
+	query, err := rego.New(
+		rego.Query("data.authz.allow"),
+		rego.Module(policyName, policyText),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		err = fmt.Errorf("failed to compile authorization policy '%s': %w", policyName, err)
+		return
+	}
+
+	// Create and populate the object:
+	result = &GrpcAuthzInterceptor{
+		logger:       b.logger,
+		dryRun:       b.dryRun,
+		tenancyLogic: b.tenancyLogic,
+		tenantsFunc:  b.tenantsFunc,
+		query:        query,
+	}
+	return
+}
+
+// UnaryServer is the unary server interceptor function.
+func (i *GrpcAuthzInterceptor) UnaryServer(ctx context.Context, request any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (response any, err error) {
+	err = i.check(ctx, info.FullMethod, request)
+	if err != nil {
+		return
+	}
+	response, err = handler(ctx, request)
+	return
+}
+
+// StreamServer is the stream server interceptor function. The request isn't available when the method is called, so
+// the policy is first evaluated without the tenants of the target object, to reject early the methods that the subject
+// can't call at all. It is then evaluated again when the first message is received from the client, as that is the
+// message that contains the identifier of the target object.
+func (i *GrpcAuthzInterceptor) StreamServer(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	err := i.check(stream.Context(), info.FullMethod, nil)
+	if err != nil {
+		return err
+	}
+	return handler(server, &grpcAuthzStream{
+		ServerStream: stream,
+		interceptor:  i,
+		method:       info.FullMethod,
+	})
+}
+
+// check evaluates the policy for the given method and request, and returns an error if the request isn't allowed.
+func (i *GrpcAuthzInterceptor) check(ctx context.Context, method string, request any) error {
+	subject := SubjectFromContext(ctx)
+	allowed, err := i.evaluate(ctx, subject, method, request)
+	if err != nil {
+		i.logger.ErrorContext(
+			ctx,
+			"Failed to evaluate authorization policy",
+			slog.String("method", method),
+			slog.String("user", subject.User),
+			slog.Bool("dry_run", i.dryRun),
+			slog.Any("error", err),
+		)
+		if i.dryRun {
+			return nil
+		}
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check authorization")
+	}
+	if allowed {
+		i.logger.DebugContext(
+			ctx,
+			"Request allowed",
+			slog.String("method", method),
+			slog.String("user", subject.User),
+		)
+		return nil
+	}
+	i.logger.InfoContext(
+		ctx,
+		"Request denied",
+		slog.String("method", method),
+		slog.String("user", subject.User),
+		slog.Any("groups", subject.Groups),
+		slog.Bool("dry_run", i.dryRun),
+	)
+	if i.dryRun {
+		return nil
+	}
+	return grpcstatus.Errorf(
+		grpccodes.PermissionDenied,
+		"user '%s' isn't allowed to call method '%s'",
+		subject.User, method,
+	)
+}
+
+// evaluate creates the input and evaluates the policy.
+func (i *GrpcAuthzInterceptor) evaluate(ctx context.Context, subject *Subject, method string,
+	request any) (result bool, err error) {
+	input, err := i.makeInput(ctx, subject, method, request)
+	if err != nil {
+		return
+	}
+	results, err := i.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return
+	}
+	result = results.Allowed()
+	return
+}
+
+// makeInput creates the input for the policy.
+func (i *GrpcAuthzInterceptor) makeInput(ctx context.Context, subject *Subject, method string,
+	request any) (result map[string]any, err error) {
+	// Find the tenants of the target object. For creation requests these are the tenants that will be assigned to
+	// the object. Note that failing to calculate them isn't an error here, as the server will report it later
+	// when trying to create the object.
+	tenants := []string{}
+	switch {
+	case strings.HasSuffix(method, "/Create"):
+		if i.tenancyLogic != nil {
+			assigned, err := i.tenancyLogic.DetermineAssignedTenants(ctx)
+			if err == nil {
+				tenants = append(tenants, assigned...)
+			}
+		}
+	case request != nil && i.tenantsFunc != nil:
+		var existing []string
+		existing, err = i.tenantsFunc(ctx, method, request)
+		if err != nil {
+			err = fmt.Errorf("failed to find tenants of target object: %w", err)
+			return
+		}
+		tenants = append(tenants, existing...)
+	}
+
+	// Find the tenants that the subject can see:
+	visibleTenants := []string{}
+	if i.tenancyLogic != nil {
+		var visible []string
+		visible, err = i.tenancyLogic.DetermineVisibleTenants(ctx)
+		if err != nil {
+			err = fmt.Errorf("failed to determine visible tenants: %w", err)
+			return
+		}
+		visibleTenants = append(visibleTenants, visible...)
+	}
+
+	// Create the input:
+	result = map[string]any{
+		"method": method,
+		"subject": map[string]any{
+			"user":    subject.User,
+			"groups":  grpcAuthzList(subject.Groups),
+			"tenants": grpcAuthzList(subject.Tenants),
+		},
+		"tenants":         tenants,
+		"visible_tenants": visibleTenants,
+	}
+	return
+}
+
+// grpcAuthzStream is a server stream that checks authorization when the first message is received.
+type grpcAuthzStream struct {
+	grpc.ServerStream
+	interceptor *GrpcAuthzInterceptor
+	method      string
+	checked     bool
+}
+
+// RecvMsg is part of the implementation of the grpc.ServerStream interface.
+func (s *grpcAuthzStream) RecvMsg(message any) error {
+	err := s.ServerStream.RecvMsg(message)
+	if err != nil || s.checked {
+		return err
+	}
+	s.checked = true
+	return s.interceptor.check(s.Context(), s.method, message)
+}
+
+// grpcAuthzList returns the given list, or an empty list if it is nil, so that policies always receive an array.
+func grpcAuthzList(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
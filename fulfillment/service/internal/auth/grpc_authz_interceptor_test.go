@@ -0,0 +1,347 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+)
+
+var _ = Describe("gRPC authorization interceptor", func() {
+	const (
+		clientAccount = "system:serviceaccount:innabox:client"
+		adminAccount  = "system:serviceaccount:innabox:admin"
+	)
+
+	var (
+		ctx  context.Context
+		ctrl *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		DeferCleanup(ctrl.Finish)
+	})
+
+	// handler is a unary handler that returns a fixed response.
+	handler := func(ctx context.Context, request any) (any, error) {
+		return "ok", nil
+	}
+
+	// call calls the unary interceptor for the given subject and method.
+	call := func(interceptor *GrpcAuthzInterceptor, user string, method string) (any, error) {
+		ctx := ContextWithSubject(ctx, &Subject{
+			User: user,
+		})
+		return interceptor.UnaryServer(ctx, "request", &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	// expectCode checks that the error is a gRPC status with the given code.
+	expectCode := func(err error, code grpccodes.Code) {
+		Expect(err).To(HaveOccurred())
+		status, ok := grpcstatus.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(status.Code()).To(Equal(code))
+	}
+
+	// writePolicy writes the given policy to a temporary file and returns the name of the file.
+	writePolicy := func(text string) string {
+		file := filepath.Join(GinkgoT().TempDir(), "policy.rego")
+		err := os.WriteFile(file, []byte(text), 0600)
+		Expect(err).ToNot(HaveOccurred())
+		return file
+	}
+
+	// makeTenancyLogic creates tenancy logic that returns the given tenants.
+	makeTenancyLogic := func(assigned []string, visible []string) TenancyLogic {
+		logic := NewMockTenancyLogic(ctrl)
+		logic.EXPECT().DetermineAssignedTenants(gomock.Any()).Return(assigned, nil).AnyTimes()
+		logic.EXPECT().DetermineVisibleTenants(gomock.Any()).Return(visible, nil).AnyTimes()
+		return logic
+	}
+
+	Describe("Building", func() {
+		It("Can be built with the default policy", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(interceptor).ToNot(BeNil())
+		})
+
+		It("Can't be built without a logger", func() {
+			_, err := NewGrpcAuthzInterceptor().Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+
+		It("Can't be built if the policy file doesn't exist", func() {
+			_, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetPolicyFile(filepath.Join(GinkgoT().TempDir(), "junk.rego")).
+				Build()
+			Expect(err).To(MatchError(ContainSubstring("failed to read authorization policy file")))
+		})
+
+		It("Can't be built if the policy isn't valid", func() {
+			_, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetPolicyFile(writePolicy("package authz\nallow if {")).
+				Build()
+			Expect(err).To(MatchError(ContainSubstring("failed to compile authorization policy")))
+		})
+
+		It("Takes the configuration from the flags", func() {
+			flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+			AddGrpcAuthzFlags(flags)
+			err := flags.Parse([]string{
+				"--grpc-authz-policy-file", writePolicy("package authz\ndefault allow := false\n"),
+				"--grpc-authz-dry-run",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetFlags(flags).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(interceptor.dryRun).To(BeTrue())
+			_, err = call(interceptor, adminAccount, "/private.v1.Clusters/Get")
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Default policy", func() {
+		var interceptor *GrpcAuthzInterceptor
+
+		BeforeEach(func() {
+			var err error
+			interceptor, err = NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Allows health checks to everyone", func() {
+			response, err := call(interceptor, guestName, "/grpc.health.v1.Health/Check")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal("ok"))
+		})
+
+		It("Allows clients to use the public API", func() {
+			_, err := call(interceptor, clientAccount, "/fulfillment.v1.Clusters/Create")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Doesn't allow clients to use the private API", func() {
+			_, err := call(interceptor, clientAccount, "/private.v1.Clusters/Create")
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Allows admins to use the private API", func() {
+			_, err := call(interceptor, adminAccount, "/private.v1.Clusters/Create")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Doesn't allow unknown users", func() {
+			_, err := call(interceptor, "junk", "/fulfillment.v1.Clusters/List")
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Checks streams", func() {
+			stream := &grpcAuthzTestStream{
+				ctx: ContextWithSubject(ctx, &Subject{
+					User: clientAccount,
+				}),
+			}
+			called := false
+			streamHandler := func(server any, stream grpc.ServerStream) error {
+				called = true
+				return nil
+			}
+			err := interceptor.StreamServer(nil, stream, &grpc.StreamServerInfo{
+				FullMethod: "/private.v1.Events/Watch",
+			}, streamHandler)
+			expectCode(err, grpccodes.PermissionDenied)
+			Expect(called).To(BeFalse())
+			err = interceptor.StreamServer(nil, stream, &grpc.StreamServerInfo{
+				FullMethod: "/events.v1.Events/Watch",
+			}, streamHandler)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeTrue())
+		})
+	})
+
+	Describe("Tenants", func() {
+		It("Allows clients to act on objects of visible tenants", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetTenancyLogic(makeTenancyLogic([]string{"blue"}, []string{"blue", "shared"})).
+				SetTenantsFunc(func(ctx context.Context, method string, request any) ([]string, error) {
+					return []string{"blue"}, nil
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = call(interceptor, clientAccount, "/fulfillment.v1.Clusters/Get")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Doesn't allow clients to act on objects of other tenants", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetTenancyLogic(makeTenancyLogic([]string{"blue"}, []string{"blue", "shared"})).
+				SetTenantsFunc(func(ctx context.Context, method string, request any) ([]string, error) {
+					return []string{"red"}, nil
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = call(interceptor, clientAccount, "/fulfillment.v1.Clusters/Delete")
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Uses the assigned tenants for creation requests", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetTenancyLogic(makeTenancyLogic([]string{"red"}, []string{"blue"})).
+				SetTenantsFunc(func(ctx context.Context, method string, request any) ([]string, error) {
+					Fail("tenants function shouldn't be called for creation requests")
+					return nil, nil
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = call(interceptor, clientAccount, "/fulfillment.v1.Clusters/Create")
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Doesn't allow clients to open the console of virtual machines of other tenants", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetTenancyLogic(makeTenancyLogic([]string{"blue"}, []string{"blue"})).
+				SetTenantsFunc(func(ctx context.Context, method string, request any) ([]string, error) {
+					Expect(method).To(Equal("/fulfillment.v1.VirtualMachines/Console"))
+					switch request.(*ffv1.VirtualMachinesConsoleRequest).GetId() {
+					case "my-vm":
+						return []string{"blue"}, nil
+					case "your-vm":
+						return []string{"red"}, nil
+					default:
+						return nil, nil
+					}
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			info := &grpc.StreamServerInfo{
+				FullMethod:     "/fulfillment.v1.VirtualMachines/Console",
+				IsClientStream: true,
+				IsServerStream: true,
+			}
+			streamHandler := func(server any, stream grpc.ServerStream) error {
+				request := &ffv1.VirtualMachinesConsoleRequest{}
+				return stream.RecvMsg(request)
+			}
+			console := func(id string) error {
+				stream := &grpcAuthzTestStream{
+					ctx: ContextWithSubject(ctx, &Subject{
+						User: clientAccount,
+					}),
+					messages: []proto.Message{
+						ffv1.VirtualMachinesConsoleRequest_builder{
+							Id: id,
+						}.Build(),
+					},
+				}
+				return interceptor.StreamServer(nil, stream, info, streamHandler)
+			}
+			err = console("my-vm")
+			Expect(err).ToNot(HaveOccurred())
+			err = console("your-vm")
+			expectCode(err, grpccodes.PermissionDenied)
+		})
+
+		It("Passes the tenants and groups to custom policies", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetPolicyFile(writePolicy(`
+package authz
+
+default allow := false
+
+allow if {
+	"operators" in input.subject.groups
+	"green" in input.tenants
+}
+`)).
+				SetTenantsFunc(func(ctx context.Context, method string, request any) ([]string, error) {
+					return []string{"green"}, nil
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			ctx := ContextWithSubject(ctx, &Subject{
+				User:   "jane",
+				Groups: []string{"operators"},
+			})
+			info := &grpc.UnaryServerInfo{
+				FullMethod: "/fulfillment.v1.Clusters/Get",
+			}
+			_, err = interceptor.UnaryServer(ctx, "request", info, handler)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Dry run", func() {
+		It("Doesn't reject denied requests", func() {
+			interceptor, err := NewGrpcAuthzInterceptor().
+				SetLogger(logger).
+				SetDryRun(true).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			response, err := call(interceptor, clientAccount, "/private.v1.Clusters/Create")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal("ok"))
+		})
+	})
+})
+
+// grpcAuthzTestStream is a server stream that only implements the Context and RecvMsg methods. The RecvMsg method
+// returns the given messages, and then io.EOF.
+type grpcAuthzTestStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []proto.Message
+}
+
+func (s *grpcAuthzTestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *grpcAuthzTestStream) RecvMsg(message any) error {
+	if len(s.messages) == 0 {
+		return io.EOF
+	}
+	proto.Merge(message.(proto.Message), s.messages[0])
+	s.messages = s.messages[1:]
+	return nil
+}
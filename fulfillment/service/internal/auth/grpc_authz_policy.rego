@@ -0,0 +1,101 @@
+#
+# Copyright (c) 2025 Red Hat Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+# the License. You may obtain a copy of the License at
+#
+#   http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+# "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+# specific language governing permissions and limitations under the License.
+#
+
+# This is the default policy used by the authorization interceptor. It mirrors the rules of the Authorino
+# configuration, and additionally checks that clients can only act on objects of the tenants that they can see.
+#
+# The input contains the following fields:
+#
+# method - Full name of the gRPC method, for example '/fulfillment.v1.Clusters/Get'.
+# subject - Authenticated subject, with the 'user', 'groups' and 'tenants' fields.
+# tenants - Tenants of the target object. For creation requests these are the tenants that will be assigned, for
+#           other requests the tenants of the existing object. Empty if not known. For streaming methods the policy
+#           is evaluated twice: first with empty tenants when the stream starts, and then with the tenants of the
+#           object targeted by the first message received from the client.
+# visible_tenants - Tenants that the subject can see. Empty means that the subject can see all tenants.
+
+package authz
+
+default allow := false
+
+# Define service accounts:
+client_accounts := {
+	"system:serviceaccount:innabox:client",
+}
+
+admin_accounts := {
+	"system:serviceaccount:innabox:admin",
+	"system:serviceaccount:innabox:controller",
+}
+
+# Define the methods that clients can call:
+client_methods := {
+	"/events.v1.Events/Watch",
+	"/fulfillment.v1.ClusterTemplates/Get",
+	"/fulfillment.v1.ClusterTemplates/List",
+	"/fulfillment.v1.Clusters/Create",
+	"/fulfillment.v1.Clusters/Delete",
+	"/fulfillment.v1.Clusters/Get",
+	"/fulfillment.v1.Clusters/GetKubeconfig",
+	"/fulfillment.v1.Clusters/GetKubeconfigViaHttp",
+	"/fulfillment.v1.Clusters/GetPassword",
+	"/fulfillment.v1.Clusters/GetPasswordViaHttp",
+	"/fulfillment.v1.Clusters/List",
+	"/fulfillment.v1.Clusters/Update",
+	"/fulfillment.v1.HostClasses/Get",
+	"/fulfillment.v1.HostClasses/List",
+	"/fulfillment.v1.VirtualMachineSnapshots/Create",
+	"/fulfillment.v1.VirtualMachineSnapshots/Delete",
+	"/fulfillment.v1.VirtualMachineSnapshots/Get",
+	"/fulfillment.v1.VirtualMachineSnapshots/List",
+	"/fulfillment.v1.VirtualMachineSnapshots/Restore",
+	"/fulfillment.v1.VirtualMachineTemplates/Get",
+	"/fulfillment.v1.VirtualMachineTemplates/List",
+	"/fulfillment.v1.VirtualMachines/Console",
+	"/fulfillment.v1.VirtualMachines/Create",
+	"/fulfillment.v1.VirtualMachines/Delete",
+	"/fulfillment.v1.VirtualMachines/Get",
+	"/fulfillment.v1.VirtualMachines/List",
+	"/fulfillment.v1.VirtualMachines/Pause",
+	"/fulfillment.v1.VirtualMachines/Restart",
+	"/fulfillment.v1.VirtualMachines/Start",
+	"/fulfillment.v1.VirtualMachines/Stop",
+	"/fulfillment.v1.VirtualMachines/Unpause",
+	"/fulfillment.v1.VirtualMachines/Update",
+}
+
+# Allow reflection and health to everyone:
+allow if startswith(input.method, "/grpc.reflection.")
+
+allow if startswith(input.method, "/grpc.health.")
+
+# Allow specific methods to clients, but only for objects of tenants that they can see:
+allow if {
+	input.method in client_methods
+	input.subject.user in client_accounts
+	tenants_visible
+}
+
+# Allow everything to admin and controller:
+allow if input.subject.user in admin_accounts
+
+# The target object is visible if it has no tenants, if the subject can see all tenants, or if they have at least one
+# tenant in common:
+tenants_visible if count(object.get(input, "tenants", [])) == 0
+
+tenants_visible if count(object.get(input, "visible_tenants", [])) == 0
+
+tenants_visible if {
+	some tenant in input.tenants
+	tenant in input.visible_tenants
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// AddGrpcJwtAuthnFlags adds to the given flag set the flags needed to configure the JWT authentication function. For
+// example:
+//
+//	auth.AddGrpcJwtAuthnFlags(flags)
+//
+// Will add the following flags:
+//
+//	--grpc-authn-jwks-file string       File containing the JSON web key set used to verify tokens.
+//	--grpc-authn-issuer string          Issuer of the tokens.
+//	--grpc-authn-audiences strings      Accepted audiences of the tokens.
+//	--grpc-authn-user-claim string      Claim that contains the user name. (default "sub")
+//	--grpc-authn-groups-claim string    Claim that contains the groups. (default "groups")
+//	--grpc-authn-tenants-claim string   Claim that contains the tenants.
+func AddGrpcJwtAuthnFlags(flags *pflag.FlagSet) {
+	_ = flags.String(
+		grpcJwtAuthnJwksFileFlagName,
+		"",
+		"File containing the JSON web key set used to verify tokens. If not specified the keys will be "+
+			"obtained using the OpenID discovery document of the issuer.",
+	)
+	_ = flags.String(
+		grpcJwtAuthnIssuerFlagName,
+		"",
+		"Issuer of the tokens. If specified the 'iss' claim of the tokens must match this value.",
+	)
+	_ = flags.StringSlice(
+		grpcJwtAuthnAudiencesFlagName,
+		[]string{},
+		"Accepted audiences of the tokens. The 'aud' claim of the tokens must contain at least one of them.",
+	)
+	_ = flags.String(
+		grpcJwtAuthnUserClaimFlagName,
+		grpcJwtAuthnDefaultUserClaim,
+		"Claim that contains the user name. Nested claims can be specified using dots, for example "+
+			"'user.name'.",
+	)
+	_ = flags.String(
+		grpcJwtAuthnGroupsClaimFlagName,
+		grpcJwtAuthnDefaultGroupsClaim,
+		"Claim that contains the groups. Nested claims can be specified using dots, for example "+
+			"'realm_access.roles'.",
+	)
+	_ = flags.String(
+		grpcJwtAuthnTenantsClaimFlagName,
+		"",
+		"Claim that contains the tenants that the user belongs to. Nested claims can be specified using dots. "+
+			"If not specified tenants will not be extracted from the token.",
+	)
+}
+
+// Names of the flags:
+const (
+	grpcJwtAuthnJwksFileFlagName     = "grpc-authn-jwks-file"
+	grpcJwtAuthnIssuerFlagName       = "grpc-authn-issuer"
+	grpcJwtAuthnAudiencesFlagName    = "grpc-authn-audiences"
+	grpcJwtAuthnUserClaimFlagName    = "grpc-authn-user-claim"
+	grpcJwtAuthnGroupsClaimFlagName  = "grpc-authn-groups-claim"
+	grpcJwtAuthnTenantsClaimFlagName = "grpc-authn-tenants-claim"
+)
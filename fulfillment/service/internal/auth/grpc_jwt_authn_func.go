@@ -0,0 +1,729 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/pflag"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/metadata"
+)
+
+// GrpcJwtAuthnType is the name of the JWT authentication function.
+const GrpcJwtAuthnType = "jwt"
+
+// GrpcJwtAuthnFuncBuilder is a gRPC authentication function that gets the subject from a JSON web token sent in the
+// `Authorization` header using the `Bearer` scheme. The signature of the token is verified using a JSON web key set
+// loaded from a file or obtained using the OpenID discovery document of the issuer. The `exp` claim is mandatory,
+// the `aud` claim must contain one of the configured audiences and the `nbf` claim, if present, is also checked. The
+// user name and the groups of the subject are extracted from configurable claims.
+type GrpcJwtAuthnFuncBuilder struct {
+	logger        *slog.Logger
+	publicMethods []string
+	jwksFile      string
+	issuer        string
+	audiences     []string
+	userClaim     string
+	groupsClaim   string
+	tenantsClaim  string
+	httpClient    *http.Client
+}
+
+// grpcJwtAuthnFunc contains the data needed by the function.
+type grpcJwtAuthnFunc struct {
+	logger        *slog.Logger
+	publicMethods []*regexp.Regexp
+	audiences     []string
+	userClaim     []string
+	groupsClaim   []string
+	tenantsClaim  []string
+	parser        *jwt.Parser
+	keys          *grpcJwtAuthnKeys
+}
+
+// grpcJwtAuthnKeys contains the keys used to verify tokens, and knows how to reload them when a token uses a key that
+// isn't known yet. The map of keys is replaced as a whole when the keys are reloaded, so it can be read without locks.
+type grpcJwtAuthnKeys struct {
+	logger *slog.Logger
+	loader func(ctx context.Context) ([]byte, error)
+	keys   atomic.Pointer[map[string]crypto.PublicKey]
+	group  *singleflight.Group
+
+	// next is the earliest time when the keys can be reloaded again. It is only accessed from the function passed to
+	// the single flight group, so it doesn't need a lock.
+	next time.Time
+}
+
+// grpcJwtAuthnJwks is the JSON representation of a JSON web key set.
+type grpcJwtAuthnJwks struct {
+	Keys []grpcJwtAuthnJwk `json:"keys"`
+}
+
+// grpcJwtAuthnJwk is the JSON representation of a JSON web key. Only the fields needed for public signature
+// verification keys are included.
+type grpcJwtAuthnJwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// NewGrpcJwtAuthnFunc creates a builder that can then be used to configure and create a new gRPC authentication
+// function.
+func NewGrpcJwtAuthnFunc() *GrpcJwtAuthnFuncBuilder {
+	return &GrpcJwtAuthnFuncBuilder{
+		userClaim:   grpcJwtAuthnDefaultUserClaim,
+		groupsClaim: grpcJwtAuthnDefaultGroupsClaim,
+	}
+}
+
+// SetLogger sets the logger that will be used to write to the log. This is mandatory.
+func (b *GrpcJwtAuthnFuncBuilder) SetLogger(value *slog.Logger) *GrpcJwtAuthnFuncBuilder {
+	b.logger = value
+	return b
+}
+
+// AddPublicMethodRegex adds a regular expression that describes a sets of methods that are considered public, and
+// therefore require no authentication. The regular expression will be matched against to the full gRPC method name,
+// including the leading slash. For example, to consider public all the methods of the `example.v1.Products` service
+// the regular expression could be `^/example\.v1\.Products/.*$`.
+//
+// This method may be called multiple times to add multiple regular expressions. A method will be considered public if
+// it matches at least one of them.
+func (b *GrpcJwtAuthnFuncBuilder) AddPublicMethodRegex(value string) *GrpcJwtAuthnFuncBuilder {
+	b.publicMethods = append(b.publicMethods, value)
+	return b
+}
+
+// SetJwksFile sets the file that contains the JSON web key set used to verify the tokens. Either this or the issuer
+// must be set.
+func (b *GrpcJwtAuthnFuncBuilder) SetJwksFile(value string) *GrpcJwtAuthnFuncBuilder {
+	b.jwksFile = value
+	return b
+}
+
+// SetIssuer sets the issuer of the tokens. The `iss` claim of the tokens must match this value. If the JSON web key
+// set file isn't set then the keys will be obtained using the OpenID discovery document of the issuer.
+func (b *GrpcJwtAuthnFuncBuilder) SetIssuer(value string) *GrpcJwtAuthnFuncBuilder {
+	b.issuer = value
+	return b
+}
+
+// AddAudiences adds accepted audiences. The `aud` claim of the tokens must contain at least one of them. At least one
+// audience is mandatory.
+func (b *GrpcJwtAuthnFuncBuilder) AddAudiences(values ...string) *GrpcJwtAuthnFuncBuilder {
+	b.audiences = append(b.audiences, values...)
+	return b
+}
+
+// SetUserClaim sets the name of the claim that contains the user name. Nested claims can be specified using dots. This
+// is optional, and the default is `sub`.
+func (b *GrpcJwtAuthnFuncBuilder) SetUserClaim(value string) *GrpcJwtAuthnFuncBuilder {
+	b.userClaim = value
+	return b
+}
+
+// SetGroupsClaim sets the name of the claim that contains the groups. Nested claims can be specified using dots, for
+// example `realm_access.roles`. This is optional, and the default is `groups`. If set to an empty string subjects will
+// not have groups.
+func (b *GrpcJwtAuthnFuncBuilder) SetGroupsClaim(value string) *GrpcJwtAuthnFuncBuilder {
+	b.groupsClaim = value
+	return b
+}
+
+// SetTenantsClaim sets the name of the claim that contains the tenants that the subject belongs to. Nested claims can
+// be specified using dots. This is optional, and by default subjects will not have tenants extracted from the token.
+func (b *GrpcJwtAuthnFuncBuilder) SetTenantsClaim(value string) *GrpcJwtAuthnFuncBuilder {
+	b.tenantsClaim = value
+	return b
+}
+
+// SetHttpClient sets the HTTP client that will be used to obtain the discovery document and the keys from the issuer.
+// This is optional, and by default a client with a ten seconds timeout will be used.
+func (b *GrpcJwtAuthnFuncBuilder) SetHttpClient(value *http.Client) *GrpcJwtAuthnFuncBuilder {
+	b.httpClient = value
+	return b
+}
+
+// SetFlags sets the command line flags that should be used to configure the function. This is optional.
+func (b *GrpcJwtAuthnFuncBuilder) SetFlags(flags *pflag.FlagSet) *GrpcJwtAuthnFuncBuilder {
+	if flags == nil {
+		return b
+	}
+
+	var (
+		flag string
+		err  error
+	)
+	failure := func() {
+		b.logger.Error(
+			"Failed to get flag value",
+			slog.String("flag", flag),
+			slog.Any("error", err),
+		)
+	}
+
+	// JWKS file:
+	flag = grpcJwtAuthnJwksFileFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetJwksFile(value)
+		}
+	}
+
+	// Issuer:
+	flag = grpcJwtAuthnIssuerFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetIssuer(value)
+		}
+	}
+
+	// Audiences:
+	flag = grpcJwtAuthnAudiencesFlagName
+	if flags.Changed(flag) {
+		var values []string
+		values, err = flags.GetStringSlice(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.AddAudiences(values...)
+		}
+	}
+
+	// User claim:
+	flag = grpcJwtAuthnUserClaimFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetUserClaim(value)
+		}
+	}
+
+	// Groups claim:
+	flag = grpcJwtAuthnGroupsClaimFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetGroupsClaim(value)
+		}
+	}
+
+	// Tenants claim:
+	flag = grpcJwtAuthnTenantsClaimFlagName
+	if flags.Changed(flag) {
+		var value string
+		value, err = flags.GetString(flag)
+		if err != nil {
+			failure()
+		} else {
+			b.SetTenantsClaim(value)
+		}
+	}
+
+	return b
+}
+
+// Build uses the data stored in the builder to create and configure a new gRPC JWT authentication function.
+func (b *GrpcJwtAuthnFuncBuilder) Build() (result GrpcAuthnFunc, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.jwksFile == "" && b.issuer == "" {
+		err = errors.New("JWKS file or issuer is mandatory")
+		return
+	}
+	if len(b.audiences) == 0 {
+		err = errors.New("at least one audience is mandatory")
+		return
+	}
+	if b.userClaim == "" {
+		err = errors.New("user claim is mandatory")
+		return
+	}
+
+	// Try to compile the regular expressions that define the set of public methods:
+	publicMethods := make([]*regexp.Regexp, len(b.publicMethods))
+	for i, expr := range b.publicMethods {
+		publicMethods[i], err = regexp.Compile(expr)
+		if err != nil {
+			return
+		}
+	}
+
+	// Create the HTTP client if needed:
+	httpClient := b.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: 10 * time.Second,
+		}
+	}
+
+	// Prepare the keys. If they are in a file we load them now so that errors are detected early. If they need to be
+	// obtained from the issuer we do it lazily, so that the server can start even if the issuer isn't available yet.
+	keys := &grpcJwtAuthnKeys{
+		logger: b.logger,
+		group:  &singleflight.Group{},
+	}
+	if b.jwksFile != "" {
+		jwksFile := b.jwksFile
+		keys.loader = func(ctx context.Context) ([]byte, error) {
+			return os.ReadFile(jwksFile)
+		}
+		err = keys.reload(context.Background())
+		if err != nil {
+			err = fmt.Errorf("failed to load keys from file '%s': %w", b.jwksFile, err)
+			return
+		}
+	} else {
+		issuer := b.issuer
+		keys.loader = func(ctx context.Context) ([]byte, error) {
+			return grpcJwtAuthnDiscoverKeys(ctx, httpClient, issuer)
+		}
+	}
+
+	// Create the token parser:
+	parserOpts := []jwt.ParserOption{
+		jwt.WithValidMethods(grpcJwtAuthnValidMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(grpcJwtAuthnLeeway),
+	}
+	if b.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(b.issuer))
+	}
+	parser := jwt.NewParser(parserOpts...)
+
+	// Split the claim names:
+	userClaim := strings.Split(b.userClaim, ".")
+	var groupsClaim []string
+	if b.groupsClaim != "" {
+		groupsClaim = strings.Split(b.groupsClaim, ".")
+	}
+	var tenantsClaim []string
+	if b.tenantsClaim != "" {
+		tenantsClaim = strings.Split(b.tenantsClaim, ".")
+	}
+
+	// Create and populate the object:
+	object := &grpcJwtAuthnFunc{
+		logger:        b.logger,
+		publicMethods: publicMethods,
+		audiences:     slices.Clone(b.audiences),
+		userClaim:     userClaim,
+		groupsClaim:   groupsClaim,
+		tenantsClaim:  tenantsClaim,
+		parser:        parser,
+		keys:          keys,
+	}
+	result = object.call
+	return
+}
+
+// call is the implementation of the `GrpcAuthnFunc` type.
+func (f *grpcJwtAuthnFunc) call(ctx context.Context, method string) (result context.Context, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(Authorization)
+	count := len(values)
+	if count == 0 && f.isPublicMethod(method) {
+		result = ContextWithSubject(ctx, Guest)
+		return
+	}
+	if count == 0 {
+		err = errors.New("missing authentication header")
+		return
+	}
+	if count != 1 {
+		f.logger.ErrorContext(
+			ctx,
+			"Expected exactly one value for the authorization header",
+			slog.Int("count", count),
+		)
+		err = errors.New("too many values for authentication header")
+		return
+	}
+	scheme, bearer, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		err = errors.New("authentication header doesn't contain a bearer token")
+		return
+	}
+	bearer = strings.TrimSpace(bearer)
+
+	// Parse and verify the token:
+	claims := jwt.MapClaims{}
+	_, err = f.parser.ParseWithClaims(bearer, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return f.keys.lookup(ctx, kid)
+	})
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		f.logger.DebugContext(
+			ctx,
+			"Bearer token is expired",
+			slog.Any("error", err),
+		)
+		err = errors.New("bearer token is expired")
+		return
+	}
+	if err != nil {
+		f.logger.ErrorContext(
+			ctx,
+			"Failed to verify bearer token",
+			slog.Any("error", err),
+		)
+		err = errors.New("bearer token isn't valid")
+		return
+	}
+
+	// Check the audience:
+	audiences, err := claims.GetAudience()
+	if err != nil || !slices.ContainsFunc(audiences, f.isAcceptedAudience) {
+		f.logger.ErrorContext(
+			ctx,
+			"Bearer token audience isn't accepted",
+			slog.Any("actual", audiences),
+			slog.Any("accepted", f.audiences),
+		)
+		err = errors.New("bearer token isn't valid")
+		return
+	}
+
+	// Extract the subject:
+	user, _ := grpcJwtAuthnLookupClaim(claims, f.userClaim).(string)
+	user = strings.TrimSpace(user)
+	if user == "" {
+		f.logger.ErrorContext(
+			ctx,
+			"Bearer token doesn't contain the user name",
+			slog.String("claim", strings.Join(f.userClaim, ".")),
+		)
+		err = errors.New("subject name is empty")
+		return
+	}
+	subject := &Subject{
+		User: user,
+	}
+	if f.groupsClaim != nil {
+		subject.Groups = grpcJwtAuthnLookupStrings(claims, f.groupsClaim)
+	}
+	if f.tenantsClaim != nil {
+		subject.Tenants = grpcJwtAuthnLookupStrings(claims, f.tenantsClaim)
+	}
+	f.logger.DebugContext(
+		ctx,
+		"Extracted subject from bearer token",
+		slog.Any("subject", subject),
+	)
+	result = ContextWithSubject(ctx, subject)
+	return
+}
+
+func (f *grpcJwtAuthnFunc) isPublicMethod(method string) bool {
+	for _, publicMethod := range f.publicMethods {
+		if publicMethod.MatchString(method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *grpcJwtAuthnFunc) isAcceptedAudience(audience string) bool {
+	return slices.Contains(f.audiences, audience)
+}
+
+// grpcJwtAuthnLookupClaim returns the value of the claim with the given path, or nil if it doesn't exist.
+func grpcJwtAuthnLookupClaim(claims jwt.MapClaims, path []string) any {
+	var value any = map[string]any(claims)
+	for _, name := range path {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// grpcJwtAuthnLookupStrings returns the values of the claim with the given path, which can be a single string or an
+// array of strings.
+func grpcJwtAuthnLookupStrings(claims jwt.MapClaims, path []string) (result []string) {
+	switch value := grpcJwtAuthnLookupClaim(claims, path).(type) {
+	case string:
+		result = []string{strings.TrimSpace(value)}
+	case []any:
+		for _, item := range value {
+			text, ok := item.(string)
+			if ok {
+				result = append(result, strings.TrimSpace(text))
+			}
+		}
+	}
+	return
+}
+
+// lookup returns the key with the given identifier. If there is no such key the keys are reloaded, but not more
+// often than once per refresh interval, or once per retry interval if the last reload failed, to avoid flooding the
+// source of the keys when receiving tokens signed with unknown keys. Concurrent lookups share the same reload, and
+// lookups of known keys don't wait for it.
+func (k *grpcJwtAuthnKeys) lookup(ctx context.Context, kid string) (result crypto.PublicKey, err error) {
+	result = k.find(kid)
+	if result != nil {
+		return
+	}
+	_, err, _ = k.group.Do("reload", func() (any, error) {
+		if time.Now().Before(k.next) {
+			return nil, nil
+		}
+		err := k.reload(context.WithoutCancel(ctx))
+		if err != nil {
+			k.next = time.Now().Add(grpcJwtAuthnKeysRetryInterval)
+			return nil, err
+		}
+		k.next = time.Now().Add(grpcJwtAuthnKeysRefreshInterval)
+		return nil, nil
+	})
+	if err != nil {
+		return
+	}
+	result = k.find(kid)
+	if result == nil {
+		err = fmt.Errorf("key '%s' doesn't exist", kid)
+	}
+	return
+}
+
+// find returns the key with the given identifier. If the identifier is empty and there is only one key it returns
+// that key.
+func (k *grpcJwtAuthnKeys) find(kid string) crypto.PublicKey {
+	pointer := k.keys.Load()
+	if pointer == nil {
+		return nil
+	}
+	keys := *pointer
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+	return keys[kid]
+}
+
+// reload loads the keys again, and replaces the current ones if that succeeds.
+func (k *grpcJwtAuthnKeys) reload(ctx context.Context) error {
+	data, err := k.loader(ctx)
+	if err != nil {
+		return err
+	}
+	var jwks grpcJwtAuthnJwks
+	err = json.Unmarshal(data, &jwks)
+	if err != nil {
+		return fmt.Errorf("failed to parse key set: %w", err)
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := grpcJwtAuthnParseKey(jwk)
+		if err != nil {
+			k.logger.WarnContext(
+				ctx,
+				"Ignoring key that can't be parsed",
+				slog.String("kid", jwk.Kid),
+				slog.String("kty", jwk.Kty),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	k.keys.Store(&keys)
+	k.logger.DebugContext(
+		ctx,
+		"Loaded keys",
+		slog.Int("count", len(keys)),
+	)
+	return nil
+}
+
+// grpcJwtAuthnParseKey converts a JSON web key into a public key.
+func grpcJwtAuthnParseKey(jwk grpcJwtAuthnJwk) (result crypto.PublicKey, err error) {
+	decode := func(name, value string) (result []byte) {
+		if err != nil {
+			return
+		}
+		result, err = base64.RawURLEncoding.DecodeString(value)
+		if err == nil && len(result) == 0 {
+			err = fmt.Errorf("parameter '%s' is mandatory", name)
+		}
+		return
+	}
+	switch jwk.Kty {
+	case "RSA":
+		n := decode("n", jwk.N)
+		e := decode("e", jwk.E)
+		if err != nil {
+			return
+		}
+		result = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			err = fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+			return
+		}
+		x := decode("x", jwk.X)
+		y := decode("y", jwk.Y)
+		if err != nil {
+			return
+		}
+		result = &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			err = fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+			return
+		}
+		x := decode("x", jwk.X)
+		if err != nil {
+			return
+		}
+		if len(x) != ed25519.PublicKeySize {
+			err = fmt.Errorf("key size should be %d, but it is %d", ed25519.PublicKeySize, len(x))
+			return
+		}
+		result = ed25519.PublicKey(x)
+	default:
+		err = fmt.Errorf("unsupported key type '%s'", jwk.Kty)
+	}
+	return
+}
+
+// grpcJwtAuthnDiscoverKeys obtains the key set of the given issuer using the OpenID discovery document.
+func grpcJwtAuthnDiscoverKeys(ctx context.Context, client *http.Client, issuer string) (result []byte, err error) {
+	discoveryUrl := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	data, err := grpcJwtAuthnGet(ctx, client, discoveryUrl)
+	if err != nil {
+		return
+	}
+	var discovery struct {
+		JwksUri string `json:"jwks_uri"`
+	}
+	err = json.Unmarshal(data, &discovery)
+	if err != nil {
+		err = fmt.Errorf("failed to parse discovery document from '%s': %w", discoveryUrl, err)
+		return
+	}
+	if discovery.JwksUri == "" {
+		err = fmt.Errorf("discovery document from '%s' doesn't contain the key set URL", discoveryUrl)
+		return
+	}
+	result, err = grpcJwtAuthnGet(ctx, client, discovery.JwksUri)
+	return
+}
+
+func grpcJwtAuthnGet(ctx context.Context, client *http.Client, url string) (result []byte, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		err = fmt.Errorf("request to '%s' failed with status code %d", url, response.StatusCode)
+		return
+	}
+	result, err = io.ReadAll(response.Body)
+	return
+}
+
+// grpcJwtAuthnValidMethods are the signing methods accepted.
+var grpcJwtAuthnValidMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+const (
+	// grpcJwtAuthnDefaultUserClaim is the default name of the claim that contains the user name.
+	grpcJwtAuthnDefaultUserClaim = "sub"
+
+	// grpcJwtAuthnDefaultGroupsClaim is the default name of the claim that contains the groups.
+	grpcJwtAuthnDefaultGroupsClaim = "groups"
+
+	// grpcJwtAuthnLeeway is the clock skew tolerated when checking the `exp` and `nbf` claims.
+	grpcJwtAuthnLeeway = 30 * time.Second
+
+	// grpcJwtAuthnKeysRefreshInterval is the minimum time between reloads of the keys.
+	grpcJwtAuthnKeysRefreshInterval = 1 * time.Minute
+
+	// grpcJwtAuthnKeysRetryInterval is the minimum time between reloads of the keys when the last one failed.
+	grpcJwtAuthnKeysRetryInterval = 5 * time.Second
+)
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/jkary/osac/fulfillment/service/internal"
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
+)
+
+// NewDbReencryptCommand creates and returns the `db reencrypt` command.
+func NewDbReencryptCommand() *cobra.Command {
+	runner := &dbReencryptCommandRunner{}
+	command := &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Encrypts again the sensitive data stored in the database with the primary key",
+		Long: "Encrypts again the sensitive data stored in the database with the primary key. This is intended " +
+			"for rotating keys: add the new key to the keys file, make it the primary key, restart the " +
+			"servers, run this command and then remove the old key. It also encrypts the sensitive data " +
+			"that was stored before encryption was enabled.",
+		Args: cobra.NoArgs,
+		RunE: runner.run,
+	}
+	flags := command.Flags()
+	database.AddFlags(flags)
+	flags.StringVar(
+		&runner.encryptionKeysFile,
+		"db-encryption-keys-file",
+		"",
+		"YAML file containing the keys used to encrypt sensitive data.",
+	)
+	return command
+}
+
+// dbReencryptCommandRunner contains the data and logic needed to run the `db reencrypt` command.
+type dbReencryptCommandRunner struct {
+	logger             *slog.Logger
+	flags              *pflag.FlagSet
+	encryptionKeysFile string
+}
+
+// run runs the `db reencrypt` command.
+func (c *dbReencryptCommandRunner) run(cmd *cobra.Command, argv []string) error {
+	// Get the context:
+	ctx := cmd.Context()
+
+	// Get the dependencies from the context:
+	c.logger = internal.LoggerFromContext(ctx)
+
+	// Save the flags:
+	c.flags = cmd.Flags()
+
+	// Check the flags:
+	if c.encryptionKeysFile == "" {
+		return errors.New("encryption keys file is mandatory")
+	}
+
+	// Create the keyring:
+	keyring, err := encryption.NewKeyring().
+		SetLogger(c.logger).
+		SetFile(c.encryptionKeysFile).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create encryption keyring: %w", err)
+	}
+
+	// Wait till the database is available. Note that we don't run the migrations here, that is the responsibility
+	// of the server.
+	dbTool, err := database.NewTool().
+		SetLogger(c.logger).
+		SetFlags(c.flags).
+		Build()
+	if err != nil {
+		return err
+	}
+	c.logger.InfoContext(ctx, "Waiting for database")
+	err = dbTool.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	dbPool, err := dbTool.Pool(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbPool.Close()
+	txManager, err := database.NewTxManager().
+		SetLogger(c.logger).
+		SetPool(dbPool).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create transaction manager: %w", err)
+	}
+
+	// Create the DAOs for the objects that have sensitive fields:
+	hubsDao, err := dao.NewGenericDAO[*privatev1.Hub]().
+		SetLogger(c.logger).
+		SetTable("hubs").
+		SetKeyring(keyring).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create hubs DAO: %w", err)
+	}
+
+	// Re-encrypt all the objects inside the same transaction, so that if something fails nothing is changed:
+	tx, err := txManager.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	count, err := hubsDao.Reencrypt(database.TxIntoContext(ctx, tx))
+	if err != nil {
+		_ = txManager.End(ctx, tx)
+		return fmt.Errorf("failed to re-encrypt hubs: %w", err)
+	}
+	err = txManager.End(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	c.logger.InfoContext(
+		ctx,
+		"Re-encrypted sensitive data",
+		slog.String("primary", keyring.Primary()),
+		slog.Int("hubs", count),
+	)
+	return nil
+}
@@ -112,11 +112,12 @@ func (c *listenCommandRunner) run(cmd *cobra.Command, argv []string) error {
 	return nil
 }
 
-func (c *listenCommandRunner) processPayload(ctx context.Context, payload proto.Message) error {
+func (c *listenCommandRunner) processPayload(ctx context.Context, seq int64, payload proto.Message) error {
 	c.logger.InfoContext(
 		ctx,
 		"Received payload",
 		slog.String("channel", c.channel),
+		slog.Int64("seq", seq),
 		slog.Any("payload", payload),
 	)
 
@@ -49,6 +49,12 @@ func NewWatchCommand() *cobra.Command {
 		"",
 		"Event filter",
 	)
+	flags.Int64Var(
+		&runner.since,
+		"since",
+		0,
+		"Sequence number of the last event already received, events after that will be replayed",
+	)
 	return command
 }
 
@@ -57,6 +63,7 @@ type watchCommandRunner struct {
 	logger *slog.Logger
 	flags  *pflag.FlagSet
 	filter string
+	since  int64
 }
 
 // run runs the `listen` command.
@@ -91,9 +98,13 @@ func (c *watchCommandRunner) run(cmd *cobra.Command, argv []string) error {
 	}()
 
 	// Start watching events:
-	stream, err := client.Events().Watch(ctx, &eventsv1.EventsWatchRequest{
+	request := &eventsv1.EventsWatchRequest{
 		Filter: proto.String(c.filter),
-	})
+	}
+	if c.flags.Changed("since") {
+		request.Since = proto.Int64(c.since)
+	}
+	stream, err := client.Events().Watch(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to start stream: %w", err)
 	}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/pflag"
+
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
+	"github.com/jkary/osac/fulfillment/service/internal/network"
+)
+
+// startMetricsServer registers the metrics of the service in the default Prometheus registry and starts an HTTP server
+// that exposes them in the `/metrics` path, using the listener configured with the metrics listener flags. The server
+// runs in a separate goroutine and stops when the context is canceled.
+func startMetricsServer(ctx context.Context, logger *slog.Logger, flags *pflag.FlagSet) error {
+	// Register the metrics:
+	err := metrics.Register(prometheus.DefaultRegisterer)
+	if err != nil {
+		return fmt.Errorf("failed to register metrics: %w", err)
+	}
+
+	// Create the listener:
+	listener, err := network.NewListener().
+		SetLogger(logger).
+		SetFlags(flags, network.MetricsListenerName).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create metrics listener: %w", err)
+	}
+
+	// Create the server:
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Start serving:
+	logger.InfoContext(
+		ctx,
+		"Start serving metrics",
+		slog.String("address", listener.Addr().String()),
+	)
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.ErrorContext(
+				ctx,
+				"Metrics server finished",
+				slog.Any("error", err),
+			)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		err := server.Close()
+		if err != nil {
+			logger.ErrorContext(
+				ctx,
+				"Failed to close metrics server",
+				slog.Any("error", err),
+			)
+		}
+	}()
+	return nil
+}
@@ -19,22 +19,30 @@ import (
 	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	crconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 	crlog "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/jkary/osac/fulfillment/service/internal"
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/controllers"
 	"github.com/jkary/osac/fulfillment/service/internal/controllers/cluster"
+	"github.com/jkary/osac/fulfillment/service/internal/controllers/snapshot"
 	"github.com/jkary/osac/fulfillment/service/internal/controllers/vm"
 	"github.com/jkary/osac/fulfillment/service/internal/network"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -49,14 +57,96 @@ func NewStartControllerCommand() *cobra.Command {
 	}
 	flags := command.Flags()
 	network.AddGrpcClientFlags(flags, network.GrpcClientName, network.DefaultGrpcAddress)
+	network.AddListenerFlags(flags, network.MetricsListenerName, network.DefaultControllerMetricsAddress)
+	tracing.AddFlags(flags)
+	flags.IntVar(
+		&runner.workers,
+		"reconciler-workers",
+		4,
+		"Number of objects of each type that will be reconciled in parallel.",
+	)
+	flags.DurationVar(
+		&runner.maxRetryDelay,
+		"reconciler-max-retry-delay",
+		5*time.Minute,
+		"Maximum time to wait before reconciling again an object that failed.",
+	)
+	flags.DurationVar(
+		&runner.hubCheckInterval,
+		"hub-check-interval",
+		time.Minute,
+		"Interval between health checks of the hubs. New objects aren't placed in hubs that aren't healthy.",
+	)
+	flags.BoolVar(
+		&runner.leaderElection,
+		"leader-election",
+		false,
+		"Enable leader election, so that only one instance of the controller, or one per shard, is active. "+
+			"The rest of the instances wait to take over if the active one fails. This requires access to "+
+			"Kubernetes leases.",
+	)
+	flags.StringVar(
+		&runner.leaderElectionNamespace,
+		"leader-election-namespace",
+		"",
+		"Namespace of the leader election lease. The default is the namespace of the pod.",
+	)
+	flags.StringVar(
+		&runner.leaderElectionName,
+		"leader-election-name",
+		"fulfillment-controller",
+		"Name of the leader election lease. When sharding is enabled the index of the shard is added as a suffix.",
+	)
+	flags.DurationVar(
+		&runner.leaderElectionLeaseDuration,
+		"leader-election-lease-duration",
+		15*time.Second,
+		"Time that standby instances wait since the last renewal of the lease before taking over.",
+	)
+	flags.DurationVar(
+		&runner.leaderElectionRenewDeadline,
+		"leader-election-renew-deadline",
+		10*time.Second,
+		"Time that the active instance keeps trying to renew the lease before giving up.",
+	)
+	flags.DurationVar(
+		&runner.leaderElectionRetryPeriod,
+		"leader-election-retry-period",
+		2*time.Second,
+		"Time between attempts to acquire or renew the lease.",
+	)
+	flags.IntVar(
+		&runner.shard.Count,
+		"shard-count",
+		1,
+		"Number of shards. Objects are split between shards using a hash of their identifier, and each "+
+			"instance only reconciles the objects of its shard. All instances must use the same value.",
+	)
+	flags.IntVar(
+		&runner.shard.Index,
+		"shard-index",
+		0,
+		"Index of the shard of this instance, from zero to the number of shards minus one.",
+	)
 	return command
 }
 
 // startControllerRunner contains the data and logic needed to run the `start controllers` command.
 type startControllerRunner struct {
-	logger *slog.Logger
-	flags  *pflag.FlagSet
-	client *grpc.ClientConn
+	logger           *slog.Logger
+	flags            *pflag.FlagSet
+	client           *grpc.ClientConn
+	workers          int
+	maxRetryDelay    time.Duration
+	hubCheckInterval time.Duration
+
+	leaderElection              bool
+	leaderElectionNamespace     string
+	leaderElectionName          string
+	leaderElectionLeaseDuration time.Duration
+	leaderElectionRenewDeadline time.Duration
+	leaderElectionRetryPeriod   time.Duration
+	shard                       controllers.Shard
 }
 
 // run runs the `start controllers` command.
@@ -78,6 +168,19 @@ func (r *startControllerRunner) run(cmd *cobra.Command, argv []string) error {
 	// Save the flags:
 	r.flags = cmd.Flags()
 
+	// Check the shard:
+	err = r.shard.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Start tracing:
+	stopTracing, err := startTracing(ctx, r.logger, r.flags, "fulfillment-controller")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	// Create the gRPC client:
 	r.client, err = network.NewClient().
 		SetLogger(r.logger).
@@ -87,6 +190,13 @@ func (r *startControllerRunner) run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("failed to create gRPC client: %w", err)
 	}
 
+	// Start the metrics server:
+	r.logger.InfoContext(ctx, "Starting metrics server")
+	err = startMetricsServer(ctx, r.logger, r.flags)
+	if err != nil {
+		return err
+	}
+
 	// Wait for the server to be ready:
 	err = r.waitForServer(ctx)
 	if err != nil {
@@ -103,6 +213,18 @@ func (r *startControllerRunner) run(cmd *cobra.Command, argv []string) error {
 		return fmt.Errorf("failed to create hub cache: %w", err)
 	}
 
+	// Create the hub health checker:
+	r.logger.InfoContext(ctx, "Creating hub health checker")
+	hubHealthChecker, err := controllers.NewHubHealthChecker().
+		SetLogger(r.logger).
+		SetConnection(r.client).
+		SetHubCache(hubCache).
+		SetInterval(r.hubCheckInterval).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create hub health checker: %w", err)
+	}
+
 	// Create the cluster reconciler:
 	r.logger.InfoContext(ctx, "Creating cluster reconciler")
 	clusterReconcilerFunction, err := cluster.NewFunction().
@@ -117,27 +239,16 @@ func (r *startControllerRunner) run(cmd *cobra.Command, argv []string) error {
 		SetLogger(r.logger).
 		SetClient(r.client).
 		SetFunction(clusterReconcilerFunction).
+		SetWorkers(r.workers).
+		SetMaxRetryDelay(r.maxRetryDelay).
+		SetRegisterer(prometheus.DefaultRegisterer).
+		SetShard(r.shard).
 		SetEventFilter("has(event.cluster) || (has(event.hub) && event.type == EVENT_TYPE_OBJECT_CREATED)").
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create cluster reconciler: %w", err)
 	}
 
-	// Start the cluster reconciler:
-	r.logger.InfoContext(ctx, "Starting cluster reconciler")
-	go func() {
-		err := clusterReconciler.Start(ctx)
-		if err == nil || errors.Is(err, context.Canceled) {
-			r.logger.InfoContext(ctx, "Cluster reconciler finished")
-		} else {
-			r.logger.InfoContext(
-				ctx,
-				"Cluster reconciler failed",
-				slog.Any("error", err),
-			)
-		}
-	}()
-
 	// Create the virtual machine reconciler:
 	r.logger.InfoContext(ctx, "Creating virtual machine reconciler")
 	vmReconcilerFunction, err := vm.NewFunction().
@@ -152,36 +263,178 @@ func (r *startControllerRunner) run(cmd *cobra.Command, argv []string) error {
 		SetLogger(r.logger).
 		SetClient(r.client).
 		SetFunction(vmReconcilerFunction).
+		SetWorkers(r.workers).
+		SetMaxRetryDelay(r.maxRetryDelay).
+		SetRegisterer(prometheus.DefaultRegisterer).
+		SetShard(r.shard).
 		SetEventFilter("has(event.virtual_machine) || (has(event.hub) && event.type == EVENT_TYPE_OBJECT_CREATED)").
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create virtual machine reconciler: %w", err)
 	}
 
-	// Start the virtual machine reconciler:
-	r.logger.InfoContext(ctx, "Starting virtual machine reconciler")
-	go func() {
-		err := vmReconciler.Start(ctx)
-		if err == nil || errors.Is(err, context.Canceled) {
-			r.logger.InfoContext(ctx, "Virtual machine reconciler finished")
-		} else {
-			r.logger.InfoContext(
-				ctx,
-				"Virtual machine reconciler failed",
-				slog.Any("error", err),
-			)
+	// Create the virtual machine snapshot reconciler:
+	r.logger.InfoContext(ctx, "Creating virtual machine snapshot reconciler")
+	snapshotReconcilerFunction, err := snapshot.NewFunction().
+		SetLogger(r.logger).
+		SetConnection(r.client).
+		SetHubCache(hubCache).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create virtual machine snapshot reconciler function: %w", err)
+	}
+	snapshotReconciler, err := controllers.NewReconciler[*privatev1.VirtualMachineSnapshot]().
+		SetLogger(r.logger).
+		SetClient(r.client).
+		SetFunction(snapshotReconcilerFunction).
+		SetWorkers(r.workers).
+		SetMaxRetryDelay(r.maxRetryDelay).
+		SetRegisterer(prometheus.DefaultRegisterer).
+		SetShard(r.shard).
+		SetEventFilter("has(event.virtual_machine_snapshot)").
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create virtual machine snapshot reconciler: %w", err)
+	}
+
+	// Prepare the list of workers. The health of the hubs is saved in the server, so it only needs to be checked by
+	// one of the shards.
+	var workers []startControllerWorker
+	if r.shard.Index == 0 {
+		workers = append(workers, startControllerWorker{
+			name:  "hub health checker",
+			start: hubHealthChecker.Start,
+		})
+	}
+	workers = append(
+		workers,
+		startControllerWorker{
+			name:  "cluster reconciler",
+			start: clusterReconciler.Start,
+		},
+		startControllerWorker{
+			name:  "virtual machine reconciler",
+			start: vmReconciler.Start,
+		},
+		startControllerWorker{
+			name:  "virtual machine snapshot reconciler",
+			start: snapshotReconciler.Start,
+		},
+	)
+
+	// Start the workers. When leader election is enabled they only run while this instance holds the lease of its
+	// shard, and the command finishes if the lease is lost.
+	done := make(chan error, 1)
+	if r.leaderElection {
+		elector, err := r.createLeaderElector()
+		if err != nil {
+			return fmt.Errorf("failed to create leader elector: %w", err)
 		}
-	}()
+		go func() {
+			done <- elector.Run(ctx, func(ctx context.Context) {
+				r.runWorkers(ctx, workers)
+			})
+		}()
+	} else {
+		go func() {
+			r.runWorkers(ctx, workers)
+			done <- nil
+		}()
+	}
 
-	// Wait for a signal:
+	// Wait for a signal, or till the workers finish because the lease was lost. When a signal is received stop the
+	// workers and wait till they finish, so that the lease is released and a standby instance can take over
+	// immediately.
 	r.logger.InfoContext(ctx, "Waiting for signal")
 	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt)
-	<-stop
-	r.logger.InfoContext(ctx, "Signal received, shutting down")
-	return nil
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-stop:
+		r.logger.InfoContext(ctx, "Signal received, shutting down")
+		cancel()
+		<-done
+		return nil
+	case err = <-done:
+		return err
+	}
+}
+
+// startControllerWorker is a component of the controller, like a reconciler, that runs till its context is cancelled.
+type startControllerWorker struct {
+	name  string
+	start func(ctx context.Context) error
+}
+
+// runWorkers starts the given workers and waits till all of them finish.
+func (r *startControllerRunner) runWorkers(ctx context.Context, workers []startControllerWorker) {
+	wg := &sync.WaitGroup{}
+	for _, worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logger := r.logger.With(slog.String("worker", worker.name))
+			logger.InfoContext(ctx, "Starting worker")
+			err := worker.start(ctx)
+			if err == nil || errors.Is(err, context.Canceled) {
+				logger.InfoContext(ctx, "Worker finished")
+			} else {
+				logger.ErrorContext(
+					ctx,
+					"Worker failed",
+					slog.Any("error", err),
+				)
+			}
+		}()
+	}
+	wg.Wait()
 }
 
+// createLeaderElector creates the leader elector that uses the lease of the shard of this instance. The Kubernetes
+// client is configured from the kubeconfig or from the service account of the pod.
+func (r *startControllerRunner) createLeaderElector() (result *controllers.LeaderElector, err error) {
+	config, err := crconfig.GetConfig()
+	if err != nil {
+		err = fmt.Errorf("failed to get Kubernetes configuration: %w", err)
+		return
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		err = fmt.Errorf("failed to create Kubernetes client: %w", err)
+		return
+	}
+	namespace := r.leaderElectionNamespace
+	if namespace == "" {
+		var data []byte
+		data, err = os.ReadFile(startControllerNamespaceFile)
+		if err != nil {
+			err = fmt.Errorf(
+				"leader election namespace wasn't specified, and failed to read it from '%s': %w",
+				startControllerNamespaceFile, err,
+			)
+			return
+		}
+		namespace = strings.TrimSpace(string(data))
+	}
+	name := r.leaderElectionName
+	if r.shard.Count > 1 {
+		name = fmt.Sprintf("%s-%d", name, r.shard.Index)
+	}
+	result, err = controllers.NewLeaderElector().
+		SetLogger(r.logger).
+		SetClient(client).
+		SetNamespace(namespace).
+		SetName(name).
+		SetLeaseDuration(r.leaderElectionLeaseDuration).
+		SetRenewDeadline(r.leaderElectionRenewDeadline).
+		SetRetryPeriod(r.leaderElectionRetryPeriod).
+		Build()
+	return
+}
+
+// startControllerNamespaceFile is the file that contains the namespace of the pod, used as the default namespace of
+// the leader election lease.
+const startControllerNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
 // waitForServer waits for the server to be ready using the health service.
 func (r *startControllerRunner) waitForServer(ctx context.Context) error {
 	r.logger.InfoContext(ctx, "Waiting for server")
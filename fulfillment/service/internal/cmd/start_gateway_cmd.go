@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/textproto"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/spf13/cobra"
@@ -25,8 +26,12 @@ import (
 	"golang.org/x/net/http2/h2c"
 
 	"github.com/jkary/osac/fulfillment/service/internal"
+	eventsv1 "github.com/jkary/osac/fulfillment/service/internal/api/events/v1"
 	api "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/gateway"
 	"github.com/jkary/osac/fulfillment/service/internal/network"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -43,6 +48,7 @@ func NewStartGatewayCommand() *cobra.Command {
 	network.AddListenerFlags(flags, network.HttpListenerName, network.DefaultHttpAddress)
 	network.AddCorsFlags(flags, network.HttpListenerName)
 	network.AddGrpcClientFlags(flags, network.GrpcClientName, network.DefaultGrpcAddress)
+	tracing.AddFlags(flags)
 	return command
 }
 
@@ -63,6 +69,13 @@ func (c *startGatewayCommandRunner) run(cmd *cobra.Command, argv []string) error
 	// Save the flags:
 	c.flags = cmd.Flags()
 
+	// Start tracing:
+	stopTracing, err := startTracing(ctx, c.logger, c.flags, "fulfillment-gateway")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	// Create the network listener:
 	c.logger.InfoContext(ctx, "Creating gateway listener")
 	gwListener, err := network.NewListener().
@@ -92,6 +105,7 @@ func (c *startGatewayCommandRunner) run(cmd *cobra.Command, argv []string) error
 	}
 	gatewayMux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, gatewayMarshaller),
+		runtime.WithIncomingHeaderMatcher(c.matchHeader),
 	)
 
 	// Register the service handlers:
@@ -107,10 +121,55 @@ func (c *startGatewayCommandRunner) run(cmd *cobra.Command, argv []string) error
 	if err != nil {
 		return err
 	}
+	err = api.RegisterVirtualMachineSnapshotsHandler(ctx, gatewayMux, grpcClient)
+	if err != nil {
+		return err
+	}
 	err = api.RegisterVirtualMachineTemplatesHandler(ctx, gatewayMux, grpcClient)
 	if err != nil {
 		return err
 	}
+	err = api.RegisterVirtualMachinesHandler(ctx, gatewayMux, grpcClient)
+	if err != nil {
+		return err
+	}
+	err = eventsv1.RegisterEventsHandler(ctx, gatewayMux, grpcClient)
+	if err != nil {
+		return err
+	}
+
+	// Browsers don't apply the CORS rules to WebSocket connections, so the handlers that accept them need to check
+	// the origins themselves:
+	allowedOrigins, err := network.GetCorsAllowedOrigins(c.flags, network.HttpListenerName)
+	if err != nil {
+		return err
+	}
+
+	// Register the handler for the virtual machine consoles. This isn't generated because the gateway doesn't support
+	// bidirectional streaming, so it is a WebSocket handler that forwards the data to the gRPC stream.
+	consoleHandler, err := gateway.NewConsoleHandler().
+		SetLogger(c.logger).
+		SetConnection(grpcClient).
+		AddAllowedOrigins(allowedOrigins...).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create console handler: %w", err)
+	}
+	err = gatewayMux.HandlePath(http.MethodGet, gateway.ConsolePath, consoleHandler.Serve)
+	if err != nil {
+		return err
+	}
+
+	// Add the support for watching events with Server-Sent Events and WebSocket. The generated handler sends the
+	// events as a stream of JSON objects, which isn't convenient for browsers.
+	eventsMiddleware, err := gateway.NewEventsMiddleware().
+		SetLogger(c.logger).
+		SetConnection(grpcClient).
+		AddAllowedOrigins(allowedOrigins...).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create events middleware: %w", err)
+	}
 
 	// Add the CORS support:
 	corsMiddleware, err := network.NewCorsMiddleware().
@@ -120,7 +179,8 @@ func (c *startGatewayCommandRunner) run(cmd *cobra.Command, argv []string) error
 	if err != nil {
 		return fmt.Errorf("failed to create CORS middleware: %w", err)
 	}
-	handler := corsMiddleware(gatewayMux)
+	handler := corsMiddleware(eventsMiddleware(gatewayMux))
+	handler = tracing.Handler(handler, "gateway")
 
 	// Start serving:
 	c.logger.InfoContext(
@@ -135,3 +195,15 @@ func (c *startGatewayCommandRunner) run(cmd *cobra.Command, argv []string) error
 	}
 	return http1Server.Serve(gwListener)
 }
+
+// matchHeader decides which HTTP headers are forwarded to the gRPC server. In addition to the headers forwarded by
+// default it forwards the header used to select the tenant.
+func (c *startGatewayCommandRunner) matchHeader(key string) (result string, ok bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == auth.Tenant {
+		result = key
+		ok = true
+		return
+	}
+	result, ok = runtime.DefaultHeaderMatcher(key)
+	return
+}
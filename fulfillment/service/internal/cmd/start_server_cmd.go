@@ -15,14 +15,17 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
-	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
@@ -36,10 +39,14 @@ import (
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 	"github.com/jkary/osac/fulfillment/service/internal/logging"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 	"github.com/jkary/osac/fulfillment/service/internal/network"
 	"github.com/jkary/osac/fulfillment/service/internal/recovery"
 	"github.com/jkary/osac/fulfillment/service/internal/servers"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
 // NewStartServerCommand creates and returns the `start server` command.
@@ -53,24 +60,94 @@ func NewStartServerCommand() *cobra.Command {
 	}
 	flags := command.Flags()
 	network.AddListenerFlags(flags, network.GrpcListenerName, network.DefaultGrpcAddress)
+	network.AddListenerFlags(flags, network.MetricsListenerName, network.DefaultServerMetricsAddress)
 	database.AddFlags(flags)
+	tracing.AddFlags(flags)
 	flags.StringVar(
 		&runner.grpcAuthnType,
 		"grpc-authn-type",
 		auth.GrpcGuestAuthnType,
 		fmt.Sprintf(
-			"Type of gRPC authentication. Valid values are \"%s\" and \"%s\"",
-			auth.GrpcGuestAuthnType, auth.GrpcExternalAuthnType,
+			"Type of gRPC authentication. Valid values are \"%s\", \"%s\" and \"%s\"",
+			auth.GrpcGuestAuthnType, auth.GrpcExternalAuthnType, auth.GrpcJwtAuthnType,
 		),
 	)
+	auth.AddGrpcJwtAuthnFlags(flags)
+	flags.StringVar(
+		&runner.tenancyLogicType,
+		"tenancy-logic",
+		auth.DefaultTenancyLogicType,
+		fmt.Sprintf(
+			"Type of tenancy logic. Valid values are \"%s\" and \"%s\"",
+			auth.DefaultTenancyLogicType, auth.GroupsTenancyLogicType,
+		),
+	)
+	auth.AddGroupsTenancyFlags(flags)
+	flags.BoolVar(
+		&runner.grpcAuthzEnabled,
+		"grpc-authz-enabled",
+		false,
+		"Check authorization of requests using the built-in policy engine. This is intended for deployments "+
+			"where authorization isn't performed by an external component.",
+	)
+	auth.AddGrpcAuthzFlags(flags)
+	flags.DurationVar(
+		&runner.eventsRetentionTime,
+		"events-retention-time",
+		10*time.Minute,
+		"How long events are kept so that clients can resume watching from a previous position. Zero means that "+
+			"events aren't deleted because of their age.",
+	)
+	flags.IntVar(
+		&runner.eventsRetentionCount,
+		"events-retention-count",
+		0,
+		"Maximum number of events kept so that clients can resume watching from a previous position. Zero means "+
+			"that events aren't deleted because of their number.",
+	)
+	flags.DurationVar(
+		&runner.auditRetentionTime,
+		"audit-retention-time",
+		30*24*time.Hour,
+		"How long entries of the audit log are kept. Zero means that entries are never deleted.",
+	)
+	flags.DurationVar(
+		&runner.archiveRetentionTime,
+		"archive-retention-time",
+		90*24*time.Hour,
+		"How long completely deleted objects are kept in the archive. Zero means that archived objects are never "+
+			"deleted.",
+	)
+	flags.IntVar(
+		&runner.eventsBufferSize,
+		"events-buffer-size",
+		100,
+		"Number of events that can be waiting to be sent to each watcher. Watchers that don't receive events fast "+
+			"enough to keep this buffer from filling up are disconnected.",
+	)
+	flags.StringVar(
+		&runner.encryptionKeysFile,
+		"db-encryption-keys-file",
+		"",
+		"YAML file containing the keys used to encrypt sensitive data, like the kubeconfigs of hubs, before "+
+			"storing it in the database. If not set sensitive data is stored without encryption.",
+	)
 	return command
 }
 
 // startServerCommandRunner contains the data and logic needed to run the `start server` command.
 type startServerCommandRunner struct {
-	logger        *slog.Logger
-	flags         *pflag.FlagSet
-	grpcAuthnType string
+	logger               *slog.Logger
+	flags                *pflag.FlagSet
+	grpcAuthnType        string
+	tenancyLogicType     string
+	grpcAuthzEnabled     bool
+	eventsRetentionTime  time.Duration
+	eventsRetentionCount int
+	eventsBufferSize     int
+	auditRetentionTime   time.Duration
+	archiveRetentionTime time.Duration
+	encryptionKeysFile   string
 }
 
 // run runs the `start server` command.
@@ -89,6 +166,13 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 	// Save the flags:
 	c.flags = cmd.Flags()
 
+	// Start tracing:
+	stopTracing, err := startTracing(ctx, c.logger, c.flags, "fulfillment-server")
+	if err != nil {
+		return err
+	}
+	defer stopTracing()
+
 	// Wait till the database is available:
 	dbTool, err := database.NewTool().
 		SetLogger(c.logger).
@@ -117,6 +201,45 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		return err
 	}
 
+	// Create the keyring used to encrypt sensitive data:
+	keyring, err := c.createKeyring(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Create the archive purger:
+	c.logger.InfoContext(ctx, "Creating archive purger")
+	archivePurger, err := database.NewArchivePurger().
+		SetLogger(c.logger).
+		SetPool(dbPool).
+		AddTables(
+			"archived_cluster_templates",
+			"archived_clusters",
+			"archived_host_classes",
+			"archived_hubs",
+			"archived_quotas",
+			"archived_virtual_machine_snapshots",
+			"archived_virtual_machine_templates",
+			"archived_virtual_machines",
+		).
+		SetRetentionTime(c.archiveRetentionTime).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create archive purger: %w", err)
+	}
+	go func() {
+		err := archivePurger.Start(ctx)
+		if err == nil || errors.Is(err, context.Canceled) {
+			c.logger.InfoContext(ctx, "Archive purger finished")
+		} else {
+			c.logger.ErrorContext(
+				ctx,
+				"Archive purger finished",
+				slog.Any("error", err),
+			)
+		}
+	}()
+
 	// Create the network listener:
 	listener, err := network.NewListener().
 		SetLogger(c.logger).
@@ -126,6 +249,38 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		return err
 	}
 
+	// Start the metrics server, and add the collector that counts the objects in each state:
+	c.logger.InfoContext(ctx, "Starting metrics server")
+	err = startMetricsServer(ctx, c.logger, c.flags)
+	if err != nil {
+		return err
+	}
+	objectsCollector, err := metrics.NewObjectsCollector().
+		SetLogger(c.logger).
+		SetPool(dbPool).
+		AddTables(
+			"clusters",
+			"virtual_machine_snapshots",
+			"virtual_machines",
+		).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create objects collector: %w", err)
+	}
+	err = prometheus.DefaultRegisterer.Register(objectsCollector)
+	if err != nil {
+		return fmt.Errorf("failed to register objects collector: %w", err)
+	}
+
+	// Prepare the metrics interceptor:
+	c.logger.InfoContext(ctx, "Creating metrics interceptor")
+	metricsInterceptor, err := metrics.NewGrpcInterceptor().
+		SetLogger(c.logger).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create metrics interceptor: %w", err)
+	}
+
 	// Prepare the logging interceptor:
 	c.logger.InfoContext(ctx, "Creating logging interceptor")
 	loggingInterceptor, err := logging.NewInterceptor().
@@ -161,10 +316,19 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		if err != nil {
 			return fmt.Errorf("failed to create gRPC external authentication function: %w", err)
 		}
+	case auth.GrpcJwtAuthnType:
+		authnFunc, err = auth.NewGrpcJwtAuthnFunc().
+			SetLogger(c.logger).
+			SetFlags(c.flags).
+			AddPublicMethodRegex(publicMethodRegex).
+			Build()
+		if err != nil {
+			return fmt.Errorf("failed to create gRPC JWT authentication function: %w", err)
+		}
 	default:
 		return fmt.Errorf(
-			"unknown gRPC authentication type '%s', valid values are '%s' and '%s'",
-			c.grpcAuthnType, auth.GrpcGuestAuthnType, auth.GrpcExternalAuthnType,
+			"unknown gRPC authentication type '%s', valid values are '%s', '%s' and '%s'",
+			c.grpcAuthnType, auth.GrpcGuestAuthnType, auth.GrpcExternalAuthnType, auth.GrpcJwtAuthnType,
 		)
 	}
 	authnInterceptor, err := auth.NewGrpcAuthnInterceptor().
@@ -201,20 +365,95 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		return fmt.Errorf("failed to create transactions interceptor: %w", err)
 	}
 
+	// Create the tenancy logic:
+	c.logger.InfoContext(
+		ctx,
+		"Creating tenancy logic",
+		slog.String("type", c.tenancyLogicType),
+	)
+	var tenancyLogic auth.TenancyLogic
+	switch strings.ToLower(c.tenancyLogicType) {
+	case auth.DefaultTenancyLogicType:
+		tenancyLogic, err = auth.NewDefaultTenancyLogic().
+			SetLogger(c.logger).
+			Build()
+	case auth.GroupsTenancyLogicType:
+		tenancyLogic, err = auth.NewGroupsTenancyLogic().
+			SetLogger(c.logger).
+			SetFlags(c.flags).
+			Build()
+	default:
+		return fmt.Errorf(
+			"unknown tenancy logic type '%s', valid values are '%s' and '%s'",
+			c.tenancyLogicType, auth.DefaultTenancyLogicType, auth.GroupsTenancyLogicType,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create tenancy logic: %w", err)
+	}
+
+	// Prepare the interceptors. Note that the metrics interceptor goes first so that it also counts the requests that
+	// fail because of panics.
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		metricsInterceptor.UnaryServer,
+		panicInterceptor.UnaryServer,
+		loggingInterceptor.UnaryServer,
+		authnInterceptor.UnaryServer,
+		txInterceptor.UnaryServer,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		metricsInterceptor.StreamServer,
+		panicInterceptor.StreamServer,
+		loggingInterceptor.StreamServer,
+		authnInterceptor.StreamServer,
+	}
+
+	// Prepare the authorization interceptor. Note that it goes after the transactions interceptor because it
+	// needs the transaction to find the tenants of the objects targeted by the requests. Streaming methods don't
+	// have a transaction, so for them the tenants lookup begins its own.
+	if c.grpcAuthzEnabled {
+		c.logger.InfoContext(ctx, "Creating authorization interceptor")
+		tenantsLookup, err := dao.NewTenantsLookup().
+			SetLogger(c.logger).
+			SetTxManager(txManager).
+			AddTable("fulfillment.v1.ClusterTemplates", "cluster_templates").
+			AddTable("fulfillment.v1.Clusters", "clusters").
+			AddTable("fulfillment.v1.HostClasses", "host_classes").
+			AddTable("fulfillment.v1.VirtualMachineSnapshots", "virtual_machine_snapshots").
+			AddTable("fulfillment.v1.VirtualMachineTemplates", "virtual_machine_templates").
+			AddTable("fulfillment.v1.VirtualMachines", "virtual_machines").
+			AddTable("private.v1.AuditLog", "audit_log").
+			AddTable("private.v1.ClusterTemplates", "cluster_templates").
+			AddTable("private.v1.Clusters", "clusters").
+			AddTable("private.v1.HostClasses", "host_classes").
+			AddTable("private.v1.Hubs", "hubs").
+			AddTable("private.v1.Quotas", "quotas").
+			AddTable("private.v1.VirtualMachineSnapshots", "virtual_machine_snapshots").
+			AddTable("private.v1.VirtualMachineTemplates", "virtual_machine_templates").
+			AddTable("private.v1.VirtualMachines", "virtual_machines").
+			Build()
+		if err != nil {
+			return fmt.Errorf("failed to create tenants lookup: %w", err)
+		}
+		authzInterceptor, err := auth.NewGrpcAuthzInterceptor().
+			SetLogger(c.logger).
+			SetFlags(c.flags).
+			SetTenancyLogic(tenancyLogic).
+			SetTenantsFunc(tenantsLookup.Lookup).
+			Build()
+		if err != nil {
+			return fmt.Errorf("failed to create authorization interceptor: %w", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, authzInterceptor.UnaryServer)
+		streamInterceptors = append(streamInterceptors, authzInterceptor.StreamServer)
+	}
+
 	// Create the gRPC server:
 	c.logger.InfoContext(ctx, "Creating gRPC server")
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			panicInterceptor.UnaryServer,
-			loggingInterceptor.UnaryServer,
-			authnInterceptor.UnaryServer,
-			txInterceptor.UnaryServer,
-		),
-		grpc.ChainStreamInterceptor(
-			panicInterceptor.StreamServer,
-			loggingInterceptor.StreamServer,
-			authnInterceptor.StreamServer,
-		),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	// Register the reflection server:
@@ -231,6 +470,8 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 	notifier, err := database.NewNotifier().
 		SetLogger(c.logger).
 		SetChannel("events").
+		SetRetentionTime(c.eventsRetentionTime).
+		SetRetentionCount(c.eventsRetentionCount).
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create notifier: %w", err)
@@ -245,15 +486,52 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		return fmt.Errorf("failed to create attribution logic: %w", err)
 	}
 
-	// Create the tenancy logic:
-	c.logger.InfoContext(ctx, "Creating tenancy logic")
-	tenancyLogic, err := auth.NewDefaultTenancyLogic().
+	// Create the audit logger:
+	c.logger.InfoContext(ctx, "Creating audit logger")
+	auditLogger, err := servers.NewAuditLogger().
 		SetLogger(c.logger).
+		SetRetentionTime(c.auditRetentionTime).
 		Build()
 	if err != nil {
-		return fmt.Errorf("failed to create tenancy logic: %w", err)
+		return fmt.Errorf("failed to create audit logger: %w", err)
 	}
 
+	// Create the private audit log server:
+	c.logger.InfoContext(ctx, "Creating private audit log server")
+	privateAuditLogServer, err := servers.NewPrivateAuditLogServer().
+		SetLogger(c.logger).
+		SetTenancyLogic(tenancyLogic).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create private audit log server: %w", err)
+	}
+	privatev1.RegisterAuditLogServer(grpcServer, privateAuditLogServer)
+
+	// Create the quota checker:
+	c.logger.InfoContext(ctx, "Creating quota checker")
+	quotaChecker, err := servers.NewQuotaChecker().
+		SetLogger(c.logger).
+		SetTenancyLogic(tenancyLogic).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create quota checker: %w", err)
+	}
+
+	// Create the private quotas server:
+	c.logger.InfoContext(ctx, "Creating private quotas server")
+	privateQuotasServer, err := servers.NewPrivateQuotasServer().
+		SetLogger(c.logger).
+		SetNotifier(notifier).
+		SetAttributionLogic(attributionLogic).
+		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
+		SetQuotaChecker(quotaChecker).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create private quotas server: %w", err)
+	}
+	privatev1.RegisterQuotasServer(grpcServer, privateQuotasServer)
+
 	// Create the private cluster templates server:
 	c.logger.InfoContext(ctx, "Creating private cluster templates server")
 	privateClusterTemplatesServer, err := servers.NewPrivateClusterTemplatesServer().
@@ -261,9 +539,10 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create private cluster templates server")
+		return fmt.Errorf("failed to create private cluster templates server: %w", err)
 	}
 	privatev1.RegisterClusterTemplatesServer(grpcServer, privateClusterTemplatesServer)
 
@@ -274,7 +553,7 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetPrivate(privateClusterTemplatesServer).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create cluster templates server")
+		return fmt.Errorf("failed to create cluster templates server: %w", err)
 	}
 	ffv1.RegisterClusterTemplatesServer(grpcServer, clusterTemplatesServer)
 
@@ -285,9 +564,12 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
+		SetQuotaChecker(quotaChecker).
+		SetKeyring(keyring).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create private clusters server")
+		return fmt.Errorf("failed to create private clusters server: %w", err)
 	}
 	privatev1.RegisterClustersServer(grpcServer, privateClustersServer)
 
@@ -296,9 +578,10 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 	clustersServer, err := servers.NewClustersServer().
 		SetLogger(c.logger).
 		SetPrivate(privateClustersServer).
+		SetKeyring(keyring).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create clusters server")
+		return fmt.Errorf("failed to create clusters server: %w", err)
 	}
 	ffv1.RegisterClustersServer(grpcServer, clustersServer)
 
@@ -309,9 +592,10 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create private host classes server")
+		return fmt.Errorf("failed to create private host classes server: %w", err)
 	}
 	privatev1.RegisterHostClassesServer(grpcServer, privateHostClassesServer)
 
@@ -322,7 +606,7 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetPrivate(privateHostClassesServer).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create host classes server")
+		return fmt.Errorf("failed to create host classes server: %w", err)
 	}
 	ffv1.RegisterHostClassesServer(grpcServer, hostClassesServer)
 
@@ -333,9 +617,10 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create private virtual machine templates server")
+		return fmt.Errorf("failed to create private virtual machine templates server: %w", err)
 	}
 	privatev1.RegisterVirtualMachineTemplatesServer(grpcServer, privateVirtualMachineTemplatesServer)
 
@@ -346,7 +631,7 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetPrivate(privateVirtualMachineTemplatesServer).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create virtual machine templates server")
+		return fmt.Errorf("failed to create virtual machine templates server: %w", err)
 	}
 	ffv1.RegisterVirtualMachineTemplatesServer(grpcServer, virtualMachineTemplatesServer)
 
@@ -357,9 +642,11 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
+		SetQuotaChecker(quotaChecker).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create private virtual machines server")
+		return fmt.Errorf("failed to create private virtual machines server: %w", err)
 	}
 	privatev1.RegisterVirtualMachinesServer(grpcServer, privateVirtualMachinesServer)
 
@@ -368,12 +655,39 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 	virtualMachinesServer, err := servers.NewVirtualMachinesServer().
 		SetLogger(c.logger).
 		SetPrivate(privateVirtualMachinesServer).
+		SetTxManager(txManager).
+		SetKeyring(keyring).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create virtual machines server")
+		return fmt.Errorf("failed to create virtual machines server: %w", err)
 	}
 	ffv1.RegisterVirtualMachinesServer(grpcServer, virtualMachinesServer)
 
+	// Create the private virtual machine snapshots server:
+	c.logger.InfoContext(ctx, "Creating private virtual machine snapshots server")
+	privateVirtualMachineSnapshotsServer, err := servers.NewPrivateVirtualMachineSnapshotsServer().
+		SetLogger(c.logger).
+		SetNotifier(notifier).
+		SetAttributionLogic(attributionLogic).
+		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create private virtual machine snapshots server: %w", err)
+	}
+	privatev1.RegisterVirtualMachineSnapshotsServer(grpcServer, privateVirtualMachineSnapshotsServer)
+
+	// Create the virtual machine snapshots server:
+	c.logger.InfoContext(ctx, "Creating virtual machine snapshots server")
+	virtualMachineSnapshotsServer, err := servers.NewVirtualMachineSnapshotsServer().
+		SetLogger(c.logger).
+		SetPrivate(privateVirtualMachineSnapshotsServer).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create virtual machine snapshots server: %w", err)
+	}
+	ffv1.RegisterVirtualMachineSnapshotsServer(grpcServer, virtualMachineSnapshotsServer)
+
 	// Create the private hubs server:
 	c.logger.InfoContext(ctx, "Creating hubs server")
 	privateHubsServer, err := servers.NewPrivateHubsServer().
@@ -381,9 +695,11 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetNotifier(notifier).
 		SetAttributionLogic(attributionLogic).
 		SetTenancyLogic(tenancyLogic).
+		SetAuditLogger(auditLogger).
+		SetKeyring(keyring).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create hubs server")
+		return fmt.Errorf("failed to create hubs server: %w", err)
 	}
 	privatev1.RegisterHubsServer(grpcServer, privateHubsServer)
 
@@ -393,10 +709,13 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetLogger(c.logger).
 		SetFlags(c.flags).
 		SetDbUrl(dbTool.URL()).
+		SetDbPool(dbPool).
 		SetTenancyLogic(tenancyLogic).
+		SetBufferSize(c.eventsBufferSize).
+		SetRegisterer(prometheus.DefaultRegisterer).
 		Build()
 	if err != nil {
-		return errors.Wrapf(err, "failed to create events server")
+		return fmt.Errorf("failed to create events server: %w", err)
 	}
 	go func() {
 		err := eventsServer.Start(ctx)
@@ -418,6 +737,9 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 		SetLogger(c.logger).
 		SetFlags(c.flags).
 		SetDbUrl(dbTool.URL()).
+		SetDbPool(dbPool).
+		SetBufferSize(c.eventsBufferSize).
+		SetRegisterer(prometheus.DefaultRegisterer).
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create private events server: %w", err)
@@ -449,6 +771,36 @@ func (c *startServerCommandRunner) run(cmd *cobra.Command, argv []string) error
 	return grpcServer.Serve(listener)
 }
 
+// createKeyring creates the keyring used to encrypt sensitive data. Returns nil if no keys file has been configured.
+func (c *startServerCommandRunner) createKeyring(ctx context.Context) (result *encryption.Keyring, err error) {
+	if c.encryptionKeysFile == "" {
+		c.logger.WarnContext(
+			ctx,
+			"Encryption keys file hasn't been configured, sensitive data will be stored without encryption",
+		)
+		return
+	}
+	c.logger.InfoContext(
+		ctx,
+		"Loading encryption keys",
+		slog.String("file", c.encryptionKeysFile),
+	)
+	result, err = encryption.NewKeyring().
+		SetLogger(c.logger).
+		SetFile(c.encryptionKeysFile).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create encryption keyring: %w", err)
+		return
+	}
+	c.logger.InfoContext(
+		ctx,
+		"Loaded encryption keys",
+		slog.String("primary", result.Primary()),
+	)
+	return
+}
+
 // publicMethodRegex is regular expression for the methods that are considered public, including the reflection and
 // health methods. These will skip authentication and authorization.
 const publicMethodRegex = `^/grpc\.(reflection|health)\..*$`
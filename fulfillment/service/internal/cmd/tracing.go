@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
+)
+
+// startTracing creates the tracing provider configured with the tracing flags and installs it as the global provider.
+// It returns a function that should be called before the process finishes, so that the spans that haven't been sent
+// yet aren't lost.
+func startTracing(ctx context.Context, logger *slog.Logger, flags *pflag.FlagSet,
+	service string) (stop func(), err error) {
+	provider, err := tracing.NewProvider().
+		SetLogger(logger).
+		SetFlags(flags).
+		SetServiceName(service).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create tracing provider: %w", err)
+		return
+	}
+	stop = func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		err := provider.Shutdown(ctx)
+		if err != nil {
+			logger.ErrorContext(
+				ctx,
+				"Failed to shutdown tracing provider",
+				slog.Any("error", err),
+			)
+		}
+	}
+	return
+}
@@ -18,15 +18,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand/v2"
+	"strconv"
+	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	clnt "sigs.k8s.io/controller-runtime/pkg/client"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/controllers"
+	"github.com/jkary/osac/fulfillment/service/internal/controllers/scheduling"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
 	"github.com/jkary/osac/fulfillment/service/internal/utils"
@@ -35,16 +38,22 @@ import (
 // objectPrefix is the prefix that will be used in the `generateName` field of the resources created in the hub.
 const objectPrefix = "order-"
 
+// listPageSize is the number of items requested in each page when listing all the hubs.
+const listPageSize = 100
+
 // FunctionBuilder contains the data and logic needed to build a function that reconciles clustes.
 type FunctionBuilder struct {
 	logger     *slog.Logger
 	connection *grpc.ClientConn
 	hubCache   *controllers.HubCache
+	scheduler  *scheduling.Scheduler
 }
 
 type function struct {
 	logger         *slog.Logger
 	hubCache       *controllers.HubCache
+	scheduler      *scheduling.Scheduler
+	scheduleLock   *sync.Mutex
 	clustersClient privatev1.ClustersClient
 	hubsClient     privatev1.HubsClient
 }
@@ -80,6 +89,13 @@ func (b *FunctionBuilder) SetHubCache(value *controllers.HubCache) *FunctionBuil
 	return b
 }
 
+// SetScheduler sets the scheduler that will be used to select the hub for new clusters. This is optional, by default
+// a scheduler with the default filters and scorers will be used.
+func (b *FunctionBuilder) SetScheduler(value *scheduling.Scheduler) *FunctionBuilder {
+	b.scheduler = value
+	return b
+}
+
 // Build uses the information stored in the buidler to create a new cluster reconciler.
 func (b *FunctionBuilder) Build() (result controllers.ReconcilerFunction[*privatev1.Cluster], err error) {
 	// Check parameters:
@@ -96,35 +112,50 @@ func (b *FunctionBuilder) Build() (result controllers.ReconcilerFunction[*privat
 		return
 	}
 
+	// Create the default scheduler if needed:
+	scheduler := b.scheduler
+	if scheduler == nil {
+		scheduler, err = scheduling.NewScheduler().
+			SetLogger(b.logger).
+			AddDefaults().
+			Build()
+		if err != nil {
+			err = fmt.Errorf("failed to create scheduler: %w", err)
+			return
+		}
+	}
+
 	// Create and populate the object:
 	object := &function{
 		logger:         b.logger,
 		clustersClient: privatev1.NewClustersClient(b.connection),
 		hubsClient:     privatev1.NewHubsClient(b.connection),
 		hubCache:       b.hubCache,
+		scheduler:      scheduler,
+		scheduleLock:   &sync.Mutex{},
 	}
 	result = object.run
 	return
 }
 
-func (r *function) run(ctx context.Context, cluster *privatev1.Cluster) error {
+func (r *function) run(ctx context.Context, cluster *privatev1.Cluster) (result controllers.ReconcilerResult,
+	err error) {
 	t := task{
 		r:       r,
 		cluster: cluster,
 	}
-	var err error
 	if cluster.GetMetadata().HasDeletionTimestamp() {
 		err = t.delete(ctx)
 	} else {
 		err = t.update(ctx)
 	}
 	if err != nil {
-		return err
+		return
 	}
 	_, err = r.clustersClient.Update(ctx, privatev1.ClustersUpdateRequest_builder{
 		Object: cluster,
 	}.Build())
-	return err
+	return
 }
 
 func (t *task) update(ctx context.Context) error {
@@ -172,6 +203,7 @@ func (t *task) update(ctx context.Context) error {
 		object.SetLabels(map[string]string{
 			labels.ClusterOrderUuid: t.cluster.GetId(),
 		})
+		t.setNameLabel(object)
 		err = unstructured.SetNestedField(object.Object, spec, "spec")
 		if err != nil {
 			return err
@@ -188,6 +220,7 @@ func (t *task) update(ctx context.Context) error {
 		)
 	} else {
 		update := object.DeepCopy()
+		t.setNameLabel(update)
 		err = unstructured.SetNestedField(update.Object, spec, "spec")
 		if err != nil {
 			return err
@@ -207,6 +240,22 @@ func (t *task) update(ctx context.Context) error {
 	return err
 }
 
+// setNameLabel adds to the Kubernetes object the label that contains the name of the cluster, or removes it if the
+// cluster doesn't have a name. This is intended to help hub administrators to correlate objects.
+func (t *task) setNameLabel(object *unstructured.Unstructured) {
+	objectLabels := object.GetLabels()
+	if objectLabels == nil {
+		objectLabels = map[string]string{}
+	}
+	name := t.cluster.GetMetadata().GetName()
+	if name != "" {
+		objectLabels[labels.ClusterOrderName] = name
+	} else {
+		delete(objectLabels, labels.ClusterOrderName)
+	}
+	object.SetLabels(objectLabels)
+}
+
 func (t *task) setDefaults() {
 	if !t.cluster.HasStatus() {
 		t.cluster.SetStatus(&privatev1.ClusterStatus{})
@@ -296,14 +345,10 @@ func (t *task) delete(ctx context.Context) error {
 func (t *task) selectHub(ctx context.Context) error {
 	t.hubId = t.cluster.GetStatus().GetHub()
 	if t.hubId == "" {
-		response, err := t.r.hubsClient.List(ctx, privatev1.HubsListRequest_builder{}.Build())
+		err := t.scheduleHub(ctx)
 		if err != nil {
 			return err
 		}
-		if len(response.Items) == 0 {
-			return errors.New("there are no hubs")
-		}
-		t.hubId = response.Items[rand.IntN(len(response.Items))].GetId()
 	}
 	t.r.logger.DebugContext(
 		ctx,
@@ -319,6 +364,98 @@ func (t *task) selectHub(ctx context.Context) error {
 	return nil
 }
 
+// scheduleHub uses the scheduler to select the hub for the cluster, and saves the result and the reason in the status.
+//
+// The scheduler decides using the number of clusters already placed in each hub, so concurrent selections could place
+// more clusters than allowed by the capacity of a hub. The server prevents that: it serializes the assignments to each
+// hub and rejects with an `ABORTED` error the ones that exceed the capacity, and then the reconciler fetches the cluster
+// again and retries. That works across all the instances of the controller, regardless of their shards. In addition
+// the selections of the workers of this instance are serialized, and the selected hub is saved before releasing the
+// lock, so that they don't have to be retried.
+func (t *task) scheduleHub(ctx context.Context) error {
+	t.r.scheduleLock.Lock()
+	defer t.r.scheduleLock.Unlock()
+	hubs, err := t.listHubs(ctx)
+	if err != nil {
+		return err
+	}
+	load, err := t.countClusters(ctx, hubs)
+	if err != nil {
+		return err
+	}
+	candidates := make([]*scheduling.Candidate, len(hubs))
+	for i, hub := range hubs {
+		candidates[i] = &scheduling.Candidate{
+			Hub:      hub,
+			Clusters: load[hub.GetId()],
+		}
+	}
+	result, err := t.r.scheduler.Schedule(
+		ctx,
+		&scheduling.Request{
+			Cluster: t.cluster,
+		},
+		candidates,
+	)
+	if err != nil {
+		return err
+	}
+	t.hubId = result.Hub.GetId()
+	t.cluster.GetStatus().SetHub(t.hubId)
+	t.cluster.GetStatus().SetHubSelectionReason(result.Reason)
+
+	// Save the selected hub, and take the new version so that the update at the end of the reconciliation doesn't
+	// fail because of a conflict:
+	response, err := t.r.clustersClient.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+		Object: t.cluster,
+	}.Build())
+	if err != nil {
+		return err
+	}
+	t.cluster.SetMetadata(response.GetObject().GetMetadata())
+	return nil
+}
+
+// listHubs retrieves all the hubs, page by page.
+func (t *task) listHubs(ctx context.Context) (result []*privatev1.Hub, err error) {
+	var pageToken *string
+	for {
+		var response *privatev1.HubsListResponse
+		response, err = t.r.hubsClient.List(ctx, privatev1.HubsListRequest_builder{
+			Limit:     proto.Int32(listPageSize),
+			PageToken: pageToken,
+			SkipTotal: proto.Bool(true),
+		}.Build())
+		if err != nil {
+			return
+		}
+		result = append(result, response.GetItems()...)
+		if !response.HasNextPageToken() {
+			return
+		}
+		pageToken = proto.String(response.GetNextPageToken())
+	}
+}
+
+// countClusters calculates the number of clusters that are already placed in each of the given hubs. The result is a
+// map where the keys are the identifiers of the hubs and the values are the number of clusters. Note that this only
+// asks the server for the total number of clusters of each hub, it doesn't retrieve the clusters themselves.
+func (t *task) countClusters(ctx context.Context, hubs []*privatev1.Hub) (result map[string]int, err error) {
+	result = make(map[string]int, len(hubs))
+	for _, hub := range hubs {
+		var response *privatev1.ClustersListResponse
+		response, err = t.r.clustersClient.List(ctx, privatev1.ClustersListRequest_builder{
+			Limit:  proto.Int32(1),
+			Filter: proto.String(fmt.Sprintf("this.status.hub == %s", strconv.Quote(hub.GetId()))),
+		}.Build())
+		if err != nil {
+			return
+		}
+		result[hub.GetId()] = int(response.GetTotal())
+	}
+	return
+}
+
 func (t *task) getHub(ctx context.Context) error {
 	t.hubId = t.cluster.GetStatus().GetHub()
 	hubEntry, err := t.r.hubCache.Get(ctx, t.hubId)
@@ -0,0 +1,462 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/controllers/scheduling"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
+)
+
+// fakeHubsClient is an implementation of the hubs client that returns a fixed list of hubs. The page tokens are the
+// index of the first item of the page.
+type fakeHubsClient struct {
+	privatev1.HubsClient
+	lock     sync.Mutex
+	hubs     []*privatev1.Hub
+	requests []*privatev1.HubsListRequest
+}
+
+func (c *fakeHubsClient) List(ctx context.Context, request *privatev1.HubsListRequest,
+	opts ...grpc.CallOption) (response *privatev1.HubsListResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.requests = append(c.requests, request)
+	start := 0
+	if request.HasPageToken() {
+		start, err = strconv.Atoi(request.GetPageToken())
+		Expect(err).ToNot(HaveOccurred())
+	}
+	end := min(start+int(request.GetLimit()), len(c.hubs))
+	items := c.hubs[start:end]
+	response = privatev1.HubsListResponse_builder{
+		Size:  proto.Int32(int32(len(items))),
+		Items: items,
+	}.Build()
+	if end < len(c.hubs) {
+		response.SetNextPageToken(strconv.Itoa(end))
+	}
+	return
+}
+
+// fakeClustersClient is an implementation of the clusters client that calculates the totals of list requests using
+// a fixed list of clusters. It only understands filters that select the clusters placed in one hub. Updated clusters
+// are added to the list. Like the real server, it rejects with an `ABORTED` error the updates that would exceed the
+// capacity of the hub.
+type fakeClustersClient struct {
+	privatev1.ClustersClient
+	lock     sync.Mutex
+	hubs     *fakeHubsClient
+	clusters []*privatev1.Cluster
+	requests []*privatev1.ClustersListRequest
+}
+
+func (c *fakeClustersClient) List(ctx context.Context, request *privatev1.ClustersListRequest,
+	opts ...grpc.CallOption) (response *privatev1.ClustersListResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.requests = append(c.requests, request)
+	hub, err := strconv.Unquote(strings.TrimPrefix(request.GetFilter(), "this.status.hub == "))
+	Expect(err).ToNot(HaveOccurred())
+	var items []*privatev1.Cluster
+	for _, cluster := range c.clusters {
+		if cluster.GetStatus().GetHub() == hub {
+			items = append(items, cluster)
+		}
+	}
+	total := len(items)
+	if limit := int(request.GetLimit()); limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	response = privatev1.ClustersListResponse_builder{
+		Size:  proto.Int32(int32(len(items))),
+		Total: proto.Int32(int32(total)),
+		Items: items,
+	}.Build()
+	return
+}
+
+func (c *fakeClustersClient) Update(ctx context.Context, request *privatev1.ClustersUpdateRequest,
+	opts ...grpc.CallOption) (response *privatev1.ClustersUpdateResponse, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hub := request.GetObject().GetStatus().GetHub()
+	for _, candidate := range c.hubs.hubs {
+		if candidate.GetId() != hub || candidate.GetMaxClusters() == 0 {
+			continue
+		}
+		count := 0
+		for _, cluster := range c.clusters {
+			if cluster.GetStatus().GetHub() == hub {
+				count++
+			}
+		}
+		if count >= int(candidate.GetMaxClusters()) {
+			err = grpcstatus.Errorf(grpccodes.Aborted, "hub '%s' is full", hub)
+			return
+		}
+	}
+	object := proto.Clone(request.GetObject()).(*privatev1.Cluster)
+	object.SetMetadata(privatev1.Metadata_builder{
+		Version: request.GetObject().GetMetadata().GetVersion() + 1,
+	}.Build())
+	c.clusters = append(c.clusters, object)
+	response = privatev1.ClustersUpdateResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+var _ = Describe("Hub scheduling", func() {
+	var (
+		ctx            context.Context
+		hubsClient     *fakeHubsClient
+		clustersClient *fakeClustersClient
+		r              *function
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		hubsClient = &fakeHubsClient{}
+		clustersClient = &fakeClustersClient{
+			hubs: hubsClient,
+		}
+		scheduler, err := scheduling.NewScheduler().
+			SetLogger(logger).
+			AddDefaults().
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		r = &function{
+			logger:         logger,
+			scheduler:      scheduler,
+			scheduleLock:   &sync.Mutex{},
+			clustersClient: clustersClient,
+			hubsClient:     hubsClient,
+		}
+	})
+
+	makeShardTask := func(r *function, spec *privatev1.ClusterSpec) *task {
+		return &task{
+			r: r,
+			cluster: privatev1.Cluster_builder{
+				Id: "my-cluster",
+				Metadata: privatev1.Metadata_builder{
+					Version: 1,
+				}.Build(),
+				Spec:   spec,
+				Status: &privatev1.ClusterStatus{},
+			}.Build(),
+		}
+	}
+
+	makeTask := func(spec *privatev1.ClusterSpec) *task {
+		return makeShardTask(r, spec)
+	}
+
+	placeClusters := func(hub string, count int) {
+		for range count {
+			clustersClient.clusters = append(clustersClient.clusters, privatev1.Cluster_builder{
+				Status: privatev1.ClusterStatus_builder{
+					Hub: hub,
+				}.Build(),
+			}.Build())
+		}
+	}
+
+	It("Selects the hub with less clusters and saves the reason", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{Id: "hub-a"}.Build(),
+			privatev1.Hub_builder{Id: "hub-b"}.Build(),
+		}
+		placeClusters("hub-a", 3)
+		placeClusters("hub-b", 1)
+		t := makeTask(nil)
+		err := t.scheduleHub(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.hubId).To(Equal("hub-b"))
+		reason := t.cluster.GetStatus().GetHubSelectionReason()
+		Expect(reason).To(HavePrefix("Selected hub 'hub-b'"))
+		Expect(reason).To(ContainSubstring("1 clusters, out of 2 candidates"))
+	})
+
+	It("Saves the selected hub and takes the new version", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{Id: "hub-a"}.Build(),
+		}
+		t := makeTask(nil)
+		err := t.scheduleHub(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.cluster.GetStatus().GetHub()).To(Equal("hub-a"))
+		Expect(t.cluster.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+		Expect(clustersClient.clusters).To(HaveLen(1))
+		saved := clustersClient.clusters[0]
+		Expect(saved.GetStatus().GetHub()).To(Equal("hub-a"))
+		Expect(saved.GetStatus().GetHubSelectionReason()).To(HavePrefix("Selected hub 'hub-a'"))
+	})
+
+	It("Doesn't exceed the capacity when scheduling concurrently", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{
+				Id:          "hub-a",
+				MaxClusters: 3,
+			}.Build(),
+			privatev1.Hub_builder{
+				Id:          "hub-b",
+				MaxClusters: 3,
+			}.Build(),
+		}
+		const count = 10
+		errs := make([]error, count)
+		wg := &sync.WaitGroup{}
+		for i := range count {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				errs[i] = makeTask(nil).scheduleHub(ctx)
+			}()
+		}
+		wg.Wait()
+		failures := 0
+		for _, err := range errs {
+			if err != nil {
+				failures++
+			}
+		}
+		Expect(failures).To(Equal(count - 6))
+		load := map[string]int{}
+		for _, cluster := range clustersClient.clusters {
+			load[cluster.GetStatus().GetHub()]++
+		}
+		Expect(load).To(Equal(map[string]int{
+			"hub-a": 3,
+			"hub-b": 3,
+		}))
+	})
+
+	It("Doesn't exceed the capacity when scheduling concurrently from two shards", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{
+				Id:          "hub-a",
+				MaxClusters: 3,
+			}.Build(),
+			privatev1.Hub_builder{
+				Id:          "hub-b",
+				MaxClusters: 3,
+			}.Build(),
+		}
+
+		// Create the function of the other shard. It shares the clients, that play the role of the server, but not
+		// the lock, like a separate instance of the controller:
+		shards := []*function{
+			r,
+			{
+				logger:         r.logger,
+				scheduler:      r.scheduler,
+				scheduleLock:   &sync.Mutex{},
+				clustersClient: r.clustersClient,
+				hubsClient:     r.hubsClient,
+			},
+		}
+
+		// Schedule the clusters, retrying when the server rejects the selection, like the reconciler does:
+		const count = 10
+		errs := make([]error, count)
+		wg := &sync.WaitGroup{}
+		for i := range count {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for {
+					errs[i] = makeShardTask(shards[i%len(shards)], nil).scheduleHub(ctx)
+					if grpcstatus.Code(errs[i]) != grpccodes.Aborted {
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		failures := 0
+		for _, err := range errs {
+			if err != nil {
+				Expect(err).To(MatchError(ContainSubstring("none of the 2 hubs can receive the cluster")))
+				failures++
+			}
+		}
+		Expect(failures).To(Equal(count - 6))
+		load := map[string]int{}
+		for _, cluster := range clustersClient.clusters {
+			load[cluster.GetStatus().GetHub()]++
+		}
+		Expect(load).To(Equal(map[string]int{
+			"hub-a": 3,
+			"hub-b": 3,
+		}))
+	})
+
+	It("Only asks for the totals of the hubs", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{Id: "hub-a"}.Build(),
+			privatev1.Hub_builder{Id: "hub-b"}.Build(),
+		}
+		placeClusters("hub-a", 5)
+		t := makeTask(nil)
+		err := t.scheduleHub(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clustersClient.requests).To(HaveLen(2))
+		for _, request := range clustersClient.requests {
+			Expect(request.GetLimit()).To(BeNumerically("==", 1))
+			Expect(request.GetSkipTotal()).To(BeFalse())
+		}
+	})
+
+	It("Lists the hubs page by page", func() {
+		count := 2*listPageSize + 1
+		for i := range count {
+			hubsClient.hubs = append(hubsClient.hubs, privatev1.Hub_builder{
+				Id: fmt.Sprintf("hub-%d", i),
+			}.Build())
+		}
+		t := makeTask(nil)
+		hubs, err := t.listHubs(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hubs).To(HaveLen(count))
+		Expect(hubsClient.requests).To(HaveLen(3))
+		for _, request := range hubsClient.requests {
+			Expect(request.GetSkipTotal()).To(BeTrue())
+			Expect(request.HasOffset()).To(BeFalse())
+		}
+	})
+
+	It("Honours the zone, the hub selector and the capacity", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{
+				Id:   "hub-a",
+				Zone: "zone-2",
+				Labels: map[string]string{
+					"gpu": "true",
+				},
+			}.Build(),
+			privatev1.Hub_builder{
+				Id:   "hub-b",
+				Zone: "zone-1",
+			}.Build(),
+			privatev1.Hub_builder{
+				Id:   "hub-c",
+				Zone: "zone-1",
+				Labels: map[string]string{
+					"gpu": "true",
+				},
+				MaxClusters: 2,
+			}.Build(),
+			privatev1.Hub_builder{
+				Id:   "hub-d",
+				Zone: "zone-1",
+				Labels: map[string]string{
+					"gpu": "true",
+				},
+			}.Build(),
+		}
+		placeClusters("hub-c", 2)
+		placeClusters("hub-d", 7)
+		t := makeTask(privatev1.ClusterSpec_builder{
+			Zone: "zone-1",
+			HubSelector: map[string]string{
+				"gpu": "true",
+			},
+		}.Build())
+		err := t.scheduleHub(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t.hubId).To(Equal("hub-d"))
+		reason := t.cluster.GetStatus().GetHubSelectionReason()
+		Expect(reason).To(HavePrefix("Selected hub 'hub-d'"))
+		Expect(reason).To(ContainSubstring("hub-a"))
+		Expect(reason).To(ContainSubstring("hub-b"))
+		Expect(reason).To(ContainSubstring("hub-c"))
+	})
+
+	It("Fails if no hub can receive the cluster", func() {
+		hubsClient.hubs = []*privatev1.Hub{
+			privatev1.Hub_builder{
+				Id:          "hub-a",
+				MaxClusters: 1,
+			}.Build(),
+		}
+		placeClusters("hub-a", 1)
+		t := makeTask(nil)
+		err := t.scheduleHub(ctx)
+		Expect(err).To(MatchError(ContainSubstring("none of the 1 hubs can receive the cluster")))
+		Expect(t.hubId).To(BeEmpty())
+		Expect(t.cluster.GetStatus().GetHubSelectionReason()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Name label", func() {
+	makeTask := func(name string) *task {
+		return &task{
+			cluster: privatev1.Cluster_builder{
+				Id: "my-id",
+				Metadata: privatev1.Metadata_builder{
+					Name: name,
+				}.Build(),
+			}.Build(),
+		}
+	}
+
+	It("Adds the name label preserving other labels", func() {
+		object := &unstructured.Unstructured{}
+		object.SetLabels(map[string]string{
+			labels.ClusterOrderUuid: "my-id",
+		})
+		makeTask("my-cluster").setNameLabel(object)
+		Expect(object.GetLabels()).To(Equal(map[string]string{
+			labels.ClusterOrderUuid: "my-id",
+			labels.ClusterOrderName: "my-cluster",
+		}))
+	})
+
+	It("Replaces the name label when the name changes", func() {
+		object := &unstructured.Unstructured{}
+		object.SetLabels(map[string]string{
+			labels.ClusterOrderName: "my-cluster",
+		})
+		makeTask("your-cluster").setNameLabel(object)
+		Expect(object.GetLabels()).To(HaveKeyWithValue(labels.ClusterOrderName, "your-cluster"))
+	})
+
+	It("Removes the name label when the name is removed", func() {
+		object := &unstructured.Unstructured{}
+		object.SetLabels(map[string]string{
+			labels.ClusterOrderUuid: "my-id",
+			labels.ClusterOrderName: "my-cluster",
+		})
+		makeTask("").setNameLabel(object)
+		Expect(object.GetLabels()).ToNot(HaveKey(labels.ClusterOrderName))
+	})
+})
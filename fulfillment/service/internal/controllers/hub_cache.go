@@ -15,27 +15,37 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"k8s.io/client-go/tools/clientcmd"
 	clnt "sigs.k8s.io/controller-runtime/pkg/client"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
 // HubClientCache contains the data and logic needed to build a cache of hub client.
 type HubCacheBuilder struct {
-	logger     *slog.Logger
-	connection *grpc.ClientConn
+	logger      *slog.Logger
+	connection  *grpc.ClientConn
+	idleTimeout time.Duration
 }
 
-// HubCache caches the information and connections to the hubs.
+// HubCache caches the information and connections to the hubs. Every time that an entry is requested the hub is
+// retrieved from the server, and the client is created again if the kubeconfig or the namespace have changed since
+// the entry was created. Entries of hubs that have been deleted, or that haven't been used for a while, are removed.
 type HubCache struct {
 	logger      *slog.Logger
 	client      privatev1.HubsClient
+	idleTimeout time.Duration
 	entries     map[string]*HubEntry
 	entriesLock *sync.Mutex
 }
@@ -43,11 +53,18 @@ type HubCache struct {
 type HubEntry struct {
 	Namespace string
 	Client    clnt.Client
+
+	// hash is the hash of the kubeconfig and namespace used to create the entry, and lastUsed is the last time that
+	// the entry was returned by the cache.
+	hash     [sha256.Size]byte
+	lastUsed time.Time
 }
 
 // NewHubCache creates a new builder that can then be used to create a new cluster order reconciler function.
 func NewHubCache() *HubCacheBuilder {
-	return &HubCacheBuilder{}
+	return &HubCacheBuilder{
+		idleTimeout: 30 * time.Minute,
+	}
 }
 
 // SetLogger sets the logger. This is mandatory.
@@ -62,6 +79,13 @@ func (b *HubCacheBuilder) SetConnection(value *grpc.ClientConn) *HubCacheBuilder
 	return b
 }
 
+// SetIdleTimeout sets how long entries are kept in the cache when they aren't used. This is optional and the default
+// is 30 minutes.
+func (b *HubCacheBuilder) SetIdleTimeout(value time.Duration) *HubCacheBuilder {
+	b.idleTimeout = value
+	return b
+}
+
 // Build uses the information stored in the buidler to create a new hub client cache.
 func (b *HubCacheBuilder) Build() (result *HubCache, err error) {
 	// Check parameters:
@@ -73,11 +97,16 @@ func (b *HubCacheBuilder) Build() (result *HubCache, err error) {
 		err = errors.New("gRPC connection is mandatory")
 		return
 	}
+	if b.idleTimeout <= 0 {
+		err = fmt.Errorf("idle timeout should be positive, but it is %s", b.idleTimeout)
+		return
+	}
 
 	// Create and populate the object:
 	result = &HubCache{
 		logger:      b.logger,
 		client:      privatev1.NewHubsClient(b.connection),
+		idleTimeout: b.idleTimeout,
 		entries:     map[string]*HubEntry{},
 		entriesLock: &sync.Mutex{},
 	}
@@ -85,32 +114,80 @@ func (b *HubCacheBuilder) Build() (result *HubCache, err error) {
 }
 
 func (r *HubCache) Get(ctx context.Context, id string) (result *HubEntry, err error) {
+	// Get the hub from the server, and forget it if it doesn't exist:
+	response, err := r.client.Get(ctx, privatev1.HubsGetRequest_builder{
+		Id: id,
+	}.Build())
+	if grpcstatus.Code(err) == grpccodes.NotFound {
+		r.entriesLock.Lock()
+		delete(r.entries, id)
+		r.entriesLock.Unlock()
+	}
+	if err != nil {
+		return
+	}
+	hub := response.GetObject()
+	hash := r.hash(hub)
+
+	// Return the existing entry if it hasn't changed, otherwise create a new one:
 	r.entriesLock.Lock()
 	defer r.entriesLock.Unlock()
+	now := time.Now()
+	r.expire(ctx, now)
 	result, ok := r.entries[id]
-	if ok {
+	if ok && result.hash == hash {
+		result.lastUsed = now
 		return
 	}
-	result, err = r.create(ctx, id)
+	if ok {
+		r.logger.InfoContext(
+			ctx,
+			"Hub has changed, will create a new client",
+			slog.String("hub", id),
+		)
+	}
+	result, err = r.create(hub)
 	if err != nil {
 		return
 	}
+	result.hash = hash
+	result.lastUsed = now
 	r.entries[id] = result
 	return
 }
 
-func (r *HubCache) create(ctx context.Context, id string) (result *HubEntry, err error) {
-	response, err := r.client.Get(ctx, privatev1.HubsGetRequest_builder{
-		Id: id,
-	}.Build())
-	if err != nil {
-		return
+// expire removes the entries that haven't been used for longer than the idle timeout. Must be called with the lock
+// acquired.
+func (r *HubCache) expire(ctx context.Context, now time.Time) {
+	for id, entry := range r.entries {
+		if now.Sub(entry.lastUsed) > r.idleTimeout {
+			r.logger.DebugContext(
+				ctx,
+				"Removing idle hub",
+				slog.String("hub", id),
+			)
+			delete(r.entries, id)
+		}
 	}
-	hub := response.GetObject()
+}
+
+// hash calculates the hash of the data of the hub that is used to create the entry.
+func (r *HubCache) hash(hub *privatev1.Hub) [sha256.Size]byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(hub.GetNamespace()))
+	hasher.Write([]byte{0})
+	hasher.Write(hub.GetKubeconfig())
+	var result [sha256.Size]byte
+	hasher.Sum(result[:0])
+	return result
+}
+
+func (r *HubCache) create(hub *privatev1.Hub) (result *HubEntry, err error) {
 	config, err := clientcmd.RESTConfigFromKubeConfig(hub.GetKubeconfig())
 	if err != nil {
 		return
 	}
+	config.Wrap(tracing.Transport)
 	client, err := clnt.New(config, clnt.Options{})
 	if err != nil {
 		return
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+var _ = Describe("Hub cache", func() {
+	var (
+		ctx        context.Context
+		hubsClient *fakeHubsClient
+		hubCache   *HubCache
+	)
+
+	// makeKubeconfig generates a kubeconfig for an API server with the given address. Note that creating a client
+	// doesn't connect to the server, so the address doesn't need to exist.
+	makeKubeconfig := func(server string) []byte {
+		return []byte(fmt.Sprintf(
+			"apiVersion: v1\n"+
+				"kind: Config\n"+
+				"clusters:\n"+
+				"- name: my-cluster\n"+
+				"  cluster:\n"+
+				"    server: %s\n"+
+				"users:\n"+
+				"- name: my-user\n"+
+				"  user:\n"+
+				"    token: my-token\n"+
+				"contexts:\n"+
+				"- name: my-context\n"+
+				"  context:\n"+
+				"    cluster: my-cluster\n"+
+				"    user: my-user\n"+
+				"current-context: my-context\n",
+			server,
+		))
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		hubsClient = &fakeHubsClient{
+			hubs: []*privatev1.Hub{
+				privatev1.Hub_builder{
+					Id:         "my-hub",
+					Kubeconfig: makeKubeconfig("https://my-hub:6443"),
+					Namespace:  "my-ns",
+				}.Build(),
+			},
+		}
+		hubCache = &HubCache{
+			logger:      logger,
+			client:      hubsClient,
+			idleTimeout: time.Hour,
+			entries:     map[string]*HubEntry{},
+			entriesLock: &sync.Mutex{},
+		}
+	})
+
+	It("Can't be created without a connection", func() {
+		_, err := NewHubCache().
+			SetLogger(logger).
+			Build()
+		Expect(err).To(MatchError("gRPC connection is mandatory"))
+	})
+
+	It("Can't be created with a zero idle timeout", func() {
+		_, err := NewHubCache().
+			SetLogger(logger).
+			SetConnection(&grpc.ClientConn{}).
+			SetIdleTimeout(0).
+			Build()
+		Expect(err).To(MatchError("idle timeout should be positive, but it is 0s"))
+	})
+
+	It("Reuses the entry if the hub hasn't changed", func() {
+		first, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first.Namespace).To(Equal("my-ns"))
+		second, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+	})
+
+	It("Creates a new entry if the kubeconfig changes", func() {
+		first, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		hubsClient.hubs[0].SetKubeconfig(makeKubeconfig("https://your-hub:6443"))
+		second, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second).ToNot(BeIdenticalTo(first))
+		Expect(second.Client).ToNot(BeIdenticalTo(first.Client))
+	})
+
+	It("Creates a new entry if the namespace changes", func() {
+		_, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		hubsClient.hubs[0].SetNamespace("your-ns")
+		entry, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entry.Namespace).To(Equal("your-ns"))
+	})
+
+	It("Removes the entry if the hub has been deleted", func() {
+		_, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		hubsClient.hubs = nil
+		_, err = hubCache.Get(ctx, "my-hub")
+		Expect(grpcstatus.Code(err)).To(Equal(grpccodes.NotFound))
+		Expect(hubCache.entries).To(BeEmpty())
+	})
+
+	It("Removes idle entries", func() {
+		_, err := hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		hubCache.entries["my-hub"].lastUsed = time.Now().Add(-2 * time.Hour)
+		hubCache.entries["your-hub"] = &HubEntry{
+			lastUsed: time.Now().Add(-2 * time.Hour),
+		}
+		_, err = hubCache.Get(ctx, "my-hub")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hubCache.entries).To(HaveLen(1))
+		Expect(hubCache.entries).To(HaveKey("my-hub"))
+	})
+})
@@ -0,0 +1,376 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clnt "sigs.k8s.io/controller-runtime/pkg/client"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
+)
+
+// HubHealthCheckerBuilder contains the data and logic needed to build a hub health checker. Don't create instances of
+// this type directly, use the NewHubHealthChecker function instead.
+type HubHealthCheckerBuilder struct {
+	logger     *slog.Logger
+	connection *grpc.ClientConn
+	hubCache   *HubCache
+	interval   time.Duration
+	timeout    time.Duration
+}
+
+// HubHealthChecker periodically checks the hubs and saves the results as conditions in their status. For each hub it
+// checks that the API server is reachable, that the custom resource definitions for cluster orders and virtual machines
+// are installed, and that the namespace exists. The reconcilers don't place new objects in hubs where any of these
+// checks fails.
+type HubHealthChecker struct {
+	logger     *slog.Logger
+	hubsClient privatev1.HubsClient
+	hubCache   *HubCache
+	interval   time.Duration
+	timeout    time.Duration
+}
+
+// hubHealthCheck contains the result of one of the checks.
+type hubHealthCheck struct {
+	kind    privatev1.HubConditionType
+	ok      bool
+	reason  string
+	message string
+}
+
+// NewHubHealthChecker creates a builder that can then be used to configure and create a hub health checker.
+func NewHubHealthChecker() *HubHealthCheckerBuilder {
+	return &HubHealthCheckerBuilder{
+		interval: hubHealthCheckerDefaultInterval,
+		timeout:  hubHealthCheckerDefaultTimeout,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *HubHealthCheckerBuilder) SetLogger(value *slog.Logger) *HubHealthCheckerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetConnection sets the gRPC client connection. This is mandatory.
+func (b *HubHealthCheckerBuilder) SetConnection(value *grpc.ClientConn) *HubHealthCheckerBuilder {
+	b.connection = value
+	return b
+}
+
+// SetHubCache sets the cache that will be used to get the clients for the hubs. This is mandatory.
+func (b *HubHealthCheckerBuilder) SetHubCache(value *HubCache) *HubHealthCheckerBuilder {
+	b.hubCache = value
+	return b
+}
+
+// SetInterval sets how often the hubs are checked. The default is one minute.
+func (b *HubHealthCheckerBuilder) SetInterval(value time.Duration) *HubHealthCheckerBuilder {
+	b.interval = value
+	return b
+}
+
+// SetTimeout sets the maximum time that checking a single hub can take. The default is ten seconds.
+func (b *HubHealthCheckerBuilder) SetTimeout(value time.Duration) *HubHealthCheckerBuilder {
+	b.timeout = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new hub health checker.
+func (b *HubHealthCheckerBuilder) Build() (result *HubHealthChecker, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.connection == nil {
+		err = errors.New("gRPC connection is mandatory")
+		return
+	}
+	if b.hubCache == nil {
+		err = errors.New("hub cache is mandatory")
+		return
+	}
+	if b.interval <= 0 {
+		err = fmt.Errorf("interval should be positive, but it is %s", b.interval)
+		return
+	}
+	if b.timeout <= 0 {
+		err = fmt.Errorf("timeout should be positive, but it is %s", b.timeout)
+		return
+	}
+
+	// Create and populate the object:
+	result = &HubHealthChecker{
+		logger:     b.logger,
+		hubsClient: privatev1.NewHubsClient(b.connection),
+		hubCache:   b.hubCache,
+		interval:   b.interval,
+		timeout:    b.timeout,
+	}
+	return
+}
+
+// Start checks the hubs immediately and then periodically, till the context is canceled.
+func (c *HubHealthChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		err := c.Check(ctx)
+		if err != nil {
+			c.logger.ErrorContext(
+				ctx,
+				"Failed to check hubs",
+				slog.Any("error", err),
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks all the hubs once, and saves the results for the hubs where they have changed.
+func (c *HubHealthChecker) Check(ctx context.Context) error {
+	hubs, err := c.listHubs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, hub := range hubs {
+		err = c.checkHub(ctx, hub)
+		if err != nil {
+			c.logger.ErrorContext(
+				ctx,
+				"Failed to check hub",
+				slog.String("hub", hub.GetId()),
+				slog.Any("error", err),
+			)
+		}
+	}
+	return nil
+}
+
+// listHubs retrieves all the hubs, page by page.
+func (c *HubHealthChecker) listHubs(ctx context.Context) (result []*privatev1.Hub, err error) {
+	var pageToken *string
+	for {
+		var response *privatev1.HubsListResponse
+		response, err = c.hubsClient.List(ctx, privatev1.HubsListRequest_builder{
+			Limit:     proto.Int32(hubHealthCheckerPageSize),
+			PageToken: pageToken,
+			SkipTotal: proto.Bool(true),
+		}.Build())
+		if err != nil {
+			return
+		}
+		result = append(result, response.GetItems()...)
+		if !response.HasNextPageToken() {
+			return
+		}
+		pageToken = proto.String(response.GetNextPageToken())
+	}
+}
+
+func (c *HubHealthChecker) checkHub(ctx context.Context, hub *privatev1.Hub) error {
+	checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	checks := c.runChecks(checkCtx, hub)
+
+	// Update the conditions, and save them only if they have changed:
+	status := proto.Clone(hub.GetStatus()).(*privatev1.HubStatus)
+	if status == nil {
+		status = &privatev1.HubStatus{}
+	}
+	var failures []string
+	for _, check := range checks {
+		setHubCondition(status, check)
+		if !check.ok && !slices.Contains(failures, check.message) {
+			failures = append(failures, check.message)
+		}
+	}
+	summary := hubHealthCheck{
+		kind:   privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY,
+		ok:     len(failures) == 0,
+		reason: "Healthy",
+	}
+	if !summary.ok {
+		summary.reason = "Unhealthy"
+		summary.message = strings.Join(failures, "; ")
+	}
+	setHubCondition(status, summary)
+	if proto.Equal(status, hub.GetStatus()) {
+		return nil
+	}
+	if summary.ok {
+		c.logger.InfoContext(
+			ctx,
+			"Hub is healthy",
+			slog.String("hub", hub.GetId()),
+		)
+	} else {
+		c.logger.WarnContext(
+			ctx,
+			"Hub is unhealthy",
+			slog.String("hub", hub.GetId()),
+			slog.String("reason", summary.message),
+		)
+	}
+	_, err := c.hubsClient.Update(ctx, privatev1.HubsUpdateRequest_builder{
+		Object: privatev1.Hub_builder{
+			Id:     hub.GetId(),
+			Status: status,
+		}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{"status"},
+		},
+	}.Build())
+	return err
+}
+
+// runChecks runs the checks for the given hub. If the API server isn't reachable the rest of the checks are reported
+// as failed as well, because there is no way to know the result.
+func (c *HubHealthChecker) runChecks(ctx context.Context, hub *privatev1.Hub) []hubHealthCheck {
+	reachable := &hubHealthCheck{
+		kind: privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE,
+	}
+	crds := &hubHealthCheck{
+		kind: privatev1.HubConditionType_HUB_CONDITION_TYPE_CRDS_INSTALLED,
+	}
+	namespace := &hubHealthCheck{
+		kind: privatev1.HubConditionType_HUB_CONDITION_TYPE_NAMESPACE_EXISTS,
+	}
+	results := func() []hubHealthCheck {
+		return []hubHealthCheck{*reachable, *crds, *namespace}
+	}
+	fail := func(reason, message string) []hubHealthCheck {
+		for _, check := range []*hubHealthCheck{reachable, crds, namespace} {
+			check.reason = reason
+			check.message = message
+		}
+		return results()
+	}
+
+	// Get the client. Failing to create it usually means that the kubeconfig is wrong, so we consider the API
+	// server unreachable.
+	entry, err := c.hubCache.Get(ctx, hub.GetId())
+	if err != nil {
+		return fail("ClientFailed", fmt.Sprintf("failed to create client: %v", err))
+	}
+
+	// Check that the API server is reachable and that the namespace exists at the same time. Any response from the
+	// API server, even an error, means that it is reachable.
+	err = entry.Client.Get(ctx, clnt.ObjectKey{Name: entry.Namespace}, &corev1.Namespace{})
+	var apiStatus apierrors.APIStatus
+	if err != nil && !errors.As(err, &apiStatus) {
+		return fail("Unreachable", fmt.Sprintf("API server isn't reachable: %v", err))
+	}
+	reachable.ok = true
+	reachable.reason = "Reachable"
+	switch {
+	case err == nil:
+		namespace.ok = true
+		namespace.reason = "Exists"
+	case apierrors.IsNotFound(err):
+		namespace.reason = "NotFound"
+		namespace.message = fmt.Sprintf("namespace '%s' doesn't exist", entry.Namespace)
+	default:
+		namespace.reason = "CheckFailed"
+		namespace.message = fmt.Sprintf("failed to check namespace '%s': %v", entry.Namespace, err)
+	}
+
+	// Check the custom resource definitions:
+	var missing []string
+	for _, gvk := range []schema.GroupVersionKind{gvks.ClusterOrder, gvks.VirtualMachine} {
+		_, err = entry.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			missing = append(missing, gvk.Kind)
+			continue
+		}
+		if err != nil {
+			crds.reason = "CheckFailed"
+			crds.message = fmt.Sprintf("failed to check custom resource definitions: %v", err)
+			return results()
+		}
+	}
+	if len(missing) > 0 {
+		crds.reason = "NotInstalled"
+		crds.message = fmt.Sprintf(
+			"custom resource definitions for '%s' aren't installed",
+			strings.Join(missing, "', '"),
+		)
+		return results()
+	}
+	crds.ok = true
+	crds.reason = "Installed"
+	return results()
+}
+
+// setHubCondition updates the condition of the given type with the result of a check, adding it if it doesn't exist.
+// The transition time is updated only when the status changes.
+func setHubCondition(status *privatev1.HubStatus, check hubHealthCheck) {
+	value := sharedv1.ConditionStatus_CONDITION_STATUS_FALSE
+	if check.ok {
+		value = sharedv1.ConditionStatus_CONDITION_STATUS_TRUE
+	}
+	var condition *privatev1.HubCondition
+	for _, current := range status.GetConditions() {
+		if current.GetType() == check.kind {
+			condition = current
+			break
+		}
+	}
+	if condition == nil {
+		condition = privatev1.HubCondition_builder{
+			Type: check.kind,
+		}.Build()
+		status.SetConditions(append(status.GetConditions(), condition))
+	}
+	if condition.GetStatus() != value {
+		condition.SetStatus(value)
+		condition.SetLastTransitionTime(timestamppb.Now())
+	}
+	condition.SetReason(check.reason)
+	if check.message != "" {
+		condition.SetMessage(check.message)
+	} else {
+		condition.ClearMessage()
+	}
+}
+
+// Defaults for the hub health checker:
+const (
+	hubHealthCheckerDefaultInterval = 1 * time.Minute
+	hubHealthCheckerDefaultTimeout  = 10 * time.Second
+	hubHealthCheckerPageSize        = 100
+)
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clnt "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
+)
+
+// fakeHubsClient is an implementation of the hubs client that returns a fixed list of hubs and remembers the update
+// requests.
+type fakeHubsClient struct {
+	privatev1.HubsClient
+	hubs    []*privatev1.Hub
+	updates []*privatev1.HubsUpdateRequest
+}
+
+func (c *fakeHubsClient) List(ctx context.Context, request *privatev1.HubsListRequest,
+	opts ...grpc.CallOption) (response *privatev1.HubsListResponse, err error) {
+	response = privatev1.HubsListResponse_builder{
+		Size:  proto.Int32(int32(len(c.hubs))),
+		Items: c.hubs,
+	}.Build()
+	return
+}
+
+func (c *fakeHubsClient) Get(ctx context.Context, request *privatev1.HubsGetRequest,
+	opts ...grpc.CallOption) (response *privatev1.HubsGetResponse, err error) {
+	for _, hub := range c.hubs {
+		if hub.GetId() == request.GetId() {
+			response = privatev1.HubsGetResponse_builder{
+				Object: hub,
+			}.Build()
+			return
+		}
+	}
+	err = grpcstatus.Errorf(grpccodes.NotFound, "hub '%s' doesn't exist", request.GetId())
+	return
+}
+
+func (c *fakeHubsClient) Update(ctx context.Context, request *privatev1.HubsUpdateRequest,
+	opts ...grpc.CallOption) (response *privatev1.HubsUpdateResponse, err error) {
+	c.updates = append(c.updates, request)
+	response = privatev1.HubsUpdateResponse_builder{
+		Object: request.GetObject(),
+	}.Build()
+	return
+}
+
+var _ = Describe("Hub health checker", func() {
+	var (
+		ctx        context.Context
+		hubsClient *fakeHubsClient
+		hubCache   *HubCache
+		checker    *HubHealthChecker
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		hubsClient = &fakeHubsClient{
+			hubs: []*privatev1.Hub{
+				privatev1.Hub_builder{
+					Id:        "my-hub",
+					Namespace: "my-ns",
+				}.Build(),
+			},
+		}
+		hubCache = &HubCache{
+			logger:      logger,
+			client:      hubsClient,
+			idleTimeout: time.Hour,
+			entries:     map[string]*HubEntry{},
+			entriesLock: &sync.Mutex{},
+		}
+		checker = &HubHealthChecker{
+			logger:     logger,
+			hubsClient: hubsClient,
+			hubCache:   hubCache,
+			interval:   time.Minute,
+			timeout:    time.Second,
+		}
+	})
+
+	// addHub adds to the cache a hub whose client is a fake with the given objects and custom resource definitions.
+	addHub := func(funcs interceptor.Funcs, kinds []schema.GroupVersionKind, objects ...clnt.Object) {
+		mapper := meta.NewDefaultRESTMapper(nil)
+		for _, kind := range kinds {
+			mapper.Add(kind, meta.RESTScopeNamespace)
+		}
+		hubCache.entries["my-hub"] = &HubEntry{
+			Namespace: "my-ns",
+			Client: fake.NewClientBuilder().
+				WithRESTMapper(mapper).
+				WithObjects(objects...).
+				WithInterceptorFuncs(funcs).
+				Build(),
+			hash:     hubCache.hash(hubsClient.hubs[0]),
+			lastUsed: time.Now(),
+		}
+	}
+
+	makeNamespace := func() *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-ns",
+			},
+		}
+	}
+
+	allKinds := []schema.GroupVersionKind{gvks.ClusterOrder, gvks.VirtualMachine}
+
+	// findCondition returns the condition of the given type saved by the last update.
+	findCondition := func(kind privatev1.HubConditionType) *privatev1.HubCondition {
+		Expect(hubsClient.updates).ToNot(BeEmpty())
+		update := hubsClient.updates[len(hubsClient.updates)-1]
+		Expect(update.GetUpdateMask().GetPaths()).To(ConsistOf("status"))
+		for _, condition := range update.GetObject().GetStatus().GetConditions() {
+			if condition.GetType() == kind {
+				return condition
+			}
+		}
+		Fail("condition not found")
+		return nil
+	}
+
+	It("Can't be created without a hub cache", func() {
+		_, err := NewHubHealthChecker().
+			SetLogger(logger).
+			SetConnection(&grpc.ClientConn{}).
+			Build()
+		Expect(err).To(MatchError("hub cache is mandatory"))
+	})
+
+	It("Reports a healthy hub", func() {
+		addHub(interceptor.Funcs{}, allKinds, makeNamespace())
+		Expect(checker.Check(ctx)).To(Succeed())
+		for _, kind := range []privatev1.HubConditionType{
+			privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY,
+			privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE,
+			privatev1.HubConditionType_HUB_CONDITION_TYPE_CRDS_INSTALLED,
+			privatev1.HubConditionType_HUB_CONDITION_TYPE_NAMESPACE_EXISTS,
+		} {
+			condition := findCondition(kind)
+			Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_TRUE))
+			Expect(condition.HasMessage()).To(BeFalse())
+			Expect(condition.HasLastTransitionTime()).To(BeTrue())
+		}
+	})
+
+	It("Reports an unreachable API server", func() {
+		addHub(interceptor.Funcs{
+			Get: func(ctx context.Context, client clnt.WithWatch, key clnt.ObjectKey, obj clnt.Object,
+				opts ...clnt.GetOption) error {
+				return errors.New("connection refused")
+			},
+		}, allKinds, makeNamespace())
+		Expect(checker.Check(ctx)).To(Succeed())
+		condition := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+		Expect(condition.GetReason()).To(Equal("Unreachable"))
+		condition = findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+		Expect(condition.GetMessage()).To(Equal("API server isn't reachable: connection refused"))
+	})
+
+	It("Reports missing custom resource definitions", func() {
+		addHub(interceptor.Funcs{}, []schema.GroupVersionKind{gvks.ClusterOrder}, makeNamespace())
+		Expect(checker.Check(ctx)).To(Succeed())
+		condition := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_CRDS_INSTALLED)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+		Expect(condition.GetMessage()).To(Equal(
+			"custom resource definitions for 'VirtualMachine' aren't installed",
+		))
+		condition = findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_TRUE))
+		condition = findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+	})
+
+	It("Reports a missing namespace", func() {
+		addHub(interceptor.Funcs{}, allKinds)
+		Expect(checker.Check(ctx)).To(Succeed())
+		condition := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_NAMESPACE_EXISTS)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+		Expect(condition.GetMessage()).To(Equal("namespace 'my-ns' doesn't exist"))
+		condition = findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_FALSE))
+		Expect(condition.GetMessage()).To(Equal("namespace 'my-ns' doesn't exist"))
+	})
+
+	It("Doesn't save the status if it hasn't changed", func() {
+		addHub(interceptor.Funcs{}, allKinds, makeNamespace())
+		Expect(checker.Check(ctx)).To(Succeed())
+		Expect(hubsClient.updates).To(HaveLen(1))
+		hubsClient.hubs[0].SetStatus(hubsClient.updates[0].GetObject().GetStatus())
+		Expect(checker.Check(ctx)).To(Succeed())
+		Expect(hubsClient.updates).To(HaveLen(1))
+	})
+
+	It("Preserves the transition time when the status doesn't change", func() {
+		addHub(interceptor.Funcs{}, allKinds)
+		Expect(checker.Check(ctx)).To(Succeed())
+		before := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE)
+		hubsClient.hubs[0].SetStatus(hubsClient.updates[0].GetObject().GetStatus())
+		Expect(hubCache.entries["my-hub"].Client.Create(ctx, makeNamespace())).To(Succeed())
+		Expect(checker.Check(ctx)).To(Succeed())
+		Expect(hubsClient.updates).To(HaveLen(2))
+		after := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_API_REACHABLE)
+		Expect(after.GetLastTransitionTime().AsTime()).To(Equal(before.GetLastTransitionTime().AsTime()))
+		condition := findCondition(privatev1.HubConditionType_HUB_CONDITION_TYPE_NAMESPACE_EXISTS)
+		Expect(condition.GetStatus()).To(Equal(sharedv1.ConditionStatus_CONDITION_STATUS_TRUE))
+	})
+})
@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
+)
+
+// ErrLeadershipLost is the error returned by the Run method of the leader elector when the instance was the leader but
+// failed to renew the lease.
+var ErrLeadershipLost = errors.New("leadership lost")
+
+// LeaderElectorBuilder contains the data and logic needed to create a leader elector. Don't create instances of this
+// type directly, use the NewLeaderElector function instead.
+type LeaderElectorBuilder struct {
+	logger        *slog.Logger
+	client        kubernetes.Interface
+	namespace     string
+	name          string
+	identity      string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
+// LeaderElector uses a Kubernetes lease to make sure that only one of the instances of the controller that use the
+// same lease is active at any time. The rest of the instances wait, ready to take over if the active one fails to renew
+// the lease.
+type LeaderElector struct {
+	logger   *slog.Logger
+	name     string
+	identity string
+	config   leaderelection.LeaderElectionConfig
+}
+
+// NewLeaderElector creates a builder that can then be used to configure and create a leader elector.
+func NewLeaderElector() *LeaderElectorBuilder {
+	return &LeaderElectorBuilder{
+		leaseDuration: 15 * time.Second,
+		renewDeadline: 10 * time.Second,
+		retryPeriod:   2 * time.Second,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *LeaderElectorBuilder) SetLogger(value *slog.Logger) *LeaderElectorBuilder {
+	b.logger = value
+	return b
+}
+
+// SetClient sets the Kubernetes client that will be used to manage the lease. This is mandatory.
+func (b *LeaderElectorBuilder) SetClient(value kubernetes.Interface) *LeaderElectorBuilder {
+	b.client = value
+	return b
+}
+
+// SetNamespace sets the namespace of the lease. This is mandatory.
+func (b *LeaderElectorBuilder) SetNamespace(value string) *LeaderElectorBuilder {
+	b.namespace = value
+	return b
+}
+
+// SetName sets the name of the lease. All the instances that use the same name compete for the same lease. This is
+// mandatory.
+func (b *LeaderElectorBuilder) SetName(value string) *LeaderElectorBuilder {
+	b.name = value
+	return b
+}
+
+// SetIdentity sets the identity that this instance will write to the lease when it is the leader. This is optional,
+// and the default is the host name followed by a random suffix.
+func (b *LeaderElectorBuilder) SetIdentity(value string) *LeaderElectorBuilder {
+	b.identity = value
+	return b
+}
+
+// SetLeaseDuration sets how long the standby instances will wait since the last renewal before trying to take over
+// the lease. This is optional, and the default is 15 seconds.
+func (b *LeaderElectorBuilder) SetLeaseDuration(value time.Duration) *LeaderElectorBuilder {
+	b.leaseDuration = value
+	return b
+}
+
+// SetRenewDeadline sets how long the leader will keep trying to renew the lease before giving up. This is optional,
+// and the default is 10 seconds.
+func (b *LeaderElectorBuilder) SetRenewDeadline(value time.Duration) *LeaderElectorBuilder {
+	b.renewDeadline = value
+	return b
+}
+
+// SetRetryPeriod sets how long the instances wait between attempts to acquire or renew the lease. This is optional,
+// and the default is two seconds.
+func (b *LeaderElectorBuilder) SetRetryPeriod(value time.Duration) *LeaderElectorBuilder {
+	b.retryPeriod = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new leader elector.
+func (b *LeaderElectorBuilder) Build() (result *LeaderElector, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.client == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.namespace == "" {
+		err = errors.New("namespace is mandatory")
+		return
+	}
+	if b.name == "" {
+		err = errors.New("name is mandatory")
+		return
+	}
+	if b.retryPeriod <= 0 {
+		err = fmt.Errorf("retry period should be positive, but it is %s", b.retryPeriod)
+		return
+	}
+	if float64(b.renewDeadline) <= leaderelection.JitterFactor*float64(b.retryPeriod) {
+		err = fmt.Errorf(
+			"renew deadline should be greater than %g times the retry period, but it is %s and the "+
+				"retry period is %s",
+			leaderelection.JitterFactor, b.renewDeadline, b.retryPeriod,
+		)
+		return
+	}
+	if b.leaseDuration <= b.renewDeadline {
+		err = fmt.Errorf(
+			"lease duration should be greater than the renew deadline, but it is %s and the renew "+
+				"deadline is %s",
+			b.leaseDuration, b.renewDeadline,
+		)
+		return
+	}
+
+	// Calculate the default identity:
+	identity := b.identity
+	if identity == "" {
+		var hostname string
+		hostname, err = os.Hostname()
+		if err != nil {
+			err = fmt.Errorf("failed to get host name: %w", err)
+			return
+		}
+		identity = fmt.Sprintf("%s_%s", hostname, uuid.NewString())
+	}
+
+	// Create and populate the object. Note that the callbacks of the configuration are set in the Run method.
+	result = &LeaderElector{
+		logger:   b.logger.With(slog.String("lease", b.name)),
+		name:     b.name,
+		identity: identity,
+		config: leaderelection.LeaderElectionConfig{
+			Lock: &resourcelock.LeaseLock{
+				LeaseMeta: metav1.ObjectMeta{
+					Namespace: b.namespace,
+					Name:      b.name,
+				},
+				Client: b.client.CoordinationV1(),
+				LockConfig: resourcelock.ResourceLockConfig{
+					Identity: identity,
+				},
+			},
+			Name:            b.name,
+			LeaseDuration:   b.leaseDuration,
+			RenewDeadline:   b.renewDeadline,
+			RetryPeriod:     b.retryPeriod,
+			ReleaseOnCancel: true,
+		},
+	}
+	return
+}
+
+// Run waits till this instance acquires the lease, and then calls the given function with a context that is cancelled
+// when the lease is lost. It returns when the context is cancelled, or when the lease is lost. In the later case it
+// waits till the function finishes and then returns ErrLeadershipLost. The caller should then exit, so that it doesn't
+// keep any state that may be stale now that another instance is the leader.
+func (e *LeaderElector) Run(ctx context.Context, function func(context.Context)) error {
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	leader := metrics.LeaderElectionLeader.WithLabelValues(e.name)
+	config := e.config
+	config.Callbacks = leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			close(started)
+			defer close(finished)
+			e.logger.InfoContext(
+				ctx,
+				"Started leading",
+				slog.String("identity", e.identity),
+			)
+			leader.Set(1)
+			defer leader.Set(0)
+			function(ctx)
+		},
+		OnStoppedLeading: func() {
+			e.logger.InfoContext(
+				ctx,
+				"Stopped leading",
+				slog.String("identity", e.identity),
+			)
+		},
+		OnNewLeader: func(identity string) {
+			if identity == e.identity {
+				return
+			}
+			e.logger.InfoContext(
+				ctx,
+				"New leader elected",
+				slog.String("identity", identity),
+			)
+		},
+	}
+	elector, err := leaderelection.NewLeaderElector(config)
+	if err != nil {
+		return err
+	}
+	e.logger.InfoContext(
+		ctx,
+		"Waiting for leadership",
+		slog.String("identity", e.identity),
+	)
+	elector.Run(ctx)
+
+	// If the function was started wait till it finishes:
+	select {
+	case <-started:
+		<-finished
+	default:
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return ErrLeadershipLost
+}
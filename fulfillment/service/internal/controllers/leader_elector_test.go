@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Leader elector", func() {
+	var (
+		ctx    context.Context
+		client *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+		client = fake.NewClientset()
+	})
+
+	// makeElector creates a leader elector for the test lease with the given identity and short durations, so that
+	// tests don't need to wait long.
+	makeElector := func(identity string) *LeaderElector {
+		elector, err := NewLeaderElector().
+			SetLogger(logger).
+			SetClient(client).
+			SetNamespace("my-ns").
+			SetName("my-lease").
+			SetIdentity(identity).
+			SetLeaseDuration(1 * time.Second).
+			SetRenewDeadline(500 * time.Millisecond).
+			SetRetryPeriod(100 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		return elector
+	}
+
+	It("Can't be created without a client", func() {
+		_, err := NewLeaderElector().
+			SetLogger(logger).
+			SetNamespace("my-ns").
+			SetName("my-lease").
+			Build()
+		Expect(err).To(MatchError("client is mandatory"))
+	})
+
+	It("Can't be created without a name", func() {
+		_, err := NewLeaderElector().
+			SetLogger(logger).
+			SetClient(client).
+			SetNamespace("my-ns").
+			Build()
+		Expect(err).To(MatchError("name is mandatory"))
+	})
+
+	It("Can't be created with a lease duration shorter than the renew deadline", func() {
+		_, err := NewLeaderElector().
+			SetLogger(logger).
+			SetClient(client).
+			SetNamespace("my-ns").
+			SetName("my-lease").
+			SetLeaseDuration(5 * time.Second).
+			SetRenewDeadline(10 * time.Second).
+			Build()
+		Expect(err).To(MatchError(ContainSubstring("lease duration should be greater than the renew deadline")))
+	})
+
+	It("Runs the function in only one instance, and the standby takes over when the leader stops", func() {
+		// Start the first instance and wait till it is running:
+		firstCtx, firstCancel := context.WithCancel(ctx)
+		defer firstCancel()
+		firstRunning := make(chan struct{})
+		firstDone := make(chan error, 1)
+		go func() {
+			firstDone <- makeElector("first").Run(firstCtx, func(ctx context.Context) {
+				close(firstRunning)
+				<-ctx.Done()
+			})
+		}()
+		Eventually(firstRunning).Should(BeClosed())
+
+		// Start the second instance, and check that it doesn't run while the first is the leader:
+		secondRunning := make(chan struct{})
+		secondDone := make(chan error, 1)
+		go func() {
+			secondDone <- makeElector("second").Run(ctx, func(ctx context.Context) {
+				close(secondRunning)
+				<-ctx.Done()
+			})
+		}()
+		Consistently(secondRunning, 1500*time.Millisecond).ShouldNot(BeClosed())
+
+		// Stop the first instance, and check that the second takes over:
+		firstCancel()
+		Eventually(firstDone).Should(Receive(MatchError(context.Canceled)))
+		Eventually(secondRunning).Should(BeClosed())
+	})
+
+	It("Stops the function and returns an error when the lease can't be renewed", func() {
+		// Start the instance and wait till it is running:
+		running := make(chan struct{})
+		stopped := make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- makeElector("first").Run(ctx, func(ctx context.Context) {
+				close(running)
+				<-ctx.Done()
+				close(stopped)
+			})
+		}()
+		Eventually(running).Should(BeClosed())
+
+		// Make the updates of the lease fail, so that it can't be renewed:
+		client.PrependReactor("update", "leases", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("my error")
+		})
+
+		// Check that the function is stopped and that the error is returned:
+		Eventually(done, 5*time.Second).Should(Receive(MatchError(ErrLeadershipLost)))
+		Expect(stopped).To(BeClosed())
+	})
+})
@@ -18,20 +18,43 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
-// ReconcilerFunction is a function that receives the current state of an object and reconciles it.
-type ReconcilerFunction[O dao.Object] func(ctx context.Context, object O) error
+// maxConflictAttempts is the maximum number of times that the reconciler function will be called for the same object
+// when it fails because the object was modified concurrently.
+const maxConflictAttempts = 5
+
+// ReconcilerResult contains the result of a reconciliation.
+type ReconcilerResult struct {
+	// RequeueAfter indicates that the object should be reconciled again after this time, even if it doesn't change.
+	// The zero value means that the object will be reconciled again only when it changes or during the next periodic
+	// synchronization.
+	RequeueAfter time.Duration
+}
+
+// ReconcilerFunction is a function that receives the current state of an object and reconciles it. If the function
+// returns an error the object will be reconciled again after a delay that grows exponentially with the number of
+// consecutive failures.
+type ReconcilerFunction[O dao.Object] func(ctx context.Context, object O) (result ReconcilerResult, err error)
 
 // ReconcilerBuilder contains the data and logic needed to create a controller. Don't create instances f this directly,
 // use the NewReconciler function instead.
@@ -43,7 +66,13 @@ type ReconcilerBuilder[O dao.Object] struct {
 	objectFilter  string
 	syncInterval  time.Duration
 	watchInterval time.Duration
+	statsInterval time.Duration
 	grpcClient    *grpc.ClientConn
+	workers       int
+	minDelay      time.Duration
+	maxDelay      time.Duration
+	registerer    prometheus.Registerer
+	shard         Shard
 }
 
 // Reconciler simplifies use of the API for clients.
@@ -56,13 +85,20 @@ type Reconciler[O dao.Object] struct {
 	lastSync      time.Time
 	watchInterval time.Duration
 	lastWatch     time.Time
+	statsInterval time.Duration
+	lastEvent     int64
 	grpcClient    *grpc.ClientConn
 	payloadField  protoreflect.FieldDescriptor
 	listMethod    string
 	listRequest   proto.Message
 	listResponse  proto.Message
-	objectChannel chan O
+	getMethod     string
+	getRequest    proto.Message
+	getResponse   proto.Message
+	queue         *WorkQueue[O]
+	workers       int
 	eventsClient  privatev1.EventsClient
+	shard         Shard
 }
 
 // NewReconciler creates a builder that can then be used to configure and create a controller.
@@ -70,6 +106,10 @@ func NewReconciler[O dao.Object]() *ReconcilerBuilder[O] {
 	return &ReconcilerBuilder[O]{
 		syncInterval:  1 * time.Hour,
 		watchInterval: 10 * time.Second,
+		statsInterval: 1 * time.Minute,
+		workers:       1,
+		minDelay:      1 * time.Second,
+		maxDelay:      5 * time.Minute,
 	}
 }
 
@@ -120,6 +160,48 @@ func (b *ReconcilerBuilder[O]) SetWatchInterval(value time.Duration) *Reconciler
 	return b
 }
 
+// SetStatsInterval sets how often the reconciler will write to the log the state of the work queue. Nothing is written
+// if the state didn't change since the last time. This is optional, and the default is one minute.
+func (b *ReconcilerBuilder[O]) SetStatsInterval(value time.Duration) *ReconcilerBuilder[O] {
+	b.statsInterval = value
+	return b
+}
+
+// SetWorkers sets the number of objects that will be reconciled in parallel. The same object is never reconciled by
+// two workers at the same time. This is optional, and the default is one.
+func (b *ReconcilerBuilder[O]) SetWorkers(value int) *ReconcilerBuilder[O] {
+	b.workers = value
+	return b
+}
+
+// SetMinRetryDelay sets how long the reconciler will wait before reconciling again an object after the first failure.
+// The delay is doubled after each consecutive failure. This is optional, and the default is one second.
+func (b *ReconcilerBuilder[O]) SetMinRetryDelay(value time.Duration) *ReconcilerBuilder[O] {
+	b.minDelay = value
+	return b
+}
+
+// SetMaxRetryDelay sets the maximum time that the reconciler will wait before reconciling again an object that failed.
+// This is optional, and the default is five minutes.
+func (b *ReconcilerBuilder[O]) SetMaxRetryDelay(value time.Duration) *ReconcilerBuilder[O] {
+	b.maxDelay = value
+	return b
+}
+
+// SetRegisterer sets the Prometheus registry where the reconciler will register the metrics that describe the state of
+// its work queue. This is optional, and by default those metrics aren't registered.
+func (b *ReconcilerBuilder[O]) SetRegisterer(value prometheus.Registerer) *ReconcilerBuilder[O] {
+	b.registerer = value
+	return b
+}
+
+// SetShard sets the shard that the reconciler is responsible for. Objects that don't belong to the shard are ignored.
+// This is optional, and by default all the objects are reconciled.
+func (b *ReconcilerBuilder[O]) SetShard(value Shard) *ReconcilerBuilder[O] {
+	b.shard = value
+	return b
+}
+
 // SetFlags sets the command line flags that should be used to configure the reconciler. This is optional.
 func (b *ReconcilerBuilder[O]) SetFlags(flags *pflag.FlagSet, name string) *ReconcilerBuilder[O] {
 	b.flags = flags
@@ -145,6 +227,18 @@ func (b *ReconcilerBuilder[O]) Build() (result *Reconciler[O], err error) {
 		err = fmt.Errorf("sync interval should be positive, but it is %s", b.syncInterval)
 		return
 	}
+	if b.workers <= 0 {
+		err = fmt.Errorf("number of workers should be positive, but it is %d", b.workers)
+		return
+	}
+	if b.statsInterval <= 0 {
+		err = fmt.Errorf("stats interval should be positive, but it is %s", b.statsInterval)
+		return
+	}
+	err = b.shard.Validate()
+	if err != nil {
+		return
+	}
 
 	// Find the field of the event payload that contains the type of objects supported by the reconciler:
 	payloadField, err := b.findPayloadField()
@@ -160,6 +254,13 @@ func (b *ReconcilerBuilder[O]) Build() (result *Reconciler[O], err error) {
 		return
 	}
 
+	// Find the method that will be used to fetch the latest version of an object when there is a conflict:
+	getMethod, getRequest, getResponse, err := b.findGetMethod()
+	if err != nil {
+		err = fmt.Errorf("failed to find get method: %w", err)
+		return
+	}
+
 	// Set the default event filter:
 	eventFilter := b.eventFilter
 	if eventFilter == "" {
@@ -173,6 +274,17 @@ func (b *ReconcilerBuilder[O]) Build() (result *Reconciler[O], err error) {
 		objectFilter = ""
 	}
 
+	// Create the work queue:
+	queue, err := NewWorkQueue[O]().
+		SetLogger(b.logger).
+		SetMinDelay(b.minDelay).
+		SetMaxDelay(b.maxDelay).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create work queue: %w", err)
+		return
+	}
+
 	// Create the events client:
 	eventsClient := privatev1.NewEventsClient(b.grpcClient)
 
@@ -184,13 +296,29 @@ func (b *ReconcilerBuilder[O]) Build() (result *Reconciler[O], err error) {
 		objectFilter:  b.objectFilter,
 		syncInterval:  b.syncInterval,
 		watchInterval: b.watchInterval,
+		statsInterval: b.statsInterval,
 		grpcClient:    b.grpcClient,
 		payloadField:  payloadField,
 		listMethod:    listMethod,
 		listRequest:   listRequest,
 		listResponse:  listResponse,
-		objectChannel: make(chan O),
+		getMethod:     getMethod,
+		getRequest:    getRequest,
+		getResponse:   getResponse,
+		queue:         queue,
+		workers:       b.workers,
 		eventsClient:  eventsClient,
+		shard:         b.shard,
+	}
+
+	// Register the metrics:
+	if b.registerer != nil {
+		err = result.registerMetrics(b.registerer)
+		if err != nil {
+			err = fmt.Errorf("failed to register metrics: %w", err)
+			result = nil
+			return
+		}
 	}
 	return
 }
@@ -222,13 +350,27 @@ func (b *ReconcilerBuilder[O]) findPayloadField() (result protoreflect.FieldDesc
 
 // findListMethod finds the method that will be used to list objects.
 func (b *ReconcilerBuilder[O]) findListMethod() (name string, request, response proto.Message, err error) {
+	name, request, response, err = b.findMethod("List", "items", true)
+	return
+}
+
+// findGetMethod finds the method that will be used to get objects.
+func (b *ReconcilerBuilder[O]) findGetMethod() (name string, request, response proto.Message, err error) {
+	name, request, response, err = b.findMethod("Get", "object", false)
+	return
+}
+
+// findMethod finds a method of the service that manages the supported type. The method is selected by name, and by
+// the name of the field of the response that contains the objects.
+func (b *ReconcilerBuilder[O]) findMethod(methodName protoreflect.Name, fieldName protoreflect.Name,
+	fieldList bool) (name string, request, response proto.Message, err error) {
 	// Determine the name of the package of the supported type:
 	var object O
 	objectDesc := object.ProtoReflect().Descriptor()
 	objectName := objectDesc.FullName()
 	objectPkg := objectName.Parent()
 
-	// Iterate over all the files, services and methods of the package to find list method:
+	// Iterate over all the files, services and methods of the package to find the method:
 	var methodDesc protoreflect.MethodDescriptor
 	protoregistry.GlobalFiles.RangeFilesByPackage(
 		objectPkg,
@@ -239,14 +381,14 @@ func (b *ReconcilerBuilder[O]) findListMethod() (name string, request, response
 				methodDescs := serviceDesc.Methods()
 				for j := range methodDescs.Len() {
 					currentDesc := methodDescs.Get(j)
-					if currentDesc.Name() != protoreflect.Name("List") {
+					if currentDesc.Name() != methodName {
 						continue
 					}
-					itemsDesc := currentDesc.Output().Fields().ByName("items")
-					if itemsDesc == nil || !itemsDesc.IsList() {
+					fieldDesc := currentDesc.Output().Fields().ByName(fieldName)
+					if fieldDesc == nil || fieldDesc.IsList() != fieldList || fieldDesc.Message() == nil {
 						continue
 					}
-					if itemsDesc.Message().FullName() != objectName {
+					if fieldDesc.Message().FullName() != objectName {
 						continue
 					}
 					methodDesc = currentDesc
@@ -257,7 +399,7 @@ func (b *ReconcilerBuilder[O]) findListMethod() (name string, request, response
 		},
 	)
 	if methodDesc == nil {
-		err = fmt.Errorf("failed to find list method for type '%T", object)
+		err = fmt.Errorf("failed to find %s method for type '%T", methodName, object)
 		return
 	}
 
@@ -280,33 +422,209 @@ func (b *ReconcilerBuilder[O]) findListMethod() (name string, request, response
 	return
 }
 
-// Start starts the controller. To stop it cancel the context.
+// Start starts the controller. To stop it cancel the context. It returns when all the workers have finished.
 func (c *Reconciler[O]) Start(ctx context.Context) error {
-	// Start the watch and sync loops:
-	go c.watchLoop(ctx)
-	go c.syncLoop(ctx)
+	wg := &sync.WaitGroup{}
+	run := func(loop func(context.Context)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			loop(ctx)
+		}()
+	}
+
+	// Start the watch, sync and stats loops:
+	run(c.watchLoop)
+	run(c.syncLoop)
+	run(c.statsLoop)
+
+	// Start the workers:
+	for range c.workers {
+		run(c.workLoop)
+	}
+
+	// Wait till the context is cancelled, then stop the queue and wait till the workers finish:
+	<-ctx.Done()
+	c.queue.Close()
+	wg.Wait()
+	return context.Canceled
+}
+
+// Stats returns information about the current state of the work queue of the reconciler.
+func (c *Reconciler[O]) Stats() WorkQueueStats {
+	return c.queue.Stats()
+}
+
+// registerMetrics registers the metrics that contain the number of objects of the work queue in each state. The values
+// are calculated when the metrics are collected.
+func (c *Reconciler[O]) registerMetrics(registerer prometheus.Registerer) error {
+	states := map[string]func(WorkQueueStats) int{
+		"ready": func(stats WorkQueueStats) int {
+			return stats.Ready
+		},
+		"waiting": func(stats WorkQueueStats) int {
+			return stats.Waiting
+		},
+		"processing": func(stats WorkQueueStats) int {
+			return stats.Processing
+		},
+	}
+	for state, value := range states {
+		gauge := prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: "reconciler",
+				Name:      "queue_objects",
+				Help:      "Number of objects in the work queue of the reconciler, by object type and state.",
+				ConstLabels: prometheus.Labels{
+					"type":  c.typeName(),
+					"state": state,
+				},
+			},
+			func() float64 {
+				return float64(value(c.Stats()))
+			},
+		)
+		err := registerer.Register(gauge)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// Run the reconcile loop:
+// typeName returns the name of the type of objects supported by the reconciler, for example `cluster`. This is used in
+// log messages and in metrics labels.
+func (c *Reconciler[O]) typeName() string {
+	return string(c.payloadField.Name())
+}
+
+// statsLoop periodically writes to the log the state of the work queue, if it changed since the last time.
+func (c *Reconciler[O]) statsLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.statsInterval)
+	defer ticker.Stop()
+	var last WorkQueueStats
 	for {
 		select {
 		case <-ctx.Done():
-			return context.Canceled
-		case object := <-c.objectChannel:
+			return
+		case <-ticker.C:
+		}
+		stats := c.Stats()
+		if stats == last {
+			continue
+		}
+		last = stats
+		c.logger.InfoContext(
+			ctx,
+			"Work queue stats",
+			slog.String("type", c.typeName()),
+			slog.Int("ready", stats.Ready),
+			slog.Int("waiting", stats.Waiting),
+			slog.Int("processing", stats.Processing),
+			slog.Int("retries", stats.Retries),
+		)
+	}
+}
+
+// workLoop takes objects from the queue and reconciles them till the queue is closed.
+func (c *Reconciler[O]) workLoop(ctx context.Context) {
+	for {
+		object, ok := c.queue.Get(ctx)
+		if !ok {
+			return
+		}
+		id := object.GetId()
+
+		// Each reconciliation is a new trace, linked to the traces of the requests that caused the events that
+		// added the object to the queue:
+		spanCtx, span := tracing.Tracer().Start(
+			ctx,
+			"reconcile "+c.typeName(),
+			trace.WithNewRoot(),
+			trace.WithLinks(c.queue.Links(id)...),
+			trace.WithAttributes(
+				attribute.String("object.type", c.typeName()),
+				attribute.String("object.id", id),
+			),
+		)
+		result, err := c.reconcile(spanCtx, object)
+		metrics.ReconcilerReconciliations.WithLabelValues(c.typeName()).Inc()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			metrics.ReconcilerErrors.WithLabelValues(c.typeName()).Inc()
+			c.logger.ErrorContext(
+				spanCtx,
+				"Reconciliation failed",
+				slog.String("id", id),
+				slog.Int("failures", c.queue.Failures(id)+1),
+				slog.Any("error", err),
+			)
+		}
+		span.End()
+		c.queue.Done(id, result, err)
+	}
+}
+
+// reconcile calls the reconciler function for the given object. If the function fails because the object was
+// modified concurrently it fetches the latest version of the object and tries again.
+func (c *Reconciler[O]) reconcile(ctx context.Context, object O) (result ReconcilerResult, err error) {
+	id := object.GetId()
+	for attempt := 1; ; attempt++ {
+		c.logger.DebugContext(
+			ctx,
+			"Reconciling object",
+			slog.Any("object", object),
+			slog.Int("attempt", attempt),
+		)
+		result, err = c.function(ctx, object)
+		if err == nil {
+			return
+		}
+		if grpcstatus.Code(err) != grpccodes.Aborted || attempt >= maxConflictAttempts {
+			return
+		}
+		c.logger.DebugContext(
+			ctx,
+			"Object was modified concurrently, will fetch it again and retry",
+			slog.String("id", id),
+			slog.Int("attempt", attempt),
+		)
+		object, err = c.getObject(ctx, id)
+		if grpcstatus.Code(err) == grpccodes.NotFound {
 			c.logger.DebugContext(
 				ctx,
-				"Reconciling object",
-				slog.Any("object", object),
+				"Object no longer exists",
+				slog.String("id", id),
 			)
-			err := c.function(ctx, object)
-			if err != nil {
-				c.logger.ErrorContext(
-					ctx,
-					"Reconciliation failed",
-					slog.Any("error", err),
-				)
-			}
+			err = nil
+			return
 		}
+		if err != nil {
+			err = fmt.Errorf("failed to fetch object: %w", err)
+			return
+		}
+	}
+}
+
+// getObject fetches the latest version of the object with the given identifier.
+func (c *Reconciler[O]) getObject(ctx context.Context, id string) (result O, err error) {
+	type requestIface interface {
+		SetId(string)
+	}
+	type responseIface interface {
+		GetObject() O
 	}
+	requestMsg := proto.Clone(c.getRequest).(requestIface)
+	requestMsg.SetId(id)
+	responseMsg := proto.Clone(c.getResponse).(responseIface)
+	err = c.grpcClient.Invoke(ctx, c.getMethod, requestMsg, responseMsg)
+	if err != nil {
+		return
+	}
+	result = responseMsg.GetObject()
+	return
 }
 
 func (c *Reconciler[O]) watchLoop(ctx context.Context) {
@@ -337,27 +655,67 @@ func (c *Reconciler[O]) watchLoop(ctx context.Context) {
 	}
 }
 
+// watchEvents watches the events and adds the changed objects to the queue. When reconnecting it asks the server to
+// resume from the last event received, so that events that happened while disconnected aren't lost. If the server no
+// longer has those events it starts watching again from the current position and does a full sync.
 func (c *Reconciler[O]) watchEvents(ctx context.Context) error {
-	stream, err := c.eventsClient.Watch(ctx, &privatev1.EventsWatchRequest{
-		Filter: &c.eventFilter,
-	})
+	stream, err := c.startWatch(ctx)
 	if err != nil {
 		return err
 	}
 	for {
 		response, err := stream.Recv()
+		if grpcstatus.Code(err) == grpccodes.OutOfRange {
+			c.logger.InfoContext(
+				ctx,
+				"Events are no longer available, will trigger a full sync",
+				slog.Int64("since", c.lastEvent),
+			)
+
+			// Note that we start watching again before the sync, so that changes that happen while the sync is
+			// in progress aren't lost.
+			c.lastEvent = 0
+			stream, err = c.startWatch(ctx)
+			if err != nil {
+				return err
+			}
+			err = c.syncObjects(ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			return err
 		}
-		event := response.Event.ProtoReflect()
+
+		// The server periodically sends bookmarks with the position of the last event that it processed, even if
+		// it wasn't sent because of the filter. Saving it means that we don't need to replay those events when
+		// reconnecting.
+		if response.HasBookmark() {
+			c.lastEvent = max(c.lastEvent, response.GetBookmark())
+		}
+		if !response.HasEvent() {
+			continue
+		}
+		c.lastEvent = max(c.lastEvent, response.GetEvent().GetSequence())
+		event := response.GetEvent().ProtoReflect()
 		if event.Has(c.payloadField) {
 			object := event.Get(c.payloadField).Message().Interface().(O)
+			if !c.shard.Contains(object.GetId()) {
+				continue
+			}
 			c.logger.DebugContext(
 				ctx,
 				"Enqueueing object",
 				slog.Any("object", object),
 			)
-			c.objectChannel <- object
+			link, ok := tracing.Link(response.GetEvent().GetTraceContext())
+			if ok {
+				c.queue.Add(object, link)
+			} else {
+				c.queue.Add(object)
+			}
 		} else {
 			c.logger.DebugContext(
 				ctx,
@@ -371,6 +729,20 @@ func (c *Reconciler[O]) watchEvents(ctx context.Context) error {
 	}
 }
 
+// startWatch starts watching events, from the last event received if there is one.
+func (c *Reconciler[O]) startWatch(
+	ctx context.Context) (result grpc.ServerStreamingClient[privatev1.EventsWatchResponse], err error) {
+	request := privatev1.EventsWatchRequest_builder{
+		Filter:    proto.String(c.eventFilter),
+		Bookmarks: proto.Bool(true),
+	}.Build()
+	if c.lastEvent > 0 {
+		request.SetSince(c.lastEvent)
+	}
+	result, err = c.eventsClient.Watch(ctx, request)
+	return
+}
+
 func (c *Reconciler[O]) syncLoop(ctx context.Context) {
 	for {
 		err := c.syncObjects(ctx)
@@ -399,23 +771,41 @@ func (c *Reconciler[O]) syncLoop(ctx context.Context) {
 	}
 }
 
+// syncObjects lists all the objects and adds them to the queue. The list is retrieved page by page using the page
+// tokens returned by the server, and without calculating the total, as that isn't needed and is expensive for large
+// collections.
 func (c *Reconciler[O]) syncObjects(ctx context.Context) error {
 	type requestIface interface {
+		SetPageToken(string)
+		SetSkipTotal(bool)
 	}
 	type responseIface interface {
 		GetItems() []O
+		GetNextPageToken() string
 	}
-	requestMsg := proto.Clone(c.listRequest).(requestIface)
-	responseMsg := proto.Clone(c.listResponse).(responseIface)
-	err := c.grpcClient.Invoke(ctx, c.listMethod, requestMsg, responseMsg)
-	if err != nil {
-		return err
-	}
-	items := responseMsg.GetItems()
-	for _, item := range items {
-		c.objectChannel <- item
+	pageToken := ""
+	for {
+		requestMsg := proto.Clone(c.listRequest).(requestIface)
+		requestMsg.SetSkipTotal(true)
+		if pageToken != "" {
+			requestMsg.SetPageToken(pageToken)
+		}
+		responseMsg := proto.Clone(c.listResponse).(responseIface)
+		err := c.grpcClient.Invoke(ctx, c.listMethod, requestMsg, responseMsg)
+		if err != nil {
+			return err
+		}
+		items := responseMsg.GetItems()
+		for _, item := range items {
+			if c.shard.Contains(item.GetId()) {
+				c.queue.Add(item)
+			}
+		}
+		pageToken = responseMsg.GetNextPageToken()
+		if pageToken == "" {
+			return nil
+		}
 	}
-	return nil
 }
 
 // sleepAfterWatch waits till the watch interval passes, or till the context is cancelled.
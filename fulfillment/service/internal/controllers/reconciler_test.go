@@ -0,0 +1,476 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
+)
+
+// fakeClustersServer is an implementation of the clusters server that keeps the clusters in memory. The page tokens
+// are the index of the first item of the page.
+type fakeClustersServer struct {
+	privatev1.UnimplementedClustersServer
+	lock     sync.Mutex
+	clusters []*privatev1.Cluster
+	requests []*privatev1.ClustersListRequest
+}
+
+func (s *fakeClustersServer) List(ctx context.Context,
+	request *privatev1.ClustersListRequest) (response *privatev1.ClustersListResponse, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requests = append(s.requests, request)
+	start := 0
+	if request.HasPageToken() {
+		start, err = strconv.Atoi(request.GetPageToken())
+		if err != nil {
+			return
+		}
+	}
+	limit := int(request.GetLimit())
+	if limit <= 0 {
+		limit = 3
+	}
+	end := min(start+limit, len(s.clusters))
+	items := s.clusters[start:end]
+	response = privatev1.ClustersListResponse_builder{
+		Size:  proto.Int32(int32(len(items))),
+		Items: items,
+	}.Build()
+	if end < len(s.clusters) {
+		response.SetNextPageToken(strconv.Itoa(end))
+	}
+	return
+}
+
+func (s *fakeClustersServer) Get(ctx context.Context,
+	request *privatev1.ClustersGetRequest) (response *privatev1.ClustersGetResponse, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, cluster := range s.clusters {
+		if cluster.GetId() == request.GetId() {
+			response = privatev1.ClustersGetResponse_builder{
+				Object: cluster,
+			}.Build()
+			return
+		}
+	}
+	err = grpcstatus.Errorf(grpccodes.NotFound, "cluster '%s' doesn't exist", request.GetId())
+	return
+}
+
+// fakeEventsServer is an implementation of the events server that sends the responses written to a channel, and
+// that finishes the watch with the errors written to another channel.
+type fakeEventsServer struct {
+	privatev1.UnimplementedEventsServer
+	lock      sync.Mutex
+	requests  []*privatev1.EventsWatchRequest
+	responses chan *privatev1.EventsWatchResponse
+	errors    chan error
+}
+
+func (s *fakeEventsServer) Watch(request *privatev1.EventsWatchRequest,
+	stream grpc.ServerStreamingServer[privatev1.EventsWatchResponse]) error {
+	s.lock.Lock()
+	s.requests = append(s.requests, request)
+	s.lock.Unlock()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case response := <-s.responses:
+			err := stream.Send(response)
+			if err != nil {
+				return err
+			}
+		case err := <-s.errors:
+			return err
+		}
+	}
+}
+
+func (s *fakeEventsServer) Requests() []*privatev1.EventsWatchRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return slices.Clone(s.requests)
+}
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx            context.Context
+		clustersServer *fakeClustersServer
+		eventsServer   *fakeEventsServer
+		conn           *grpc.ClientConn
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+
+		// Start the server:
+		listener := bufconn.Listen(1024 * 1024)
+		server := grpc.NewServer()
+		DeferCleanup(server.Stop)
+		clustersServer = &fakeClustersServer{}
+		eventsServer = &fakeEventsServer{
+			responses: make(chan *privatev1.EventsWatchResponse),
+			errors:    make(chan error),
+		}
+		privatev1.RegisterClustersServer(server, clustersServer)
+		privatev1.RegisterEventsServer(server, eventsServer)
+		go server.Serve(listener)
+
+		// Create the client:
+		var err error
+		conn, err = grpc.NewClient(
+			"passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+	})
+
+	makeClusters := func(count int) {
+		for i := range count {
+			clustersServer.clusters = append(clustersServer.clusters, privatev1.Cluster_builder{
+				Id: fmt.Sprintf("cluster-%d", i),
+				Metadata: privatev1.Metadata_builder{
+					Version: 1,
+				}.Build(),
+			}.Build())
+		}
+	}
+
+	It("Synchronizes all the objects page by page", func() {
+		makeClusters(10)
+		seen := &sync.Map{}
+		reconciler, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				seen.Store(object.GetId(), true)
+				return
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		runCtx, runCancel := context.WithCancel(ctx)
+		defer runCancel()
+		go reconciler.Start(runCtx)
+		Eventually(func() int {
+			count := 0
+			seen.Range(func(_, _ any) bool {
+				count++
+				return true
+			})
+			return count
+		}).Should(Equal(10))
+		clustersServer.lock.Lock()
+		defer clustersServer.lock.Unlock()
+		Expect(len(clustersServer.requests)).To(BeNumerically(">=", 4))
+		for _, request := range clustersServer.requests {
+			Expect(request.GetSkipTotal()).To(BeTrue())
+		}
+	})
+
+	It("Only reconciles the objects of its shard", func() {
+		makeClusters(20)
+		seen := &sync.Map{}
+		runCtx, runCancel := context.WithCancel(ctx)
+		defer runCancel()
+		for index := range 2 {
+			reconciler, err := NewReconciler[*privatev1.Cluster]().
+				SetLogger(logger).
+				SetClient(conn).
+				SetShard(Shard{
+					Index: index,
+					Count: 2,
+				}).
+				SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult,
+					err error) {
+					previous, loaded := seen.LoadOrStore(object.GetId(), index)
+					if loaded && previous != index {
+						err = fmt.Errorf(
+							"object '%s' reconciled by shards %d and %d",
+							object.GetId(), previous, index,
+						)
+					}
+					return
+				}).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			go reconciler.Start(runCtx)
+		}
+		Eventually(func() int {
+			count := 0
+			seen.Range(func(_, _ any) bool {
+				count++
+				return true
+			})
+			return count
+		}).Should(Equal(20))
+		seen.Range(func(key, value any) bool {
+			shard := Shard{
+				Index: value.(int),
+				Count: 2,
+			}
+			Expect(shard.Contains(key.(string))).To(BeTrue())
+			return true
+		})
+	})
+
+	It("Can't be created with an invalid shard", func() {
+		_, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				return
+			}).
+			SetShard(Shard{
+				Index: 2,
+				Count: 2,
+			}).
+			Build()
+		Expect(err).To(MatchError("shard index should be between zero and 1, but it is 2"))
+	})
+
+	It("Waits for the workers to finish when stopped", func() {
+		makeClusters(1)
+		once := &sync.Once{}
+		started := make(chan struct{})
+		release := make(chan struct{})
+		finished := make(chan struct{})
+		reconciler, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				once.Do(func() {
+					close(started)
+					<-release
+					close(finished)
+				})
+				return
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		runCtx, runCancel := context.WithCancel(ctx)
+		stopped := make(chan error)
+		go func() {
+			stopped <- reconciler.Start(runCtx)
+		}()
+		Eventually(started).Should(BeClosed())
+		runCancel()
+		Consistently(stopped, 100*time.Millisecond).ShouldNot(Receive())
+		close(release)
+		Eventually(stopped).Should(Receive(MatchError(context.Canceled)))
+		Expect(finished).To(BeClosed())
+	})
+
+	It("Exposes the state of the work queue", func() {
+		makeClusters(1)
+		once := &sync.Once{}
+		started := make(chan struct{})
+		release := make(chan struct{})
+		reconciler, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				once.Do(func() {
+					close(started)
+					<-release
+				})
+				return
+			}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		runCtx, runCancel := context.WithCancel(ctx)
+		defer runCancel()
+		go reconciler.Start(runCtx)
+		Eventually(started).Should(BeClosed())
+		Expect(reconciler.Stats().Processing).To(Equal(1))
+		close(release)
+		Eventually(func() int {
+			return reconciler.Stats().Processing
+		}).Should(BeZero())
+	})
+
+	It("Updates the metrics", func() {
+		makeClusters(1)
+		registry := prometheus.NewRegistry()
+		reconciler, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				err = errors.New("my-error")
+				return
+			}).
+			SetMinRetryDelay(time.Hour).
+			SetMaxRetryDelay(time.Hour).
+			SetRegisterer(registry).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		before := testutil.ToFloat64(metrics.ReconcilerErrors.WithLabelValues("cluster"))
+		runCtx, runCancel := context.WithCancel(ctx)
+		defer runCancel()
+		go reconciler.Start(runCtx)
+		Eventually(func() float64 {
+			return testutil.ToFloat64(metrics.ReconcilerErrors.WithLabelValues("cluster"))
+		}).Should(BeNumerically(">", before))
+		Eventually(func() error {
+			return testutil.GatherAndCompare(
+				registry,
+				strings.NewReader(
+					"# HELP fulfillment_reconciler_queue_objects Number of objects in the work queue "+
+						"of the reconciler, by object type and state.\n"+
+						"# TYPE fulfillment_reconciler_queue_objects gauge\n"+
+						"fulfillment_reconciler_queue_objects{state=\"processing\",type=\"cluster\"} 0\n"+
+						"fulfillment_reconciler_queue_objects{state=\"ready\",type=\"cluster\"} 0\n"+
+						"fulfillment_reconciler_queue_objects{state=\"waiting\",type=\"cluster\"} 1\n",
+				),
+			)
+		}).Should(Succeed())
+	})
+
+	It("Can't be created with a stats interval that isn't positive", func() {
+		reconciler, err := NewReconciler[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetClient(conn).
+			SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+				return
+			}).
+			SetStatsInterval(0).
+			Build()
+		Expect(err).To(MatchError(ContainSubstring("stats interval should be positive")))
+		Expect(reconciler).To(BeNil())
+	})
+
+	Describe("Watch", func() {
+		var reconciler *Reconciler[*privatev1.Cluster]
+
+		BeforeEach(func() {
+			makeClusters(1)
+			var err error
+			reconciler, err = NewReconciler[*privatev1.Cluster]().
+				SetLogger(logger).
+				SetClient(conn).
+				SetFunction(func(ctx context.Context, object *privatev1.Cluster) (result ReconcilerResult, err error) {
+					return
+				}).
+				SetWatchInterval(10 * time.Millisecond).
+				SetSyncInterval(time.Hour).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			runCtx, runCancel := context.WithCancel(ctx)
+			DeferCleanup(runCancel)
+			go reconciler.Start(runCtx)
+		})
+
+		// event creates a response containing an event for the cluster with the given sequence number.
+		event := func(seq int64) *privatev1.EventsWatchResponse {
+			return privatev1.EventsWatchResponse_builder{
+				Event: privatev1.Event_builder{
+					Id:       fmt.Sprintf("event-%d", seq),
+					Type:     privatev1.EventType_EVENT_TYPE_OBJECT_UPDATED,
+					Sequence: seq,
+					Cluster:  clustersServer.clusters[0],
+				}.Build(),
+			}.Build()
+		}
+
+		// bookmark creates a response containing only a bookmark.
+		bookmark := func(seq int64) *privatev1.EventsWatchResponse {
+			return privatev1.EventsWatchResponse_builder{
+				Bookmark: proto.Int64(seq),
+			}.Build()
+		}
+
+		// disconnect finishes the current watch with an error that isn't related to the position.
+		disconnect := func() {
+			eventsServer.errors <- grpcstatus.Error(grpccodes.Unavailable, "disconnected")
+		}
+
+		It("Requests bookmarks and doesn't send a position initially", func() {
+			Eventually(eventsServer.Requests).Should(HaveLen(1))
+			request := eventsServer.Requests()[0]
+			Expect(request.GetBookmarks()).To(BeTrue())
+			Expect(request.HasSince()).To(BeFalse())
+		})
+
+		It("Resumes from the last event received", func() {
+			eventsServer.responses <- event(3)
+			eventsServer.responses <- event(5)
+			disconnect()
+			Eventually(eventsServer.Requests).Should(HaveLen(2))
+			request := eventsServer.Requests()[1]
+			Expect(request.HasSince()).To(BeTrue())
+			Expect(request.GetSince()).To(BeNumerically("==", 5))
+		})
+
+		It("Resumes from the last bookmark received", func() {
+			eventsServer.responses <- event(3)
+			eventsServer.responses <- bookmark(7)
+			disconnect()
+			Eventually(eventsServer.Requests).Should(HaveLen(2))
+			request := eventsServer.Requests()[1]
+			Expect(request.GetSince()).To(BeNumerically("==", 7))
+		})
+
+		It("Watches from the current position and synchronizes when events are no longer available", func() {
+			// Wait for the initial synchronizations, so that we can then check that another one happens:
+			listCount := func() int {
+				clustersServer.lock.Lock()
+				defer clustersServer.lock.Unlock()
+				return len(clustersServer.requests)
+			}
+			Eventually(listCount).Should(Equal(2))
+
+			// Receive an event and then disconnect, so that the reconciler tries to resume:
+			eventsServer.responses <- event(3)
+			disconnect()
+			Eventually(eventsServer.Requests).Should(HaveLen(2))
+			Expect(eventsServer.Requests()[1].GetSince()).To(BeNumerically("==", 3))
+
+			// Tell the reconciler that the events are no longer available:
+			eventsServer.errors <- grpcstatus.Error(grpccodes.OutOfRange, "pruned")
+			Eventually(eventsServer.Requests).Should(HaveLen(3))
+			Expect(eventsServer.Requests()[2].HasSince()).To(BeFalse())
+			Eventually(listCount).Should(Equal(3))
+		})
+	})
+})
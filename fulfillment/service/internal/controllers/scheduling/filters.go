@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
+)
+
+// HealthFilter rejects the hubs that are cordoned or that the hub health checker found unhealthy. Hubs that haven't
+// been checked yet are accepted.
+type HealthFilter struct{}
+
+func (f HealthFilter) Name() string {
+	return "health"
+}
+
+func (f HealthFilter) Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool,
+	reason string, err error) {
+	accepted, reason = HubAvailable(candidate.Hub)
+	return
+}
+
+// HubAvailable checks if new clusters or virtual machines can be placed in the given hub. When they can't it also
+// returns a human readable explanation of the reason.
+func HubAvailable(hub *privatev1.Hub) (available bool, reason string) {
+	if hub.GetCordoned() {
+		reason = "is cordoned"
+		return
+	}
+	for _, condition := range hub.GetStatus().GetConditions() {
+		if condition.GetType() != privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY {
+			continue
+		}
+		if condition.GetStatus() == sharedv1.ConditionStatus_CONDITION_STATUS_FALSE {
+			reason = "is unhealthy"
+			if condition.GetMessage() != "" {
+				reason = fmt.Sprintf("is unhealthy: %s", condition.GetMessage())
+			}
+			return
+		}
+	}
+	available = true
+	return
+}
+
+// ZoneFilter rejects the hubs that aren't in the zone requested by the cluster. Clusters that don't request a zone can
+// be placed in any hub.
+type ZoneFilter struct{}
+
+func (f ZoneFilter) Name() string {
+	return "zone"
+}
+
+func (f ZoneFilter) Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool, reason string,
+	err error) {
+	zone := request.Cluster.GetSpec().GetZone()
+	if zone == "" || candidate.Hub.GetZone() == zone {
+		accepted = true
+		return
+	}
+	reason = fmt.Sprintf("is in zone '%s' but the cluster requires zone '%s'", candidate.Hub.GetZone(), zone)
+	return
+}
+
+// SelectorFilter rejects the hubs that don't have all the labels of the hub selector of the cluster.
+type SelectorFilter struct{}
+
+func (f SelectorFilter) Name() string {
+	return "selector"
+}
+
+func (f SelectorFilter) Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool,
+	reason string, err error) {
+	selector := request.Cluster.GetSpec().GetHubSelector()
+	labels := candidate.Hub.GetLabels()
+	var mismatches []string
+	for key, value := range selector {
+		actual, ok := labels[key]
+		if !ok || actual != value {
+			mismatches = append(mismatches, fmt.Sprintf("%s=%s", key, value))
+		}
+	}
+	if len(mismatches) == 0 {
+		accepted = true
+		return
+	}
+	sort.Strings(mismatches)
+	reason = fmt.Sprintf("doesn't have labels %s", strings.Join(mismatches, ", "))
+	return
+}
+
+// CapacityFilter rejects the hubs that already have the maximum number of clusters.
+type CapacityFilter struct{}
+
+func (f CapacityFilter) Name() string {
+	return "capacity"
+}
+
+func (f CapacityFilter) Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool,
+	reason string, err error) {
+	limit := int(candidate.Hub.GetMaxClusters())
+	if limit <= 0 || candidate.Clusters < limit {
+		accepted = true
+		return
+	}
+	reason = fmt.Sprintf("has reached its maximum of %d clusters", limit)
+	return
+}
+
+// HostClassesFilter rejects the hubs that don't support all the host classes used by the node sets of the cluster.
+// Hubs that don't list their host classes are assumed to support all of them.
+type HostClassesFilter struct{}
+
+func (f HostClassesFilter) Name() string {
+	return "host-classes"
+}
+
+func (f HostClassesFilter) Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool,
+	reason string, err error) {
+	available := candidate.Hub.GetHostClasses()
+	if len(available) == 0 {
+		accepted = true
+		return
+	}
+	var missing []string
+	for _, nodeSet := range request.Cluster.GetSpec().GetNodeSets() {
+		hostClass := nodeSet.GetHostClass()
+		if !slices.Contains(available, hostClass) && !slices.Contains(missing, hostClass) {
+			missing = append(missing, hostClass)
+		}
+	}
+	if len(missing) == 0 {
+		accepted = true
+		return
+	}
+	sort.Strings(missing)
+	reason = fmt.Sprintf("doesn't support host classes '%s'", strings.Join(missing, "', '"))
+	return
+}
@@ -0,0 +1,294 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+// Request contains the details of the cluster that needs to be placed in a hub.
+type Request struct {
+	Cluster *privatev1.Cluster
+}
+
+// Candidate contains a hub that could receive the cluster, together with the information about its current load.
+type Candidate struct {
+	// Hub is the hub itself.
+	Hub *privatev1.Hub
+
+	// Clusters is the number of clusters that are already placed in the hub.
+	Clusters int
+}
+
+// Filter decides if a candidate hub can receive a cluster. When the hub is rejected the filter should return a human
+// readable explanation of the reason.
+type Filter interface {
+	// Name returns the name of the filter, used in log messages.
+	Name() string
+
+	// Filter checks if the candidate can receive the cluster.
+	Filter(ctx context.Context, request *Request, candidate *Candidate) (accepted bool, reason string, err error)
+}
+
+// Scorer calculates how good a candidate hub is for a cluster. Scores should be between zero and one, with higher values
+// meaning better candidates.
+type Scorer interface {
+	// Name returns the name of the scorer, used in log messages.
+	Name() string
+
+	// Score calculates the score of the candidate.
+	Score(ctx context.Context, request *Request, candidate *Candidate) (score float64, err error)
+}
+
+// Result contains the hub selected by the scheduler and the explanation of why it was selected.
+type Result struct {
+	Hub    *privatev1.Hub
+	Score  float64
+	Reason string
+}
+
+// SchedulerBuilder contains the data and logic needed to create a scheduler. Don't create instances of this directly,
+// use the NewScheduler function instead.
+type SchedulerBuilder struct {
+	logger  *slog.Logger
+	filters []Filter
+	scorers []weightedScorer
+}
+
+// Scheduler selects the hub where a cluster will be placed. It first discards the hubs that can't receive the cluster,
+// using the configured filters, and then selects the candidate with the highest weighted score. Ties are resolved
+// choosing the hub with less clusters, and then the one with the lowest identifier, so that results are predictable.
+type Scheduler struct {
+	logger  *slog.Logger
+	filters []Filter
+	scorers []weightedScorer
+}
+
+type weightedScorer struct {
+	scorer Scorer
+	weight float64
+}
+
+type scoredCandidate struct {
+	candidate *Candidate
+	score     float64
+}
+
+// NewScheduler creates a builder that can then be used to configure and create a scheduler.
+func NewScheduler() *SchedulerBuilder {
+	return &SchedulerBuilder{}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *SchedulerBuilder) SetLogger(value *slog.Logger) *SchedulerBuilder {
+	b.logger = value
+	return b
+}
+
+// AddFilter adds a filter. Filters are applied in the order they are added.
+func (b *SchedulerBuilder) AddFilter(value Filter) *SchedulerBuilder {
+	b.filters = append(b.filters, value)
+	return b
+}
+
+// AddScorer adds a scorer with the given weight. The total score of a candidate is the sum of the scores calculated by
+// each scorer multiplied by its weight.
+func (b *SchedulerBuilder) AddScorer(value Scorer, weight float64) *SchedulerBuilder {
+	b.scorers = append(b.scorers, weightedScorer{
+		scorer: value,
+		weight: weight,
+	})
+	return b
+}
+
+// AddDefaults adds the default filters and scorers: the health, zone, hub selector, capacity and host classes filters,
+// and the load scorer.
+func (b *SchedulerBuilder) AddDefaults() *SchedulerBuilder {
+	return b.
+		AddFilter(HealthFilter{}).
+		AddFilter(ZoneFilter{}).
+		AddFilter(SelectorFilter{}).
+		AddFilter(CapacityFilter{}).
+		AddFilter(HostClassesFilter{}).
+		AddScorer(LoadScorer{}, 1)
+}
+
+// Build uses the data stored in the builder to create a new scheduler.
+func (b *SchedulerBuilder) Build() (result *Scheduler, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	for _, filter := range b.filters {
+		if filter == nil {
+			err = errors.New("filters can't be nil")
+			return
+		}
+	}
+	for _, scorer := range b.scorers {
+		if scorer.scorer == nil {
+			err = errors.New("scorers can't be nil")
+			return
+		}
+		if scorer.weight < 0 {
+			err = fmt.Errorf(
+				"weight of scorer '%s' should be zero or positive, but it is %f",
+				scorer.scorer.Name(), scorer.weight,
+			)
+			return
+		}
+	}
+
+	// Create and populate the object:
+	result = &Scheduler{
+		logger:  b.logger,
+		filters: b.filters,
+		scorers: b.scorers,
+	}
+	return
+}
+
+// Schedule selects the best hub for the cluster from the given candidates. It returns an error if there are no
+// candidates or if none of them can receive the cluster.
+func (s *Scheduler) Schedule(ctx context.Context, request *Request, candidates []*Candidate) (result *Result,
+	err error) {
+	if len(candidates) == 0 {
+		err = errors.New("there are no hubs")
+		return
+	}
+
+	// Discard the candidates that can't receive the cluster:
+	var accepted []*Candidate
+	var rejections []string
+	for _, candidate := range candidates {
+		var reason string
+		reason, err = s.filter(ctx, request, candidate)
+		if err != nil {
+			return
+		}
+		if reason != "" {
+			rejections = append(rejections, reason)
+			continue
+		}
+		accepted = append(accepted, candidate)
+	}
+	if len(accepted) == 0 {
+		err = fmt.Errorf("none of the %d hubs can receive the cluster: %s", len(candidates), strings.Join(rejections, "; "))
+		return
+	}
+
+	// Calculate the scores and select the best candidate:
+	var best *scoredCandidate
+	for _, candidate := range accepted {
+		var score float64
+		score, err = s.score(ctx, request, candidate)
+		if err != nil {
+			return
+		}
+		current := &scoredCandidate{
+			candidate: candidate,
+			score:     score,
+		}
+		if best == nil || s.better(current, best) {
+			best = current
+		}
+	}
+
+	// Explain the decision:
+	reason := &strings.Builder{}
+	fmt.Fprintf(
+		reason,
+		"Selected hub '%s' with score %.2f and %d clusters, out of %d candidates",
+		best.candidate.Hub.GetId(), best.score, best.candidate.Clusters, len(candidates),
+	)
+	if len(rejections) > 0 {
+		fmt.Fprintf(reason, ", rejected: %s", strings.Join(rejections, "; "))
+	}
+
+	result = &Result{
+		Hub:    best.candidate.Hub,
+		Score:  best.score,
+		Reason: reason.String(),
+	}
+	s.logger.DebugContext(
+		ctx,
+		"Scheduled cluster",
+		slog.String("cluster", request.Cluster.GetId()),
+		slog.String("hub", result.Hub.GetId()),
+		slog.Float64("score", result.Score),
+		slog.String("reason", result.Reason),
+	)
+	return
+}
+
+func (s *Scheduler) filter(ctx context.Context, request *Request, candidate *Candidate) (result string, err error) {
+	for _, filter := range s.filters {
+		var accepted bool
+		var reason string
+		accepted, reason, err = filter.Filter(ctx, request, candidate)
+		if err != nil {
+			err = fmt.Errorf(
+				"filter '%s' failed for hub '%s': %w",
+				filter.Name(), candidate.Hub.GetId(), err,
+			)
+			return
+		}
+		if !accepted {
+			result = fmt.Sprintf("hub '%s' %s", candidate.Hub.GetId(), reason)
+			s.logger.DebugContext(
+				ctx,
+				"Hub rejected",
+				slog.String("cluster", request.Cluster.GetId()),
+				slog.String("hub", candidate.Hub.GetId()),
+				slog.String("filter", filter.Name()),
+				slog.String("reason", reason),
+			)
+			return
+		}
+	}
+	return
+}
+
+func (s *Scheduler) score(ctx context.Context, request *Request, candidate *Candidate) (result float64, err error) {
+	for _, scorer := range s.scorers {
+		var score float64
+		score, err = scorer.scorer.Score(ctx, request, candidate)
+		if err != nil {
+			err = fmt.Errorf(
+				"scorer '%s' failed for hub '%s': %w",
+				scorer.scorer.Name(), candidate.Hub.GetId(), err,
+			)
+			return
+		}
+		result += score * scorer.weight
+	}
+	return
+}
+
+func (s *Scheduler) better(x, y *scoredCandidate) bool {
+	if x.score != y.score {
+		return x.score > y.score
+	}
+	if x.candidate.Clusters != y.candidate.Clusters {
+		return x.candidate.Clusters < y.candidate.Clusters
+	}
+	return x.candidate.Hub.GetId() < y.candidate.Hub.GetId()
+}
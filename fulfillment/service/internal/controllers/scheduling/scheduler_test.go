@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		ctx       context.Context
+		scheduler *Scheduler
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		scheduler, err = NewScheduler().
+			SetLogger(logger).
+			AddDefaults().
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	makeRequest := func(spec *privatev1.ClusterSpec) *Request {
+		return &Request{
+			Cluster: privatev1.Cluster_builder{
+				Id:   "my-cluster",
+				Spec: spec,
+			}.Build(),
+		}
+	}
+
+	It("Can't be created without a logger", func() {
+		scheduler, err := NewScheduler().Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+		Expect(scheduler).To(BeNil())
+	})
+
+	It("Rejects negative weights", func() {
+		scheduler, err := NewScheduler().
+			SetLogger(logger).
+			AddScorer(LoadScorer{}, -1).
+			Build()
+		Expect(err).To(MatchError(ContainSubstring("should be zero or positive")))
+		Expect(scheduler).To(BeNil())
+	})
+
+	It("Fails if there are no hubs", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), nil)
+		Expect(err).To(MatchError("there are no hubs"))
+		Expect(result).To(BeNil())
+	})
+
+	It("Selects the hub with less clusters", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub:      privatev1.Hub_builder{Id: "hub-a"}.Build(),
+				Clusters: 10,
+			},
+			{
+				Hub:      privatev1.Hub_builder{Id: "hub-b"}.Build(),
+				Clusters: 2,
+			},
+			{
+				Hub:      privatev1.Hub_builder{Id: "hub-c"}.Build(),
+				Clusters: 5,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("Selected hub 'hub-b'"))
+	})
+
+	It("Prefers the hub with more free capacity", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:          "hub-a",
+					MaxClusters: 10,
+				}.Build(),
+				Clusters: 5,
+			},
+			{
+				Hub: privatev1.Hub_builder{
+					Id:          "hub-b",
+					MaxClusters: 100,
+				}.Build(),
+				Clusters: 20,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+	})
+
+	It("Uses the identifier to break ties", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{Id: "hub-b"}.Build(),
+			},
+			{
+				Hub: privatev1.Hub_builder{Id: "hub-a"}.Build(),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-a"))
+	})
+
+	It("Rejects hubs that are full", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:          "hub-a",
+					MaxClusters: 3,
+				}.Build(),
+				Clusters: 3,
+			},
+			{
+				Hub:      privatev1.Hub_builder{Id: "hub-b"}.Build(),
+				Clusters: 50,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("hub 'hub-a' has reached its maximum of 3 clusters"))
+	})
+
+	It("Rejects hubs that are cordoned", func() {
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:       "hub-a",
+					Cordoned: true,
+				}.Build(),
+			},
+			{
+				Hub:      privatev1.Hub_builder{Id: "hub-b"}.Build(),
+				Clusters: 10,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("hub 'hub-a' is cordoned"))
+	})
+
+	It("Rejects hubs that are unhealthy", func() {
+		makeHub := func(id string, status sharedv1.ConditionStatus, message string) *privatev1.Hub {
+			return privatev1.Hub_builder{
+				Id: id,
+				Status: privatev1.HubStatus_builder{
+					Conditions: []*privatev1.HubCondition{
+						privatev1.HubCondition_builder{
+							Type:    privatev1.HubConditionType_HUB_CONDITION_TYPE_HEALTHY,
+							Status:  status,
+							Message: &message,
+						}.Build(),
+					},
+				}.Build(),
+			}.Build()
+		}
+		result, err := scheduler.Schedule(ctx, makeRequest(nil), []*Candidate{
+			{
+				Hub: makeHub("hub-a", sharedv1.ConditionStatus_CONDITION_STATUS_FALSE, "API server isn't reachable"),
+			},
+			{
+				Hub:      makeHub("hub-b", sharedv1.ConditionStatus_CONDITION_STATUS_TRUE, ""),
+				Clusters: 10,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("hub 'hub-a' is unhealthy: API server isn't reachable"))
+	})
+
+	It("Rejects hubs in other zones", func() {
+		request := makeRequest(privatev1.ClusterSpec_builder{
+			Zone: "east",
+		}.Build())
+		result, err := scheduler.Schedule(ctx, request, []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:   "hub-a",
+					Zone: "west",
+				}.Build(),
+			},
+			{
+				Hub: privatev1.Hub_builder{
+					Id:   "hub-b",
+					Zone: "east",
+				}.Build(),
+				Clusters: 10,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring(
+			"hub 'hub-a' is in zone 'west' but the cluster requires zone 'east'",
+		))
+	})
+
+	It("Rejects hubs that don't match the selector", func() {
+		request := makeRequest(privatev1.ClusterSpec_builder{
+			HubSelector: map[string]string{
+				"tier": "gold",
+			},
+		}.Build())
+		result, err := scheduler.Schedule(ctx, request, []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id: "hub-a",
+					Labels: map[string]string{
+						"tier": "silver",
+					},
+				}.Build(),
+			},
+			{
+				Hub: privatev1.Hub_builder{
+					Id: "hub-b",
+					Labels: map[string]string{
+						"tier": "gold",
+					},
+				}.Build(),
+				Clusters: 10,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("hub 'hub-a' doesn't have labels tier=gold"))
+	})
+
+	It("Rejects hubs that don't support the host classes", func() {
+		request := makeRequest(privatev1.ClusterSpec_builder{
+			NodeSets: map[string]*privatev1.ClusterNodeSet{
+				"compute": privatev1.ClusterNodeSet_builder{
+					HostClass: "gpu",
+					Size:      3,
+				}.Build(),
+			},
+		}.Build())
+		result, err := scheduler.Schedule(ctx, request, []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:          "hub-a",
+					HostClasses: []string{"cpu"},
+				}.Build(),
+			},
+			{
+				Hub: privatev1.Hub_builder{
+					Id:          "hub-b",
+					HostClasses: []string{"cpu", "gpu"},
+				}.Build(),
+				Clusters: 10,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Hub.GetId()).To(Equal("hub-b"))
+		Expect(result.Reason).To(ContainSubstring("hub 'hub-a' doesn't support host classes 'gpu'"))
+	})
+
+	It("Fails if no hub can receive the cluster", func() {
+		request := makeRequest(privatev1.ClusterSpec_builder{
+			Zone: "east",
+		}.Build())
+		result, err := scheduler.Schedule(ctx, request, []*Candidate{
+			{
+				Hub: privatev1.Hub_builder{
+					Id:   "hub-a",
+					Zone: "west",
+				}.Build(),
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring("none of the 1 hubs can receive the cluster")))
+		Expect(result).To(BeNil())
+	})
+})
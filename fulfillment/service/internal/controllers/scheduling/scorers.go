@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+)
+
+// LoadScorer prefers the hubs that have less clusters. For hubs that have a maximum number of clusters the score is the
+// fraction of that capacity that is still free. For hubs without a maximum the score is one divided by the number of
+// clusters plus one, so an empty hub always gets the highest possible score.
+type LoadScorer struct{}
+
+func (s LoadScorer) Name() string {
+	return "load"
+}
+
+func (s LoadScorer) Score(ctx context.Context, request *Request, candidate *Candidate) (score float64, err error) {
+	limit := candidate.Hub.GetMaxClusters()
+	if limit > 0 {
+		score = 1 - float64(candidate.Clusters)/float64(limit)
+		if score < 0 {
+			score = 0
+		}
+		return
+	}
+	score = 1 / float64(candidate.Clusters+1)
+	return
+}
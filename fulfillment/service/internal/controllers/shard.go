@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Shard describes the subset of objects that an instance of the controller is responsible for when the objects are
+// split between multiple instances. Objects are assigned to shards using a hash of their identifier, so all the
+// instances must be configured with the same number of shards, and each shard should have exactly one active
+// instance.
+//
+// The zero value means that there is only one shard, containing all the objects.
+type Shard struct {
+	// Index is the index of the shard, from zero to the number of shards minus one.
+	Index int
+
+	// Count is the total number of shards.
+	Count int
+}
+
+// Validate checks that the index and count of the shard are consistent.
+func (s Shard) Validate() error {
+	if s.Count < 0 {
+		return fmt.Errorf("number of shards should be positive, but it is %d", s.Count)
+	}
+	if s.Count > 0 && (s.Index < 0 || s.Index >= s.Count) {
+		return fmt.Errorf(
+			"shard index should be between zero and %d, but it is %d",
+			s.Count-1, s.Index,
+		)
+	}
+	return nil
+}
+
+// Contains checks if the object with the given identifier belongs to this shard.
+func (s Shard) Contains(id string) bool {
+	if s.Count <= 1 {
+		return true
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(id))
+	return int(hash.Sum32()%uint32(s.Count)) == s.Index
+}
+
+// String returns a representation of the shard like `1/3`, intended for log messages.
+func (s Shard) String() string {
+	return fmt.Sprintf("%d/%d", s.Index, max(s.Count, 1))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Shard", func() {
+	It("Contains all the objects when it is the zero value", func() {
+		var shard Shard
+		Expect(shard.Validate()).To(Succeed())
+		for i := range 100 {
+			Expect(shard.Contains(fmt.Sprintf("object-%d", i))).To(BeTrue())
+		}
+	})
+
+	It("Assigns each object to exactly one shard", func() {
+		counts := make([]int, 3)
+		for i := range 300 {
+			id := fmt.Sprintf("object-%d", i)
+			owners := 0
+			for index := range counts {
+				shard := Shard{
+					Index: index,
+					Count: len(counts),
+				}
+				if shard.Contains(id) {
+					owners++
+					counts[index]++
+				}
+			}
+			Expect(owners).To(Equal(1), "object '%s' belongs to %d shards", id, owners)
+		}
+
+		// All the shards should get a reasonable part of the objects:
+		for _, count := range counts {
+			Expect(count).To(BeNumerically(">", 50))
+		}
+	})
+
+	It("Rejects a negative number of shards", func() {
+		shard := Shard{
+			Count: -1,
+		}
+		Expect(shard.Validate()).To(MatchError("number of shards should be positive, but it is -1"))
+	})
+
+	It("Rejects an index out of range", func() {
+		shard := Shard{
+			Index: 3,
+			Count: 3,
+		}
+		Expect(shard.Validate()).To(MatchError("shard index should be between zero and 2, but it is 3"))
+	})
+})
@@ -0,0 +1,339 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clnt "sigs.k8s.io/controller-runtime/pkg/client"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/controllers"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/finalizers"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
+)
+
+// objectPrefix is the prefix that will be used in the `generateName` field of the resources created in the hub.
+const objectPrefix = "vmsnap-"
+
+// deleteCheckInterval is how often the reconciler checks if the resources of a deleted snapshot have been removed
+// from the hub.
+const deleteCheckInterval = 10 * time.Second
+
+// FunctionBuilder contains the data and logic needed to build a function that reconciles virtual machine snapshots.
+type FunctionBuilder struct {
+	logger     *slog.Logger
+	connection *grpc.ClientConn
+	hubCache   *controllers.HubCache
+}
+
+type function struct {
+	logger          *slog.Logger
+	hubCache        *controllers.HubCache
+	snapshotsClient privatev1.VirtualMachineSnapshotsClient
+	vmsClient       privatev1.VirtualMachinesClient
+}
+
+type task struct {
+	r            *function
+	snapshot     *privatev1.VirtualMachineSnapshot
+	hubId        string
+	hubNamespace string
+	hubClient    clnt.Client
+}
+
+// NewFunction creates a new builder that can then be used to create a new virtual machine snapshot reconciler
+// function.
+func NewFunction() *FunctionBuilder {
+	return &FunctionBuilder{}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *FunctionBuilder) SetLogger(value *slog.Logger) *FunctionBuilder {
+	b.logger = value
+	return b
+}
+
+// SetConnection sets the gRPC client connection. This is mandatory.
+func (b *FunctionBuilder) SetConnection(value *grpc.ClientConn) *FunctionBuilder {
+	b.connection = value
+	return b
+}
+
+// SetHubCache sets the cache of hubs. This is mandatory.
+func (b *FunctionBuilder) SetHubCache(value *controllers.HubCache) *FunctionBuilder {
+	b.hubCache = value
+	return b
+}
+
+// Build uses the information stored in the builder to create a new virtual machine snapshot reconciler.
+func (b *FunctionBuilder) Build() (result controllers.ReconcilerFunction[*privatev1.VirtualMachineSnapshot], err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.connection == nil {
+		err = errors.New("client is mandatory")
+		return
+	}
+	if b.hubCache == nil {
+		err = errors.New("hub cache is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	object := &function{
+		logger:          b.logger,
+		hubCache:        b.hubCache,
+		snapshotsClient: privatev1.NewVirtualMachineSnapshotsClient(b.connection),
+		vmsClient:       privatev1.NewVirtualMachinesClient(b.connection),
+	}
+	result = object.run
+	return
+}
+
+func (r *function) run(ctx context.Context, snapshot *privatev1.VirtualMachineSnapshot) (
+	result controllers.ReconcilerResult, err error) {
+	t := task{
+		r:        r,
+		snapshot: snapshot,
+	}
+	if snapshot.GetMetadata().HasDeletionTimestamp() {
+		result, err = t.delete(ctx)
+	} else {
+		err = t.update(ctx)
+	}
+	if err != nil {
+		return
+	}
+	_, err = r.snapshotsClient.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+		Object: snapshot,
+	}.Build())
+	return
+}
+
+func (t *task) update(ctx context.Context) error {
+	// Set the default values:
+	t.setDefaults()
+
+	// The snapshot is created in the same hub than the virtual machine, so get it:
+	vmId := t.snapshot.GetSpec().GetVirtualMachine()
+	response, err := t.r.vmsClient.Get(ctx, privatev1.VirtualMachinesGetRequest_builder{
+		Id: vmId,
+	}.Build())
+	if err != nil {
+		return err
+	}
+	vm := response.GetObject()
+	t.hubId = vm.GetStatus().GetHub()
+	if t.hubId == "" {
+		return fmt.Errorf("virtual machine '%s' hasn't been assigned to a hub yet", vmId)
+	}
+	err = t.getHub(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Save the hub in the private data of the snapshot:
+	t.snapshot.GetStatus().SetHub(t.hubId)
+
+	// Get the K8S object of the virtual machine, as we need its name:
+	vmObject, err := t.getVmObject(ctx)
+	if err != nil {
+		return err
+	}
+	if vmObject == nil {
+		return fmt.Errorf("virtual machine '%s' hasn't been created in hub '%s' yet", vmId, t.hubId)
+	}
+
+	// Prepare the spec:
+	spec := map[string]any{
+		"virtualMachineName": vmObject.GetName(),
+	}
+	if t.snapshot.GetSpec().HasRestoreRequestTime() {
+		restoreRequestTime := t.snapshot.GetSpec().GetRestoreRequestTime().AsTime().UTC().Format(time.RFC3339)
+		spec["restoreRequestTime"] = restoreRequestTime
+	}
+
+	// Create or update the Kubernetes object:
+	object, err := t.getKubeObject(ctx)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		object := &unstructured.Unstructured{}
+		object.SetGroupVersionKind(gvks.VirtualMachineSnapshot)
+		object.SetNamespace(t.hubNamespace)
+		object.SetGenerateName(objectPrefix)
+		object.SetLabels(map[string]string{
+			labels.VirtualMachineSnapshotUuid: t.snapshot.GetId(),
+			labels.VirtualMachineUuid:         vmId,
+		})
+		err = unstructured.SetNestedField(object.Object, spec, "spec")
+		if err != nil {
+			return err
+		}
+		err = t.hubClient.Create(ctx, object)
+		if err != nil {
+			return err
+		}
+		t.r.logger.DebugContext(
+			ctx,
+			"Created virtual machine snapshot",
+			slog.String("namespace", object.GetNamespace()),
+			slog.String("name", object.GetName()),
+		)
+	} else {
+		update := object.DeepCopy()
+		err = unstructured.SetNestedField(update.Object, spec, "spec")
+		if err != nil {
+			return err
+		}
+		err = t.hubClient.Patch(ctx, update, clnt.MergeFrom(object))
+		if err != nil {
+			return err
+		}
+		t.r.logger.DebugContext(
+			ctx,
+			"Updated virtual machine snapshot",
+			slog.String("namespace", object.GetNamespace()),
+			slog.String("name", object.GetName()),
+		)
+	}
+
+	return nil
+}
+
+func (t *task) setDefaults() {
+	if !t.snapshot.HasStatus() {
+		t.snapshot.SetStatus(&privatev1.VirtualMachineSnapshotStatus{})
+	}
+	if t.snapshot.GetStatus().GetState() == privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_UNSPECIFIED {
+		t.snapshot.GetStatus().SetState(privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING)
+	}
+}
+
+// delete deletes the Kubernetes object from the hub and, once it is completely gone, removes the finalizer so that the
+// snapshot can be archived.
+func (t *task) delete(ctx context.Context) (result controllers.ReconcilerResult, err error) {
+	// If we don't know the hub then there is nothing to delete:
+	t.hubId = t.snapshot.GetStatus().GetHub()
+	if t.hubId == "" {
+		t.removeFinalizer()
+		return
+	}
+	err = t.getHub(ctx)
+	if err != nil {
+		return
+	}
+
+	// Delete the K8S object, and check again later till it is gone:
+	object, err := t.getKubeObject(ctx)
+	if err != nil {
+		return
+	}
+	if object == nil {
+		t.r.logger.DebugContext(
+			ctx,
+			"Virtual machine snapshot doesn't exist",
+			slog.String("id", t.snapshot.GetId()),
+		)
+		t.removeFinalizer()
+		return
+	}
+	if object.GetDeletionTimestamp() == nil {
+		err = t.hubClient.Delete(ctx, object)
+		if err != nil {
+			return
+		}
+		t.r.logger.DebugContext(
+			ctx,
+			"Deleted virtual machine snapshot",
+			slog.String("namespace", object.GetNamespace()),
+			slog.String("name", object.GetName()),
+		)
+	}
+	result.RequeueAfter = deleteCheckInterval
+	return
+}
+
+func (t *task) removeFinalizer() {
+	if !t.snapshot.HasMetadata() {
+		return
+	}
+	metadata := t.snapshot.GetMetadata()
+	list := slices.DeleteFunc(slices.Clone(metadata.GetFinalizers()), func(value string) bool {
+		return value == finalizers.HubResources
+	})
+	metadata.SetFinalizers(list)
+}
+
+func (t *task) getHub(ctx context.Context) error {
+	hubEntry, err := t.r.hubCache.Get(ctx, t.hubId)
+	if err != nil {
+		return err
+	}
+	t.hubNamespace = hubEntry.Namespace
+	t.hubClient = hubEntry.Client
+	return nil
+}
+
+func (t *task) getVmObject(ctx context.Context) (result *unstructured.Unstructured, err error) {
+	return t.findObject(ctx, gvks.VirtualMachineList, labels.VirtualMachineUuid, t.snapshot.GetSpec().GetVirtualMachine())
+}
+
+func (t *task) getKubeObject(ctx context.Context) (result *unstructured.Unstructured, err error) {
+	return t.findObject(ctx, gvks.VirtualMachineSnapshotList, labels.VirtualMachineSnapshotUuid, t.snapshot.GetId())
+}
+
+// findObject finds the object of the given list type that has the given label value. It returns nil if there is no
+// such object, and an error if there is more than one.
+func (t *task) findObject(ctx context.Context, listGvk schema.GroupVersionKind, label string,
+	value string) (result *unstructured.Unstructured, err error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(listGvk)
+	err = t.hubClient.List(
+		ctx, list,
+		clnt.InNamespace(t.hubNamespace),
+		clnt.MatchingLabels{
+			label: value,
+		},
+	)
+	if err != nil {
+		return
+	}
+	items := list.Items
+	count := len(items)
+	if count > 1 {
+		err = fmt.Errorf(
+			"expected at most one object of kind '%s' with identifier '%s' but found %d",
+			listGvk.Kind, value, count,
+		)
+		return
+	}
+	if count > 0 {
+		result = &items[0]
+	}
+	return
+}
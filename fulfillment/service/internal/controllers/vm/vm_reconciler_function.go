@@ -19,14 +19,22 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	clnt "sigs.k8s.io/controller-runtime/pkg/client"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/controllers"
+	"github.com/jkary/osac/fulfillment/service/internal/controllers/scheduling"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/finalizers"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
 	"github.com/jkary/osac/fulfillment/service/internal/utils"
@@ -35,6 +43,13 @@ import (
 // objectPrefix is the prefix that will be used in the `generateName` field of the resources created in the hub.
 const objectPrefix = "vm-"
 
+// listPageSize is the number of items requested in each page when listing all the hubs or snapshots.
+const listPageSize = 100
+
+// snapshotsCheckInterval is how often the reconciler checks if the snapshots of a deleted virtual machine have been
+// removed.
+const snapshotsCheckInterval = 10 * time.Second
+
 // FunctionBuilder contains the data and logic needed to build a function that reconciles virtual machines.
 type FunctionBuilder struct {
 	logger     *slog.Logger
@@ -43,10 +58,11 @@ type FunctionBuilder struct {
 }
 
 type function struct {
-	logger     *slog.Logger
-	hubCache   *controllers.HubCache
-	vmsClient  privatev1.VirtualMachinesClient
-	hubsClient privatev1.HubsClient
+	logger          *slog.Logger
+	hubCache        *controllers.HubCache
+	vmsClient       privatev1.VirtualMachinesClient
+	hubsClient      privatev1.HubsClient
+	snapshotsClient privatev1.VirtualMachineSnapshotsClient
 }
 
 type task struct {
@@ -98,42 +114,44 @@ func (b *FunctionBuilder) Build() (result controllers.ReconcilerFunction[*privat
 
 	// Create and populate the object:
 	object := &function{
-		logger:     b.logger,
-		vmsClient:  privatev1.NewVirtualMachinesClient(b.connection),
-		hubsClient: privatev1.NewHubsClient(b.connection),
-		hubCache:   b.hubCache,
+		logger:          b.logger,
+		vmsClient:       privatev1.NewVirtualMachinesClient(b.connection),
+		hubsClient:      privatev1.NewHubsClient(b.connection),
+		snapshotsClient: privatev1.NewVirtualMachineSnapshotsClient(b.connection),
+		hubCache:        b.hubCache,
 	}
 	result = object.run
 	return
 }
 
-func (r *function) run(ctx context.Context, vm *privatev1.VirtualMachine) error {
+func (r *function) run(ctx context.Context, vm *privatev1.VirtualMachine) (result controllers.ReconcilerResult,
+	err error) {
 	t := task{
 		r:  r,
 		vm: vm,
 	}
-	var err error
 	if vm.GetMetadata().HasDeletionTimestamp() {
-		err = t.delete(ctx)
+		result, err = t.delete(ctx)
 	} else {
 		err = t.update(ctx)
 	}
 	if err != nil {
-		return err
+		return
 	}
 	_, err = r.vmsClient.Update(ctx, privatev1.VirtualMachinesUpdateRequest_builder{
 		Object: vm,
 	}.Build())
-	return err
+	return
 }
 
 func (t *task) update(ctx context.Context) error {
 	// Set the default values:
 	t.setDefaults()
 
-	// Do nothing if the VM isn't progressing:
+	// If the VM isn't progressing the only thing that needs to be done is propagating the power settings, as those can
+	// be changed at any time:
 	if t.vm.GetStatus().GetState() != privatev1.VirtualMachineState_VIRTUAL_MACHINE_STATE_PROGRESSING {
-		return nil
+		return t.updatePower(ctx)
 	}
 
 	// Select the hub:
@@ -170,10 +188,15 @@ func (t *task) update(ctx context.Context) error {
 		object.SetLabels(map[string]string{
 			labels.VirtualMachineUuid: t.vm.GetId(),
 		})
+		t.setNameLabel(object)
 		err = unstructured.SetNestedField(object.Object, spec, "spec")
 		if err != nil {
 			return err
 		}
+		err = t.setPowerFields(object)
+		if err != nil {
+			return err
+		}
 		err = t.hubClient.Create(ctx, object)
 		if err != nil {
 			return err
@@ -186,10 +209,15 @@ func (t *task) update(ctx context.Context) error {
 		)
 	} else {
 		update := object.DeepCopy()
+		t.setNameLabel(update)
 		err = unstructured.SetNestedField(update.Object, spec, "spec")
 		if err != nil {
 			return err
 		}
+		err = t.setPowerFields(update)
+		if err != nil {
+			return err
+		}
 		err = t.hubClient.Patch(ctx, update, clnt.MergeFrom(object))
 		if err != nil {
 			return err
@@ -205,6 +233,103 @@ func (t *task) update(ctx context.Context) error {
 	return err
 }
 
+// updatePower propagates the run strategy, the restart request time and the paused flag of a virtual machine that has
+// already been created in the hub.
+func (t *task) updatePower(ctx context.Context) error {
+	// Do nothing if we don't know the hub yet:
+	if t.vm.GetStatus().GetHub() == "" {
+		return nil
+	}
+	err := t.getHub(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Get the K8S object, and do nothing if it doesn't exist or if the power fields are already up to date:
+	object, err := t.getKubeObject(ctx)
+	if err != nil || object == nil {
+		return err
+	}
+	update := object.DeepCopy()
+	err = t.setPowerFields(update)
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(update.Object, object.Object) {
+		return nil
+	}
+	err = t.hubClient.Patch(ctx, update, clnt.MergeFrom(object))
+	if err != nil {
+		return err
+	}
+	t.r.logger.DebugContext(
+		ctx,
+		"Updated power settings of virtual machine",
+		slog.String("namespace", object.GetNamespace()),
+		slog.String("name", object.GetName()),
+		slog.String("run_strategy", t.vm.GetSpec().GetRunStrategy().String()),
+		slog.Bool("paused", t.vm.GetSpec().GetPaused()),
+	)
+	return nil
+}
+
+// setPowerFields copies the run strategy, the restart request time and the paused flag of the virtual machine to the
+// spec of the Kubernetes object, or removes them if they aren't set.
+func (t *task) setPowerFields(object *unstructured.Unstructured) error {
+	runStrategy, ok := runStrategies[t.vm.GetSpec().GetRunStrategy()]
+	if ok {
+		err := unstructured.SetNestedField(object.Object, runStrategy, "spec", "runStrategy")
+		if err != nil {
+			return err
+		}
+	} else {
+		unstructured.RemoveNestedField(object.Object, "spec", "runStrategy")
+	}
+	if t.vm.GetSpec().HasRestartRequestTime() {
+		restartRequestTime := t.vm.GetSpec().GetRestartRequestTime().AsTime().UTC().Format(time.RFC3339)
+		err := unstructured.SetNestedField(object.Object, restartRequestTime, "spec", "restartRequestTime")
+		if err != nil {
+			return err
+		}
+	} else {
+		unstructured.RemoveNestedField(object.Object, "spec", "restartRequestTime")
+	}
+	if t.vm.GetSpec().GetPaused() {
+		err := unstructured.SetNestedField(object.Object, true, "spec", "paused")
+		if err != nil {
+			return err
+		}
+	} else {
+		unstructured.RemoveNestedField(object.Object, "spec", "paused")
+	}
+	return nil
+}
+
+// runStrategies contains the values of the run strategy field of the Kubernetes object that correspond to the values of
+// the run strategy enumerated type of the API.
+var runStrategies = map[privatev1.VirtualMachineRunStrategy]string{
+	privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS:           "Always",
+	privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED:           "Halted",
+	privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_MANUAL:           "Manual",
+	privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_RERUN_ON_FAILURE: "RerunOnFailure",
+}
+
+// setNameLabel adds to the Kubernetes object the label that contains the name of the virtual machine, or removes it if the
+// virtual machine doesn't have a name. This is intended to help hub administrators to correlate objects.
+func (t *task) setNameLabel(object *unstructured.Unstructured) {
+	objectLabels := object.GetLabels()
+	if objectLabels == nil {
+		objectLabels = map[string]string{}
+	}
+	name := t.vm.GetMetadata().GetName()
+	if name != "" {
+		objectLabels[labels.VirtualMachineName] = name
+	} else {
+		delete(objectLabels, labels.VirtualMachineName)
+	}
+	object.SetLabels(objectLabels)
+}
+
 func (t *task) setDefaults() {
 	if !t.vm.HasStatus() {
 		t.vm.SetStatus(&privatev1.VirtualMachineStatus{})
@@ -237,21 +362,33 @@ func (t *task) setConditionDefaults(value privatev1.VirtualMachineConditionType)
 	}
 }
 
-func (t *task) delete(ctx context.Context) error {
+func (t *task) delete(ctx context.Context) (result controllers.ReconcilerResult, err error) {
+	// The snapshots need to be deleted before the virtual machine, and we need to wait till they are completely gone
+	// before removing the finalizer:
+	pending, err := t.deleteSnapshots(ctx)
+	if err != nil {
+		return
+	}
+	if pending {
+		result.RequeueAfter = snapshotsCheckInterval
+		return
+	}
+	t.removeFinalizer()
+
 	// Do nothing if we don't know the hub yet:
 	t.hubId = t.vm.GetStatus().GetHub()
 	if t.hubId == "" {
-		return nil
+		return
 	}
-	err := t.getHub(ctx)
+	err = t.getHub(ctx)
 	if err != nil {
-		return err
+		return
 	}
 
 	// Delete the K8S object:
 	object, err := t.getKubeObject(ctx)
 	if err != nil {
-		return err
+		return
 	}
 	if object == nil {
 		t.r.logger.DebugContext(
@@ -259,11 +396,11 @@ func (t *task) delete(ctx context.Context) error {
 			"Virtual machine doesn't exist",
 			slog.String("id", t.vm.GetId()),
 		)
-		return nil
+		return
 	}
 	err = t.hubClient.Delete(ctx, object)
 	if err != nil {
-		return err
+		return
 	}
 	t.r.logger.DebugContext(
 		ctx,
@@ -272,20 +409,99 @@ func (t *task) delete(ctx context.Context) error {
 		slog.String("name", object.GetName()),
 	)
 
-	return err
+	return
+}
+
+// deleteSnapshots requests the deletion of the snapshots of the virtual machine that aren't already being deleted. It
+// returns true if there are snapshots that still exist.
+func (t *task) deleteSnapshots(ctx context.Context) (pending bool, err error) {
+	snapshots, err := t.listSnapshots(ctx)
+	if err != nil {
+		return
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.GetMetadata().HasDeletionTimestamp() {
+			continue
+		}
+		_, err = t.r.snapshotsClient.Delete(ctx, privatev1.VirtualMachineSnapshotsDeleteRequest_builder{
+			Id: snapshot.GetId(),
+		}.Build())
+		if err != nil {
+			return
+		}
+		t.r.logger.DebugContext(
+			ctx,
+			"Deleted virtual machine snapshot",
+			slog.String("vm_id", t.vm.GetId()),
+			slog.String("snapshot_id", snapshot.GetId()),
+		)
+	}
+	pending = len(snapshots) > 0
+	return
+}
+
+// listSnapshots retrieves all the snapshots of the virtual machine, page by page.
+func (t *task) listSnapshots(ctx context.Context) (result []*privatev1.VirtualMachineSnapshot, err error) {
+	var pageToken *string
+	for {
+		var response *privatev1.VirtualMachineSnapshotsListResponse
+		response, err = t.r.snapshotsClient.List(ctx, privatev1.VirtualMachineSnapshotsListRequest_builder{
+			Filter:    proto.String(fmt.Sprintf("this.spec.virtual_machine == %s", strconv.Quote(t.vm.GetId()))),
+			Limit:     proto.Int32(listPageSize),
+			PageToken: pageToken,
+			SkipTotal: proto.Bool(true),
+		}.Build())
+		if err != nil {
+			return
+		}
+		result = append(result, response.GetItems()...)
+		if !response.HasNextPageToken() {
+			return
+		}
+		pageToken = proto.String(response.GetNextPageToken())
+	}
+}
+
+// removeFinalizer removes the finalizer that prevents the virtual machine from being archived while it has
+// snapshots.
+func (t *task) removeFinalizer() {
+	if !t.vm.HasMetadata() {
+		return
+	}
+	metadata := t.vm.GetMetadata()
+	list := slices.DeleteFunc(slices.Clone(metadata.GetFinalizers()), func(value string) bool {
+		return value == finalizers.VirtualMachineSnapshots
+	})
+	metadata.SetFinalizers(list)
 }
 
 func (t *task) selectHub(ctx context.Context) error {
 	t.hubId = t.vm.GetStatus().GetHub()
 	if t.hubId == "" {
-		response, err := t.r.hubsClient.List(ctx, privatev1.HubsListRequest_builder{}.Build())
+		hubs, err := t.listHubs(ctx)
 		if err != nil {
 			return err
 		}
-		if len(response.Items) == 0 {
+		if len(hubs) == 0 {
 			return errors.New("there are no hubs")
 		}
-		t.hubId = response.Items[rand.IntN(len(response.Items))].GetId()
+		var available []*privatev1.Hub
+		var rejections []string
+		for _, hub := range hubs {
+			ok, reason := scheduling.HubAvailable(hub)
+			if !ok {
+				rejections = append(rejections, fmt.Sprintf("hub '%s' %s", hub.GetId(), reason))
+				continue
+			}
+			available = append(available, hub)
+		}
+		if len(available) == 0 {
+			return fmt.Errorf(
+				"none of the %d hubs can receive the virtual machine: %s",
+				len(hubs), strings.Join(rejections, "; "),
+			)
+		}
+		t.hubId = available[rand.IntN(len(available))].GetId()
 	}
 	t.r.logger.DebugContext(
 		ctx,
@@ -301,6 +517,27 @@ func (t *task) selectHub(ctx context.Context) error {
 	return nil
 }
 
+// listHubs retrieves all the hubs, page by page.
+func (t *task) listHubs(ctx context.Context) (result []*privatev1.Hub, err error) {
+	var pageToken *string
+	for {
+		var response *privatev1.HubsListResponse
+		response, err = t.r.hubsClient.List(ctx, privatev1.HubsListRequest_builder{
+			Limit:     proto.Int32(listPageSize),
+			PageToken: pageToken,
+			SkipTotal: proto.Bool(true),
+		}.Build())
+		if err != nil {
+			return
+		}
+		result = append(result, response.GetItems()...)
+		if !response.HasNextPageToken() {
+			return
+		}
+		pageToken = proto.String(response.GetNextPageToken())
+	}
+}
+
 func (t *task) getHub(ctx context.Context) error {
 	t.hubId = t.vm.GetStatus().GetHub()
 	hubEntry, err := t.r.hubCache.Get(ctx, t.hubId)
@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+// fakeHubsClient is an implementation of the hubs client that returns a fixed list of hubs. The page tokens are the
+// index of the first item of the page.
+type fakeHubsClient struct {
+	privatev1.HubsClient
+	hubs     []*privatev1.Hub
+	requests []*privatev1.HubsListRequest
+}
+
+func (c *fakeHubsClient) List(ctx context.Context, request *privatev1.HubsListRequest,
+	opts ...grpc.CallOption) (response *privatev1.HubsListResponse, err error) {
+	c.requests = append(c.requests, request)
+	start := 0
+	if request.HasPageToken() {
+		start, err = strconv.Atoi(request.GetPageToken())
+		Expect(err).ToNot(HaveOccurred())
+	}
+	end := min(start+int(request.GetLimit()), len(c.hubs))
+	items := c.hubs[start:end]
+	response = privatev1.HubsListResponse_builder{
+		Size:  proto.Int32(int32(len(items))),
+		Items: items,
+	}.Build()
+	if end < len(c.hubs) {
+		response.SetNextPageToken(strconv.Itoa(end))
+	}
+	return
+}
+
+// fakeSnapshotsClient is an implementation of the snapshots client that returns a fixed list of snapshots. The page
+// tokens are the index of the first item of the page.
+type fakeSnapshotsClient struct {
+	privatev1.VirtualMachineSnapshotsClient
+	snapshots []*privatev1.VirtualMachineSnapshot
+	requests  []*privatev1.VirtualMachineSnapshotsListRequest
+}
+
+func (c *fakeSnapshotsClient) List(ctx context.Context, request *privatev1.VirtualMachineSnapshotsListRequest,
+	opts ...grpc.CallOption) (response *privatev1.VirtualMachineSnapshotsListResponse, err error) {
+	c.requests = append(c.requests, request)
+	start := 0
+	if request.HasPageToken() {
+		start, err = strconv.Atoi(request.GetPageToken())
+		Expect(err).ToNot(HaveOccurred())
+	}
+	end := min(start+int(request.GetLimit()), len(c.snapshots))
+	items := c.snapshots[start:end]
+	response = privatev1.VirtualMachineSnapshotsListResponse_builder{
+		Size:  proto.Int32(int32(len(items))),
+		Items: items,
+	}.Build()
+	if end < len(c.snapshots) {
+		response.SetNextPageToken(strconv.Itoa(end))
+	}
+	return
+}
+
+var _ = Describe("Virtual machine reconciler", func() {
+	var (
+		ctx             context.Context
+		hubsClient      *fakeHubsClient
+		snapshotsClient *fakeSnapshotsClient
+		t               *task
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		hubsClient = &fakeHubsClient{}
+		snapshotsClient = &fakeSnapshotsClient{}
+		t = &task{
+			r: &function{
+				logger:          logger,
+				hubsClient:      hubsClient,
+				snapshotsClient: snapshotsClient,
+			},
+			vm: privatev1.VirtualMachine_builder{
+				Id: "my_vm",
+			}.Build(),
+		}
+	})
+
+	It("Lists the hubs page by page", func() {
+		count := 2*listPageSize + 1
+		for i := range count {
+			hubsClient.hubs = append(hubsClient.hubs, privatev1.Hub_builder{
+				Id: fmt.Sprintf("hub-%d", i),
+			}.Build())
+		}
+		hubs, err := t.listHubs(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hubs).To(HaveLen(count))
+		Expect(hubsClient.requests).To(HaveLen(3))
+		for _, request := range hubsClient.requests {
+			Expect(request.GetSkipTotal()).To(BeTrue())
+			Expect(request.HasOffset()).To(BeFalse())
+		}
+	})
+
+	It("Lists the snapshots page by page", func() {
+		count := 2*listPageSize + 1
+		for i := range count {
+			snapshotsClient.snapshots = append(snapshotsClient.snapshots, privatev1.VirtualMachineSnapshot_builder{
+				Id: fmt.Sprintf("snapshot-%d", i),
+			}.Build())
+		}
+		snapshots, err := t.listSnapshots(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshots).To(HaveLen(count))
+		Expect(snapshotsClient.requests).To(HaveLen(3))
+		for _, request := range snapshotsClient.requests {
+			Expect(request.GetFilter()).To(Equal(`this.spec.virtual_machine == "my_vm"`))
+			Expect(request.GetSkipTotal()).To(BeTrue())
+			Expect(request.HasOffset()).To(BeFalse())
+		}
+	})
+
+	It("Copies the paused flag to the Kubernetes object", func() {
+		object := &unstructured.Unstructured{
+			Object: map[string]any{},
+		}
+		t.vm.SetSpec(privatev1.VirtualMachineSpec_builder{
+			Paused: true,
+		}.Build())
+		err := t.setPowerFields(object)
+		Expect(err).ToNot(HaveOccurred())
+		paused, found, err := unstructured.NestedBool(object.Object, "spec", "paused")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(paused).To(BeTrue())
+
+		// Check that the flag is removed when the virtual machine is unpaused:
+		t.vm.GetSpec().SetPaused(false)
+		err = t.setPowerFields(object)
+		Expect(err).ToNot(HaveOccurred())
+		_, found, err = unstructured.NestedBool(object.Object, "spec", "paused")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
@@ -0,0 +1,406 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+)
+
+// WorkQueueBuilder contains the data and logic needed to create a work queue. Don't create instances of this directly,
+// use the NewWorkQueue function instead.
+type WorkQueueBuilder[O dao.Object] struct {
+	logger   *slog.Logger
+	minDelay time.Duration
+	maxDelay time.Duration
+}
+
+// WorkQueue is a queue of objects that need to be reconciled. Objects are identified by their identifier, so adding an
+// object that is already in the queue doesn't add a new entry, it only replaces the object with the latest version. An
+// object is never returned to two workers at the same time: if it is added again while it is being processed it will be
+// returned again only when the processing finishes. Objects that fail are added again after a delay that grows
+// exponentially with the number of consecutive failures.
+type WorkQueue[O dao.Object] struct {
+	logger   *slog.Logger
+	minDelay time.Duration
+	maxDelay time.Duration
+	lock     *sync.Mutex
+	items    map[string]*workQueueItem[O]
+	ready    []string
+	retries  int
+	closed   bool
+	wakeup   chan struct{}
+	done     chan struct{}
+}
+
+// WorkQueueStats contains information about the current state of the queue.
+type WorkQueueStats struct {
+	// Ready is the number of objects that are ready to be processed.
+	Ready int
+
+	// Waiting is the number of objects that are waiting for a delay to expire before they are ready.
+	Waiting int
+
+	// Processing is the number of objects that are currently being processed by workers.
+	Processing int
+
+	// Retries is the total number of times that objects were added again because of failures.
+	Retries int
+}
+
+type workQueueItemState int
+
+const (
+	workQueueItemReady workQueueItemState = iota
+	workQueueItemWaiting
+	workQueueItemProcessing
+)
+
+type workQueueItem[O dao.Object] struct {
+	object   O
+	state    workQueueItemState
+	dirty    bool
+	failures int
+	timer    *time.Timer
+	wait     int
+	links    []trace.Link
+	active   []trace.Link
+}
+
+// workQueueMaxLinks is the maximum number of tracing links that are kept for each object. When an object is added more
+// times than this before it is processed the oldest links are discarded.
+const workQueueMaxLinks = 32
+
+// NewWorkQueue creates a builder that can then be used to configure and create a work queue.
+func NewWorkQueue[O dao.Object]() *WorkQueueBuilder[O] {
+	return &WorkQueueBuilder[O]{
+		minDelay: 1 * time.Second,
+		maxDelay: 5 * time.Minute,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *WorkQueueBuilder[O]) SetLogger(value *slog.Logger) *WorkQueueBuilder[O] {
+	b.logger = value
+	return b
+}
+
+// SetMinDelay sets the delay used the first time that an object fails. Each consecutive failure doubles the delay. This
+// is optional, and the default is one second.
+func (b *WorkQueueBuilder[O]) SetMinDelay(value time.Duration) *WorkQueueBuilder[O] {
+	b.minDelay = value
+	return b
+}
+
+// SetMaxDelay sets the maximum delay used when an object fails. This is optional, and the default is five minutes.
+func (b *WorkQueueBuilder[O]) SetMaxDelay(value time.Duration) *WorkQueueBuilder[O] {
+	b.maxDelay = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new work queue.
+func (b *WorkQueueBuilder[O]) Build() (result *WorkQueue[O], err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.minDelay <= 0 {
+		err = fmt.Errorf("minimum delay should be positive, but it is %s", b.minDelay)
+		return
+	}
+	if b.maxDelay < b.minDelay {
+		err = fmt.Errorf(
+			"maximum delay should be greater or equal than minimum delay, but maximum is %s and minimum is %s",
+			b.maxDelay, b.minDelay,
+		)
+		return
+	}
+
+	// Create and populate the object:
+	result = &WorkQueue[O]{
+		logger:   b.logger,
+		minDelay: b.minDelay,
+		maxDelay: b.maxDelay,
+		lock:     &sync.Mutex{},
+		items:    map[string]*workQueueItem[O]{},
+		wakeup:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	return
+}
+
+// Add adds an object to the queue. If the queue already contains an object with the same identifier it will be
+// replaced, unless the version of the object already in the queue is newer. If the object was waiting because of a
+// previous failure it will be ready immediately.
+//
+// The optional links point to the spans of the requests that caused the change of the object. They are accumulated
+// till the object is returned by the Get method, and then they are available with the Links method.
+func (q *WorkQueue[O]) Add(object O, links ...trace.Link) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.closed {
+		return
+	}
+	id := object.GetId()
+	item, ok := q.items[id]
+	if !ok {
+		item = &workQueueItem[O]{
+			object: object,
+		}
+		item.addLinks(links)
+		q.items[id] = item
+		q.pushReady(id)
+		return
+	}
+	item.addLinks(links)
+	if objectVersion(object) < objectVersion(item.object) {
+		q.logger.Debug(
+			"Ignoring object because the queue already contains a newer version",
+			slog.String("id", id),
+			slog.Int64("version", objectVersion(object)),
+			slog.Int64("queued", objectVersion(item.object)),
+		)
+		return
+	}
+	item.object = object
+	switch item.state {
+	case workQueueItemProcessing:
+		item.dirty = true
+	case workQueueItemWaiting:
+		item.timer.Stop()
+		item.timer = nil
+		item.state = workQueueItemReady
+		q.pushReady(id)
+	}
+}
+
+// Get waits till there is an object ready to be processed and returns it. The caller must call the Done method when it
+// finishes processing the object. The returned flag will be false if the queue has been closed or if the context has
+// been cancelled.
+func (q *WorkQueue[O]) Get(ctx context.Context) (result O, ok bool) {
+	for {
+		q.lock.Lock()
+		if q.closed {
+			q.lock.Unlock()
+			return
+		}
+		if len(q.ready) > 0 {
+			id := q.ready[0]
+			q.ready = q.ready[1:]
+			item := q.items[id]
+			item.state = workQueueItemProcessing
+			item.active = item.links
+			item.links = nil
+			result = item.object
+			ok = true
+			if len(q.ready) > 0 {
+				q.notify()
+			}
+			q.lock.Unlock()
+			return
+		}
+		q.lock.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.done:
+			return
+		case <-q.wakeup:
+		}
+	}
+}
+
+// Done tells the queue that the processing of the object with the given identifier has finished. If the error isn't nil
+// the object will be added again after a delay that depends on the number of consecutive failures. If the result asks
+// to requeue the object it will be added again after the requested delay. If a new version of the object was added
+// while it was being processed it will be ready again immediately.
+func (q *WorkQueue[O]) Done(id string, result ReconcilerResult, err error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	item, ok := q.items[id]
+	if !ok || item.state != workQueueItemProcessing {
+		return
+	}
+	if q.closed {
+		delete(q.items, id)
+		return
+	}
+
+	// Calculate the delay:
+	var delay time.Duration
+	if err != nil {
+		item.failures++
+		q.retries++
+		delay = q.backoff(item.failures)
+		q.logger.Debug(
+			"Object failed, will retry",
+			slog.String("id", id),
+			slog.Int("failures", item.failures),
+			slog.Duration("delay", delay),
+		)
+	} else {
+		item.failures = 0
+		delay = result.RequeueAfter
+	}
+
+	// Objects that were modified while being processed are ready immediately, regardless of the delay:
+	if item.dirty {
+		item.dirty = false
+		item.state = workQueueItemReady
+		q.pushReady(id)
+		return
+	}
+
+	// Schedule the object again if needed, otherwise forget it:
+	if delay > 0 {
+		item.state = workQueueItemWaiting
+		item.wait++
+		wait := item.wait
+		item.timer = time.AfterFunc(delay, func() {
+			q.expire(id, wait)
+		})
+		return
+	}
+	delete(q.items, id)
+}
+
+// Failures returns the number of consecutive failures of the object with the given identifier.
+func (q *WorkQueue[O]) Failures(id string) int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	item, ok := q.items[id]
+	if !ok {
+		return 0
+	}
+	return item.failures
+}
+
+// Links returns the tracing links that were added together with the object with the given identifier before it was
+// returned by the last call to the Get method.
+func (q *WorkQueue[O]) Links(id string) []trace.Link {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	item, ok := q.items[id]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(item.active)
+}
+
+// Stats returns information about the current state of the queue.
+func (q *WorkQueue[O]) Stats() (result WorkQueueStats) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for _, item := range q.items {
+		switch item.state {
+		case workQueueItemReady:
+			result.Ready++
+		case workQueueItemWaiting:
+			result.Waiting++
+		case workQueueItemProcessing:
+			result.Processing++
+		}
+	}
+	result.Retries = q.retries
+	return
+}
+
+// Close stops the queue. Objects that are waiting are discarded, and calls to the Get method will return immediately.
+func (q *WorkQueue[O]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	for _, item := range q.items {
+		if item.timer != nil {
+			item.timer.Stop()
+			item.timer = nil
+		}
+	}
+	close(q.done)
+}
+
+// expire is called when the delay of an object that is waiting expires. The wait number is used to ignore timers that
+// were stopped too late, after they had already fired.
+func (q *WorkQueue[O]) expire(id string, wait int) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	item, ok := q.items[id]
+	if !ok || item.state != workQueueItemWaiting || item.wait != wait {
+		return
+	}
+	item.timer = nil
+	item.state = workQueueItemReady
+	q.pushReady(id)
+}
+
+// backoff calculates the delay for the given number of consecutive failures.
+func (q *WorkQueue[O]) backoff(failures int) time.Duration {
+	delay := q.minDelay
+	for i := 1; i < failures; i++ {
+		delay *= 2
+		if delay >= q.maxDelay {
+			return q.maxDelay
+		}
+	}
+	return delay
+}
+
+// pushReady adds the identifier to the list of ready objects and wakes up one of the workers. Must be called with the
+// lock held.
+func (q *WorkQueue[O]) pushReady(id string) {
+	q.ready = append(q.ready, id)
+	q.notify()
+}
+
+// notify wakes up one of the workers that are waiting for objects.
+func (q *WorkQueue[O]) notify() {
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// addLinks adds the given links to the item, discarding the oldest ones if there are too many.
+func (i *workQueueItem[O]) addLinks(links []trace.Link) {
+	i.links = append(i.links, links...)
+	if len(i.links) > workQueueMaxLinks {
+		i.links = slices.Clone(i.links[len(i.links)-workQueueMaxLinks:])
+	}
+}
+
+// objectVersion returns the version of the object, or zero if it doesn't have metadata.
+func objectVersion(object dao.Object) int64 {
+	type metadataIface interface {
+		GetMetadata() *privatev1.Metadata
+	}
+	md, ok := object.(metadataIface)
+	if !ok {
+		return 0
+	}
+	return md.GetMetadata().GetVersion()
+}
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+var _ = Describe("Work queue", func() {
+	var (
+		ctx   context.Context
+		queue *WorkQueue[*privatev1.Cluster]
+	)
+
+	BeforeEach(func() {
+		var err error
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		DeferCleanup(cancel)
+		queue, err = NewWorkQueue[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetMinDelay(10 * time.Millisecond).
+			SetMaxDelay(40 * time.Millisecond).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(queue.Close)
+	})
+
+	makeCluster := func(id string, version int64) *privatev1.Cluster {
+		return privatev1.Cluster_builder{
+			Id: id,
+			Metadata: privatev1.Metadata_builder{
+				Version: version,
+			}.Build(),
+		}.Build()
+	}
+
+	It("Can't be created without a logger", func() {
+		queue, err := NewWorkQueue[*privatev1.Cluster]().Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+		Expect(queue).To(BeNil())
+	})
+
+	It("Can't be created with maximum delay less than minimum delay", func() {
+		queue, err := NewWorkQueue[*privatev1.Cluster]().
+			SetLogger(logger).
+			SetMinDelay(time.Minute).
+			SetMaxDelay(time.Second).
+			Build()
+		Expect(err).To(MatchError(ContainSubstring("maximum delay should be greater or equal")))
+		Expect(queue).To(BeNil())
+	})
+
+	It("Returns objects in the order they were added", func() {
+		queue.Add(makeCluster("a", 1))
+		queue.Add(makeCluster("b", 1))
+		object, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetId()).To(Equal("a"))
+		object, ok = queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetId()).To(Equal("b"))
+	})
+
+	It("Collapses duplicated objects keeping the latest version", func() {
+		queue.Add(makeCluster("a", 1))
+		queue.Add(makeCluster("a", 3))
+		queue.Add(makeCluster("a", 2))
+		Expect(queue.Stats().Ready).To(Equal(1))
+		object, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 3))
+	})
+
+	It("Doesn't return an object that is being processed", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		queue.Add(makeCluster("a", 2))
+		Expect(queue.Stats().Ready).To(BeZero())
+		Expect(queue.Stats().Processing).To(Equal(1))
+
+		// When processing finishes the new version should be ready immediately:
+		queue.Done("a", ReconcilerResult{}, nil)
+		object, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+	})
+
+	It("Keeps the trace links of the events that added the object", func() {
+		makeLink := func(b byte) trace.Link {
+			return trace.Link{
+				SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+					TraceID: trace.TraceID{b},
+					SpanID:  trace.SpanID{b},
+				}),
+			}
+		}
+		first := makeLink(1)
+		second := makeLink(2)
+		queue.Add(makeCluster("a", 1), first)
+		queue.Add(makeCluster("a", 2), second)
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(queue.Links("a")).To(Equal([]trace.Link{first, second}))
+
+		// Links added while the object is processed should be kept for the next time:
+		third := makeLink(3)
+		queue.Add(makeCluster("a", 3), third)
+		queue.Done("a", ReconcilerResult{}, nil)
+		_, ok = queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(queue.Links("a")).To(Equal([]trace.Link{third}))
+	})
+
+	It("Forgets objects that are processed successfully", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		queue.Done("a", ReconcilerResult{}, nil)
+		Expect(queue.Stats()).To(Equal(WorkQueueStats{}))
+	})
+
+	It("Retries failed objects with exponential backoff", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		for i := 1; i <= 3; i++ {
+			start := time.Now()
+			queue.Done("a", ReconcilerResult{}, errors.New("failed"))
+			Expect(queue.Stats().Waiting).To(Equal(1))
+			_, ok = queue.Get(ctx)
+			Expect(ok).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically(">=", queue.backoff(i)))
+		}
+		Expect(queue.Failures("a")).To(Equal(3))
+		Expect(queue.Stats().Retries).To(Equal(3))
+	})
+
+	It("Calculates the backoff delays", func() {
+		Expect(queue.backoff(1)).To(Equal(10 * time.Millisecond))
+		Expect(queue.backoff(2)).To(Equal(20 * time.Millisecond))
+		Expect(queue.backoff(3)).To(Equal(40 * time.Millisecond))
+		Expect(queue.backoff(4)).To(Equal(40 * time.Millisecond))
+		Expect(queue.backoff(100)).To(Equal(40 * time.Millisecond))
+	})
+
+	It("Resets failures after success", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		queue.Done("a", ReconcilerResult{}, errors.New("failed"))
+		Expect(queue.Failures("a")).To(Equal(1))
+		_, ok = queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		queue.Done("a", ReconcilerResult{}, nil)
+		Expect(queue.Failures("a")).To(BeZero())
+		Expect(queue.Stats().Retries).To(Equal(1))
+	})
+
+	It("Requeues objects after the requested delay", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		start := time.Now()
+		queue.Done("a", ReconcilerResult{RequeueAfter: 50 * time.Millisecond}, nil)
+		Expect(queue.Stats().Waiting).To(Equal(1))
+		object, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetId()).To(Equal("a"))
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("Makes waiting objects ready immediately when they are added again", func() {
+		queue.Add(makeCluster("a", 1))
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		queue.Done("a", ReconcilerResult{RequeueAfter: time.Hour}, nil)
+		queue.Add(makeCluster("a", 2))
+		Expect(queue.Stats().Ready).To(Equal(1))
+		object, ok := queue.Get(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+	})
+
+	It("Returns immediately when the context is cancelled", func() {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, ok := queue.Get(cancelled)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Returns immediately when the queue is closed", func() {
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(10 * time.Millisecond)
+			queue.Close()
+		}()
+		_, ok := queue.Get(ctx)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Distributes objects to multiple workers", func() {
+		const count = 100
+		results := make(chan string, count)
+		for range 4 {
+			go func() {
+				defer GinkgoRecover()
+				for {
+					object, ok := queue.Get(ctx)
+					if !ok {
+						return
+					}
+					results <- object.GetId()
+					queue.Done(object.GetId(), ReconcilerResult{}, nil)
+				}
+			}()
+		}
+		for i := range count {
+			queue.Add(makeCluster(string(rune('a'+i%26))+string(rune('a'+i/26)), 1))
+		}
+		seen := map[string]bool{}
+		for range count {
+			Eventually(results).Should(Receive(Satisfy(func(id string) bool {
+				seen[id] = true
+				return true
+			})))
+		}
+		Expect(seen).To(HaveLen(count))
+	})
+})
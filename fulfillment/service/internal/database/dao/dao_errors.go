@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package dao
+
+import (
+	"fmt"
+)
+
+// ConflictError is the error returned by the DAO when an object can't be updated because the version provided by the
+// caller doesn't match the current version of the object. That usually means that the object was modified by some
+// other process since it was retrieved.
+type ConflictError struct {
+	// ID is the identifier of the object.
+	ID string
+
+	// Expected is the version that the caller expected the object to have.
+	Expected int64
+
+	// Actual is the version that the object actually has. It will be zero if the conflict was detected when writing
+	// the object and the actual version isn't known.
+	Actual int64
+}
+
+// Error is the implementation of the error interface.
+func (e *ConflictError) Error() string {
+	if e.Actual == 0 {
+		return fmt.Sprintf(
+			"object with identifier '%s' was modified concurrently, expected version %d",
+			e.ID, e.Expected,
+		)
+	}
+	return fmt.Sprintf(
+		"object with identifier '%s' has version %d but version %d was expected",
+		e.ID, e.Actual, e.Expected,
+	)
+}
+
+// RequestError is the error returned by the DAO when the parameters of a request aren't valid, for example when the
+// order criteria or the page token can't be parsed.
+type RequestError struct {
+	// Err is the underlying error.
+	Err error
+}
+
+// Error is the implementation of the error interface.
+func (e *RequestError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// NameConflictError is the error returned by the DAO when an object can't be created or updated because there is
+// already another object with the same name in the same tenants.
+type NameConflictError struct {
+	// Name is the name of the object.
+	Name string
+}
+
+// Error is the implementation of the error interface.
+func (e *NameConflictError) Error() string {
+	return fmt.Sprintf("there is already an object with name '%s'", e.Name)
+}
+
+// AmbiguousNameError is the error returned by the DAO when an object is requested by name and there are multiple
+// objects with that name visible to the user. That can happen when the user can see objects from multiple tenants.
+type AmbiguousNameError struct {
+	// Name is the requested name.
+	Name string
+}
+
+// Error is the implementation of the error interface.
+func (e *AmbiguousNameError) Error() string {
+	return fmt.Sprintf("there are multiple objects with name '%s', use the identifier instead", e.Name)
+}
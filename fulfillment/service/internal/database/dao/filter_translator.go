@@ -59,6 +59,7 @@ const (
 	filterTranslatorThisKind
 	filterTranslatorMdKind
 	filterTranslatorJsonKind
+	filterTranslatorMapKind
 )
 
 // String returns a string representation of the translator result type.
@@ -80,6 +81,8 @@ func (t filterTranslatorResultKind) String() string {
 		return "metadata"
 	case filterTranslatorJsonKind:
 		return "json"
+	case filterTranslatorMapKind:
+		return "map"
 	default:
 		return fmt.Sprintf("unknown:%d", t)
 	}
@@ -199,6 +202,116 @@ func (t *FilterTranslator[O]) Translate(ctx context.Context, filter string) (sql
 	return
 }
 
+// OrderTerm is one of the terms of a translated order criteria.
+type OrderTerm struct {
+	// SQL is the SQL expression that calculates the value used for sorting.
+	SQL string
+
+	// Descending indicates if the results should be sorted in descending order.
+	Descending bool
+
+	// kind is the type of the value, used to cast values in the page tokens to the right SQL type.
+	kind filterTranslatorResultKind
+}
+
+// TranslateOrder translates the given order criteria into a list of terms that can be used to build a SQL order by
+// clause. The criteria is a comma separated list of field paths, each of them optionally followed by `asc` or `desc`.
+// For example:
+//
+//	this.metadata.creation_timestamp desc, this.spec.template
+//
+// The `this.` prefix of the paths is optional.
+func (t *FilterTranslator[O]) TranslateOrder(ctx context.Context, order string) (result []OrderTerm, err error) {
+	for _, text := range strings.Split(order, ",") {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		var term OrderTerm
+		term, err = t.translateOrderTerm(text)
+		if err != nil {
+			return
+		}
+		result = append(result, term)
+	}
+	return
+}
+
+func (t *FilterTranslator[O]) translateOrderTerm(text string) (result OrderTerm, err error) {
+	// Extract the direction:
+	fields := strings.Fields(text)
+	path := fields[0]
+	switch len(fields) {
+	case 1:
+	case 2:
+		switch strings.ToLower(fields[1]) {
+		case "asc":
+		case "desc":
+			result.Descending = true
+		default:
+			err = fmt.Errorf(
+				"order direction should be 'asc' or 'desc', but it is '%s'",
+				fields[1],
+			)
+			return
+		}
+	default:
+		err = fmt.Errorf(
+			"order term '%s' should be a field path optionally followed by 'asc' or 'desc'",
+			text,
+		)
+		return
+	}
+
+	// Compile and translate the path:
+	if path != "this" && !strings.HasPrefix(path, "this.") {
+		path = "this." + path
+	}
+	compiled, issues := t.celEnv.Compile(path)
+	if issues != nil {
+		err = issues.Err()
+		if err != nil {
+			return
+		}
+	}
+	expr := compiled.NativeRep().Expr()
+	if expr.Kind() != ast.SelectKind || expr.AsSelect().IsTestOnly() {
+		err = fmt.Errorf("order term '%s' should be a field path", text)
+		return
+	}
+	translated, err := t.translate(expr)
+	if err != nil {
+		return
+	}
+	switch translated.kind {
+	case filterTranslatorBooleanKind, filterTranslatorNumericKind, filterTranslatorStringKind,
+		filterTranslatorTimeKind:
+	default:
+		err = fmt.Errorf(
+			"order term '%s' has type '%s', but only booleans, numbers, strings and timestamps are supported",
+			text, translated.kind,
+		)
+		return
+	}
+	result.SQL = translated.sql
+	result.kind = translated.kind
+	return
+}
+
+// sqlType returns the SQL type that corresponds to the kind of the term.
+func (t OrderTerm) sqlType() string {
+	switch t.kind {
+	case filterTranslatorBooleanKind:
+		return "boolean"
+	case filterTranslatorNumericKind:
+		return "numeric"
+	case filterTranslatorTimeKind:
+		return "timestamp with time zone"
+	default:
+		return "text"
+	}
+}
+
 func (t *FilterTranslator[O]) translate(expr ast.Expr) (result filterTranslatorResult, err error) {
 	switch expr.Kind() {
 	case ast.CallKind:
@@ -243,6 +356,15 @@ func (t *FilterTranslator[O]) translateCall(expr ast.CallExpr) (result filterTra
 		result, err = t.translateBinary(funcName, funcArgs[0], funcArgs[1])
 	case operators.LogicalNot:
 		result, err = t.translateNot(funcArgs[0])
+	case operators.Index:
+		if len(funcArgs) != 2 {
+			err = fmt.Errorf(
+				"expected exactly two arguments for operator '%s' but got %d",
+				funcName, len(funcArgs),
+			)
+			return
+		}
+		result, err = t.translateIndex(funcArgs[0], funcArgs[1])
 	case operators.In:
 		result, err = t.translateIn(funcArgs)
 	case "contains":
@@ -414,6 +536,28 @@ func (t *FilterTranslator[O]) translateNot(value ast.Expr) (result filterTransla
 	return
 }
 
+func (t *FilterTranslator[O]) translateIndex(operand, key ast.Expr) (result filterTranslatorResult, err error) {
+	operandTr, err := t.translate(operand)
+	if err != nil {
+		return
+	}
+	if operandTr.kind != filterTranslatorMapKind {
+		err = fmt.Errorf("index operator is only supported for labels, but operand is of kind '%s'", operandTr.kind)
+		return
+	}
+	if key.Kind() != ast.LiteralKind {
+		err = fmt.Errorf("index of labels must be a string literal")
+		return
+	}
+	keyValue, ok := key.AsLiteral().Value().(string)
+	if !ok {
+		err = fmt.Errorf("index of labels must be a string literal")
+		return
+	}
+	result = t.translateSelectMapKey(operandTr.sql, keyValue, false)
+	return
+}
+
 func (t *FilterTranslator[O]) translateIdent(name string) (result filterTranslatorResult, err error) {
 	switch name {
 	case "this":
@@ -552,6 +696,15 @@ func (t *FilterTranslator[O]) translateInField(key ast.Expr, value ast.SelectExp
 	}
 	var buffer bytes.Buffer
 	buffer.WriteString(valueTr.sql)
+	if valueTr.kind == filterTranslatorMapKind {
+		// For maps, like the labels, the 'in' operator checks if the key is present:
+		buffer.WriteString(" ? ")
+		buffer.WriteString(keyTr.sql)
+		result.sql = buffer.String()
+		result.kind = filterTranslatorBooleanKind
+		result.precedence = filterTranslatorOtherPrecedence
+		return
+	}
 	buffer.WriteString(" @> array[")
 	buffer.WriteString(keyTr.sql)
 	buffer.WriteString("]")
@@ -615,6 +768,9 @@ func (t *FilterTranslator[O]) translateSelectField(expr ast.SelectExpr) (result
 		result, err = t.translateSelectThisMdField(fieldName, testOnly)
 	case filterTranslatorJsonKind:
 		result, err = t.translateSelectJsonField(operandTr.sql, operandTr.desc, fieldName, testOnly)
+	case filterTranslatorMapKind:
+		result = t.translateSelectMapKey(operandTr.sql, fieldName, testOnly)
+		return
 	default:
 		err = fmt.Errorf("select of field '%s' of kind '%s' isn't supported", fieldName, operandTr.kind)
 		return
@@ -696,12 +852,69 @@ func (t *FilterTranslator[O]) translateSelectThisMdField(fieldName string,
 		result.sql = fieldName
 		result.kind = filterTranslatorStringKind
 		result.precedence = filterTranslatorMaxPrecedence
+	case "version":
+		if testOnly {
+			result.sql = "true"
+			result.kind = filterTranslatorBooleanKind
+			result.precedence = filterTranslatorMaxPrecedence
+		} else {
+			result.sql = fieldName
+			result.kind = filterTranslatorNumericKind
+			result.precedence = filterTranslatorMaxPrecedence
+		}
+	case "name":
+		// The name column doesn't accept null values, instead it is empty when the object has no name.
+		if testOnly {
+			result.sql = fmt.Sprintf("%s != ''", fieldName)
+			result.kind = filterTranslatorBooleanKind
+			result.precedence = filterTranslatorComparisonPrecedence
+		} else {
+			result.sql = fieldName
+			result.kind = filterTranslatorStringKind
+			result.precedence = filterTranslatorMaxPrecedence
+		}
+	case "labels":
+		// The labels are stored in a JSON column that is never null, but the 'has' macro for maps should only
+		// return true if the map isn't empty.
+		if testOnly {
+			result.sql = fmt.Sprintf("%s != '{}'", fieldName)
+			result.kind = filterTranslatorBooleanKind
+			result.precedence = filterTranslatorComparisonPrecedence
+		} else {
+			result.sql = fieldName
+			result.kind = filterTranslatorMapKind
+			result.precedence = filterTranslatorMaxPrecedence
+		}
+	case "annotations":
+		err = fmt.Errorf("metadata field '%s' can't be used in filters, use labels instead", fieldName)
 	default:
 		err = fmt.Errorf("metadata doesn't have a '%s' field", fieldName)
 	}
 	return
 }
 
+// translateSelectMapKey translates the selection of a key of a map stored in a JSON column, like the labels. If testOnly
+// is true the result checks if the key is present, otherwise it returns the value of the key.
+func (t *FilterTranslator[O]) translateSelectMapKey(operandSql string, key string,
+	testOnly bool) (result filterTranslatorResult) {
+	text, escaped := t.translateString(key, "")
+	if escaped {
+		text = "e'" + text + "'"
+	} else {
+		text = "'" + text + "'"
+	}
+	if testOnly {
+		result.sql = fmt.Sprintf("%s ? %s", operandSql, text)
+		result.kind = filterTranslatorBooleanKind
+		result.precedence = filterTranslatorOtherPrecedence
+	} else {
+		result.sql = fmt.Sprintf("%s->>%s", operandSql, text)
+		result.kind = filterTranslatorStringKind
+		result.precedence = filterTranslatorMaxPrecedence
+	}
+	return
+}
+
 func (t *FilterTranslator[O]) translateSelectJsonField(operandSql string, msgDesc protoreflect.MessageDescriptor,
 	fieldName string, testOnly bool) (result filterTranslatorResult, err error) {
 	if testOnly {
@@ -222,5 +222,212 @@ var _ = Describe("Filter translator", func() {
 			`'my_tenant' in this.metadata.tenants`,
 			`tenants @> array['my_tenant']`,
 		),
+		Entry(
+			"Filter by version",
+			`this.metadata.version > 1`,
+			`version > 1`,
+		),
+		Entry(
+			"Check presence of version",
+			`has(this.metadata.version)`,
+			`true`,
+		),
+		Entry(
+			"Filter by name",
+			`this.metadata.name == 'my-cluster'`,
+			`name = 'my-cluster'`,
+		),
+		Entry(
+			"Check presence of name",
+			`has(this.metadata.name)`,
+			`name != ''`,
+		),
+		Entry(
+			"Filter by label using index",
+			`this.metadata.labels['environment'] == 'production'`,
+			`labels->>'environment' = 'production'`,
+		),
+		Entry(
+			"Filter by label using select",
+			`this.metadata.labels.environment == 'production'`,
+			`labels->>'environment' = 'production'`,
+		),
+		Entry(
+			"Filter by label with quote in key",
+			`this.metadata.labels["owner's"] == 'me'`,
+			`labels->>e'owner\'s' = 'me'`,
+		),
+		Entry(
+			"Filter by multiple labels",
+			`this.metadata.labels['environment'] == 'production' && this.metadata.labels['owner'] != 'joe'`,
+			`labels->>'environment' = 'production' and labels->>'owner' != 'joe'`,
+		),
+		Entry(
+			"Filter by label value in list",
+			`this.metadata.labels['environment'] in ['production', 'staging']`,
+			`labels->>'environment' in ('production', 'staging')`,
+		),
+		Entry(
+			"Check presence of label using 'in'",
+			`'environment' in this.metadata.labels`,
+			`labels ? 'environment'`,
+		),
+		Entry(
+			"Check presence of label using 'has'",
+			`has(this.metadata.labels.environment)`,
+			`labels ? 'environment'`,
+		),
+		Entry(
+			"Check absence of label",
+			`!('environment' in this.metadata.labels)`,
+			`not labels ? 'environment'`,
+		),
+		Entry(
+			"Check presence of labels",
+			`has(this.metadata.labels)`,
+			`labels != '{}'`,
+		),
+	)
+
+	DescribeTable(
+		"Translation errors",
+		func(filter, expected string) {
+			_, err := translator.Translate(ctx, filter)
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry(
+			"Annotations",
+			`this.metadata.annotations['description'] == 'my cluster'`,
+			`metadata field 'annotations' can't be used in filters`,
+		),
+		Entry(
+			"Label key that isn't a literal",
+			`this.metadata.labels[this.my_string] == 'production'`,
+			`index of labels must be a string literal`,
+		),
+	)
+
+	DescribeTable(
+		"Order translation",
+		func(order string, expected []OrderTerm) {
+			actual, err := translator.TranslateOrder(ctx, order)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(HaveLen(len(expected)))
+			for i, term := range actual {
+				Expect(term.SQL).To(Equal(expected[i].SQL))
+				Expect(term.Descending).To(Equal(expected[i].Descending))
+			}
+		},
+		Entry(
+			"Empty",
+			``,
+			nil,
+		),
+		Entry(
+			"Identifier",
+			`id`,
+			[]OrderTerm{
+				{SQL: `id`},
+			},
+		),
+		Entry(
+			"Identifier with explicit prefix",
+			`this.id`,
+			[]OrderTerm{
+				{SQL: `id`},
+			},
+		),
+		Entry(
+			"Explicit ascending direction",
+			`id asc`,
+			[]OrderTerm{
+				{SQL: `id`},
+			},
+		),
+		Entry(
+			"Descending direction",
+			`id desc`,
+			[]OrderTerm{
+				{SQL: `id`, Descending: true},
+			},
+		),
+		Entry(
+			"Upper case direction",
+			`id DESC`,
+			[]OrderTerm{
+				{SQL: `id`, Descending: true},
+			},
+		),
+		Entry(
+			"Creation timestamp",
+			`metadata.creation_timestamp`,
+			[]OrderTerm{
+				{SQL: `creation_timestamp`},
+			},
+		),
+		Entry(
+			"Version",
+			`metadata.version desc`,
+			[]OrderTerm{
+				{SQL: `version`, Descending: true},
+			},
+		),
+		Entry(
+			"String field",
+			`my_string`,
+			[]OrderTerm{
+				{SQL: `data->>'my_string'`},
+			},
+		),
+		Entry(
+			"Integer field",
+			`my_int32`,
+			[]OrderTerm{
+				{SQL: `cast(data->>'my_int32' as integer)`},
+			},
+		),
+		Entry(
+			"Nested boolean field",
+			`spec.spec_bool`,
+			[]OrderTerm{
+				{SQL: `cast(data->'spec'->>'spec_bool' as bool)`},
+			},
+		),
+		Entry(
+			"Multiple terms",
+			`my_string desc, id`,
+			[]OrderTerm{
+				{SQL: `data->>'my_string'`, Descending: true},
+				{SQL: `id`},
+			},
+		),
+	)
+
+	DescribeTable(
+		"Order translation errors",
+		func(order string, expected string) {
+			_, err := translator.TranslateOrder(ctx, order)
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry(
+			"Unknown field",
+			`junk`,
+			`undefined field 'junk'`,
+		),
+		Entry(
+			"Invalid direction",
+			`id up`,
+			`order direction should be 'asc' or 'desc', but it is 'up'`,
+		),
+		Entry(
+			"Too many words",
+			`id asc desc`,
+			`should be a field path optionally followed by 'asc' or 'desc'`,
+		),
+		Entry(
+			"Message field",
+			`spec`,
+			`only booleans, numbers, strings and timestamps are supported`,
+		),
 	)
 })
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"reflect"
 	"slices"
 	"sort"
 	"strings"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -32,7 +34,9 @@ import (
 
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 	"github.com/jkary/osac/fulfillment/service/internal/json"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 )
 
 // Object is the interface that should be satisfied by objects to be managed by the generic DAO.
@@ -52,6 +56,7 @@ type GenericDAOBuilder[O Object] struct {
 	eventCallbacks   []EventCallback
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	keyring          *encryption.Keyring
 }
 
 // GenericDAO provides generic data access operations for protocol buffers messages. It assumes that objects will be
@@ -62,9 +67,17 @@ type GenericDAOBuilder[O Object] struct {
 //   - `deletion_timestamp` - The time the object was deleted.
 //   - `finalizers` - The list of finalizers for the object.
 //   - `creators` - The list of creators for the object.
+//   - `tenants` - The list of tenants for the object.
+//   - `name` - The optional name of the object, unique within the tenants of the object.
+//   - `labels` - The labels of the object, as a JSON object.
+//   - `annotations` - The annotations of the object, as a JSON object.
+//   - `version` - The version of the object, incremented every time that the object is modified.
 //   - `data` - The serialized object, using the protocol buffers JSON serialization.
 //
 // Objects must have field named `id` of string type.
+//
+// Sensitive fields, those that have the `debug_redact` option, are encrypted inside the `data` column when a keyring
+// has been configured.
 type GenericDAO[O Object] struct {
 	logger           *slog.Logger
 	table            string
@@ -82,6 +95,8 @@ type GenericDAO[O Object] struct {
 	filterTranslator *FilterTranslator[O]
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	keyring          *encryption.Keyring
+	sensitive        bool
 }
 
 type metadataIface interface {
@@ -96,6 +111,14 @@ type metadataIface interface {
 	SetCreators([]string)
 	GetTenants() []string
 	SetTenants([]string)
+	GetName() string
+	SetName(string)
+	GetLabels() map[string]string
+	SetLabels(map[string]string)
+	GetAnnotations() map[string]string
+	SetAnnotations(map[string]string)
+	GetVersion() int64
+	SetVersion(int64)
 }
 
 // NewGenericDAO creates a builder that can then be used to configure and create a generic DAO.
@@ -118,9 +141,8 @@ func (b *GenericDAOBuilder[O]) SetTable(value string) *GenericDAOBuilder[O] {
 	return b
 }
 
-// SetDefaultOrder sets the default order criteria to use when nothing has been requested by the user. This is optional
-// and the default is no order. This is intended only for use in unit tests, where it is convenient to have some
-// predictable ordering.
+// SetDefaultOrder sets the default order criteria to use when nothing has been requested by the user. The syntax is the
+// same used for the order parameter of list requests. This is optional, and the default is to sort only by identifier.
 func (b *GenericDAOBuilder[O]) SetDefaultOrder(value string) *GenericDAOBuilder[O] {
 	b.defaultOrder = value
 	return b
@@ -165,6 +187,14 @@ func (b *GenericDAOBuilder[O]) SetTenancyLogic(value auth.TenancyLogic) *Generic
 	return b
 }
 
+// SetKeyring sets the keyring that will be used to encrypt the sensitive fields of the objects before saving them to
+// the database, and to decrypt them after reading them. This is optional. If not provided sensitive fields will be
+// stored without encryption, and reading a sensitive field that has been encrypted will fail.
+func (b *GenericDAOBuilder[O]) SetKeyring(value *encryption.Keyring) *GenericDAOBuilder[O] {
+	b.keyring = value
+	return b
+}
+
 // Build creates a new generic DAO using the configuration stored in the builder.
 func (b *GenericDAOBuilder[O]) Build() (result *GenericDAO[O], err error) {
 	// Check parameters:
@@ -294,13 +324,15 @@ func (b *GenericDAOBuilder[O]) Build() (result *GenericDAO[O], err error) {
 		filterTranslator: filterTranslator,
 		attributionLogic: attributionLogic,
 		tenancyLogic:     tenancyLogic,
+		keyring:          b.keyring,
+		sensitive:        encryption.HasSensitiveFields(objectDesc),
 	}
 	return
 }
 
 // ListRequest represents the parameters for paginated queries.
 type ListRequest struct {
-	// Offset specifies the starting point.
+	// Offset specifies the starting point. It is ignored when a page token is used.
 	Offset int32
 
 	// Limit specifies the maximum number of items.
@@ -308,6 +340,17 @@ type ListRequest struct {
 
 	// Filter is the CEL expression that defines which objects should be returned.
 	Filter string
+
+	// Order is the order criteria. It is a comma separated list of field paths, each of them optionally followed by
+	// `asc` or `desc`. Results are always sorted by identifier after this criteria, so that the order is stable.
+	Order string
+
+	// PageToken is the token returned in the NextPageToken field of a previous response. When this is used the
+	// results will start right after the last item of the previous page.
+	PageToken string
+
+	// SkipTotal indicates that the total number of items shouldn't be calculated.
+	SkipTotal bool
 }
 
 // ListResponse represents the result of a paginated query.
@@ -315,26 +358,44 @@ type ListResponse[I any] struct {
 	// Size is the actual number of items returned.
 	Size int32
 
-	// Total is the total number of items available.
+	// Total is the total number of items available. It will be zero if the request asked to skip the calculation.
 	Total int32
 
 	// Items is the list of items.
 	Items []I
+
+	// NextPageToken is the token that can be used to request the next page of results. It will be empty if there are
+	// no more results.
+	NextPageToken string
 }
 
 // List retrieves all rows from the table and deserializes them into a slice of messages.
 func (d *GenericDAO[O]) List(ctx context.Context, request ListRequest) (response ListResponse[O], err error) {
+	defer d.observe(d.table, "list", time.Now())
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
 		return
 	}
 	defer tx.ReportError(&err)
-	response, err = d.list(ctx, tx, request)
+	response, err = d.list(ctx, tx, d.table, request)
 	return
 }
 
-func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRequest) (response ListResponse[O],
-	err error) {
+// ListArchived is like List, but it retrieves the objects from the archive table, where objects are moved when they
+// are completely deleted.
+func (d *GenericDAO[O]) ListArchived(ctx context.Context, request ListRequest) (response ListResponse[O], err error) {
+	defer d.observe(d.archiveTable(), "list", time.Now())
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+	response, err = d.list(ctx, tx, d.archiveTable(), request)
+	return
+}
+
+func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, table string,
+	request ListRequest) (response ListResponse[O], err error) {
 	// Calculate the filter:
 	filterBuffer := &strings.Builder{}
 	parameters := []any{}
@@ -342,6 +403,9 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 		var filter string
 		filter, err = d.filterTranslator.Translate(ctx, request.Filter)
 		if err != nil {
+			err = &RequestError{
+				Err: fmt.Errorf("failed to translate filter '%s': %w", request.Filter, err),
+			}
 			return
 		}
 		filterBuffer.WriteString(filter)
@@ -353,33 +417,64 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 		return
 	}
 
-	// Calculate the order clause:
-	var order string
-	if d.defaultOrder != "" {
+	// Calculate the order terms, adding the identifier at the end so that the order is always stable:
+	order := request.Order
+	if order == "" {
 		order = d.defaultOrder
 	}
+	terms, err := d.filterTranslator.TranslateOrder(ctx, order)
+	if err != nil {
+		err = &RequestError{
+			Err: fmt.Errorf("failed to translate order '%s': %w", order, err),
+		}
+		return
+	}
+	if len(terms) == 0 || terms[len(terms)-1].SQL != "id" {
+		terms = append(terms, OrderTerm{
+			SQL:  "id",
+			kind: filterTranslatorStringKind,
+		})
+	}
 
-	// Count the total number of results, disregarding the offset and the limit:
+	// Count the total number of results, disregarding the offset, the limit and the page token:
 	sqlBuffer := &strings.Builder{}
-	fmt.Fprintf(sqlBuffer, `select count(*) from %s`, d.table)
-	if filterBuffer.Len() > 0 {
-		sqlBuffer.WriteString(" where ")
-		sqlBuffer.WriteString(filterBuffer.String())
-	}
-	sql := sqlBuffer.String()
-	d.logger.DebugContext(
-		ctx,
-		"Running SQL query",
-		slog.String("sql", sql),
-	)
-	row := tx.QueryRow(ctx, sql, parameters...)
 	var total int
-	err = row.Scan(&total)
-	if err != nil {
-		return
+	if !request.SkipTotal {
+		fmt.Fprintf(sqlBuffer, `select count(*) from %s`, table)
+		if filterBuffer.Len() > 0 {
+			sqlBuffer.WriteString(" where ")
+			sqlBuffer.WriteString(filterBuffer.String())
+		}
+		sql := sqlBuffer.String()
+		d.logger.DebugContext(
+			ctx,
+			"Running SQL query",
+			slog.String("sql", sql),
+		)
+		row := tx.QueryRow(ctx, sql, parameters...)
+		err = row.Scan(&total)
+		if err != nil {
+			return
+		}
+	}
+
+	// Add the condition that selects the items after the end of the previous page:
+	offset := max(request.Offset, 0)
+	if request.PageToken != "" {
+		var token *pageToken
+		token, err = decodePageToken(request.PageToken, request.Order, request.Filter, len(terms))
+		if err != nil {
+			err = &RequestError{
+				Err: err,
+			}
+			return
+		}
+		d.addKeysetFilter(filterBuffer, &parameters, terms, token.Values)
+		offset = 0
 	}
 
-	// Fetch the results:
+	// Fetch the results. Note that we also fetch the text representation of the values of the order terms because
+	// we need them to generate the next page token.
 	sqlBuffer.Reset()
 	fmt.Fprintf(
 		sqlBuffer,
@@ -388,26 +483,36 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 			id,
 			creation_timestamp,
 			deletion_timestamp,
-			finalizers,
+			%s,
 			creators,
 			tenants,
-			data
-		from
-			 %s
-		`,
-		d.table,
+			name,
+			labels,
+			annotations,
+			version,
+			data`,
+		d.finalizersColumn(table),
 	)
+	for _, term := range terms {
+		fmt.Fprintf(sqlBuffer, ",\n\t\t\tcast(%s as text)", term.SQL)
+	}
+	fmt.Fprintf(sqlBuffer, "\n\t\tfrom\n\t\t\t%s", table)
 	if filterBuffer.Len() > 0 {
 		sqlBuffer.WriteString(" where ")
 		sqlBuffer.WriteString(filterBuffer.String())
 	}
-	if order != "" {
-		sqlBuffer.WriteString(" order by ")
-		sqlBuffer.WriteString(order)
+	sqlBuffer.WriteString(" order by ")
+	for i, term := range terms {
+		if i > 0 {
+			sqlBuffer.WriteString(", ")
+		}
+		sqlBuffer.WriteString(term.SQL)
+		if term.Descending {
+			sqlBuffer.WriteString(" desc")
+		}
 	}
 
 	// Add the offset:
-	offset := max(request.Offset, 0)
 	parameters = append(parameters, offset)
 	fmt.Fprintf(sqlBuffer, " offset $%d", len(parameters))
 
@@ -424,7 +529,7 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 	fmt.Fprintf(sqlBuffer, " limit $%d", len(parameters))
 
 	// Execute the SQL query:
-	sql = sqlBuffer.String()
+	sql := sqlBuffer.String()
 	d.logger.DebugContext(
 		ctx,
 		"Running SQL query",
@@ -436,35 +541,51 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 		return
 	}
 	defer itemsRows.Close()
-	var items []O
+	var (
+		items []O
+		keys  []*string
+	)
 	for itemsRows.Next() {
 		var (
-			id         string
-			creationTs time.Time
-			deletionTs time.Time
-			finalizers []string
-			creators   []string
-			tenants    []string
-			data       []byte
+			id          string
+			creationTs  time.Time
+			deletionTs  time.Time
+			finalizers  []string
+			creators    []string
+			tenants     []string
+			name        string
+			labels      map[string]string
+			annotations map[string]string
+			version     int64
+			data        []byte
 		)
-		err = itemsRows.Scan(
+		keys = make([]*string, len(terms))
+		targets := []any{
 			&id,
 			&creationTs,
 			&deletionTs,
 			&finalizers,
 			&creators,
 			&tenants,
+			&name,
+			&labels,
+			&annotations,
+			&version,
 			&data,
-		)
+		}
+		for i := range keys {
+			targets = append(targets, &keys[i])
+		}
+		err = itemsRows.Scan(targets...)
 		if err != nil {
 			return
 		}
 		item := d.newObject()
-		err = d.unmarshalData(data, item)
+		err = d.unmarshalData(id, data, item)
 		if err != nil {
 			return
 		}
-		md := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants)
+		md := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants, name, labels, annotations, version)
 		item.SetId(id)
 		d.setMetadata(item, md)
 		items = append(items, item)
@@ -474,16 +595,92 @@ func (d *GenericDAO[O]) list(ctx context.Context, tx database.Tx, request ListRe
 		return
 	}
 
+	// If the page is full there may be more results, so we need to generate the token for the next page:
+	var nextPageToken string
+	if limit > 0 && len(items) == int(limit) {
+		nextPageToken, err = encodePageToken(&pageToken{
+			Order:  request.Order,
+			Filter: hashPageTokenFilter(request.Filter),
+			Values: keys,
+		})
+		if err != nil {
+			return
+		}
+	}
+
 	// Populate the response:
 	response.Size = int32(len(items))
 	response.Total = int32(total)
 	response.Items = items
+	response.NextPageToken = nextPageToken
 	return
 }
 
+// addKeysetFilter adds to the filter the condition that selects the rows that go after the row that has the given
+// values for the order terms. For example, if the order terms are `a` and `id`, both ascending, the condition will be
+// like this:
+//
+//	(a > $1 or a is null) or (a = $1 and id > $2)
+//
+// Note that the handling of null values needs to be consistent with the default behaviour of PostgreSQL, where nulls
+// go after all other values when sorting in ascending order and before them when sorting in descending order.
+func (d *GenericDAO[O]) addKeysetFilter(buffer *strings.Builder, parameters *[]any, terms []OrderTerm,
+	values []*string) {
+	// Add the parameters:
+	placeholders := make([]string, len(terms))
+	for i, term := range terms {
+		if values[i] == nil {
+			continue
+		}
+		*parameters = append(*parameters, *values[i])
+		placeholders[i] = fmt.Sprintf("cast($%d as %s)", len(*parameters), term.sqlType())
+	}
+
+	// Build the conditions that check if a term is after or equal to the value:
+	after := func(i int) string {
+		term := terms[i]
+		switch {
+		case values[i] == nil && term.Descending:
+			return fmt.Sprintf("%s is not null", term.SQL)
+		case values[i] == nil:
+			return "false"
+		case term.Descending:
+			return fmt.Sprintf("%s < %s", term.SQL, placeholders[i])
+		default:
+			return fmt.Sprintf("(%s > %s or %s is null)", term.SQL, placeholders[i], term.SQL)
+		}
+	}
+	equal := func(i int) string {
+		if values[i] == nil {
+			return fmt.Sprintf("%s is null", terms[i].SQL)
+		}
+		return fmt.Sprintf("%s = %s", terms[i].SQL, placeholders[i])
+	}
+	disjuncts := make([]string, len(terms))
+	for i := range terms {
+		conjuncts := make([]string, i+1)
+		for j := range i {
+			conjuncts[j] = equal(j)
+		}
+		conjuncts[i] = after(i)
+		disjuncts[i] = "(" + strings.Join(conjuncts, " and ") + ")"
+	}
+	filter := strings.Join(disjuncts, " or ")
+
+	// Add the condition to the buffer:
+	if buffer.Len() == 0 {
+		buffer.WriteString(filter)
+	} else {
+		previous := buffer.String()
+		buffer.Reset()
+		fmt.Fprintf(buffer, "(%s) and (%s)", previous, filter)
+	}
+}
+
 // Get retrieves a single row by its identifier and deserializes it into a message. Returns nil and no error if there
 // is no row with the given identifier.
 func (d *GenericDAO[O]) Get(ctx context.Context, id string) (result O, err error) {
+	defer d.observe(d.table, "get", time.Now())
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
 		return
@@ -493,7 +690,26 @@ func (d *GenericDAO[O]) Get(ctx context.Context, id string) (result O, err error
 	return
 }
 
+// GetArchived is like Get, but it retrieves the object from the archive table, where objects are moved when they are
+// completely deleted.
+func (d *GenericDAO[O]) GetArchived(ctx context.Context, id string) (result O, err error) {
+	defer d.observe(d.archiveTable(), "get", time.Now())
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+	result, err = d.getFrom(ctx, tx, d.archiveTable(), id)
+	return
+}
+
 func (d *GenericDAO[O]) get(ctx context.Context, tx database.Tx, id string) (result O, err error) {
+	result, err = d.getFrom(ctx, tx, d.table, id)
+	return
+}
+
+func (d *GenericDAO[O]) getFrom(ctx context.Context, tx database.Tx, table string, id string) (result O,
+	err error) {
 	// Add the id parameter:
 	if id == "" {
 		err = errors.New("object identifier is mandatory")
@@ -518,16 +734,21 @@ func (d *GenericDAO[O]) get(ctx context.Context, tx database.Tx, id string) (res
 		select
 			creation_timestamp,
 			deletion_timestamp,
-			finalizers,
+			%s,
 			creators,
 			tenants,
+			name,
+			labels,
+			annotations,
+			version,
 			data
 		from
 			%s
 		where
 			%s
 		`,
-		d.table,
+		d.finalizersColumn(table),
+		table,
 		filterBuffer.String(),
 	)
 
@@ -541,12 +762,16 @@ func (d *GenericDAO[O]) get(ctx context.Context, tx database.Tx, id string) (res
 	)
 	row := tx.QueryRow(ctx, sql, parameters...)
 	var (
-		creationTs time.Time
-		deletionTs time.Time
-		finalizers []string
-		creators   []string
-		tenants    []string
-		data       []byte
+		creationTs  time.Time
+		deletionTs  time.Time
+		finalizers  []string
+		creators    []string
+		tenants     []string
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		version     int64
+		data        []byte
 	)
 	err = row.Scan(
 		&creationTs,
@@ -554,6 +779,10 @@ func (d *GenericDAO[O]) get(ctx context.Context, tx database.Tx, id string) (res
 		&finalizers,
 		&creators,
 		&tenants,
+		&name,
+		&labels,
+		&annotations,
+		&version,
 		&data,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -564,20 +793,81 @@ func (d *GenericDAO[O]) get(ctx context.Context, tx database.Tx, id string) (res
 		return
 	}
 	object := d.newObject()
-	err = d.unmarshalData(data, object)
+	err = d.unmarshalData(id, data, object)
 	if err != nil {
 		return
 	}
-	metadata := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants)
+	metadata := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants, name, labels, annotations, version)
 	object.SetId(id)
 	d.setMetadata(object, metadata)
 	result = object
 	return
 }
 
+// GetByName retrieves the object that has the given name. Returns nil and no error if there is no object with that
+// name. Returns an AmbiguousNameError if there are multiple objects with that name visible to the user.
+func (d *GenericDAO[O]) GetByName(ctx context.Context, name string) (result O, err error) {
+	defer d.observe(d.table, "get_by_name", time.Now())
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+	result, err = d.getByName(ctx, tx, name)
+	return
+}
+
+func (d *GenericDAO[O]) getByName(ctx context.Context, tx database.Tx, name string) (result O, err error) {
+	// Add the name parameter:
+	if name == "" {
+		err = errors.New("object name is mandatory")
+		return
+	}
+	filterBuffer := &strings.Builder{}
+	parameters := []any{}
+	parameters = append(parameters, name)
+	filterBuffer.WriteString("name = $1")
+
+	// Create the where clause to filter by tenant:
+	err = d.addTenancyFilter(ctx, filterBuffer, &parameters)
+	if err != nil {
+		return
+	}
+
+	// Find the identifiers of the objects that have that name. Note that we only need to fetch two of them to
+	// detect if the name is ambiguous.
+	sql := fmt.Sprintf(`select id from %s where %s limit 2`, d.table, filterBuffer.String())
+	d.logger.DebugContext(
+		ctx,
+		"Running SQL query",
+		slog.String("sql", sql),
+		slog.Any("parameters", parameters),
+	)
+	rows, err := tx.Query(ctx, sql, parameters...)
+	if err != nil {
+		return
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return
+	}
+	switch len(ids) {
+	case 0:
+		return
+	case 1:
+		result, err = d.get(ctx, tx, ids[0])
+	default:
+		err = &AmbiguousNameError{
+			Name: name,
+		}
+	}
+	return
+}
+
 // Exists checks if a row with the given identifiers exists. Returns false and no error if there is no row with the
 // given identifier.
 func (d *GenericDAO[O]) Exists(ctx context.Context, id string) (ok bool, err error) {
+	defer d.observe(d.table, "exists", time.Now())
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
 		return
@@ -635,6 +925,7 @@ func (d *GenericDAO[O]) exists(ctx context.Context, tx database.Tx, id string) (
 
 // Create adds a new row to the table with a generated identifier and serialized data.
 func (d *GenericDAO[O]) Create(ctx context.Context, object O) (result O, err error) {
+	defer d.observe(d.table, "create", time.Now())
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
 		return
@@ -668,9 +959,12 @@ func (d *GenericDAO[O]) create(ctx context.Context, tx database.Tx, object O) (r
 	if tenants == nil {
 		tenants = []string{}
 	}
+	name := d.getName(metadata)
+	labels := d.getLabels(metadata)
+	annotations := d.getAnnotations(metadata)
 
 	// Save the object:
-	data, err := d.marshalData(object)
+	data, err := d.marshalData(id, object)
 	if err != nil {
 		return
 	}
@@ -681,34 +975,46 @@ func (d *GenericDAO[O]) create(ctx context.Context, tx database.Tx, object O) (r
 			finalizers,
 			creators,
 			tenants,
+			name,
+			labels,
+			annotations,
+			version,
 			data
 		) values (
 		 	$1,
 		 	$2,
 			$3,
 			$4,
-			$5
+			$5,
+			$6,
+			$7,
+			1,
+			$8
 		)
 		returning
 			creation_timestamp,
-			deletion_timestamp
+			deletion_timestamp,
+			version
 		`,
 		d.table,
 	)
-	row := tx.QueryRow(ctx, sql, id, finalizers, creators, tenants, data)
+	row := tx.QueryRow(ctx, sql, id, finalizers, creators, tenants, name, labels, annotations, data)
 	var (
 		creationTs time.Time
 		deletionTs time.Time
+		version    int64
 	)
 	err = row.Scan(
 		&creationTs,
 		&deletionTs,
+		&version,
 	)
 	if err != nil {
+		err = d.checkNameConflict(err, name)
 		return
 	}
 	created := d.cloneObject(object)
-	metadata = d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants)
+	metadata = d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants, name, labels, annotations, version)
 	created.SetId(id)
 	d.setMetadata(created, metadata)
 
@@ -727,6 +1033,7 @@ func (d *GenericDAO[O]) create(ctx context.Context, tx database.Tx, object O) (r
 
 // Update modifies an existing row in the table by its identifier with the result of serializing the provided object.
 func (d *GenericDAO[O]) Update(ctx context.Context, object O) (result O, err error) {
+	defer d.observe(d.table, "update", time.Now())
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
 		return
@@ -747,18 +1054,40 @@ func (d *GenericDAO[O]) update(ctx context.Context, tx database.Tx, object O) (r
 	if err != nil {
 		return
 	}
+	if d.isNil(current) {
+		err = fmt.Errorf("object with identifier '%s' doesn't exist", id)
+		return
+	}
+
+	// Check that the version expected by the caller, if any, matches the current version:
+	metadata := d.getMetadata(object)
+	currentVersion := d.getMetadata(current).GetVersion()
+	if metadata != nil {
+		expectedVersion := metadata.GetVersion()
+		if expectedVersion != 0 && expectedVersion != currentVersion {
+			err = &ConflictError{
+				ID:       id,
+				Expected: expectedVersion,
+				Actual:   currentVersion,
+			}
+			return
+		}
+	}
 
 	// Do nothing if there are no changes:
 	if d.equivalent(current, object) {
 		return
 	}
 
-	// Get the metadata:
-	metadata := d.getMetadata(object)
+	// Get the finalizers, name, labels and annotations:
 	finalizers := d.getFinalizers(metadata)
+	name := d.getName(metadata)
+	labels := d.getLabels(metadata)
+	annotations := d.getAnnotations(metadata)
 
-	// Save the object:
-	data, err := d.marshalData(object)
+	// Save the object. Note that the condition on the version guarantees that the object hasn't been modified by
+	// other transaction since we retrieved it.
+	data, err := d.marshalData(id, object)
 	if err != nil {
 		return
 	}
@@ -766,35 +1095,51 @@ func (d *GenericDAO[O]) update(ctx context.Context, tx database.Tx, object O) (r
 		`
 		update %s set
 			finalizers = $1,
-			data = $2
+			name = $2,
+			labels = $3,
+			annotations = $4,
+			data = $5,
+			version = version + 1
 		where
-			id = $3
+			id = $6 and
+			version = $7
 		returning
 			creation_timestamp,
 			deletion_timestamp,
 			creators,
-			tenants
+			tenants,
+			version
 		`,
 		d.table,
 	)
-	row := tx.QueryRow(ctx, sql, finalizers, data, id)
+	row := tx.QueryRow(ctx, sql, finalizers, name, labels, annotations, data, id, currentVersion)
 	var (
 		creationTs time.Time
 		deletionTs time.Time
 		creators   []string
 		tenants    []string
+		version    int64
 	)
 	err = row.Scan(
 		&creationTs,
 		&deletionTs,
 		&creators,
 		&tenants,
+		&version,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = &ConflictError{
+			ID:       id,
+			Expected: currentVersion,
+		}
+		return
+	}
 	if err != nil {
+		err = d.checkNameConflict(err, name)
 		return
 	}
 	object = d.cloneObject(object)
-	metadata = d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants)
+	metadata = d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants, name, labels, annotations, version)
 	object.SetId(id)
 	d.setMetadata(object, metadata)
 
@@ -809,7 +1154,8 @@ func (d *GenericDAO[O]) update(ctx context.Context, tx database.Tx, object O) (r
 
 	// If the object has been deleted and there are no finalizers we can now archive the object and delete the row:
 	if deletionTs.Unix() != 0 && len(finalizers) == 0 {
-		err = d.archive(ctx, tx, id, creationTs, deletionTs, creators, tenants, data)
+		err = d.archive(ctx, tx, id, creationTs, deletionTs, creators, tenants, name, labels, annotations, version,
+			data)
 		if err != nil {
 			return
 		}
@@ -822,6 +1168,8 @@ func (d *GenericDAO[O]) update(ctx context.Context, tx database.Tx, object O) (r
 
 // Delete removes a row from the table by its identifier.
 func (d *GenericDAO[O]) Delete(ctx context.Context, id string) (err error) {
+	defer d.observe(d.table, "delete", time.Now())
+
 	// Start a transaction:
 	tx, err := database.TxFromContext(ctx)
 	if err != nil {
@@ -856,7 +1204,8 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 		sqlBuffer,
 		`
 		update %s set
-			deletion_timestamp = now()
+			deletion_timestamp = now(),
+			version = version + 1
 		where
 			%s
 		returning
@@ -865,6 +1214,10 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 			finalizers,
 			creators,
 			tenants,
+			name,
+			labels,
+			annotations,
+			version,
 			data
 		`,
 		d.table,
@@ -881,12 +1234,16 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 	)
 	row := tx.QueryRow(ctx, sql, parameters...)
 	var (
-		creationTs time.Time
-		deletionTs time.Time
-		finalizers []string
-		creators   []string
-		tenants    []string
-		data       []byte
+		creationTs  time.Time
+		deletionTs  time.Time
+		finalizers  []string
+		creators    []string
+		tenants     []string
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		version     int64
+		data        []byte
 	)
 	err = row.Scan(
 		&creationTs,
@@ -894,6 +1251,10 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 		&finalizers,
 		&creators,
 		&tenants,
+		&name,
+		&labels,
+		&annotations,
+		&version,
 		&data,
 	)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -904,11 +1265,11 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 		return
 	}
 	object := d.newObject()
-	err = d.unmarshalData(data, object)
+	err = d.unmarshalData(id, data, object)
 	if err != nil {
 		return
 	}
-	metadata := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants)
+	metadata := d.makeMetadata(creationTs, deletionTs, finalizers, creators, tenants, name, labels, annotations, version)
 	object.SetId(id)
 	d.setMetadata(object, metadata)
 
@@ -923,7 +1284,8 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 
 	// If there are no finalizers we can now archive the object and delete the row:
 	if len(finalizers) == 0 {
-		err = d.archive(ctx, tx, id, creationTs, deletionTs, creators, tenants, data)
+		err = d.archive(ctx, tx, id, creationTs, deletionTs, creators, tenants, name, labels, annotations, version,
+			data)
 		if err != nil {
 			return
 		}
@@ -932,16 +1294,178 @@ func (d *GenericDAO[O]) delete(ctx context.Context, tx database.Tx, id string) (
 	return
 }
 
+// Undelete clears the deletion timestamp of an object that has been deleted but that hasn't been archived yet because
+// it still has finalizers. Returns nil and no error if there is no such object. Objects that haven't been deleted are
+// returned without changes.
+func (d *GenericDAO[O]) Undelete(ctx context.Context, id string) (result O, err error) {
+	defer d.observe(d.table, "undelete", time.Now())
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+	result, err = d.undelete(ctx, tx, id)
+	return
+}
+
+func (d *GenericDAO[O]) undelete(ctx context.Context, tx database.Tx, id string) (result O, err error) {
+	// Get the current object, and do nothing if it doesn't exist or if it hasn't been deleted:
+	current, err := d.get(ctx, tx, id)
+	if err != nil || d.isNil(current) {
+		return
+	}
+	metadata := d.getMetadata(current)
+	if metadata.GetDeletionTimestamp() == nil {
+		result = current
+		return
+	}
+
+	// Clear the deletion timestamp. Note that the condition on the version guarantees that the object hasn't been
+	// modified by other transaction since we retrieved it.
+	currentVersion := metadata.GetVersion()
+	sql := fmt.Sprintf(
+		`
+		update %s set
+			deletion_timestamp = 'epoch',
+			version = version + 1
+		where
+			id = $1 and
+			version = $2
+		returning
+			version
+		`,
+		d.table,
+	)
+	row := tx.QueryRow(ctx, sql, id, currentVersion)
+	var version int64
+	err = row.Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = &ConflictError{
+			ID:       id,
+			Expected: currentVersion,
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+	metadata.SetDeletionTimestamp(nil)
+	metadata.SetVersion(version)
+
+	// Fire the event:
+	err = d.fireEvent(ctx, Event{
+		Type:   EventTypeUpdated,
+		Object: current,
+	})
+	if err != nil {
+		return
+	}
+
+	result = current
+	return
+}
+
+// Reencrypt encrypts again with the primary key of the keyring the sensitive fields of all the objects, including the
+// archived ones, that aren't already encrypted with that key. This is intended for rotating keys, and for encrypting
+// data that was saved before the keyring was configured. The version of the objects isn't changed and no events are
+// fired, because the objects don't change, only the way they are stored. Returns the number of objects that were
+// updated.
+func (d *GenericDAO[O]) Reencrypt(ctx context.Context) (result int, err error) {
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+	if d.keyring == nil {
+		err = errors.New("keyring is mandatory for re-encryption")
+		return
+	}
+	if !d.sensitive {
+		return
+	}
+	for _, table := range []string{d.table, d.archiveTable()} {
+		var count int
+		count, err = d.reencrypt(ctx, tx, table)
+		if err != nil {
+			err = fmt.Errorf("failed to re-encrypt table '%s': %w", table, err)
+			return
+		}
+		result += count
+	}
+	return
+}
+
+func (d *GenericDAO[O]) reencrypt(ctx context.Context, tx database.Tx, table string) (result int, err error) {
+	// Find the rows that need to be encrypted again. Note that we can't use the unmarshalData method here because
+	// that would decrypt the values, and we need to know what key was used to encrypt them.
+	sql := fmt.Sprintf(`select id, data from %s for update`, table)
+	rows, err := tx.Query(ctx, sql)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	updates := map[string][]byte{}
+	for rows.Next() {
+		var (
+			id   string
+			data []byte
+		)
+		err = rows.Scan(&id, &data)
+		if err != nil {
+			return
+		}
+		object := d.newObject()
+		err = d.unmarshalOptions.Unmarshal(data, object)
+		if err != nil {
+			return
+		}
+		if d.keyring.IsCurrentFields(object) {
+			continue
+		}
+		err = d.keyring.DecryptFields(object, []byte(id))
+		if err != nil {
+			err = fmt.Errorf("failed to decrypt object '%s': %w", id, err)
+			return
+		}
+		data, err = d.marshalData(id, object)
+		if err != nil {
+			return
+		}
+		updates[id] = data
+	}
+	err = rows.Err()
+	if err != nil {
+		return
+	}
+	rows.Close()
+
+	// Save the updated rows:
+	sql = fmt.Sprintf(`update %s set data = $1 where id = $2`, table)
+	for id, data := range updates {
+		_, err = tx.Exec(ctx, sql, data, id)
+		if err != nil {
+			return
+		}
+	}
+	result = len(updates)
+	return
+}
+
 func (d *GenericDAO[O]) archive(ctx context.Context, tx database.Tx, id string, creationTs, deletionTs time.Time,
-	creators []string, tenants []string, data []byte) error {
+	creators []string, tenants []string, name string, labels, annotations map[string]string, version int64,
+	data []byte) error {
 	sql := fmt.Sprintf(
 		`
-		insert into archived_%s (
+		insert into %s (
 			id,
 			creation_timestamp,
 			deletion_timestamp,
 			creators,
 			tenants,
+			name,
+			labels,
+			annotations,
+			version,
 			data
 		) values (
 		 	$1,
@@ -949,12 +1473,17 @@ func (d *GenericDAO[O]) archive(ctx context.Context, tx database.Tx, id string,
 			$3,
 			$4,
 			$5,
-			$6
+			$6,
+			$7,
+			$8,
+			$9,
+			$10
 		)
 		`,
-		d.table,
+		d.archiveTable(),
 	)
-	_, err := tx.Exec(ctx, sql, id, creationTs, deletionTs, creators, tenants, data)
+	_, err := tx.Exec(ctx, sql, id, creationTs, deletionTs, creators, tenants, name, labels, annotations, version,
+		data)
 	if err != nil {
 		return err
 	}
@@ -963,6 +1492,41 @@ func (d *GenericDAO[O]) archive(ctx context.Context, tx database.Tx, id string,
 	return err
 }
 
+// archiveTable returns the name of the table where objects are moved when they are completely deleted.
+func (d *GenericDAO[O]) archiveTable() string {
+	return "archived_" + d.table
+}
+
+// finalizersColumn returns the SQL expression used to read the finalizers from the given table. Archive tables don't
+// have that column because objects are only archived when they don't have finalizers.
+func (d *GenericDAO[O]) finalizersColumn(table string) string {
+	if table == d.archiveTable() {
+		return "cast('{}' as text[])"
+	}
+	return "finalizers"
+}
+
+// checkNameConflict checks if the given error is a violation of the uniqueness constraint of the name column, and in
+// that case replaces it with a name conflict error.
+func (d *GenericDAO[O]) checkNameConflict(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == d.table+"_by_name" {
+		return &NameConflictError{
+			Name: name,
+		}
+	}
+	return err
+}
+
+// uniqueViolationCode is the PostgreSQL error code for violations of uniqueness constraints.
+const uniqueViolationCode = "23505"
+
+// observe updates the metric that measures the duration of operations. It is intended to be called with defer at the
+// beginning of the operation, so that the start time is evaluated immediately.
+func (d *GenericDAO[O]) observe(table, operation string, start time.Time) {
+	metrics.DaoOperationDuration.WithLabelValues(table, operation).Observe(time.Since(start).Seconds())
+}
+
 func (d *GenericDAO[O]) fireEvent(ctx context.Context, event Event) error {
 	event.Table = d.table
 	for _, eventCallback := range d.eventCallbacks {
@@ -978,6 +1542,10 @@ func (d *GenericDAO[O]) newId() string {
 	return uuid.NewString()
 }
 
+func (d *GenericDAO[O]) isNil(object O) bool {
+	return reflect.ValueOf(object).IsNil()
+}
+
 func (d *GenericDAO[O]) newObject() O {
 	return d.objectTemplate.New().Interface().(O)
 }
@@ -986,17 +1554,35 @@ func (d *GenericDAO[O]) cloneObject(object O) O {
 	return proto.Clone(object).(O)
 }
 
-func (d *GenericDAO[O]) marshalData(object O) (result []byte, err error) {
+// marshalData converts the object to the JSON stored in the data column, encrypting the sensitive fields. The
+// encrypted values are bound to the identifier of the object, so that they can't be decrypted if copied to other row.
+func (d *GenericDAO[O]) marshalData(id string, object O) (result []byte, err error) {
+	if d.sensitive && d.keyring != nil {
+		object = d.cloneObject(object)
+		err = d.keyring.EncryptFields(object, []byte(id))
+		if err != nil {
+			return
+		}
+	}
 	result, err = d.jsonEncoder.Marshal(object)
 	return
 }
 
-func (d *GenericDAO[O]) unmarshalData(data []byte, object O) error {
-	return d.unmarshalOptions.Unmarshal(data, object)
+// unmarshalData is the opposite of marshalData.
+func (d *GenericDAO[O]) unmarshalData(id string, data []byte, object O) error {
+	err := d.unmarshalOptions.Unmarshal(data, object)
+	if err != nil || !d.sensitive {
+		return err
+	}
+	if d.keyring == nil {
+		return encryption.CheckFields(object)
+	}
+	return d.keyring.DecryptFields(object, []byte(id))
 }
 
 func (d *GenericDAO[O]) makeMetadata(creationTs, deletionTs time.Time, finalizers []string,
-	creators []string, tenants []string) metadataIface {
+	creators []string, tenants []string, name string, labels, annotations map[string]string,
+	version int64) metadataIface {
 	result := d.metadataTemplate.New().Interface().(metadataIface)
 	if creationTs.Unix() != 0 {
 		result.SetCreationTimestamp(timestamppb.New(creationTs))
@@ -1007,6 +1593,10 @@ func (d *GenericDAO[O]) makeMetadata(creationTs, deletionTs time.Time, finalizer
 	result.SetFinalizers(finalizers)
 	result.SetCreators(creators)
 	result.SetTenants(tenants)
+	result.SetName(name)
+	result.SetLabels(labels)
+	result.SetAnnotations(annotations)
+	result.SetVersion(version)
 	return result
 }
 
@@ -1047,8 +1637,29 @@ func (d *GenericDAO[O]) getFinalizers(metadata metadataIface) []string {
 	return list
 }
 
+func (d *GenericDAO[O]) getName(metadata metadataIface) string {
+	if metadata == nil {
+		return ""
+	}
+	return metadata.GetName()
+}
+
+func (d *GenericDAO[O]) getLabels(metadata metadataIface) map[string]string {
+	if metadata == nil || metadata.GetLabels() == nil {
+		return map[string]string{}
+	}
+	return metadata.GetLabels()
+}
+
+func (d *GenericDAO[O]) getAnnotations(metadata metadataIface) map[string]string {
+	if metadata == nil || metadata.GetAnnotations() == nil {
+		return map[string]string{}
+	}
+	return metadata.GetAnnotations()
+}
+
 // equivalent checks if two objects are equivalent. That means that they are equal excepty maybe in the creation and
-// deletion timestamps.
+// deletion timestamps and the version.
 func (d *GenericDAO[O]) equivalent(x, y O) bool {
 	return d.equivalentMessages(x.ProtoReflect(), y.ProtoReflect())
 }
@@ -1091,7 +1702,8 @@ func (d *GenericDAO[O]) equivalentMetadata(x, y protoreflect.Message) bool {
 	fields := x.Descriptor().Fields()
 	for i := range fields.Len() {
 		field := fields.Get(i)
-		if field.Name() == creationTimestampFieldName || field.Name() == deletionTimestampFieldName {
+		switch field.Name() {
+		case creationTimestampFieldName, deletionTimestampFieldName, versionFieldName:
 			continue
 		}
 		xv := x.Get(field)
@@ -1138,4 +1750,5 @@ var (
 	deletionTimestampFieldName = protoreflect.Name("deletion_timestamp")
 	idFieldName                = protoreflect.Name("id")
 	metadataFieldName          = protoreflect.Name("metadata")
+	versionFieldName           = protoreflect.Name("version")
 )
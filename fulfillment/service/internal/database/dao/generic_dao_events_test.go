@@ -56,6 +56,10 @@ var _ = Describe("Generic DAO events", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -66,6 +70,10 @@ var _ = Describe("Generic DAO events", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
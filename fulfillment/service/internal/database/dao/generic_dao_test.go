@@ -14,9 +14,12 @@ language governing permissions and limitations under the License.
 package dao
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -26,11 +29,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	testsv1 "github.com/jkary/osac/fulfillment/service/internal/api/tests/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 )
 
 var _ = Describe("Generic DAO", func() {
@@ -182,9 +187,15 @@ var _ = Describe("Generic DAO", func() {
 					finalizers text[] not null default '{}',
 					creators text[] not null default '{}',
 					tenants text[] not null default '{}',
+					name text not null default '',
+					labels jsonb not null default '{}',
+					annotations jsonb not null default '{}',
+					version bigint not null default 1,
 					data jsonb not null
 				);
 
+				create unique index objects_by_name on objects (name, tenants) where name != '';
+
 				create table archived_objects (
 					id text not null,
 					creation_timestamp timestamp with time zone not null,
@@ -192,6 +203,10 @@ var _ = Describe("Generic DAO", func() {
 					archival_timestamp timestamp with time zone not null default now(),
 					creators text[] not null default '{}',
 					tenants text[] not null default '{}',
+					name text not null default '',
+					labels jsonb not null default '{}',
+					annotations jsonb not null default '{}',
+					version bigint not null default 0,
 					data jsonb not null
 				);
 				`,
@@ -609,6 +624,556 @@ var _ = Describe("Generic DAO", func() {
 			})
 		})
 
+		Describe("Labels and annotations", func() {
+			It("Gets labels and annotations", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My object",
+						},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetLabels()).To(Equal(map[string]string{
+					"environment": "production",
+				}))
+				Expect(object.GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+					"description": "My object",
+				}))
+			})
+
+			It("Lists labels and annotations", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My object",
+						},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				response, err := generic.List(ctx, ListRequest{
+					Filter: fmt.Sprintf("this.id == '%s'", object.GetId()),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(HaveLen(1))
+				object = response.Items[0]
+				Expect(object.GetMetadata().GetLabels()).To(Equal(map[string]string{
+					"environment": "production",
+				}))
+				Expect(object.GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+					"description": "My object",
+				}))
+			})
+
+			It("Saves empty labels and annotations when object is created without them", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{})
+				Expect(err).ToNot(HaveOccurred())
+				row := tx.QueryRow(
+					ctx,
+					"select labels, annotations from objects where id = $1",
+					object.GetId(),
+				)
+				var labels, annotations map[string]string
+				err = row.Scan(&labels, &annotations)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labels).To(BeEmpty())
+				Expect(annotations).To(BeEmpty())
+			})
+
+			It("Doesn't save labels and annotations in the 'data' column", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My object",
+						},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				row := tx.QueryRow(ctx, "select data from objects where id = $1", object.GetId())
+				var data []byte
+				err = row.Scan(&data)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).ToNot(ContainSubstring("environment"))
+				Expect(data).ToNot(ContainSubstring("description"))
+			})
+
+			It("Replaces labels and annotations when object is updated", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My object",
+						},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object.GetMetadata().SetLabels(map[string]string{
+					"environment": "staging",
+					"owner":       "joe",
+				})
+				object.GetMetadata().SetAnnotations(nil)
+				_, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				object, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetLabels()).To(Equal(map[string]string{
+					"environment": "staging",
+					"owner":       "joe",
+				}))
+				Expect(object.GetMetadata().GetAnnotations()).To(BeEmpty())
+			})
+
+			It("Archives labels and annotations", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My object",
+						},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				row := tx.QueryRow(
+					ctx,
+					"select labels, annotations from archived_objects where id = $1",
+					object.GetId(),
+				)
+				var labels, annotations map[string]string
+				err = row.Scan(&labels, &annotations)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labels).To(Equal(map[string]string{
+					"environment": "production",
+				}))
+				Expect(annotations).To(Equal(map[string]string{
+					"description": "My object",
+				}))
+			})
+		})
+
+		Describe("Names", func() {
+			It("Saves the name", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetName()).To(Equal("my-object"))
+				row := tx.QueryRow(ctx, "select name from objects where id = $1", object.GetId())
+				var name string
+				err = row.Scan(&name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(name).To(Equal("my-object"))
+			})
+
+			It("Gets object by name", func() {
+				created, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object, err := generic.GetByName(ctx, "my-object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object).ToNot(BeNil())
+				Expect(object.GetId()).To(Equal(created.GetId()))
+				Expect(object.GetMetadata().GetName()).To(Equal("my-object"))
+			})
+
+			It("Returns nil if there is no object with the given name", func() {
+				object, err := generic.GetByName(ctx, "does-not-exist")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object).To(BeNil())
+			})
+
+			It("Renames object", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object.GetMetadata().SetName("your-object")
+				_, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				object, err = generic.GetByName(ctx, "my-object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object).To(BeNil())
+				object, err = generic.GetByName(ctx, "your-object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object).ToNot(BeNil())
+			})
+
+			It("Allows multiple objects without name", func() {
+				for range 2 {
+					_, err := generic.Create(ctx, &testsv1.Object{})
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("Rejects duplicated name when creating", func() {
+				_, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				_, err = generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				var nameErr *NameConflictError
+				Expect(errors.As(err, &nameErr)).To(BeTrue())
+				Expect(nameErr.Name).To(Equal("my-object"))
+			})
+
+			It("Rejects duplicated name when updating", func() {
+				_, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "your-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				object.GetMetadata().SetName("my-object")
+				_, err = generic.Update(ctx, object)
+				var nameErr *NameConflictError
+				Expect(errors.As(err, &nameErr)).To(BeTrue())
+			})
+
+			It("Allows the same name in different tenants", func() {
+				_, err := tx.Exec(ctx, `
+					insert into objects (id, tenants, name, data)
+					values ('other_object', array['other_tenant'], 'my-object', '{}')
+				`)
+				Expect(err).ToNot(HaveOccurred())
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+
+				// Only the object of the visible tenant should be found:
+				found, err := generic.GetByName(ctx, "my-object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found.GetId()).To(Equal(object.GetId()))
+			})
+
+			It("Rejects ambiguous name", func() {
+				// Create a DAO that can see multiple tenants:
+				tenancyLogic := auth.NewMockTenancyLogic(ctrl)
+				tenancyLogic.EXPECT().DetermineVisibleTenants(gomock.Any()).
+					Return([]string{"tenant_a", "tenant_b"}, nil).
+					AnyTimes()
+				multiDAO, err := NewGenericDAO[*testsv1.Object]().
+					SetLogger(logger).
+					SetTable("objects").
+					SetTenancyLogic(tenancyLogic).
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tx.Exec(ctx, `
+					insert into objects (id, tenants, name, data) values
+					('object_a', array['tenant_a'], 'my-object', '{}'),
+					('object_b', array['tenant_b'], 'my-object', '{}')
+				`)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = multiDAO.GetByName(ctx, "my-object")
+				var ambiguousErr *AmbiguousNameError
+				Expect(errors.As(err, &ambiguousErr)).To(BeTrue())
+			})
+
+			It("Archives the name", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				row := tx.QueryRow(ctx, "select name from archived_objects where id = $1", object.GetId())
+				var name string
+				err = row.Scan(&name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(name).To(Equal("my-object"))
+			})
+		})
+
+		Describe("Archive", func() {
+			It("Lists archived objects", func() {
+				// Create two objects and delete one of them:
+				kept, err := generic.Create(ctx, testsv1.Object_builder{
+					MyString: "kept",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				deleted, err := generic.Create(ctx, testsv1.Object_builder{
+					MyString: "deleted",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, deleted.GetId())
+				Expect(err).ToNot(HaveOccurred())
+
+				// Verify that only the deleted object is in the archive:
+				response, err := generic.ListArchived(ctx, ListRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Total).To(BeNumerically("==", 1))
+				Expect(response.Items).To(HaveLen(1))
+				item := response.Items[0]
+				Expect(item.GetId()).To(Equal(deleted.GetId()))
+				Expect(item.GetId()).ToNot(Equal(kept.GetId()))
+				Expect(item.GetMyString()).To(Equal("deleted"))
+				Expect(item.GetMetadata().GetDeletionTimestamp()).ToNot(BeNil())
+				Expect(item.GetMetadata().GetFinalizers()).To(BeEmpty())
+			})
+
+			It("Filters archived objects", func() {
+				for _, value := range []string{"a", "b"} {
+					object, err := generic.Create(ctx, testsv1.Object_builder{
+						MyString: value,
+					}.Build())
+					Expect(err).ToNot(HaveOccurred())
+					err = generic.Delete(ctx, object.GetId())
+					Expect(err).ToNot(HaveOccurred())
+				}
+				response, err := generic.ListArchived(ctx, ListRequest{
+					Filter: "this.my_string == 'b'",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(HaveLen(1))
+				Expect(response.Items[0].GetMyString()).To(Equal("b"))
+			})
+
+			It("Doesn't list archived objects of other tenants", func() {
+				_, err := tx.Exec(ctx, `
+					insert into archived_objects (id, creation_timestamp, deletion_timestamp, tenants, data)
+					values ('other_object', now(), now(), array['other_tenant'], '{}')
+				`)
+				Expect(err).ToNot(HaveOccurred())
+				response, err := generic.ListArchived(ctx, ListRequest{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(BeEmpty())
+				object, err := generic.GetArchived(ctx, "other_object")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object).To(BeNil())
+			})
+
+			It("Gets archived object", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Name: "my-object",
+					}.Build(),
+					MyString: "my value",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				result, err := generic.GetArchived(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).ToNot(BeNil())
+				Expect(result.GetMetadata().GetName()).To(Equal("my-object"))
+				Expect(result.GetMyString()).To(Equal("my value"))
+
+				// Verify that it is no longer available with the regular method:
+				result, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("Returns nil if archived object doesn't exist", func() {
+				result, err := generic.GetArchived(ctx, "does-not-exist")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+
+			It("Undeletes object with pending finalizers", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Finalizers: []string{"a"},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				deleted, err := generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deleted.GetMetadata().GetDeletionTimestamp()).ToNot(BeNil())
+
+				// Undelete the object and verify that the deletion timestamp has been cleared, both in the
+				// result and in the database:
+				result, err := generic.Undelete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+				Expect(result.GetMetadata().GetFinalizers()).To(ConsistOf("a"))
+				Expect(result.GetMetadata().GetVersion()).To(BeNumerically(">", deleted.GetMetadata().GetVersion()))
+				result, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+			})
+
+			It("Doesn't change object that hasn't been deleted", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{})
+				Expect(err).ToNot(HaveOccurred())
+				result, err := generic.Undelete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.GetMetadata().GetVersion()).To(Equal(object.GetMetadata().GetVersion()))
+			})
+
+			It("Can't undelete archived object", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{})
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				result, err := generic.Undelete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(BeNil())
+			})
+		})
+
+		Describe("Encryption", func() {
+			var (
+				key1      = bytes.Repeat([]byte{1}, 32)
+				key2      = bytes.Repeat([]byte{2}, 32)
+				encrypted *GenericDAO[*testsv1.Object]
+			)
+
+			makeDAO := func(keyring *encryption.Keyring) *GenericDAO[*testsv1.Object] {
+				result, err := NewGenericDAO[*testsv1.Object]().
+					SetLogger(logger).
+					SetTable("objects").
+					SetKeyring(keyring).
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				return result
+			}
+
+			readSecret := func(table, id string) string {
+				row := tx.QueryRow(ctx, fmt.Sprintf("select data->>'my_secret' from %s where id = $1", table), id)
+				var value string
+				err := row.Scan(&value)
+				Expect(err).ToNot(HaveOccurred())
+				return value
+			}
+
+			BeforeEach(func() {
+				keyring, err := encryption.NewKeyring().
+					SetLogger(logger).
+					AddKey("key-1", key1).
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				encrypted = makeDAO(keyring)
+			})
+
+			It("Stores sensitive fields encrypted and returns them decrypted", func() {
+				object, err := encrypted.Create(ctx, testsv1.Object_builder{
+					MyString: "my-value",
+					MySecret: "my-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMySecret()).To(Equal("my-secret"))
+				Expect(readSecret("objects", object.GetId())).To(HavePrefix("enc:v1:key-1:"))
+				object, err = encrypted.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMyString()).To(Equal("my-value"))
+				Expect(object.GetMySecret()).To(Equal("my-secret"))
+			})
+
+			It("Fails to read encrypted fields without a keyring", func() {
+				object, err := encrypted.Create(ctx, testsv1.Object_builder{
+					MySecret: "my-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				_, err = makeDAO(nil).Get(ctx, object.GetId())
+				Expect(err).To(MatchError(
+					"field 'tests.v1.Object.my_secret' is encrypted, but there is no keyring to decrypt it",
+				))
+			})
+
+			It("Fails to read encrypted fields copied from other object", func() {
+				mine, err := encrypted.Create(ctx, testsv1.Object_builder{
+					MySecret: "my-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				yours, err := encrypted.Create(ctx, testsv1.Object_builder{
+					MySecret: "your-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				_, err = tx.Exec(
+					ctx,
+					`update objects set data = (select data from objects where id = $1) where id = $2`,
+					mine.GetId(), yours.GetId(),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = encrypted.Get(ctx, yours.GetId())
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Re-encrypts with the primary key", func() {
+				// Create one object without encryption and other encrypted with the old key, then delete
+				// it so that it is archived:
+				plain, err := makeDAO(nil).Create(ctx, testsv1.Object_builder{
+					MySecret: "plain-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				archived, err := encrypted.Create(ctx, testsv1.Object_builder{
+					MySecret: "archived-secret",
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = encrypted.Delete(ctx, archived.GetId())
+				Expect(err).ToNot(HaveOccurred())
+
+				// Re-encrypt with a new primary key:
+				keyring, err := encryption.NewKeyring().
+					SetLogger(logger).
+					AddKey("key-1", key1).
+					AddKey("key-2", key2).
+					SetPrimary("key-2").
+					Build()
+				Expect(err).ToNot(HaveOccurred())
+				rotated := makeDAO(keyring)
+				count, err := rotated.Reencrypt(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(2))
+				Expect(readSecret("objects", plain.GetId())).To(HavePrefix("enc:v1:key-2:"))
+				Expect(readSecret("archived_objects", archived.GetId())).To(HavePrefix("enc:v1:key-2:"))
+
+				// Verify that the values are preserved, and that running it again doesn't change anything:
+				object, err := rotated.Get(ctx, plain.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMySecret()).To(Equal("plain-secret"))
+				Expect(object.GetMetadata().GetVersion()).To(Equal(plain.GetMetadata().GetVersion()))
+				object, err = rotated.GetArchived(ctx, archived.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMySecret()).To(Equal("archived-secret"))
+				count, err = rotated.Reencrypt(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			})
+		})
+
 		Describe("Paging", func() {
 			var objects []*testsv1.Object
 
@@ -719,6 +1284,317 @@ var _ = Describe("Generic DAO", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.Total).To(BeNumerically("==", objectCount))
 			})
+
+			It("Doesn't return the total number of items if asked to skip it", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit:     1,
+					SkipTotal: true,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Total).To(BeZero())
+				Expect(response.Items).To(HaveLen(1))
+			})
+
+			It("Returns next page token if the page is full", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit: 1,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.NextPageToken).ToNot(BeEmpty())
+			})
+
+			It("Doesn't return next page token if there are no more items", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Offset: objectCount - 2,
+					Limit:  10,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.NextPageToken).To(BeEmpty())
+			})
+
+			It("Iterates all the items using the page token", func() {
+				var ids []string
+				token := ""
+				for {
+					response, err := generic.List(ctx, ListRequest{
+						Limit:     3,
+						PageToken: token,
+					})
+					Expect(err).ToNot(HaveOccurred())
+					for _, item := range response.Items {
+						ids = append(ids, item.GetId())
+					}
+					token = response.NextPageToken
+					if token == "" {
+						break
+					}
+				}
+				Expect(ids).To(HaveLen(objectCount))
+				for i, id := range ids {
+					Expect(id).To(Equal(objects[i].GetId()))
+				}
+			})
+
+			It("Ignores offset when page token is used", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit: 1,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				response, err = generic.List(ctx, ListRequest{
+					Offset:    5,
+					Limit:     1,
+					PageToken: response.NextPageToken,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items[0].GetId()).To(Equal(objects[1].GetId()))
+			})
+
+			It("Rejects invalid page token", func() {
+				_, err := generic.List(ctx, ListRequest{
+					Limit:     1,
+					PageToken: "junk",
+				})
+				var requestErr *RequestError
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+			})
+
+			It("Rejects page token generated for a different order", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit: 1,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = generic.List(ctx, ListRequest{
+					Limit:     1,
+					Order:     "id desc",
+					PageToken: response.NextPageToken,
+				})
+				var requestErr *RequestError
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+			})
+
+			It("Rejects page token generated for a different filter", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit:  1,
+					Filter: "this.id != 'junk'",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = generic.List(ctx, ListRequest{
+					Limit:     1,
+					PageToken: response.NextPageToken,
+				})
+				var requestErr *RequestError
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+				_, err = generic.List(ctx, ListRequest{
+					Limit:     1,
+					Filter:    "this.id != 'other'",
+					PageToken: response.NextPageToken,
+				})
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+			})
+
+			It("Accepts page token generated for the same filter", func() {
+				response, err := generic.List(ctx, ListRequest{
+					Limit:  1,
+					Filter: "this.id != 'junk'",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				response, err = generic.List(ctx, ListRequest{
+					Limit:     1,
+					Filter:    "this.id != 'junk'",
+					PageToken: response.NextPageToken,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items[0].GetId()).To(Equal(objects[1].GetId()))
+			})
+
+			It("Rejects invalid filter", func() {
+				_, err := generic.List(ctx, ListRequest{
+					Filter: "this.junk ==",
+				})
+				var requestErr *RequestError
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+			})
+		})
+
+		Describe("Ordering", func() {
+			It("Sorts by identifier in descending order", func() {
+				ids := make([]string, 3)
+				for i := range ids {
+					object, err := generic.Create(ctx, &testsv1.Object{})
+					Expect(err).ToNot(HaveOccurred())
+					ids[i] = object.GetId()
+				}
+				slices.Sort(ids)
+				slices.Reverse(ids)
+				response, err := generic.List(ctx, ListRequest{
+					Order: "id desc",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(HaveLen(3))
+				for i, item := range response.Items {
+					Expect(item.GetId()).To(Equal(ids[i]))
+				}
+			})
+
+			It("Sorts by JSON field and then by identifier", func() {
+				for _, value := range []int32{2, 1, 2, 1} {
+					_, err := generic.Create(ctx, testsv1.Object_builder{
+						MyInt32: value,
+					}.Build())
+					Expect(err).ToNot(HaveOccurred())
+				}
+				response, err := generic.List(ctx, ListRequest{
+					Order: "this.my_int32 desc",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(HaveLen(4))
+				Expect(response.Items[0].GetMyInt32()).To(BeNumerically("==", 2))
+				Expect(response.Items[1].GetMyInt32()).To(BeNumerically("==", 2))
+				Expect(response.Items[2].GetMyInt32()).To(BeNumerically("==", 1))
+				Expect(response.Items[3].GetMyInt32()).To(BeNumerically("==", 1))
+				Expect(response.Items[0].GetId() < response.Items[1].GetId()).To(BeTrue())
+				Expect(response.Items[2].GetId() < response.Items[3].GetId()).To(BeTrue())
+			})
+
+			It("Iterates items with missing values using the page token", func() {
+				for _, value := range []string{"b", "", "a", "", "c"} {
+					object := &testsv1.Object{}
+					if value != "" {
+						object.SetMyString(value)
+					}
+					_, err := generic.Create(ctx, object)
+					Expect(err).ToNot(HaveOccurred())
+				}
+				for _, order := range []string{"my_string", "my_string desc"} {
+					var values []string
+					token := ""
+					for {
+						response, err := generic.List(ctx, ListRequest{
+							Order:     order,
+							Limit:     2,
+							PageToken: token,
+						})
+						Expect(err).ToNot(HaveOccurred())
+						for _, item := range response.Items {
+							values = append(values, item.GetMyString())
+						}
+						token = response.NextPageToken
+						if token == "" {
+							break
+						}
+					}
+					if order == "my_string" {
+						Expect(values).To(Equal([]string{"a", "b", "c", "", ""}))
+					} else {
+						Expect(values).To(Equal([]string{"", "", "c", "b", "a"}))
+					}
+				}
+			})
+
+			// listAll retrieves all the objects using the page token, with pages of the given size.
+			listAll := func(order string, limit int32) (result []*testsv1.Object) {
+				token := ""
+				for {
+					response, err := generic.List(ctx, ListRequest{
+						Order:     order,
+						Limit:     limit,
+						PageToken: token,
+						SkipTotal: true,
+					})
+					Expect(err).ToNot(HaveOccurred())
+					result = append(result, response.Items...)
+					token = response.NextPageToken
+					if token == "" {
+						return
+					}
+				}
+			}
+
+			It("Iterates items with the same creation timestamp using the page token", func() {
+				// Note that all the objects are created in the same transaction, so they all have the same
+				// creation timestamp and the page token needs to use the identifier to break the tie.
+				ids := make([]string, 5)
+				for i := range ids {
+					object, err := generic.Create(ctx, &testsv1.Object{})
+					Expect(err).ToNot(HaveOccurred())
+					ids[i] = object.GetId()
+				}
+				slices.Sort(ids)
+				for _, order := range []string{"metadata.creation_timestamp", "metadata.creation_timestamp desc"} {
+					items := listAll(order, 2)
+					Expect(items).To(HaveLen(len(ids)))
+					for i, item := range items {
+						Expect(item.GetId()).To(Equal(ids[i]))
+					}
+				}
+			})
+
+			It("Iterates items by timestamp field using the page token", func() {
+				// Use sub-second precision and values that are very close to each other, so that the text
+				// representation used in the page token has to preserve the microseconds to work correctly.
+				base := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+				offsets := []time.Duration{
+					3 * time.Microsecond,
+					1 * time.Microsecond,
+					4 * time.Hour,
+					2 * time.Microsecond,
+					0,
+				}
+				for _, offset := range offsets {
+					_, err := generic.Create(ctx, testsv1.Object_builder{
+						MyTimestamp: timestamppb.New(base.Add(offset)),
+					}.Build())
+					Expect(err).ToNot(HaveOccurred())
+				}
+				expected := slices.Clone(offsets)
+				slices.Sort(expected)
+
+				// Ascending:
+				items := listAll("my_timestamp", 1)
+				Expect(items).To(HaveLen(len(expected)))
+				for i, item := range items {
+					Expect(item.GetMyTimestamp().AsTime()).To(Equal(base.Add(expected[i])))
+				}
+
+				// Descending:
+				slices.Reverse(expected)
+				items = listAll("my_timestamp desc", 1)
+				Expect(items).To(HaveLen(len(expected)))
+				for i, item := range items {
+					Expect(item.GetMyTimestamp().AsTime()).To(Equal(base.Add(expected[i])))
+				}
+			})
+
+			It("Iterates items with missing values in descending order using the page token", func() {
+				// The database puts null values first when sorting in descending order, so the page token has
+				// to handle pages that end with a null value, and pages that start with a non null value after
+				// a null one. Note that zero values aren't stored in the JSON data, so they will be null.
+				for _, value := range []int32{0, 2, 0, 1, 0, 2} {
+					_, err := generic.Create(ctx, testsv1.Object_builder{
+						MyInt32: value,
+					}.Build())
+					Expect(err).ToNot(HaveOccurred())
+				}
+				for _, limit := range []int32{1, 2, 4} {
+					items := listAll("my_int32 desc", limit)
+					values := make([]int32, len(items))
+					for i, item := range items {
+						values[i] = item.GetMyInt32()
+					}
+					Expect(values).To(Equal([]int32{0, 0, 0, 2, 2, 1}))
+					Expect(items[0].GetId() < items[1].GetId()).To(BeTrue())
+					Expect(items[1].GetId() < items[2].GetId()).To(BeTrue())
+					Expect(items[3].GetId() < items[4].GetId()).To(BeTrue())
+				}
+			})
+
+			It("Rejects invalid order", func() {
+				_, err := generic.List(ctx, ListRequest{
+					Order: "junk",
+				})
+				var requestErr *RequestError
+				Expect(errors.As(err, &requestErr)).To(BeTrue())
+			})
 		})
 
 		Describe("Check if object exists", func() {
@@ -749,6 +1625,88 @@ var _ = Describe("Generic DAO", func() {
 			Expect(object.GetMyString()).To(Equal("your_value"))
 		})
 
+		Describe("Versions", func() {
+			It("Sets version one when creating", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 1))
+			})
+
+			It("Increments version when updating", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{
+					MyString: "my_value",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				object.SetMyString("your_value")
+				object, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+				object, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+			})
+
+			It("Doesn't increment version if there are no changes", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{
+					MyString: "my_value",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				object, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 1))
+			})
+
+			It("Increments version when deleting", func() {
+				object, err := generic.Create(ctx, testsv1.Object_builder{
+					Metadata: testsv1.Metadata_builder{
+						Finalizers: []string{"a"},
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+				err = generic.Delete(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				object, err = generic.Get(ctx, object.GetId())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+			})
+
+			It("Rejects update with stale version", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{
+					MyString: "my_value",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				stale := proto.Clone(object).(*testsv1.Object)
+				object.SetMyString("your_value")
+				_, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				stale.SetMyString("their_value")
+				_, err = generic.Update(ctx, stale)
+				var conflictErr *ConflictError
+				Expect(errors.As(err, &conflictErr)).To(BeTrue())
+				Expect(conflictErr.ID).To(Equal(object.GetId()))
+				Expect(conflictErr.Expected).To(BeNumerically("==", 1))
+				Expect(conflictErr.Actual).To(BeNumerically("==", 2))
+			})
+
+			It("Doesn't check version if it is zero", func() {
+				object, err := generic.Create(ctx, &testsv1.Object{
+					MyString: "my_value",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				object.SetMyString("your_value")
+				_, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				object.GetMetadata().SetVersion(0)
+				object.SetMyString("their_value")
+				object, err = generic.Update(ctx, object)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(object.GetMyString()).To(Equal("their_value"))
+				Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 3))
+			})
+		})
+
 		Describe("Filtering", func() {
 			It("Filters by identifier", func() {
 				for i := range 10 {
@@ -812,6 +1770,65 @@ var _ = Describe("Generic DAO", func() {
 				Expect(items[0].GetMyString()).To(Equal("my_value_5"))
 			})
 
+			It("Filters by label", func() {
+				for i := range 10 {
+					environment := "staging"
+					if i%2 == 0 {
+						environment = "production"
+					}
+					_, err := generic.Create(
+						ctx,
+						testsv1.Object_builder{
+							Id: fmt.Sprintf("%d", i),
+							Metadata: testsv1.Metadata_builder{
+								Labels: map[string]string{
+									"environment": environment,
+								},
+							}.Build(),
+						}.Build(),
+					)
+					Expect(err).ToNot(HaveOccurred())
+				}
+				response, err := generic.List(ctx, ListRequest{
+					Filter: "this.metadata.labels['environment'] == 'staging'",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				items := response.Items
+				sort(items)
+				Expect(items).To(HaveLen(5))
+				Expect(items[0].GetId()).To(Equal("1"))
+				Expect(items[1].GetId()).To(Equal("3"))
+				Expect(items[2].GetId()).To(Equal("5"))
+				Expect(items[3].GetId()).To(Equal("7"))
+				Expect(items[4].GetId()).To(Equal("9"))
+			})
+
+			It("Filters by presence of label", func() {
+				for i := range 10 {
+					labels := map[string]string{}
+					if i == 3 {
+						labels["owner"] = "joe"
+					}
+					_, err := generic.Create(
+						ctx,
+						testsv1.Object_builder{
+							Id: fmt.Sprintf("%d", i),
+							Metadata: testsv1.Metadata_builder{
+								Labels: labels,
+							}.Build(),
+						}.Build(),
+					)
+					Expect(err).ToNot(HaveOccurred())
+				}
+				response, err := generic.List(ctx, ListRequest{
+					Filter: "'owner' in this.metadata.labels",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				items := response.Items
+				Expect(items).To(HaveLen(1))
+				Expect(items[0].GetId()).To(Equal("3"))
+			})
+
 			It("Filters by identifier or JSON field", func() {
 				for i := range 10 {
 					_, err := generic.Create(
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package dao
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// pageToken contains the information stored in the opaque page tokens used for keyset pagination. It contains the order
+// criteria and a hash of the filter that were used to generate it, and the text representation of the values of the
+// order terms for the last item of the page. Nil values represent SQL null values.
+type pageToken struct {
+	Order  string    `json:"o,omitempty"`
+	Filter string    `json:"f,omitempty"`
+	Values []*string `json:"v"`
+}
+
+// hashPageTokenFilter calculates the hash of the filter that is stored in the page token. The filter itself isn't
+// stored because it can be long, and because the token only needs to detect that the filter has changed. The empty
+// filter is represented by the empty hash.
+func hashPageTokenFilter(filter string) string {
+	if filter == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(filter))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// encodePageToken converts the page token into an opaque string that can be returned to clients.
+func encodePageToken(token *pageToken) (result string, err error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return
+	}
+	result = base64.RawURLEncoding.EncodeToString(data)
+	return
+}
+
+// decodePageToken converts the opaque string received from a client into a page token, and checks that it was
+// generated for the given order criteria, filter and number of terms.
+func decodePageToken(text string, order string, filter string, terms int) (result *pageToken, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(text)
+	if err != nil {
+		err = errors.New("page token isn't valid")
+		return
+	}
+	token := &pageToken{}
+	err = json.Unmarshal(data, token)
+	if err != nil {
+		err = errors.New("page token isn't valid")
+		return
+	}
+	if token.Order != order {
+		err = fmt.Errorf(
+			"page token was generated for order '%s' but the requested order is '%s'",
+			token.Order, order,
+		)
+		return
+	}
+	if token.Filter != hashPageTokenFilter(filter) {
+		err = errors.New("page token was generated for a different filter than the requested one")
+		return
+	}
+	if len(token.Values) != terms {
+		err = errors.New("page token isn't valid")
+		return
+	}
+	result = token
+	return
+}
@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+// TenantsLookupBuilder contains the data and logic needed to create a tenants lookup. Don't create instances of this
+// type directly, use the NewTenantsLookup function instead.
+type TenantsLookupBuilder struct {
+	logger    *slog.Logger
+	txManager database.TxManager
+	tables    map[string]string
+}
+
+// TenantsLookup finds the tenants of the object targeted by a gRPC request. It is intended for authorization checks,
+// so it doesn't apply the tenancy filter: it returns the tenants of the object even if the current user can't see it.
+type TenantsLookup struct {
+	logger    *slog.Logger
+	txManager database.TxManager
+	tables    map[string]string
+}
+
+// NewTenantsLookup creates a builder that can then be used to configure and create a tenants lookup.
+func NewTenantsLookup() *TenantsLookupBuilder {
+	return &TenantsLookupBuilder{
+		tables: map[string]string{},
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *TenantsLookupBuilder) SetLogger(value *slog.Logger) *TenantsLookupBuilder {
+	b.logger = value
+	return b
+}
+
+// SetTxManager sets the transaction manager used to begin a short transaction when the context doesn't already contain
+// one, as happens for streaming methods. This is optional, and if not set the context must always contain a
+// transaction.
+func (b *TenantsLookupBuilder) SetTxManager(value database.TxManager) *TenantsLookupBuilder {
+	b.txManager = value
+	return b
+}
+
+// AddTable associates a gRPC service, for example 'fulfillment.v1.Clusters', with the table that stores its objects.
+// Requests for services that haven't been added will not have tenants.
+func (b *TenantsLookupBuilder) AddTable(service string, table string) *TenantsLookupBuilder {
+	b.tables[service] = table
+	return b
+}
+
+// Build uses the data stored in the builder to create a new tenants lookup.
+func (b *TenantsLookupBuilder) Build() (result *TenantsLookup, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &TenantsLookup{
+		logger:    b.logger,
+		txManager: b.txManager,
+		tables:    maps.Clone(b.tables),
+	}
+	return
+}
+
+// Lookup returns the tenants of the object targeted by the given request. The identifier of the object is taken from
+// the 'id' field of the request, or from the 'id' field of the 'object' field. It returns an empty list if the
+// service isn't known, if the request doesn't contain an identifier, or if the object doesn't exist.
+func (l *TenantsLookup) Lookup(ctx context.Context, method string, request any) (result []string, err error) {
+	// Find the table:
+	service := strings.TrimPrefix(method, "/")
+	index := strings.LastIndex(service, "/")
+	if index >= 0 {
+		service = service[:index]
+	}
+	table, ok := l.tables[service]
+	if !ok {
+		return
+	}
+
+	// Find the identifier:
+	message, ok := request.(proto.Message)
+	if !ok {
+		return
+	}
+	id := l.findId(message.ProtoReflect())
+	if id == "" {
+		return
+	}
+
+	// Get the transaction from the context, or begin a new one if there is no transaction there:
+	tx, err := database.TxFromContext(ctx)
+	if err != nil && l.txManager != nil {
+		tx, err = l.txManager.Begin(ctx)
+		if err != nil {
+			return
+		}
+		defer func() {
+			endErr := l.txManager.End(ctx, tx)
+			if err == nil {
+				err = endErr
+			}
+		}()
+	}
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+
+	// Run the query:
+	sql := fmt.Sprintf(`select tenants from %s where id = $1`, table)
+	l.logger.DebugContext(
+		ctx,
+		"Running SQL query",
+		slog.String("sql", sql),
+		slog.String("id", id),
+	)
+	row := tx.QueryRow(ctx, sql, id)
+	err = row.Scan(&result)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+	return
+}
+
+// findId returns the value of the 'id' field of the message, or of the 'id' field of the 'object' field.
+func (l *TenantsLookup) findId(message protoreflect.Message) string {
+	fields := message.Descriptor().Fields()
+	idField := fields.ByName(idFieldName)
+	if idField != nil && idField.Kind() == protoreflect.StringKind {
+		return message.Get(idField).String()
+	}
+	objectField := fields.ByName(tenantsLookupObjectFieldName)
+	if objectField != nil && objectField.Kind() == protoreflect.MessageKind && message.Has(objectField) {
+		object := message.Get(objectField).Message()
+		idField = object.Descriptor().Fields().ByName(idFieldName)
+		if idField != nil && idField.Kind() == protoreflect.StringKind {
+			return object.Get(idField).String()
+		}
+	}
+	return ""
+}
+
+// tenantsLookupObjectFieldName is the name of the field of update requests that contains the object.
+var tenantsLookupObjectFieldName = protoreflect.Name("object")
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package dao
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	testsv1 "github.com/jkary/osac/fulfillment/service/internal/api/tests/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+var _ = Describe("Tenants lookup", func() {
+	var (
+		ctx    context.Context
+		tm     database.TxManager
+		lookup *TenantsLookup
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err := pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the transaction manager:
+		tm, err = database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start a transaction and add it to the context:
+		tx, err := tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		ctx = database.TxIntoContext(ctx, tx)
+
+		// Create the table and an object:
+		_, err = tx.Exec(
+			ctx,
+			`
+			create table objects (
+				id text not null primary key,
+				tenants text[] not null default '{}',
+				data jsonb not null
+			);
+
+			insert into objects (id, tenants, data) values ('123', '{"blue", "green"}', '{}');
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the lookup:
+		lookup, err = NewTenantsLookup().
+			SetLogger(logger).
+			AddTable("tests.v1.Objects", "objects").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can't be built without a logger", func() {
+		_, err := NewTenantsLookup().Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+	})
+
+	It("Returns the tenants of the object", func() {
+		request := testsv1.Object_builder{
+			Id: "123",
+		}.Build()
+		result, err := lookup.Lookup(ctx, "/tests.v1.Objects/Get", request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(ConsistOf("blue", "green"))
+	})
+
+	It("Returns nothing if the object doesn't exist", func() {
+		request := testsv1.Object_builder{
+			Id: "456",
+		}.Build()
+		result, err := lookup.Lookup(ctx, "/tests.v1.Objects/Get", request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+
+	It("Begins a transaction if there is none in the context", func() {
+		// Create the object in a committed transaction, so that it is visible to the transaction created by the
+		// lookup:
+		tx, err := tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = tx.Exec(
+			ctx,
+			`
+			create table committed_objects (
+				id text not null primary key,
+				tenants text[] not null default '{}',
+				data jsonb not null
+			);
+
+			insert into committed_objects (id, tenants, data) values ('123', '{"red"}', '{}');
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		err = tm.End(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check that the lookup works with a context that doesn't contain a transaction:
+		lookup, err := NewTenantsLookup().
+			SetLogger(logger).
+			SetTxManager(tm).
+			AddTable("tests.v1.Objects", "committed_objects").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		request := testsv1.Object_builder{
+			Id: "123",
+		}.Build()
+		result, err := lookup.Lookup(context.Background(), "/tests.v1.Objects/Get", request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(ConsistOf("red"))
+	})
+
+	It("Returns nothing if the service isn't known", func() {
+		request := testsv1.Object_builder{
+			Id: "123",
+		}.Build()
+		result, err := lookup.Lookup(ctx, "/tests.v1.Junk/Get", request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+})
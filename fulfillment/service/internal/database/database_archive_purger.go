@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ArchivePurgerBuilder contains the data and logic needed to build an archive purger. Don't create instances of this
+// type directly, use the NewArchivePurger function instead.
+type ArchivePurgerBuilder struct {
+	logger        *slog.Logger
+	pool          *pgxpool.Pool
+	tables        []string
+	retentionTime time.Duration
+	interval      time.Duration
+}
+
+// ArchivePurger periodically deletes from the archive tables the objects that were archived more than the retention
+// time ago. The archive tables are the tables where the DAOs move the objects when they are completely deleted, and
+// they are expected to have an `archival_timestamp` column.
+type ArchivePurger struct {
+	logger        *slog.Logger
+	pool          *pgxpool.Pool
+	tables        []string
+	retentionTime time.Duration
+	interval      time.Duration
+}
+
+// NewArchivePurger creates a builder that can then be used to configure and create an archive purger.
+func NewArchivePurger() *ArchivePurgerBuilder {
+	return &ArchivePurgerBuilder{
+		retentionTime: archivePurgerDefaultRetentionTime,
+		interval:      archivePurgerDefaultInterval,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *ArchivePurgerBuilder) SetLogger(value *slog.Logger) *ArchivePurgerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetPool sets the database connection pool. This is mandatory.
+func (b *ArchivePurgerBuilder) SetPool(value *pgxpool.Pool) *ArchivePurgerBuilder {
+	b.pool = value
+	return b
+}
+
+// AddTable adds an archive table, for example `archived_clusters`. At least one is mandatory.
+func (b *ArchivePurgerBuilder) AddTable(value string) *ArchivePurgerBuilder {
+	b.tables = append(b.tables, value)
+	return b
+}
+
+// AddTables adds a set of archive tables.
+func (b *ArchivePurgerBuilder) AddTables(values ...string) *ArchivePurgerBuilder {
+	b.tables = append(b.tables, values...)
+	return b
+}
+
+// SetRetentionTime sets how long archived objects are kept. Zero means that archived objects are kept forever. The
+// default is ninety days.
+func (b *ArchivePurgerBuilder) SetRetentionTime(value time.Duration) *ArchivePurgerBuilder {
+	b.retentionTime = value
+	return b
+}
+
+// SetInterval sets how often archived objects older than the retention time are deleted. The default is one hour.
+func (b *ArchivePurgerBuilder) SetInterval(value time.Duration) *ArchivePurgerBuilder {
+	b.interval = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new archive purger.
+func (b *ArchivePurgerBuilder) Build() (result *ArchivePurger, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.pool == nil {
+		err = errors.New("database connection pool is mandatory")
+		return
+	}
+	if len(b.tables) == 0 {
+		err = errors.New("at least one table is mandatory")
+		return
+	}
+	if b.retentionTime < 0 {
+		err = fmt.Errorf("retention time should be zero or positive, but it is %s", b.retentionTime)
+		return
+	}
+	if b.interval <= 0 {
+		err = fmt.Errorf("interval should be positive, but it is %s", b.interval)
+		return
+	}
+
+	// Create and populate the object:
+	result = &ArchivePurger{
+		logger:        b.logger,
+		pool:          b.pool,
+		tables:        slices.Clone(b.tables),
+		retentionTime: b.retentionTime,
+		interval:      b.interval,
+	}
+	return
+}
+
+// Start purges the archive tables immediately and then periodically, till the context is canceled. If the retention
+// time is zero it returns immediately without doing anything.
+func (p *ArchivePurger) Start(ctx context.Context) error {
+	if p.retentionTime == 0 {
+		p.logger.InfoContext(ctx, "Archived objects will be kept forever because the retention time is zero")
+		return nil
+	}
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		err := p.Purge(ctx)
+		if err != nil {
+			p.logger.ErrorContext(
+				ctx,
+				"Failed to purge archived objects",
+				slog.Any("error", err),
+			)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes from the archive tables the objects that were archived more than the retention time ago.
+func (p *ArchivePurger) Purge(ctx context.Context) error {
+	if p.retentionTime == 0 {
+		return nil
+	}
+	limit := time.Now().Add(-p.retentionTime)
+	for _, table := range p.tables {
+		sql := fmt.Sprintf(`delete from %s where archival_timestamp < $1`, table)
+		p.logger.DebugContext(
+			ctx,
+			"Running SQL statement",
+			slog.String("sql", sql),
+			slog.Time("limit", limit),
+		)
+		tag, err := p.pool.Exec(ctx, sql, limit)
+		if err != nil {
+			return fmt.Errorf("failed to purge table '%s': %w", table, err)
+		}
+		if tag.RowsAffected() > 0 {
+			p.logger.InfoContext(
+				ctx,
+				"Purged archived objects",
+				slog.String("table", table),
+				slog.Int64("count", tag.RowsAffected()),
+			)
+		}
+	}
+	return nil
+}
+
+// Defaults for the archive purger:
+const (
+	archivePurgerDefaultRetentionTime = 90 * 24 * time.Hour
+	archivePurgerDefaultInterval      = 1 * time.Hour
+)
@@ -0,0 +1,218 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archive purger", func() {
+	var (
+		ctx  context.Context
+		pool *pgxpool.Pool
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := dbServer.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err = pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the archive tables:
+		_, err = pool.Exec(
+			ctx,
+			`
+			create table archived_a (
+				id text not null,
+				archival_timestamp timestamp with time zone not null default now()
+			);
+
+			create table archived_b (
+				id text not null,
+				archival_timestamp timestamp with time zone not null default now()
+			);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// ids returns the identifiers of the rows of the given table.
+	ids := func(table string) []string {
+		rows, err := pool.Query(ctx, "select id from "+table+" order by id")
+		Expect(err).ToNot(HaveOccurred())
+		defer rows.Close()
+		var result []string
+		for rows.Next() {
+			var id string
+			err = rows.Scan(&id)
+			Expect(err).ToNot(HaveOccurred())
+			result = append(result, id)
+		}
+		Expect(rows.Err()).ToNot(HaveOccurred())
+		return result
+	}
+
+	Describe("Creation", func() {
+		It("Can be created when all the required parameters are set", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTable("archived_a").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(purger).ToNot(BeNil())
+		})
+
+		It("Can't be created without a logger", func() {
+			purger, err := NewArchivePurger().
+				SetPool(pool).
+				AddTable("archived_a").
+				Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+			Expect(purger).To(BeNil())
+		})
+
+		It("Can't be created without a pool", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				AddTable("archived_a").
+				Build()
+			Expect(err).To(MatchError("database connection pool is mandatory"))
+			Expect(purger).To(BeNil())
+		})
+
+		It("Can't be created without tables", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				Build()
+			Expect(err).To(MatchError("at least one table is mandatory"))
+			Expect(purger).To(BeNil())
+		})
+
+		It("Can't be created with negative retention time", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTable("archived_a").
+				SetRetentionTime(-time.Hour).
+				Build()
+			Expect(err).To(MatchError("retention time should be zero or positive, but it is -1h0m0s"))
+			Expect(purger).To(BeNil())
+		})
+
+		It("Can't be created with zero interval", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTable("archived_a").
+				SetInterval(0).
+				Build()
+			Expect(err).To(MatchError("interval should be positive, but it is 0s"))
+			Expect(purger).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		BeforeEach(func() {
+			_, err := pool.Exec(
+				ctx,
+				`
+				insert into archived_a (id, archival_timestamp) values
+				('old', now() - interval '2 days'),
+				('new', now());
+
+				insert into archived_b (id, archival_timestamp) values
+				('old', now() - interval '2 days'),
+				('new', now());
+				`,
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Deletes rows older than the retention time from all the tables", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTables("archived_a", "archived_b").
+				SetRetentionTime(24 * time.Hour).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = purger.Purge(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids("archived_a")).To(ConsistOf("new"))
+			Expect(ids("archived_b")).To(ConsistOf("new"))
+		})
+
+		It("Doesn't delete rows of tables that weren't added", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTable("archived_a").
+				SetRetentionTime(24 * time.Hour).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = purger.Purge(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids("archived_a")).To(ConsistOf("new"))
+			Expect(ids("archived_b")).To(ConsistOf("new", "old"))
+		})
+
+		It("Doesn't delete anything if the retention time is zero", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTables("archived_a", "archived_b").
+				SetRetentionTime(0).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			err = purger.Purge(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids("archived_a")).To(ConsistOf("new", "old"))
+			Expect(ids("archived_b")).To(ConsistOf("new", "old"))
+		})
+
+		It("Purges when started and stops when the context is canceled", func() {
+			purger, err := NewArchivePurger().
+				SetLogger(logger).
+				SetPool(pool).
+				AddTables("archived_a", "archived_b").
+				SetRetentionTime(24 * time.Hour).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			startCtx, cancel := context.WithCancel(ctx)
+			done := make(chan error)
+			go func() {
+				done <- purger.Start(startCtx)
+			}()
+			Eventually(func() []string {
+				return ids("archived_a")
+			}).Should(ConsistOf("new"))
+			cancel()
+			Eventually(done).Should(Receive(MatchError(context.Canceled)))
+		})
+	})
+})
@@ -27,10 +27,14 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 )
 
-// ListenerPayloadCallback is a function that will be called by the listener when a notification arrives.
-type ListenerPayloadCallback func(ctx context.Context, payload proto.Message) error
+// ListenerPayloadCallback is a function that will be called by the listener when a notification arrives. The sequence
+// number is the position of the notification in the stream of notifications, and can be used to resume processing
+// from that position.
+type ListenerPayloadCallback func(ctx context.Context, seq int64, payload proto.Message) error
 
 // ListenerReadyCallback is a function that will be called by the listener when it is actually listening for
 // notifications.
@@ -210,6 +214,7 @@ func (l *Listener) Listen(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		metrics.ListenerReconnects.WithLabelValues(l.channel).Inc()
 		err = l.listenLoop(ctx)
 		if err != nil {
 			return err
@@ -280,6 +285,7 @@ func (l *Listener) wait(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	metrics.ListenerNotifications.WithLabelValues(l.channel).Inc()
 	l.processNotification(ctx, notification)
 	return nil
 }
@@ -295,9 +301,12 @@ func (l *Listener) processNotification(ctx context.Context, notification *pgconn
 
 	// Get the payload from the database:
 	id := notification.Payload
-	row := l.conn.QueryRow(ctx, "select payload from notifications where id = $1", id)
-	var data []byte
-	err := row.Scan(&data)
+	row := l.conn.QueryRow(ctx, "select seq, payload from notifications where id = $1", id)
+	var (
+		seq  int64
+		data []byte
+	)
+	err := row.Scan(&seq, &data)
 	if err != nil {
 		l.logger.ErrorContext(
 			ctx,
@@ -352,7 +361,7 @@ func (l *Listener) processNotification(ctx context.Context, notification *pgconn
 	}
 
 	// Run the callbacks:
-	l.runPayloadCallbacks(ctx, payload)
+	l.runPayloadCallbacks(ctx, seq, payload)
 }
 
 func (l *Listener) runReadyCallbacks(ctx context.Context) {
@@ -377,10 +386,10 @@ func (l *Listener) runReadyCallbacks(ctx context.Context) {
 	)
 }
 
-func (l *Listener) runPayloadCallbacks(ctx context.Context, payload proto.Message) {
+func (l *Listener) runPayloadCallbacks(ctx context.Context, seq int64, payload proto.Message) {
 	errors := 0
 	for i, payloadCallback := range l.payloadCallbacks {
-		err := payloadCallback(ctx, payload)
+		err := payloadCallback(ctx, seq, payload)
 		if err != nil {
 			l.logger.ErrorContext(
 				ctx,
@@ -32,7 +32,7 @@ var _ = Describe("Listener", func() {
 		const url = "postgresql://myserver/mydb"
 
 		// A payload callback that does nothing.
-		nothing := func(ctx context.Context, payload proto.Message) error {
+		nothing := func(ctx context.Context, seq int64, payload proto.Message) error {
 			return nil
 		}
 
@@ -143,8 +143,17 @@ var _ = Describe("Listener", func() {
 				create table notifications (
 					id text not null primary key,
 					creation_timestamp timestamp with time zone default now(),
-					payload bytea
+					payload bytea,
+					seq bigserial not null,
+					xid xid8 not null default pg_current_xact_id(),
+					horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot())
 				);
+
+				create table notifications_watermark (
+					pruned bigint not null
+				);
+
+				insert into notifications_watermark (pruned) values (0);
 				`,
 			)
 			Expect(err).ToNot(HaveOccurred())
@@ -164,7 +173,7 @@ var _ = Describe("Listener", func() {
 					close(ready)
 					return nil
 				}).
-				AddPayloadCallback(func(ctx context.Context, payload proto.Message) error {
+				AddPayloadCallback(func(ctx context.Context, seq int64, payload proto.Message) error {
 					payloads <- payload
 					return nil
 				}).
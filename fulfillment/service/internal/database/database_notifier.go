@@ -16,29 +16,46 @@ package database
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 )
 
 // NotifierBuilder contains the data and logic needed to build a notifier.
 type NotifierBuilder struct {
-	logger  *slog.Logger
-	channel string
+	logger         *slog.Logger
+	channel        string
+	retentionTime  time.Duration
+	retentionCount int
 }
 
 // Notifier knows how to send notifications using PostgreSQL's NOTIFY command, using protocol buffers messages as
 // payload.
+//
+// Notifications are saved to the `notifications` table and each of them gets a sequence number. Sequence numbers are
+// assigned when the notification is sent, but transactions aren't serialized, so they may be committed in a different
+// order. To compensate for that each notification also saves the identifier of its transaction and the oldest
+// transaction that was still running when it was sent. The replayer uses that to find the notifications of
+// transactions that were committed later even if they have lower sequence numbers.
 type Notifier struct {
-	logger  *slog.Logger
-	channel string
+	logger         *slog.Logger
+	channel        string
+	retentionTime  time.Duration
+	retentionCount int
 }
 
 // NewNotifier uses the information stored in the builder to create a new notifier.
 func NewNotifier() *NotifierBuilder {
-	return &NotifierBuilder{}
+	return &NotifierBuilder{
+		retentionTime: 1 * time.Minute,
+	}
 }
 
 // SetLogger sets the logger for the notifier. This is mandatory.
@@ -53,6 +70,21 @@ func (b *NotifierBuilder) SetChannel(value string) *NotifierBuilder {
 	return b
 }
 
+// SetRetentionTime sets how long notifications will be kept in the database. Clients can resume watching from any
+// notification that is still in the database. This is optional, and the default is one minute. A zero value means
+// that notifications will not be deleted because of their age.
+func (b *NotifierBuilder) SetRetentionTime(value time.Duration) *NotifierBuilder {
+	b.retentionTime = value
+	return b
+}
+
+// SetRetentionCount sets the maximum number of notifications that will be kept in the database. This is optional, and
+// the default is zero, which means that notifications will not be deleted because of their number.
+func (b *NotifierBuilder) SetRetentionCount(value int) *NotifierBuilder {
+	b.retentionCount = value
+	return b
+}
+
 // Build constructs a notifier instance using the configured parameters.
 func (b *NotifierBuilder) Build() (result *Notifier, err error) {
 	// Check parameters:
@@ -64,14 +96,28 @@ func (b *NotifierBuilder) Build() (result *Notifier, err error) {
 		err = errors.New("channel is mandatory")
 		return
 	}
+	if b.retentionTime < 0 {
+		err = fmt.Errorf("retention time should be zero or positive, but it is %s", b.retentionTime)
+		return
+	}
+	if b.retentionCount < 0 {
+		err = fmt.Errorf("retention count should be zero or positive, but it is %d", b.retentionCount)
+		return
+	}
+	if b.retentionTime == 0 && b.retentionCount == 0 {
+		err = errors.New("at least one of retention time or retention count should be positive")
+		return
+	}
 
 	// Create and populate the object:
 	logger := b.logger.With(
 		slog.String("channel", b.channel),
 	)
 	result = &Notifier{
-		logger:  logger,
-		channel: b.channel,
+		logger:         logger,
+		channel:        b.channel,
+		retentionTime:  b.retentionTime,
+		retentionCount: b.retentionCount,
 	}
 	return
 }
@@ -107,8 +153,15 @@ func (n *Notifier) Notify(ctx context.Context, payload proto.Message) (err error
 	// Generate an identifier:
 	id := uuid.NewString()
 
-	// Save the payload to the database:
-	_, err = tx.Exec(ctx, "insert into notifications (id, payload) values ($1, $2)", id, data)
+	// Save the payload to the database. Note that the identifier of the transaction and the oldest running
+	// transaction are filled by the defaults of the `xid` and `horizon` columns.
+	row := tx.QueryRow(
+		ctx,
+		"insert into notifications (id, payload) values ($1, $2) returning seq",
+		id, data,
+	)
+	var seq int64
+	err = row.Scan(&seq)
 	if err != nil {
 		return err
 	}
@@ -118,28 +171,82 @@ func (n *Notifier) Notify(ctx context.Context, payload proto.Message) (err error
 	if err != nil {
 		return err
 	}
+	metrics.NotifierNotifications.WithLabelValues(n.channel).Inc()
 	if n.logger.Enabled(ctx, slog.LevelDebug) {
 		n.logger.DebugContext(
 			ctx,
 			"Sent notification",
 			slog.String("id", id),
+			slog.Int64("seq", seq),
 			slog.Any("payload", payload),
 		)
 	}
 
 	// Delete old notifications:
-	tag, err := tx.Exec(ctx, "delete from notifications where creation_timestamp < now() - interval '1 minute'")
+	return n.prune(ctx, tx, seq)
+}
+
+// prune deletes the notifications that are older than the retention time or that exceed the retention count, and
+// updates the watermark that remembers the sequence number of the last deleted notification.
+//
+// The watermark is locked till the end of the transaction, so to avoid serializing all the transactions that send
+// notifications this does nothing if it is already locked by another transaction, as that one is already pruning.
+func (n *Notifier) prune(ctx context.Context, tx Tx, last int64) error {
+	row := tx.QueryRow(ctx, "select pruned from notifications_watermark for update skip locked")
+	var current int64
+	err := row.Scan(&current)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	count := tag.RowsAffected()
-	if count > 0 {
-		n.logger.DebugContext(
-			ctx,
-			"Deleted old notifications",
-			slog.Int64("count", count),
-		)
+	var cutoff time.Time
+	if n.retentionTime > 0 {
+		cutoff = time.Now().Add(-n.retentionTime)
 	}
-
+	var limit int64
+	if n.retentionCount > 0 {
+		limit = last - int64(n.retentionCount)
+	}
+	rows, err := tx.Query(
+		ctx,
+		"delete from notifications where creation_timestamp < $1 or seq <= $2 returning seq",
+		cutoff, limit,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	var (
+		count  int
+		pruned int64
+	)
+	for rows.Next() {
+		var seq int64
+		err = rows.Scan(&seq)
+		if err != nil {
+			return err
+		}
+		pruned = max(pruned, seq)
+		count++
+	}
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+	if count == 0 || pruned <= current {
+		return nil
+	}
+	_, err = tx.Exec(ctx, "update notifications_watermark set pruned = $1", pruned)
+	if err != nil {
+		return err
+	}
+	n.logger.DebugContext(
+		ctx,
+		"Deleted old notifications",
+		slog.Int("count", count),
+		slog.Int64("pruned", pruned),
+	)
 	return nil
 }
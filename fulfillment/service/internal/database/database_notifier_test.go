@@ -15,7 +15,9 @@ package database
 
 import (
 	"context"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -51,8 +53,17 @@ var _ = Describe("Notifier", func() {
 				create table notifications (
 					id text not null primary key,
 					creation_timestamp timestamp with time zone default now(),
-					payload bytea
+					payload bytea,
+					seq bigserial not null,
+					xid xid8 not null default pg_current_xact_id(),
+					horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot())
 				);
+
+				create table notifications_watermark (
+					pruned bigint not null
+				);
+
+				insert into notifications_watermark (pruned) values (0);
 				`,
 		)
 		Expect(err).ToNot(HaveOccurred())
@@ -100,6 +111,26 @@ var _ = Describe("Notifier", func() {
 			Expect(err).To(MatchError("channel is mandatory"))
 			Expect(notifier).To(BeNil())
 		})
+
+		It("Can't be created with negative retention time", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				SetRetentionTime(-time.Minute).
+				Build()
+			Expect(err).To(MatchError("retention time should be zero or positive, but it is -1m0s"))
+			Expect(notifier).To(BeNil())
+		})
+
+		It("Can't be created without retention time or count", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				SetRetentionTime(0).
+				Build()
+			Expect(err).To(MatchError("at least one of retention time or retention count should be positive"))
+			Expect(notifier).To(BeNil())
+		})
 	})
 
 	Describe("Behaviour", func() {
@@ -152,5 +183,120 @@ var _ = Describe("Notifier", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(count).To(BeZero())
 		})
+
+		It("Assigns increasing sequence numbers", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			for i := range 3 {
+				runWithTx(func(ctx context.Context) {
+					err = notifier.Notify(ctx, wrapperspb.Int32(int32(i)))
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			rows, err := pool.Query(ctx, `select seq from notifications order by creation_timestamp, seq`)
+			Expect(err).ToNot(HaveOccurred())
+			seqs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(seqs).To(HaveLen(3))
+			Expect(seqs[0]).To(BeNumerically("<", seqs[1]))
+			Expect(seqs[1]).To(BeNumerically("<", seqs[2]))
+		})
+
+		It("Saves the transaction and the horizon", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			runWithTx(func(ctx context.Context) {
+				err = notifier.Notify(ctx, wrapperspb.Int32(42))
+			})
+			Expect(err).ToNot(HaveOccurred())
+			row := pool.QueryRow(ctx, `select horizon <= xid from notifications`)
+			var ok bool
+			err = row.Scan(&ok)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("Doesn't wait for other transactions that are pruning", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				SetRetentionTime(0).
+				SetRetentionCount(1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// Lock the watermark in another transaction, as if it was pruning:
+			other, err := pool.Begin(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(func() {
+				err := other.Rollback(ctx)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			_, err = other.Exec(ctx, `select pruned from notifications_watermark for update`)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Send notifications, which would block if they waited for the lock:
+			for i := range 3 {
+				runWithTx(func(ctx context.Context) {
+					err = notifier.Notify(ctx, wrapperspb.Int32(int32(i)))
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// Check that nothing was deleted:
+			row := pool.QueryRow(ctx, `select count(*) from notifications`)
+			var count int
+			err = row.Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(3))
+		})
+
+		It("Deletes notifications that exceed the retention count", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				SetRetentionTime(0).
+				SetRetentionCount(2).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			for i := range 5 {
+				runWithTx(func(ctx context.Context) {
+					err = notifier.Notify(ctx, wrapperspb.Int32(int32(i)))
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			row := pool.QueryRow(ctx, `select count(*) from notifications`)
+			var count int
+			err = row.Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(2))
+		})
+
+		It("Updates the watermark when notifications are deleted", func() {
+			notifier, err := NewNotifier().
+				SetLogger(logger).
+				SetChannel(channel).
+				SetRetentionTime(0).
+				SetRetentionCount(1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			for i := range 3 {
+				runWithTx(func(ctx context.Context) {
+					err = notifier.Notify(ctx, wrapperspb.Int32(int32(i)))
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			row := pool.QueryRow(ctx, `select (select pruned from notifications_watermark), max(seq) from notifications`)
+			var pruned, last int64
+			err = row.Scan(&pruned, &last)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal(last - 1))
+		})
 	})
 })
@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ReplayerBuilder contains the data and logic needed to build a replayer.
+type ReplayerBuilder struct {
+	logger *slog.Logger
+	pool   *pgxpool.Pool
+}
+
+// Replayer knows how to read again the notifications that are still saved in the database, so that clients that were
+// disconnected can resume processing from the last notification that they received.
+type Replayer struct {
+	logger *slog.Logger
+	pool   *pgxpool.Pool
+}
+
+// PrunedError is the error returned by the replayer when some of the notifications that the client requested have
+// already been deleted from the database. When this happens the client will need to obtain the current state by other
+// means.
+type PrunedError struct {
+	// Since is the sequence number requested by the client.
+	Since int64
+
+	// Pruned is the sequence number of the last notification that has been deleted.
+	Pruned int64
+}
+
+// Error is the implementation of the error interface.
+func (e *PrunedError) Error() string {
+	return fmt.Sprintf(
+		"notifications after %d are no longer available, notifications up to %d have been deleted",
+		e.Since, e.Pruned,
+	)
+}
+
+// NewReplayer creates a builder that can then be used to configure and create a replayer.
+func NewReplayer() *ReplayerBuilder {
+	return &ReplayerBuilder{}
+}
+
+// SetLogger sets the logger for the replayer. This is mandatory.
+func (b *ReplayerBuilder) SetLogger(value *slog.Logger) *ReplayerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetPool sets the database connection pool. This is mandatory.
+func (b *ReplayerBuilder) SetPool(value *pgxpool.Pool) *ReplayerBuilder {
+	b.pool = value
+	return b
+}
+
+// Build constructs a replayer instance using the configured parameters.
+func (b *ReplayerBuilder) Build() (result *Replayer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.pool == nil {
+		err = errors.New("database connection pool is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &Replayer{
+		logger: b.logger,
+		pool:   b.pool,
+	}
+	return
+}
+
+// Replay calls the callback for all the notifications that have a sequence number greater than the given one, and for
+// the notifications that have lower sequence numbers but whose transactions may have been committed after the given
+// one, in order of sequence number. Note that this means that the callback may be called for notifications that the
+// client already processed. If some of those notifications have already been deleted it returns a PrunedError without
+// calling the callback. Errors returned by the callback stop the replay and are returned to the caller. The returned
+// value is the highest sequence number processed, or the given one if there were no notifications.
+func (r *Replayer) Replay(ctx context.Context, since int64, callback ListenerPayloadCallback) (last int64, err error) {
+	// Read the notifications. Note that we read all of them before calling the callback because the callback may take
+	// long or block, and we don't want to keep the database transaction open during that time.
+	notifications, err := r.read(ctx, since)
+	if err != nil {
+		return
+	}
+	r.logger.DebugContext(
+		ctx,
+		"Replaying notifications",
+		slog.Int64("since", since),
+		slog.Int("count", len(notifications)),
+	)
+
+	// Decode the payloads and call the callback:
+	last = since
+	for _, notification := range notifications {
+		wrapper := &anypb.Any{}
+		err = proto.Unmarshal(notification.data, wrapper)
+		if err != nil {
+			err = fmt.Errorf("failed to unmarshal payload of notification %d: %w", notification.seq, err)
+			return
+		}
+		var payload proto.Message
+		payload, err = wrapper.UnmarshalNew()
+		if err != nil {
+			err = fmt.Errorf("failed to unwrap payload of notification %d: %w", notification.seq, err)
+			return
+		}
+		err = callback(ctx, notification.seq, payload)
+		if err != nil {
+			return
+		}
+		last = max(last, notification.seq)
+	}
+	return
+}
+
+type replayerNotification struct {
+	seq  int64
+	data []byte
+}
+
+// read checks that the requested notifications haven't been deleted and reads them. This uses a repeatable read
+// transaction, so that the check of the watermark and the read of the notifications see the same snapshot of the
+// database.
+func (r *Replayer) read(ctx context.Context, since int64) (result []replayerNotification, err error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return
+	}
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+		if rollbackErr != nil && err == nil {
+			err = rollbackErr
+		}
+	}()
+
+	// Check the watermark:
+	row := tx.QueryRow(ctx, "select pruned from notifications_watermark")
+	var pruned int64
+	err = row.Scan(&pruned)
+	if err != nil {
+		return
+	}
+	// Note that the notification given by the client needs to be still available, because we need its horizon to find
+	// the notifications of the transactions that were committed after it.
+	if since < pruned || (since > 0 && since == pruned) {
+		err = &PrunedError{
+			Since:  since,
+			Pruned: pruned,
+		}
+		return
+	}
+
+	// Read the notifications that have greater sequence numbers, and the ones whose transactions were still running
+	// when the given notification was sent:
+	rows, err := tx.Query(
+		ctx,
+		`
+		select seq, payload from notifications
+		where seq > $1 or (seq < $1 and xid >= (select horizon from notifications where seq = $1))
+		order by seq
+		`,
+		since,
+	)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var notification replayerNotification
+		err = rows.Scan(&notification.seq, &notification.data)
+		if err != nil {
+			return
+		}
+		result = append(result, notification)
+	}
+	err = rows.Err()
+	return
+}
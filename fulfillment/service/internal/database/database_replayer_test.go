@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ = Describe("Replayer", func() {
+	const channel = "my_channel"
+
+	var (
+		ctx      context.Context
+		pool     *pgxpool.Pool
+		tm       TxManager
+		notifier *Notifier
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := dbServer.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err = pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the notifications tables:
+		_, err = pool.Exec(
+			ctx,
+			`
+			create table notifications (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone default now(),
+				payload bytea,
+				seq bigserial not null,
+				xid xid8 not null default pg_current_xact_id(),
+				horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot())
+			);
+
+			create table notifications_watermark (
+				pruned bigint not null
+			);
+
+			insert into notifications_watermark (pruned) values (0);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Prepare the transaction manager:
+		tm, err = NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the notifier:
+		notifier, err = NewNotifier().
+			SetLogger(logger).
+			SetChannel(channel).
+			SetRetentionTime(0).
+			SetRetentionCount(3).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// notify sends a notification with the given value in its own transaction.
+	notify := func(value string) {
+		tx, err := tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = notifier.Notify(TxIntoContext(ctx, tx), wrapperspb.String(value))
+		Expect(err).ToNot(HaveOccurred())
+		err = tm.End(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	// replay replays the notifications and returns the values and sequence numbers.
+	replay := func(replayer *Replayer, since int64) (values []string, seqs []int64, err error) {
+		_, err = replayer.Replay(ctx, since, func(ctx context.Context, seq int64, payload proto.Message) error {
+			values = append(values, payload.(*wrapperspb.StringValue).GetValue())
+			seqs = append(seqs, seq)
+			return nil
+		})
+		return
+	}
+
+	Describe("Creation", func() {
+		It("Can be created when all the required parameters are set", func() {
+			replayer, err := NewReplayer().
+				SetLogger(logger).
+				SetPool(pool).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replayer).ToNot(BeNil())
+		})
+
+		It("Can't be created without a logger", func() {
+			replayer, err := NewReplayer().
+				SetPool(pool).
+				Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+			Expect(replayer).To(BeNil())
+		})
+
+		It("Can't be created without a pool", func() {
+			replayer, err := NewReplayer().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(MatchError("database connection pool is mandatory"))
+			Expect(replayer).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		var replayer *Replayer
+
+		BeforeEach(func() {
+			var err error
+			replayer, err = NewReplayer().
+				SetLogger(logger).
+				SetPool(pool).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Replays all the notifications", func() {
+			notify("uno")
+			notify("dos")
+			values, seqs, err := replay(replayer, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"uno", "dos"}))
+			Expect(seqs[0]).To(BeNumerically("<", seqs[1]))
+		})
+
+		It("Replays only the notifications after the given sequence number", func() {
+			notify("uno")
+			notify("dos")
+			notify("tres")
+			_, seqs, err := replay(replayer, 0)
+			Expect(err).ToNot(HaveOccurred())
+			values, _, err := replay(replayer, seqs[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"dos", "tres"}))
+		})
+
+		It("Replays notifications of transactions committed after the given one", func() {
+			// Send a notification in a transaction that will be committed later:
+			tx, err := tm.Begin(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			err = notifier.Notify(TxIntoContext(ctx, tx), wrapperspb.String("uno"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// Send and commit another notification, which gets a greater sequence number:
+			notify("dos")
+			values, seqs, err := replay(replayer, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"dos"}))
+
+			// Commit the first transaction, and check that its notification is replayed for a client that has
+			// already processed the second one:
+			err = tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+			values, _, err = replay(replayer, seqs[0])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(values).To(Equal([]string{"uno"}))
+		})
+
+		It("Returns the last sequence number", func() {
+			notify("uno")
+			notify("dos")
+			_, seqs, err := replay(replayer, 0)
+			Expect(err).ToNot(HaveOccurred())
+			last, err := replayer.Replay(ctx, 0, func(ctx context.Context, seq int64, payload proto.Message) error {
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(last).To(Equal(seqs[1]))
+		})
+
+		It("Fails if the requested notifications have been deleted", func() {
+			for _, value := range []string{"uno", "dos", "tres", "cuatro", "cinco"} {
+				notify(value)
+			}
+			values, _, err := replay(replayer, 0)
+			var prunedErr *PrunedError
+			Expect(errors.As(err, &prunedErr)).To(BeTrue())
+			Expect(prunedErr.Since).To(BeZero())
+			Expect(prunedErr.Pruned).To(BeNumerically(">", 0))
+			Expect(values).To(BeEmpty())
+		})
+
+		It("Stops if the callback fails", func() {
+			notify("uno")
+			notify("dos")
+			calls := 0
+			_, err := replayer.Replay(ctx, 0, func(ctx context.Context, seq int64, payload proto.Message) error {
+				calls++
+				return errors.New("my error")
+			})
+			Expect(err).To(MatchError("my error"))
+			Expect(calls).To(Equal(1))
+		})
+	})
+})
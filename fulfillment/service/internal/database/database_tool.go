@@ -254,9 +254,14 @@ func (t *tool) URL() string {
 	return t.url
 }
 
-// Pool returns the pool of database connections.
+// Pool returns the pool of database connections. The connections create tracing spans for the SQL statements.
 func (t *tool) Pool(ctx context.Context) (result *pgxpool.Pool, err error) {
-	result, err = pgxpool.New(ctx, t.url)
+	config, err := pgxpool.ParseConfig(t.url)
+	if err != nil {
+		return
+	}
+	config.ConnConfig.Tracer = &queryTracer{}
+	result, err = pgxpool.NewWithConfig(ctx, config)
 	return
 }
 
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
+)
+
+// queryTracer is an implementation of the pgx.QueryTracer interface that creates a span for each SQL statement. The
+// span contains the text of the statement, but not the values of the parameters, as those may contain sensitive data.
+type queryTracer struct {
+}
+
+// TraceQueryStart is part of the implementation of the pgx.QueryTracer interface.
+func (t *queryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn,
+	data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = tracing.Tracer().Start(
+		ctx,
+		"sql "+queryOperation(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			dbSystemAttribute,
+			attribute.String("db.query.text", data.SQL),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd is part of the implementation of the pgx.QueryTracer interface.
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.response.returned_rows", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+// queryOperation returns the first word of the SQL statement in lower case, for example `select` or `insert`. This is
+// used to give spans short names that don't depend on the values of the parameters.
+func queryOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+	return strings.ToLower(fields[0])
+}
+
+// dbSystemAttribute is the attribute that identifies the database system in the spans.
+var dbSystemAttribute = attribute.String("db.system.name", "postgresql")
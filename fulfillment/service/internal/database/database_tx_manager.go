@@ -22,6 +22,10 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
 // TxManager is a database transaction manager. It knows how to start, commit and rollback transactions.
@@ -124,15 +128,25 @@ func (m *txManager) end(ctx context.Context, tx *managedTx) error {
 	}
 
 	// Commit the transaction if there are no errors, otherwise roll it back:
+	defer tx.span.End()
+	ctx = trace.ContextWithSpan(ctx, tx.span)
 	if len(tx.errs) == 0 {
 		m.logger.DebugContext(ctx, "Committing transaction")
-		return tx.real.Commit(ctx)
+		tx.span.AddEvent("commit")
+		err := tx.real.Commit(ctx)
+		if err != nil {
+			tx.span.RecordError(err)
+			tx.span.SetStatus(codes.Error, err.Error())
+		}
+		return err
 	}
 	m.logger.DebugContext(
 		ctx,
 		"Rolling back transaction",
 		slog.Any("errors", tx.errs),
 	)
+	tx.span.AddEvent("rollback")
+	tx.span.SetStatus(codes.Error, errors.Join(tx.errs...).Error())
 	return tx.real.Rollback(ctx)
 }
 
@@ -142,6 +156,7 @@ func (m *txManager) end(ctx context.Context, tx *managedTx) error {
 type managedTx struct {
 	manager *txManager
 	real    pgx.Tx
+	span    trace.Span
 	errs    []error
 }
 
@@ -150,7 +165,7 @@ func (t *managedTx) Query(ctx context.Context, query string, args ...any) (resul
 	if err != nil {
 		return
 	}
-	result, err = t.real.Query(ctx, query, args...)
+	result, err = t.real.Query(trace.ContextWithSpan(ctx, t.span), query, args...)
 	return
 }
 
@@ -161,7 +176,7 @@ func (t *managedTx) QueryRow(ctx context.Context, query string, args ...any) pgx
 			err: err,
 		}
 	}
-	return t.real.QueryRow(ctx, query, args...)
+	return t.real.QueryRow(trace.ContextWithSpan(ctx, t.span), query, args...)
 }
 
 func (t *managedTx) Exec(ctx context.Context, query string, args ...any) (tag pgconn.CommandTag, err error) {
@@ -169,7 +184,7 @@ func (t *managedTx) Exec(ctx context.Context, query string, args ...any) (tag pg
 	if err != nil {
 		return
 	}
-	tag, err = t.real.Exec(ctx, query, args...)
+	tag, err = t.real.Exec(trace.ContextWithSpan(ctx, t.span), query, args...)
 	return
 }
 
@@ -179,15 +194,30 @@ func (t *managedTx) ReportError(err *error) {
 	}
 }
 
-// ensureReal makes sure that the real transaction exists, creating it if needed.
+// ensureReal makes sure that the real transaction exists, creating it if needed. It also starts the span of the
+// transaction, which is the parent of the spans of the SQL statements executed inside the transaction, and that ends
+// when the transaction is committed or rolled back.
 func (t *managedTx) ensureReal(ctx context.Context) error {
 	if t.real != nil {
 		return nil
 	}
 	t.manager.logger.DebugContext(ctx, "Starting transaction")
-	var err error
-	t.real, err = t.manager.pool.Begin(ctx)
-	return err
+	ctx, span := tracing.Tracer().Start(
+		ctx,
+		"transaction",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(dbSystemAttribute),
+	)
+	real, err := t.manager.pool.Begin(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return err
+	}
+	t.real = real
+	t.span = span
+	return nil
 }
 
 // managedRow is an implementation of the row interface that always returns the contained error. This is necessary
@@ -0,0 +1,28 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Add the version column to the tables:
+alter table cluster_templates add column version bigint not null default 1;
+alter table clusters add column version bigint not null default 1;
+alter table host_classes add column version bigint not null default 1;
+alter table hubs add column version bigint not null default 1;
+alter table virtual_machine_templates add column version bigint not null default 1;
+alter table virtual_machines add column version bigint not null default 1;
+
+-- Add the version column to the archive tables:
+alter table archived_cluster_templates add column version bigint not null default 0;
+alter table archived_clusters add column version bigint not null default 0;
+alter table archived_host_classes add column version bigint not null default 0;
+alter table archived_hubs add column version bigint not null default 0;
+alter table archived_virtual_machine_templates add column version bigint not null default 0;
+alter table archived_virtual_machines add column version bigint not null default 0;
@@ -0,0 +1,23 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Add the sequence number to the notifications, so that clients can resume watching from a known position:
+alter table notifications add column seq bigserial not null;
+create unique index notifications_by_seq on notifications (seq);
+
+-- Create the table that remembers the sequence number of the last notification that has been deleted, so that we can
+-- detect when a client tries to resume from a position that is no longer available:
+create table notifications_watermark (
+  pruned bigint not null
+);
+insert into notifications_watermark (pruned) values (0);
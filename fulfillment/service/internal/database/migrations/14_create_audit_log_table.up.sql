@@ -0,0 +1,28 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Create the table that stores the audit log. It has the same columns as the tables of other objects so that it
+-- can be read with the generic DAO, but entries are never updated, and they are deleted directly, without archiving,
+-- when they exceed the retention time.
+create table audit_log (
+  id text not null primary key,
+  creation_timestamp timestamp with time zone not null default now(),
+  deletion_timestamp timestamp with time zone not null default 'epoch',
+  finalizers text[] not null default '{}',
+  creators text[] not null default '{}',
+  tenants text[] not null default '{}',
+  version bigint not null default 1,
+  data jsonb not null
+);
+
+create index audit_log_by_creation_timestamp on audit_log (creation_timestamp);
@@ -0,0 +1,37 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Add the labels and annotations columns to the tables:
+alter table cluster_templates add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table clusters add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table host_classes add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table hubs add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table virtual_machine_templates add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table virtual_machines add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table audit_log add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+
+-- Add indexes on the labels column, so that label selectors don't need to scan the complete tables:
+create index cluster_templates_by_label on cluster_templates using gin (labels);
+create index clusters_by_label on clusters using gin (labels);
+create index host_classes_by_label on host_classes using gin (labels);
+create index hubs_by_label on hubs using gin (labels);
+create index virtual_machine_templates_by_label on virtual_machine_templates using gin (labels);
+create index virtual_machines_by_label on virtual_machines using gin (labels);
+
+-- Add the labels and annotations columns to the archive tables:
+alter table archived_cluster_templates add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table archived_clusters add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table archived_host_classes add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table archived_hubs add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table archived_virtual_machine_templates add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
+alter table archived_virtual_machines add column labels jsonb not null default '{}', add column annotations jsonb not null default '{}';
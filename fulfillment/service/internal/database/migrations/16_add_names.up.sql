@@ -0,0 +1,39 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Add the name column to the tables:
+alter table cluster_templates add column name text not null default '';
+alter table clusters add column name text not null default '';
+alter table host_classes add column name text not null default '';
+alter table hubs add column name text not null default '';
+alter table virtual_machine_templates add column name text not null default '';
+alter table virtual_machines add column name text not null default '';
+alter table audit_log add column name text not null default '';
+
+-- Names are optional, but when they are set they must be unique within the tenants of the object. Note that the
+-- tenancy logic assigns exactly one tenant to new objects, so this is in practice a uniqueness constraint per tenant.
+create unique index cluster_templates_by_name on cluster_templates (name, tenants) where name != '';
+create unique index clusters_by_name on clusters (name, tenants) where name != '';
+create unique index host_classes_by_name on host_classes (name, tenants) where name != '';
+create unique index hubs_by_name on hubs (name, tenants) where name != '';
+create unique index virtual_machine_templates_by_name on virtual_machine_templates (name, tenants) where name != '';
+create unique index virtual_machines_by_name on virtual_machines (name, tenants) where name != '';
+
+-- Add the name column to the archive tables. There is no uniqueness constraint here because the name can be reused
+-- once the object has been archived.
+alter table archived_cluster_templates add column name text not null default '';
+alter table archived_clusters add column name text not null default '';
+alter table archived_host_classes add column name text not null default '';
+alter table archived_hubs add column name text not null default '';
+alter table archived_virtual_machine_templates add column name text not null default '';
+alter table archived_virtual_machines add column name text not null default '';
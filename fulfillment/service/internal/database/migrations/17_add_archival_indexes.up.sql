@@ -0,0 +1,20 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Add indexes on the archival timestamp, as that is used to find the archived objects that need to be purged:
+create index archived_cluster_templates_by_archival_timestamp on archived_cluster_templates (archival_timestamp);
+create index archived_clusters_by_archival_timestamp on archived_clusters (archival_timestamp);
+create index archived_host_classes_by_archival_timestamp on archived_host_classes (archival_timestamp);
+create index archived_hubs_by_archival_timestamp on archived_hubs (archival_timestamp);
+create index archived_virtual_machine_templates_by_archival_timestamp on archived_virtual_machine_templates (archival_timestamp);
+create index archived_virtual_machines_by_archival_timestamp on archived_virtual_machines (archival_timestamp);
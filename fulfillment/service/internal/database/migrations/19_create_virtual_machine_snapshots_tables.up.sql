@@ -0,0 +1,51 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Create the virtual machine snapshots tables:
+--
+create table virtual_machine_snapshots (
+  id text not null primary key,
+  creation_timestamp timestamp with time zone not null default now(),
+  deletion_timestamp timestamp with time zone not null default 'epoch',
+  finalizers text[] not null default '{}',
+  creators text[] not null default '{}',
+  tenants text[] not null default '{}',
+  name text not null default '',
+  labels jsonb not null default '{}',
+  annotations jsonb not null default '{}',
+  version bigint not null default 1,
+  data jsonb not null
+);
+
+create index virtual_machine_snapshots_by_owner on virtual_machine_snapshots using gin (creators);
+create index virtual_machine_snapshots_by_tenant on virtual_machine_snapshots using gin (tenants);
+create index virtual_machine_snapshots_by_label on virtual_machine_snapshots using gin (labels);
+create unique index virtual_machine_snapshots_by_name on virtual_machine_snapshots (name, tenants) where name != '';
+
+create index virtual_machine_snapshots_by_virtual_machine on virtual_machine_snapshots ((data->'spec'->>'virtual_machine'));
+
+create table archived_virtual_machine_snapshots (
+  id text not null,
+  creation_timestamp timestamp with time zone not null,
+  deletion_timestamp timestamp with time zone not null,
+  archival_timestamp timestamp with time zone not null default now(),
+  creators text[] not null default '{}',
+  tenants text[] not null default '{}',
+  name text not null default '',
+  labels jsonb not null default '{}',
+  annotations jsonb not null default '{}',
+  version bigint not null default 0,
+  data jsonb not null
+);
+
+create index archived_virtual_machine_snapshots_by_archival_timestamp on archived_virtual_machine_snapshots (archival_timestamp);
@@ -0,0 +1,21 @@
+--
+-- Copyright (c) 2025 Red Hat Inc.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+-- the License. You may obtain a copy of the License at
+--
+--   http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+-- an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+-- specific language governing permissions and limitations under the License.
+--
+
+-- Save the transaction that sent each notification, and the oldest transaction that was still running at that moment.
+-- Transactions that send notifications aren't serialized, so a transaction that got a lower sequence number may be
+-- committed after one that got a higher sequence number. Any such transaction was still running when the later
+-- notification was sent, so its identifier isn't older than the horizon saved with that notification. Clients resuming
+-- from a notification need to also receive the notifications of those transactions.
+alter table notifications
+  add column xid xid8 not null default pg_current_xact_id(),
+  add column horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot());
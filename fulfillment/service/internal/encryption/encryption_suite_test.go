@@ -0,0 +1,36 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package encryption
+
+import (
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+)
+
+func TestEncryption(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Encryption")
+}
+
+var logger *slog.Logger
+
+var _ = BeforeSuite(func() {
+	// Note that we can't use the logging package here because it uses this package to redact sensitive fields:
+	logger = slog.New(slog.NewJSONHandler(GinkgoWriter, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+})
@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package encryption
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// IsSensitive checks if the given field contains sensitive data. Sensitive fields are the fields that have the
+// standard `debug_redact` option. For example:
+//
+//	bytes kubeconfig = 3 [debug_redact = true];
+//
+// The values of those fields are encrypted when they are stored in the database, and they are never written to logs.
+func IsSensitive(field protoreflect.FieldDescriptor) bool {
+	options, ok := field.Options().(*descriptorpb.FieldOptions)
+	return ok && options.GetDebugRedact()
+}
+
+// HasSensitiveFields checks if the given message type, or any of the message types that it contains, has sensitive
+// fields.
+func HasSensitiveFields(desc protoreflect.MessageDescriptor) bool {
+	return hasSensitiveFields(desc, map[protoreflect.FullName]bool{})
+}
+
+func hasSensitiveFields(desc protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[desc.FullName()] {
+		return false
+	}
+	visited[desc.FullName()] = true
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if IsSensitive(field) {
+			return true
+		}
+		if field.Message() != nil && hasSensitiveFields(field.Message(), visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns a copy of the given message where the values of the sensitive fields have been removed. It returns
+// the same message if it doesn't have sensitive fields.
+func Redact(message proto.Message) proto.Message {
+	if message == nil || !HasSensitiveFields(message.ProtoReflect().Descriptor()) {
+		return message
+	}
+	message = proto.Clone(message)
+	_ = rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		m.Clear(field)
+		return nil
+	})
+	return message
+}
+
+// EncryptFields replaces the values of the sensitive fields of the given message with the result of encrypting them.
+// Values that are already encrypted are preserved. The additional data is passed to the Encrypt method for all the
+// fields, and is usually the identifier of the object, so that the encrypted values can only be decrypted for that same
+// object.
+func (k *Keyring) EncryptFields(message proto.Message, additionalData []byte) error {
+	return rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		plain, ok := getBytes(m, field)
+		if !ok || IsEncrypted(plain) {
+			return nil
+		}
+		encrypted, err := k.Encrypt(plain, additionalData)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt field '%s': %w", field.FullName(), err)
+		}
+		setBytes(m, field, encrypted)
+		return nil
+	})
+}
+
+// DecryptFields replaces the values of the sensitive fields of the given message with the result of decrypting them.
+// Values that aren't encrypted are preserved. The additional data must be the same that was used to encrypt them.
+func (k *Keyring) DecryptFields(message proto.Message, additionalData []byte) error {
+	return rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		encrypted, ok := getBytes(m, field)
+		if !ok || !IsEncrypted(encrypted) {
+			return nil
+		}
+		plain, err := k.Decrypt(encrypted, additionalData)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt field '%s': %w", field.FullName(), err)
+		}
+		setBytes(m, field, plain)
+		return nil
+	})
+}
+
+// IsCurrentFields checks if all the sensitive fields of the given message have been encrypted with the primary key.
+func (k *Keyring) IsCurrentFields(message proto.Message) bool {
+	current := true
+	_ = rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		value, ok := getBytes(m, field)
+		if ok && !k.IsCurrent(value) {
+			current = false
+		}
+		return nil
+	})
+	return current
+}
+
+// CheckFields returns an error if any of the sensitive fields of the given message is encrypted. This is intended for
+// reading data when there is no keyring, so that encrypted values aren't silently returned to the caller.
+func CheckFields(message proto.Message) error {
+	return rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		value, ok := getBytes(m, field)
+		if ok && IsEncrypted(value) {
+			return fmt.Errorf("field '%s' is encrypted, but there is no keyring to decrypt it", field.FullName())
+		}
+		return nil
+	})
+}
+
+// FindEncrypted returns the descriptor of the first sensitive field of the given message that contains a value that is
+// already encrypted, or nil if there is no such field. This is intended to reject values received from clients that
+// have the format of encrypted values, because those would be stored without encrypting them again.
+func FindEncrypted(message proto.Message) (result protoreflect.FieldDescriptor) {
+	_ = rangeSensitive(message.ProtoReflect(), func(m protoreflect.Message, field protoreflect.FieldDescriptor) error {
+		value, ok := getBytes(m, field)
+		if ok && IsEncrypted(value) {
+			result = field
+			return errFound
+		}
+		return nil
+	})
+	return
+}
+
+// errFound is used to stop the iteration over the sensitive fields once the searched field has been found.
+var errFound = errors.New("found")
+
+// rangeSensitive calls the given function for each populated sensitive field of the message, including the fields of
+// nested messages.
+func rangeSensitive(message protoreflect.Message,
+	fn func(protoreflect.Message, protoreflect.FieldDescriptor) error) error {
+	var err error
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case IsSensitive(field):
+			if field.Cardinality() != protoreflect.Repeated {
+				err = fn(message, field)
+			}
+		case field.IsMap():
+			if field.MapValue().Message() != nil {
+				value.Map().Range(func(_ protoreflect.MapKey, item protoreflect.Value) bool {
+					err = rangeSensitive(item.Message(), fn)
+					return err == nil
+				})
+			}
+		case field.IsList():
+			if field.Message() != nil {
+				list := value.List()
+				for i := 0; i < list.Len() && err == nil; i++ {
+					err = rangeSensitive(list.Get(i).Message(), fn)
+				}
+			}
+		case field.Message() != nil:
+			err = rangeSensitive(value.Message(), fn)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// getBytes returns the value of a bytes or string field as an array of bytes. The boolean result will be false if the
+// field has other type.
+func getBytes(message protoreflect.Message, field protoreflect.FieldDescriptor) (result []byte, ok bool) {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		result = message.Get(field).Bytes()
+		ok = true
+	case protoreflect.StringKind:
+		result = []byte(message.Get(field).String())
+		ok = true
+	}
+	return
+}
+
+// setBytes is the opposite of getBytes.
+func setBytes(message protoreflect.Message, field protoreflect.FieldDescriptor, value []byte) {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		message.Set(field, protoreflect.ValueOfBytes(value))
+	case protoreflect.StringKind:
+		message.Set(field, protoreflect.ValueOfString(string(value)))
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+var _ = Describe("Sensitive fields", func() {
+	var keyring *Keyring
+
+	BeforeEach(func() {
+		var err error
+		keyring, err = NewKeyring().
+			SetLogger(logger).
+			AddKey("key-1", bytes.Repeat([]byte{1}, 32)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Detects message types with sensitive fields", func() {
+		Expect(HasSensitiveFields((&privatev1.Hub{}).ProtoReflect().Descriptor())).To(BeTrue())
+		Expect(HasSensitiveFields((&privatev1.Cluster{}).ProtoReflect().Descriptor())).To(BeFalse())
+	})
+
+	It("Encrypts and decrypts only the sensitive fields", func() {
+		hub := privatev1.Hub_builder{
+			Id:         "my-hub",
+			Kubeconfig: []byte("my-kubeconfig"),
+			Namespace:  "my-ns",
+		}.Build()
+		Expect(keyring.EncryptFields(hub, []byte("my-hub"))).To(Succeed())
+		Expect(IsEncrypted(hub.GetKubeconfig())).To(BeTrue())
+		Expect(hub.GetId()).To(Equal("my-hub"))
+		Expect(hub.GetNamespace()).To(Equal("my-ns"))
+		Expect(keyring.IsCurrentFields(hub)).To(BeTrue())
+		Expect(CheckFields(hub)).To(MatchError(
+			"field 'private.v1.Hub.kubeconfig' is encrypted, but there is no keyring to decrypt it",
+		))
+		Expect(keyring.DecryptFields(hub, []byte("my-hub"))).To(Succeed())
+		Expect(string(hub.GetKubeconfig())).To(Equal("my-kubeconfig"))
+		Expect(keyring.IsCurrentFields(hub)).To(BeFalse())
+		Expect(CheckFields(hub)).To(Succeed())
+	})
+
+	It("Doesn't decrypt values copied from other object", func() {
+		hub := privatev1.Hub_builder{
+			Id:         "my-hub",
+			Kubeconfig: []byte("my-kubeconfig"),
+		}.Build()
+		Expect(keyring.EncryptFields(hub, []byte("my-hub"))).To(Succeed())
+		copied := privatev1.Hub_builder{
+			Id:         "your-hub",
+			Kubeconfig: hub.GetKubeconfig(),
+		}.Build()
+		Expect(keyring.DecryptFields(copied, []byte("your-hub"))).To(MatchError(
+			HavePrefix("failed to decrypt field 'private.v1.Hub.kubeconfig'"),
+		))
+	})
+
+	It("Finds the fields that are already encrypted", func() {
+		hub := privatev1.Hub_builder{
+			Id:         "my-hub",
+			Kubeconfig: []byte("my-kubeconfig"),
+		}.Build()
+		Expect(FindEncrypted(hub)).To(BeNil())
+		Expect(keyring.EncryptFields(hub, []byte("my-hub"))).To(Succeed())
+		field := FindEncrypted(hub)
+		Expect(field).ToNot(BeNil())
+		Expect(string(field.FullName())).To(Equal("private.v1.Hub.kubeconfig"))
+	})
+
+	It("Doesn't encrypt twice", func() {
+		hub := privatev1.Hub_builder{
+			Kubeconfig: []byte("my-kubeconfig"),
+		}.Build()
+		Expect(keyring.EncryptFields(hub, []byte("my-hub"))).To(Succeed())
+		encrypted := hub.GetKubeconfig()
+		Expect(keyring.EncryptFields(hub, []byte("my-hub"))).To(Succeed())
+		Expect(hub.GetKubeconfig()).To(Equal(encrypted))
+	})
+
+	It("Redacts the sensitive fields of a copy", func() {
+		hub := privatev1.Hub_builder{
+			Id:         "my-hub",
+			Kubeconfig: []byte("my-kubeconfig"),
+		}.Build()
+		redacted := Redact(hub).(*privatev1.Hub)
+		Expect(redacted.GetId()).To(Equal("my-hub"))
+		Expect(redacted.GetKubeconfig()).To(BeEmpty())
+		Expect(string(hub.GetKubeconfig())).To(Equal("my-kubeconfig"))
+	})
+
+	It("Redacts sensitive fields of nested messages", func() {
+		response := privatev1.HubsGetResponse_builder{
+			Object: privatev1.Hub_builder{
+				Kubeconfig: []byte("my-kubeconfig"),
+			}.Build(),
+		}.Build()
+		redacted := Redact(response).(*privatev1.HubsGetResponse)
+		Expect(redacted.GetObject().GetKubeconfig()).To(BeEmpty())
+	})
+
+	It("Returns the same message if there are no sensitive fields", func() {
+		cluster := privatev1.Cluster_builder{
+			Id: "my-cluster",
+		}.Build()
+		Expect(Redact(cluster)).To(BeIdenticalTo(cluster))
+	})
+})
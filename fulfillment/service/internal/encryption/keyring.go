@@ -0,0 +1,359 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KeyringBuilder contains the data and logic needed to create a keyring.
+type KeyringBuilder struct {
+	logger  *slog.Logger
+	file    string
+	keys    map[string][]byte
+	order   []string
+	primary string
+}
+
+// Keyring contains the keys used to encrypt and decrypt sensitive data. It uses envelope encryption: every value is
+// encrypted with a new random data key, and that data key is encrypted with the primary key of the keyring. The
+// identifier of the primary key is stored together with the encrypted value, so that values encrypted with keys that
+// are no longer primary can still be decrypted as long as those keys are in the keyring. That makes it possible to
+// rotate keys: add a new key, make it the primary, re-encrypt the data, and then remove the old key.
+//
+// Encrypted values have the following format:
+//
+//	enc:v1:<key id>:<encrypted data key>:<encrypted value>
+//
+// Where the encrypted data key and the encrypted value are encoded using base64. Both are encrypted with AES-256-GCM
+// and contain the random nonce followed by the cipher text. The encryption of the value also authenticates additional
+// data provided by the caller, usually the identifier of the object that contains the value. That additional data
+// isn't stored, and the same must be provided to decrypt the value, so encrypted values can't be copied from one object
+// to another.
+type Keyring struct {
+	logger  *slog.Logger
+	keys    map[string]cipher.AEAD
+	primary string
+}
+
+// keyringFile is the structure of the keys file.
+type keyringFile struct {
+	Primary string           `yaml:"primary"`
+	Keys    []keyringFileKey `yaml:"keys"`
+}
+
+// keyringFileKey is the structure of each key of the keys file.
+type keyringFileKey struct {
+	ID     string `yaml:"id"`
+	Secret string `yaml:"secret"`
+}
+
+// NewKeyring creates a builder that can then be used to configure and create a keyring.
+func NewKeyring() *KeyringBuilder {
+	return &KeyringBuilder{
+		keys: map[string][]byte{},
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *KeyringBuilder) SetLogger(value *slog.Logger) *KeyringBuilder {
+	b.logger = value
+	return b
+}
+
+// SetFile sets the YAML file that contains the keys. For example:
+//
+//	primary: key-2
+//	keys:
+//	- id: key-1
+//	  secret: 0JM1Xh6fQ5k7sZb8...
+//	- id: key-2
+//	  secret: Yk1pU0x2c2V0b3Jk...
+//
+// The secrets are 32 random bytes encoded using base64, for example generated with `openssl rand -base64 32`. The
+// primary key is the one used to encrypt new values, the rest are only used to decrypt values encrypted before. The
+// primary can be omitted if there is only one key.
+//
+// This is optional, keys can also be added with the AddKey method.
+func (b *KeyringBuilder) SetFile(value string) *KeyringBuilder {
+	b.file = value
+	return b
+}
+
+// AddKey adds a key. The secret must be 32 bytes long.
+func (b *KeyringBuilder) AddKey(id string, secret []byte) *KeyringBuilder {
+	if _, ok := b.keys[id]; !ok {
+		b.order = append(b.order, id)
+	}
+	b.keys[id] = secret
+	return b
+}
+
+// SetPrimary sets the identifier of the key that will be used to encrypt new values. This is optional if there is
+// only one key.
+func (b *KeyringBuilder) SetPrimary(value string) *KeyringBuilder {
+	b.primary = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new keyring.
+func (b *KeyringBuilder) Build() (result *Keyring, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Load the keys file:
+	secrets := maps.Clone(b.keys)
+	order := append([]string(nil), b.order...)
+	primary := b.primary
+	if b.file != "" {
+		var data []byte
+		data, err = os.ReadFile(b.file)
+		if err != nil {
+			err = fmt.Errorf("failed to read keys file '%s': %w", b.file, err)
+			return
+		}
+		var file keyringFile
+		err = yaml.Unmarshal(data, &file)
+		if err != nil {
+			err = fmt.Errorf("failed to parse keys file '%s': %w", b.file, err)
+			return
+		}
+		for i, key := range file.Keys {
+			var secret []byte
+			secret, err = base64.StdEncoding.DecodeString(key.Secret)
+			if err != nil {
+				err = fmt.Errorf("failed to decode secret of key %d of file '%s': %w", i, b.file, err)
+				return
+			}
+			if _, ok := secrets[key.ID]; !ok {
+				order = append(order, key.ID)
+			}
+			secrets[key.ID] = secret
+		}
+		if file.Primary != "" {
+			primary = file.Primary
+		}
+	}
+
+	// Check the keys:
+	if len(secrets) == 0 {
+		err = errors.New("at least one key is mandatory")
+		return
+	}
+	keys := map[string]cipher.AEAD{}
+	for _, id := range order {
+		if id == "" {
+			err = errors.New("key identifier is mandatory")
+			return
+		}
+		if strings.Contains(id, encryptedSeparator) {
+			err = fmt.Errorf(
+				"key identifier '%s' isn't valid because it contains '%s'",
+				id, encryptedSeparator,
+			)
+			return
+		}
+		secret := secrets[id]
+		if len(secret) != keySize {
+			err = fmt.Errorf(
+				"key '%s' should be %d bytes long, but it is %d bytes long",
+				id, keySize, len(secret),
+			)
+			return
+		}
+		keys[id], err = newAEAD(secret)
+		if err != nil {
+			err = fmt.Errorf("failed to create cipher for key '%s': %w", id, err)
+			return
+		}
+	}
+	if primary == "" {
+		if len(keys) > 1 {
+			err = errors.New("primary key is mandatory when there are multiple keys")
+			return
+		}
+		primary = order[0]
+	}
+	if _, ok := keys[primary]; !ok {
+		err = fmt.Errorf("primary key '%s' doesn't exist", primary)
+		return
+	}
+
+	// Create and populate the object:
+	result = &Keyring{
+		logger:  b.logger,
+		keys:    keys,
+		primary: primary,
+	}
+	return
+}
+
+// Primary returns the identifier of the key used to encrypt new values.
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Encrypt encrypts the given value with a new random data key, and the data key with the primary key. The additional
+// data is authenticated but not encrypted or stored, and it will be required to decrypt the value.
+func (k *Keyring) Encrypt(value, additionalData []byte) (result []byte, err error) {
+	dataKey := make([]byte, keySize)
+	_, err = rand.Read(dataKey)
+	if err != nil {
+		err = fmt.Errorf("failed to generate data key: %w", err)
+		return
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return
+	}
+	encryptedKey, err := seal(k.keys[k.primary], dataKey, nil)
+	if err != nil {
+		return
+	}
+	encryptedValue, err := seal(dataAEAD, value, additionalData)
+	if err != nil {
+		return
+	}
+	var buffer bytes.Buffer
+	buffer.WriteString(encryptedPrefix)
+	buffer.WriteString(k.primary)
+	buffer.WriteString(encryptedSeparator)
+	buffer.WriteString(base64.StdEncoding.EncodeToString(encryptedKey))
+	buffer.WriteString(encryptedSeparator)
+	buffer.WriteString(base64.StdEncoding.EncodeToString(encryptedValue))
+	result = buffer.Bytes()
+	return
+}
+
+// Decrypt decrypts a value that was encrypted with the Encrypt method, using the key that was primary at that time. The
+// additional data must be the same that was used to encrypt the value.
+func (k *Keyring) Decrypt(value, additionalData []byte) (result []byte, err error) {
+	id, encryptedKey, encryptedValue, err := parseEncrypted(value)
+	if err != nil {
+		return
+	}
+	keyAEAD, ok := k.keys[id]
+	if !ok {
+		err = fmt.Errorf("key '%s' used to encrypt the value doesn't exist", id)
+		return
+	}
+	dataKey, err := open(keyAEAD, encryptedKey, nil)
+	if err != nil {
+		err = fmt.Errorf("failed to decrypt data key with key '%s': %w", id, err)
+		return
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return
+	}
+	result, err = open(dataAEAD, encryptedValue, additionalData)
+	if err != nil {
+		err = fmt.Errorf("failed to decrypt value: %w", err)
+		return
+	}
+	return
+}
+
+// IsCurrent checks if the given value has been encrypted with the primary key. It returns false if the value isn't
+// encrypted or if it has been encrypted with other key.
+func (k *Keyring) IsCurrent(value []byte) bool {
+	id, _, _, err := parseEncrypted(value)
+	return err == nil && id == k.primary
+}
+
+// IsEncrypted checks if the given value has the format of the values generated by the Encrypt method.
+func IsEncrypted(value []byte) bool {
+	return bytes.HasPrefix(value, []byte(encryptedPrefix))
+}
+
+func parseEncrypted(value []byte) (id string, encryptedKey, encryptedValue []byte, err error) {
+	if !IsEncrypted(value) {
+		err = errors.New("value isn't encrypted")
+		return
+	}
+	parts := strings.Split(string(value[len(encryptedPrefix):]), encryptedSeparator)
+	if len(parts) != 3 {
+		err = fmt.Errorf("encrypted value should have 3 parts, but it has %d", len(parts))
+		return
+	}
+	id = parts[0]
+	encryptedKey, err = base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		err = fmt.Errorf("failed to decode data key: %w", err)
+		return
+	}
+	encryptedValue, err = base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		err = fmt.Errorf("failed to decode value: %w", err)
+		return
+	}
+	return
+}
+
+func newAEAD(key []byte) (result cipher.AEAD, err error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return
+	}
+	result, err = cipher.NewGCM(block)
+	return
+}
+
+// seal encrypts the given plain text and returns the nonce followed by the cipher text. The additional data is
+// authenticated but not encrypted.
+func seal(aead cipher.AEAD, plain, additionalData []byte) (result []byte, err error) {
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		err = fmt.Errorf("failed to generate nonce: %w", err)
+		return
+	}
+	result = aead.Seal(nonce, nonce, plain, additionalData)
+	return
+}
+
+// open is the opposite of seal.
+func open(aead cipher.AEAD, data, additionalData []byte) (result []byte, err error) {
+	size := aead.NonceSize()
+	if len(data) < size {
+		err = errors.New("encrypted data is too short")
+		return
+	}
+	result, err = aead.Open(nil, data[:size], data[size:], additionalData)
+	return
+}
+
+// keySize is the size of the keys in bytes, 32 bytes because we use AES-256.
+const keySize = 32
+
+// Prefix and separator of the encrypted values:
+const (
+	encryptedPrefix    = "enc:v1:"
+	encryptedSeparator = ":"
+)
@@ -0,0 +1,203 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keyring", func() {
+	var (
+		key1 = bytes.Repeat([]byte{1}, 32)
+		key2 = bytes.Repeat([]byte{2}, 32)
+	)
+
+	Describe("Creation", func() {
+		It("Can be created with one key", func() {
+			keyring, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyring.Primary()).To(Equal("key-1"))
+		})
+
+		It("Can't be created without a logger", func() {
+			_, err := NewKeyring().
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+
+		It("Can't be created without keys", func() {
+			_, err := NewKeyring().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(MatchError("at least one key is mandatory"))
+		})
+
+		It("Can't be created with a key of the wrong size", func() {
+			_, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", []byte("short")).
+				Build()
+			Expect(err).To(MatchError("key 'key-1' should be 32 bytes long, but it is 5 bytes long"))
+		})
+
+		It("Can't be created with multiple keys and no primary", func() {
+			_, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				AddKey("key-2", key2).
+				Build()
+			Expect(err).To(MatchError("primary key is mandatory when there are multiple keys"))
+		})
+
+		It("Can't be created with a primary key that doesn't exist", func() {
+			_, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				SetPrimary("key-2").
+				Build()
+			Expect(err).To(MatchError("primary key 'key-2' doesn't exist"))
+		})
+
+		It("Can be created from a file", func() {
+			dir := GinkgoT().TempDir()
+			file := filepath.Join(dir, "keys.yaml")
+			data := "primary: key-2\n" +
+				"keys:\n" +
+				"- id: key-1\n" +
+				"  secret: " + base64.StdEncoding.EncodeToString(key1) + "\n" +
+				"- id: key-2\n" +
+				"  secret: " + base64.StdEncoding.EncodeToString(key2) + "\n"
+			Expect(os.WriteFile(file, []byte(data), 0600)).To(Succeed())
+			keyring, err := NewKeyring().
+				SetLogger(logger).
+				SetFile(file).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyring.Primary()).To(Equal("key-2"))
+		})
+	})
+
+	Describe("Encryption", func() {
+		It("Decrypts what it encrypts", func() {
+			keyring, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := keyring.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsEncrypted(encrypted)).To(BeTrue())
+			Expect(string(encrypted)).To(HavePrefix("enc:v1:key-1:"))
+			Expect(string(encrypted)).ToNot(ContainSubstring("my-secret"))
+			decrypted, err := keyring.Decrypt(encrypted, []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(decrypted)).To(Equal("my-secret"))
+		})
+
+		It("Uses a different data key for each value", func() {
+			keyring, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			first, err := keyring.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			second, err := keyring.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first).ToNot(Equal(second))
+		})
+
+		It("Decrypts values encrypted with a key that is no longer primary", func() {
+			old, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := old.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			rotated, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				AddKey("key-2", key2).
+				SetPrimary("key-2").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rotated.IsCurrent(encrypted)).To(BeFalse())
+			decrypted, err := rotated.Decrypt(encrypted, []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(decrypted)).To(Equal("my-secret"))
+			encrypted, err = rotated.Encrypt(decrypted, []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rotated.IsCurrent(encrypted)).To(BeTrue())
+		})
+
+		It("Fails if the key doesn't exist", func() {
+			old, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := old.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			other, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-2", key2).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = other.Decrypt(encrypted, []byte("my-id"))
+			Expect(err).To(MatchError("key 'key-1' used to encrypt the value doesn't exist"))
+		})
+
+		It("Fails if the additional data is different", func() {
+			keyring, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := keyring.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = keyring.Decrypt(encrypted, []byte("your-id"))
+			Expect(err).To(HaveOccurred())
+			_, err = keyring.Decrypt(encrypted, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Fails if the key is wrong", func() {
+			old, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key1).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := old.Encrypt([]byte("my-secret"), []byte("my-id"))
+			Expect(err).ToNot(HaveOccurred())
+			other, err := NewKeyring().
+				SetLogger(logger).
+				AddKey("key-1", key2).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = other.Decrypt(encrypted, []byte("my-id"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
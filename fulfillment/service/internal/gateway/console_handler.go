@@ -0,0 +1,279 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+)
+
+// ConsolePath is the path pattern where the console handler should be registered.
+const ConsolePath = "/api/fulfillment/v1/virtual_machines/{id}/console"
+
+// ConsoleHandlerBuilder contains the data and logic needed to create a console handler. Don't create instances of this
+// type directly, use the NewConsoleHandler function instead.
+type ConsoleHandlerBuilder struct {
+	logger         *slog.Logger
+	connection     *grpc.ClientConn
+	allowedOrigins []string
+}
+
+// ConsoleHandler is an HTTP handler that accepts WebSocket connections and forwards them to the `Console` method of
+// the virtual machines gRPC service. The data is sent and received as binary WebSocket messages.
+type ConsoleHandler struct {
+	logger         *slog.Logger
+	client         ffv1.VirtualMachinesClient
+	allowedOrigins []string
+	upgrader       *websocket.Upgrader
+}
+
+// NewConsoleHandler creates a builder that can then be used to configure and create a console handler.
+func NewConsoleHandler() *ConsoleHandlerBuilder {
+	return &ConsoleHandlerBuilder{}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *ConsoleHandlerBuilder) SetLogger(value *slog.Logger) *ConsoleHandlerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetConnection sets the connection to the gRPC server. This is mandatory.
+func (b *ConsoleHandlerBuilder) SetConnection(value *grpc.ClientConn) *ConsoleHandlerBuilder {
+	b.connection = value
+	return b
+}
+
+// AddAllowedOrigins adds origins that are allowed to open WebSocket connections. Browsers don't apply the CORS rules
+// to WebSocket connections, so this should usually be the same list of origins given to the CORS middleware. Use `*`
+// to allow all origins. This is optional, and the default is to allow only requests from the same origin.
+func (b *ConsoleHandlerBuilder) AddAllowedOrigins(values ...string) *ConsoleHandlerBuilder {
+	b.allowedOrigins = append(b.allowedOrigins, values...)
+	return b
+}
+
+// Build uses the data stored in the builder to create a new console handler.
+func (b *ConsoleHandlerBuilder) Build() (result *ConsoleHandler, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.connection == nil {
+		err = errors.New("gRPC connection is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &ConsoleHandler{
+		logger:         b.logger,
+		client:         ffv1.NewVirtualMachinesClient(b.connection),
+		allowedOrigins: slices.Clone(b.allowedOrigins),
+	}
+	result.upgrader = &websocket.Upgrader{
+		CheckOrigin: result.checkOrigin,
+	}
+	return
+}
+
+// checkOrigin checks if the origin of a WebSocket request is allowed.
+func (h *ConsoleHandler) checkOrigin(r *http.Request) bool {
+	return checkWebSocketOrigin(h.allowedOrigins, r)
+}
+
+// Serve is the handler function. It has the signature required by the gateway multiplexer, so it can be registered
+// using its HandlePath method with the ConsolePath pattern.
+func (h *ConsoleHandler) Serve(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	// Get the identifier of the virtual machine and the type of console:
+	id := pathParams["id"]
+	if id == "" {
+		http.Error(w, "virtual machine identifier is mandatory", http.StatusBadRequest)
+		return
+	}
+	kind, err := h.parseType(r.URL.Query().Get("type"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Start the gRPC stream, forwarding the authentication and tenant headers, and send the first message:
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = metadata.NewOutgoingContext(ctx, makeMetadata(r))
+	stream, err := h.client.Console(ctx)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+	err = stream.Send(ffv1.VirtualMachinesConsoleRequest_builder{
+		Id:   id,
+		Type: kind,
+	}.Build())
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	// The server sends the headers once it has connected to the console, so wait for them before upgrading the
+	// connection. That way errors like a virtual machine that doesn't exist can be reported with the regular HTTP
+	// status codes.
+	header, err := stream.Header()
+	if err == nil && header == nil {
+		err = stream.RecvMsg(&ffv1.VirtualMachinesConsoleResponse{})
+		if err == nil {
+			err = grpcstatus.Errorf(grpccodes.Internal, "console stream ended without headers")
+		}
+	}
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	// Upgrade the connection:
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already sent the error response to the client.
+		h.logger.DebugContext(
+			ctx,
+			"Failed to upgrade console connection",
+			slog.String("id", id),
+			slog.Any("error", err),
+		)
+		return
+	}
+	defer conn.Close()
+
+	// Copy the data in both directions till one of the sides finishes:
+	h.copy(ctx, stream, conn)
+}
+
+// copy copies the data received from the WebSocket connection to the gRPC stream and the other way around. It returns
+// when either of them finishes.
+func (h *ConsoleHandler) copy(ctx context.Context, stream ffv1.VirtualMachines_ConsoleClient, conn *websocket.Conn) {
+	// Copy from the WebSocket connection to the gRPC stream:
+	go func() {
+		defer func() {
+			err := stream.CloseSend()
+			if err != nil {
+				h.logger.DebugContext(
+					ctx,
+					"Failed to close console stream",
+					slog.Any("error", err),
+				)
+			}
+		}()
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			err = stream.Send(ffv1.VirtualMachinesConsoleRequest_builder{
+				Data: data,
+			}.Build())
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// Copy from the gRPC stream to the WebSocket connection, and when the stream finishes tell the client why:
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			sendClose(conn, websocket.CloseNormalClosure, "")
+			return
+		}
+		if err != nil {
+			code := websocket.CloseInternalServerErr
+			status := grpcstatus.Convert(err)
+			switch status.Code() {
+			case grpccodes.Canceled:
+				return
+			case grpccodes.Unauthenticated, grpccodes.PermissionDenied:
+				code = websocket.ClosePolicyViolation
+			}
+			sendClose(conn, code, status.Message())
+			return
+		}
+		err = conn.WriteMessage(websocket.BinaryMessage, response.GetData())
+		if err != nil {
+			return
+		}
+	}
+}
+
+// sendClose sends the close message to the WebSocket client. Errors are ignored because at this point the client may
+// have already closed the connection.
+func sendClose(conn *websocket.Conn, code int, text string) {
+	// The close message can't be longer than 125 bytes, and two of them are used for the code:
+	if len(text) > 123 {
+		text = text[:123]
+	}
+	_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(code, text))
+}
+
+// sendError sends an HTTP error response corresponding to the given gRPC error.
+func sendError(w http.ResponseWriter, err error) {
+	status := grpcstatus.Convert(err)
+	http.Error(w, status.Message(), runtime.HTTPStatusFromCode(status.Code()))
+}
+
+// makeMetadata creates the gRPC metadata from the HTTP headers that need to be forwarded to the server.
+func makeMetadata(r *http.Request) metadata.MD {
+	result := metadata.MD{}
+	for _, name := range forwardedHeaders {
+		values := r.Header.Values(name)
+		if len(values) > 0 {
+			result.Set(strings.ToLower(name), values...)
+		}
+	}
+	return result
+}
+
+// parseType converts the value of the `type` query parameter into the console type.
+func (h *ConsoleHandler) parseType(value string) (result ffv1.VirtualMachineConsoleType, err error) {
+	switch strings.ToLower(value) {
+	case "", "serial":
+		result = ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_SERIAL
+	case "vnc":
+		result = ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_VNC
+	default:
+		err = fmt.Errorf("console type '%s' isn't supported, valid values are 'serial' and 'vnc'", value)
+	}
+	return
+}
+
+// forwardedHeaders are the HTTP headers that are forwarded to the gRPC server.
+var forwardedHeaders = []string{
+	auth.Authorization,
+	auth.Tenant,
+}
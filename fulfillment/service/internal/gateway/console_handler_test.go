@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package gateway
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+)
+
+// consoleServer is a fake implementation of the virtual machines server that echoes back the console data that it
+// receives, converted to upper case.
+type consoleServer struct {
+	ffv1.UnimplementedVirtualMachinesServer
+}
+
+func (s *consoleServer) Console(stream ffv1.VirtualMachines_ConsoleServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if len(md.Get("authorization")) == 0 {
+		return grpcstatus.Errorf(grpccodes.Unauthenticated, "missing authorization")
+	}
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if request.GetId() != "123" {
+		return grpcstatus.Errorf(grpccodes.NotFound, "virtual machine '%s' doesn't exist", request.GetId())
+	}
+	if request.GetType() == ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_VNC {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "VNC isn't available")
+	}
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+	for {
+		request, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if string(request.GetData()) == "exit" {
+			return nil
+		}
+		err = stream.Send(ffv1.VirtualMachinesConsoleResponse_builder{
+			Data: []byte(strings.ToUpper(string(request.GetData()))),
+		}.Build())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+var _ = Describe("Console handler", func() {
+	var (
+		grpcServer *grpc.Server
+		grpcConn   *grpc.ClientConn
+		httpServer *httptest.Server
+		consoleURL string
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Start the fake gRPC server:
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		grpcServer = grpc.NewServer()
+		ffv1.RegisterVirtualMachinesServer(grpcServer, &consoleServer{})
+		go func() {
+			defer GinkgoRecover()
+			err := grpcServer.Serve(listener)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Create the connection to the gRPC server:
+		grpcConn, err = grpc.NewClient(
+			listener.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the handler and the HTTP server:
+		handler, err := NewConsoleHandler().
+			SetLogger(logger).
+			SetConnection(grpcConn).
+			AddAllowedOrigins("http://my.com").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		mux := runtime.NewServeMux()
+		err = mux.HandlePath(http.MethodGet, ConsolePath, handler.Serve)
+		Expect(err).ToNot(HaveOccurred())
+		httpServer = httptest.NewServer(mux)
+		consoleURL = "ws" + strings.TrimPrefix(httpServer.URL, "http") + "/api/fulfillment/v1/virtual_machines"
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+		err := grpcConn.Close()
+		Expect(err).ToNot(HaveOccurred())
+		grpcServer.Stop()
+	})
+
+	// dial opens a WebSocket connection to the given path, sending the authorization header and the given additional
+	// header names and values.
+	dial := func(path string, headers ...string) (conn *websocket.Conn, response *http.Response, err error) {
+		header := http.Header{}
+		header.Set("Authorization", "Bearer my-token")
+		for i := 0; i+1 < len(headers); i += 2 {
+			header.Set(headers[i], headers[i+1])
+		}
+		conn, response, err = websocket.DefaultDialer.Dial(consoleURL+path, header)
+		return
+	}
+
+	Context("Using the builder", func() {
+		It("Fails if the logger is not set", func() {
+			_, err := NewConsoleHandler().
+				SetConnection(grpcConn).
+				Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("logger"))
+			Expect(err.Error()).To(ContainSubstring("mandatory"))
+		})
+
+		It("Fails if the connection is not set", func() {
+			_, err := NewConsoleHandler().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("connection"))
+			Expect(err.Error()).To(ContainSubstring("mandatory"))
+		})
+	})
+
+	It("Copies data in both directions", func() {
+		conn, _, err := dial("/123/console?type=serial")
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		err = conn.WriteMessage(websocket.BinaryMessage, []byte("hello"))
+		Expect(err).ToNot(HaveOccurred())
+		kind, data, err := conn.ReadMessage()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kind).To(Equal(websocket.BinaryMessage))
+		Expect(string(data)).To(Equal("HELLO"))
+	})
+
+	It("Sends normal close when the console finishes", func() {
+		conn, _, err := dial("/123/console")
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		err = conn.WriteMessage(websocket.BinaryMessage, []byte("exit"))
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = conn.ReadMessage()
+		Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+	})
+
+	It("Returns not found if the virtual machine doesn't exist", func() {
+		_, response, err := dial("/456/console")
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("Returns bad request for unsupported console type", func() {
+		_, response, err := dial("/123/console?type=junk")
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Reports the error if the console isn't available", func() {
+		_, response, err := dial("/123/console?type=vnc")
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+
+	It("Accepts allowed origins", func() {
+		conn, _, err := dial("/123/console", "Origin", "http://my.com")
+		Expect(err).ToNot(HaveOccurred())
+		conn.Close()
+	})
+
+	It("Rejects origins that aren't allowed", func() {
+		_, response, err := dial("/123/console", "Origin", "http://your.com")
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+	})
+
+	It("Forwards the authorization header", func() {
+		_, response, err := websocket.DefaultDialer.Dial(consoleURL+"/123/console", nil)
+		Expect(err).To(HaveOccurred())
+		Expect(response).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+})
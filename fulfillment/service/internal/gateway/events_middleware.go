@@ -0,0 +1,401 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/jkary/osac/fulfillment/service/internal/api/events/v1"
+)
+
+// EventsPath is the path of the `Watch` method of the events service. It is the same path used by the generated
+// gateway handler.
+const EventsPath = "/api/events/v1/events"
+
+// EventsMiddlewareBuilder contains the data and logic needed to create the events middleware. Don't create instances
+// of this type directly, use the NewEventsMiddleware function instead.
+type EventsMiddlewareBuilder struct {
+	logger         *slog.Logger
+	connection     *grpc.ClientConn
+	allowedOrigins []string
+}
+
+// eventsMiddleware intercepts the requests to watch events that ask for Server-Sent Events or for a WebSocket
+// connection, and forwards them to the `Watch` method of the events gRPC service. The rest of the requests are passed
+// to the next handler, usually the gateway multiplexer, which sends the events as a stream of JSON objects.
+type eventsMiddleware struct {
+	logger         *slog.Logger
+	client         eventsv1.EventsClient
+	allowedOrigins []string
+	upgrader       *websocket.Upgrader
+	marshaller     protojson.MarshalOptions
+	next           http.Handler
+}
+
+// NewEventsMiddleware creates a builder that can then be used to configure and create the events middleware.
+func NewEventsMiddleware() *EventsMiddlewareBuilder {
+	return &EventsMiddlewareBuilder{}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *EventsMiddlewareBuilder) SetLogger(value *slog.Logger) *EventsMiddlewareBuilder {
+	b.logger = value
+	return b
+}
+
+// SetConnection sets the connection to the gRPC server. This is mandatory.
+func (b *EventsMiddlewareBuilder) SetConnection(value *grpc.ClientConn) *EventsMiddlewareBuilder {
+	b.connection = value
+	return b
+}
+
+// AddAllowedOrigins adds origins that are allowed to open WebSocket connections. Browsers don't apply the CORS rules
+// to WebSocket connections, so this should usually be the same list of origins given to the CORS middleware. Use `*`
+// to allow all origins. This is optional, and the default is to allow only requests from the same origin.
+func (b *EventsMiddlewareBuilder) AddAllowedOrigins(values ...string) *EventsMiddlewareBuilder {
+	b.allowedOrigins = append(b.allowedOrigins, values...)
+	return b
+}
+
+// Build uses the data stored in the builder to create a new events middleware.
+func (b *EventsMiddlewareBuilder) Build() (result func(http.Handler) http.Handler, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.connection == nil {
+		err = errors.New("gRPC connection is mandatory")
+		return
+	}
+
+	// Create the middleware:
+	allowedOrigins := slices.Clone(b.allowedOrigins)
+	client := eventsv1.NewEventsClient(b.connection)
+	result = func(next http.Handler) http.Handler {
+		m := &eventsMiddleware{
+			logger:         b.logger,
+			client:         client,
+			allowedOrigins: allowedOrigins,
+			marshaller: protojson.MarshalOptions{
+				UseProtoNames: true,
+			},
+			next: next,
+		}
+		m.upgrader = &websocket.Upgrader{
+			CheckOrigin: m.checkOrigin,
+		}
+		return m
+	}
+	return
+}
+
+// ServeHTTP is the implementation of the http.Handler interface.
+func (m *eventsMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != EventsPath {
+		m.next.ServeHTTP(w, r)
+		return
+	}
+	switch {
+	case websocket.IsWebSocketUpgrade(r):
+		m.serveWebSocket(w, r)
+	case m.acceptsEventStream(r):
+		m.serveEventStream(w, r)
+	default:
+		m.next.ServeHTTP(w, r)
+	}
+}
+
+// serveEventStream sends the events using the Server-Sent Events protocol. Each event is sent with its sequence number
+// as the identifier, so that when a browser connects again it sends it in the `Last-Event-ID` header and the server
+// continues from that point. Bookmarks are sent with the `bookmark` type, and errors that happen after the stream
+// has started with the `error` type.
+func (m *eventsMiddleware) serveEventStream(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Start watching:
+	request, err := m.parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	stream, err := m.watch(ctx, r, request)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	// Send the headers, and then the events as they arrive:
+	controller := http.NewResponseController(w)
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	err = controller.Flush()
+	if err != nil {
+		m.logger.ErrorContext(
+			ctx,
+			"Failed to flush event stream",
+			slog.Any("error", err),
+		)
+		return
+	}
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			status := grpcstatus.Convert(err)
+			if status.Code() == grpccodes.Canceled {
+				return
+			}
+			_ = m.writeEvent(w, "error", "", status.Proto())
+			_ = controller.Flush()
+			return
+		}
+		if response.HasEvent() {
+			err = m.writeEvent(w, "", strconv.FormatInt(response.GetEvent().GetSequence(), 10), response)
+		} else {
+			err = m.writeEvent(w, "bookmark", strconv.FormatInt(response.GetBookmark(), 10), response)
+		}
+		if err == nil {
+			err = controller.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes one Server-Sent Event with the given type, identifier and message. The type and identifier are
+// omitted when they are empty.
+func (m *eventsMiddleware) writeEvent(w io.Writer, kind, id string, message proto.Message) error {
+	data, err := m.marshaller.Marshal(message)
+	if err != nil {
+		return err
+	}
+	buffer := &bytes.Buffer{}
+	if kind != "" {
+		fmt.Fprintf(buffer, "event: %s\n", kind)
+	}
+	if id != "" {
+		fmt.Fprintf(buffer, "id: %s\n", id)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(buffer, "data: %s\n", line)
+	}
+	buffer.WriteString("\n")
+	_, err = w.Write(buffer.Bytes())
+	return err
+}
+
+// serveWebSocket sends the events using a WebSocket connection. Each response of the gRPC stream is sent as a text
+// message containing its JSON representation. When the gRPC stream finishes the connection is closed, with a close
+// code and text that describe the reason.
+func (m *eventsMiddleware) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Start watching. Note that this is done before upgrading the connection, so that errors like an invalid filter
+	// are reported with the regular HTTP status codes.
+	request, err := m.parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	stream, err := m.watch(ctx, r, request)
+	if err != nil {
+		sendError(w, err)
+		return
+	}
+
+	// Upgrade the connection:
+	conn, err := m.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already sent the error response to the client.
+		m.logger.DebugContext(
+			ctx,
+			"Failed to upgrade events connection",
+			slog.Any("error", err),
+		)
+		return
+	}
+	defer conn.Close()
+
+	// The client isn't expected to send messages, but we need to read them in order to process the control
+	// messages and to find out when the client closes the connection:
+	go func() {
+		defer cancel()
+		for {
+			_, _, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// Send the responses till the stream finishes:
+	for {
+		response, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			sendClose(conn, websocket.CloseNormalClosure, "")
+			return
+		}
+		if err != nil {
+			code := websocket.CloseInternalServerErr
+			status := grpcstatus.Convert(err)
+			switch status.Code() {
+			case grpccodes.Canceled:
+				return
+			case grpccodes.Unauthenticated, grpccodes.PermissionDenied:
+				code = websocket.ClosePolicyViolation
+			case grpccodes.ResourceExhausted, grpccodes.OutOfRange:
+				code = websocket.CloseTryAgainLater
+			}
+			sendClose(conn, code, status.Message())
+			return
+		}
+		data, err := m.marshaller.Marshal(response)
+		if err != nil {
+			m.logger.ErrorContext(
+				ctx,
+				"Failed to marshal events response",
+				slog.Any("error", err),
+			)
+			sendClose(conn, websocket.CloseInternalServerErr, "")
+			return
+		}
+		err = conn.WriteMessage(websocket.TextMessage, data)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// watch starts the gRPC stream, forwarding the authentication and tenant headers, and waits till the server sends the
+// headers, so that errors that happen before that can be reported to the client with the regular HTTP status codes.
+func (m *eventsMiddleware) watch(ctx context.Context, r *http.Request,
+	request *eventsv1.EventsWatchRequest) (result eventsv1.Events_WatchClient, err error) {
+	ctx = metadata.NewOutgoingContext(ctx, makeMetadata(r))
+	stream, err := m.client.Watch(ctx, request)
+	if err != nil {
+		return
+	}
+	header, err := stream.Header()
+	if err == nil && header == nil {
+		_, err = stream.Recv()
+		if err == nil {
+			err = grpcstatus.Errorf(grpccodes.Internal, "events stream started without headers")
+		}
+	}
+	if err != nil {
+		return
+	}
+	result = stream
+	return
+}
+
+// parseRequest creates the watch request from the `filter`, `since` and `bookmarks` query parameters. The
+// `Last-Event-ID` header, sent by browsers when they reconnect to an event stream, takes precedence over the `since`
+// parameter.
+func (m *eventsMiddleware) parseRequest(r *http.Request) (result *eventsv1.EventsWatchRequest, err error) {
+	query := r.URL.Query()
+	request := &eventsv1.EventsWatchRequest{}
+	if query.Has("filter") {
+		request.SetFilter(query.Get("filter"))
+	}
+	since := r.Header.Get("Last-Event-ID")
+	if since == "" {
+		since = query.Get("since")
+	}
+	if since != "" {
+		var value int64
+		value, err = strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			err = fmt.Errorf("sequence number '%s' isn't a valid integer", since)
+			return
+		}
+		request.SetSince(value)
+	}
+	if query.Has("bookmarks") {
+		var value bool
+		value, err = strconv.ParseBool(query.Get("bookmarks"))
+		if err != nil {
+			err = fmt.Errorf("bookmarks value '%s' isn't a valid boolean", query.Get("bookmarks"))
+			return
+		}
+		request.SetBookmarks(value)
+	}
+	result = request
+	return
+}
+
+// acceptsEventStream checks if the `Accept` header of the request contains the Server-Sent Events media type.
+func (m *eventsMiddleware) acceptsEventStream(r *http.Request) bool {
+	for _, header := range r.Header.Values("Accept") {
+		for _, item := range strings.Split(header, ",") {
+			mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(item))
+			if err == nil && mediaType == "text/event-stream" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkOrigin checks if the origin of a WebSocket request is allowed.
+func (m *eventsMiddleware) checkOrigin(r *http.Request) bool {
+	return checkWebSocketOrigin(m.allowedOrigins, r)
+}
+
+// checkWebSocketOrigin checks if the origin of a WebSocket request is in the given list of allowed origins. Requests
+// without an origin come from clients that aren't browsers, and are always allowed. When the list is empty only
+// requests from the same origin are allowed, like the default check of the WebSocket library.
+func checkWebSocketOrigin(allowedOrigins []string, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if len(allowedOrigins) == 0 {
+		parsed, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(parsed.Host, r.Host)
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
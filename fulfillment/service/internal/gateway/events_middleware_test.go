@@ -0,0 +1,407 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package gateway
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	eventsv1 "github.com/jkary/osac/fulfillment/service/internal/api/events/v1"
+)
+
+// eventsServer is a fake implementation of the events server that sends two events after the requested sequence
+// number, and a bookmark if requested, and then finishes.
+type eventsServer struct {
+	eventsv1.UnimplementedEventsServer
+
+	lock    sync.Mutex
+	request *eventsv1.EventsWatchRequest
+}
+
+func (s *eventsServer) Watch(request *eventsv1.EventsWatchRequest,
+	stream grpc.ServerStreamingServer[eventsv1.EventsWatchResponse]) error {
+	s.lock.Lock()
+	s.request = request
+	s.lock.Unlock()
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if !slices.Equal(md.Get("authorization"), []string{"Bearer my-token"}) {
+		return grpcstatus.Errorf(grpccodes.Unauthenticated, "missing authorization")
+	}
+	if request.GetFilter() == "junk" {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "failed to compile filter 'junk'")
+	}
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+	since := request.GetSince()
+	for i := int64(1); i <= 2; i++ {
+		err = stream.Send(eventsv1.EventsWatchResponse_builder{
+			Event: eventsv1.Event_builder{
+				Id:       "my-event",
+				Type:     eventsv1.EventType_EVENT_TYPE_OBJECT_CREATED,
+				Sequence: since + i,
+			}.Build(),
+		}.Build())
+		if err != nil {
+			return err
+		}
+	}
+	if request.GetBookmarks() {
+		bookmark := since + 3
+		err = stream.Send(eventsv1.EventsWatchResponse_builder{
+			Bookmark: &bookmark,
+		}.Build())
+		if err != nil {
+			return err
+		}
+	}
+	if request.GetFilter() == "overflow" {
+		return grpcstatus.Errorf(grpccodes.ResourceExhausted, "client is too slow")
+	}
+	return nil
+}
+
+func (s *eventsServer) lastRequest() *eventsv1.EventsWatchRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.request
+}
+
+var _ = Describe("Events middleware", func() {
+	var (
+		server     *eventsServer
+		grpcServer *grpc.Server
+		grpcConn   *grpc.ClientConn
+		httpServer *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Start the fake gRPC server:
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		server = &eventsServer{}
+		grpcServer = grpc.NewServer()
+		eventsv1.RegisterEventsServer(grpcServer, server)
+		go func() {
+			defer GinkgoRecover()
+			err := grpcServer.Serve(listener)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// Create the connection to the gRPC server:
+		grpcConn, err = grpc.NewClient(
+			listener.Addr().String(),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if httpServer != nil {
+			httpServer.Close()
+			httpServer = nil
+		}
+		err := grpcConn.Close()
+		Expect(err).ToNot(HaveOccurred())
+		grpcServer.Stop()
+	})
+
+	// start creates the middleware with the given allowed origins and starts an HTTP server that uses it. The next
+	// handler responds with a fixed text, so that tests can check when requests are passed to it.
+	start := func(origins ...string) {
+		middleware, err := NewEventsMiddleware().
+			SetLogger(logger).
+			SetConnection(grpcConn).
+			AddAllowedOrigins(origins...).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("next"))
+		})
+		httpServer = httptest.NewServer(middleware(next))
+	}
+
+	// get sends a request to the given path, asking for an event stream and sending the authorization header, and
+	// returns the response status and body.
+	get := func(path string, headers ...string) (status int, body string) {
+		request, err := http.NewRequest(http.MethodGet, httpServer.URL+path, nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Accept", "text/event-stream")
+		request.Header.Set("Authorization", "Bearer my-token")
+		for i := 0; i+1 < len(headers); i += 2 {
+			request.Header.Set(headers[i], headers[i+1])
+		}
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).ToNot(HaveOccurred())
+		defer response.Body.Close()
+		data, err := io.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		status = response.StatusCode
+		body = string(data)
+		return
+	}
+
+	// sseEvent is a Server-Sent Event parsed from the body of a response.
+	type sseEvent struct {
+		Kind string
+		ID   string
+		Data map[string]any
+	}
+
+	// parse parses the Server-Sent Events contained in the given response body.
+	parse := func(body string) (result []sseEvent) {
+		for _, block := range strings.Split(strings.TrimSuffix(body, "\n\n"), "\n\n") {
+			var event sseEvent
+			for _, line := range strings.Split(block, "\n") {
+				field, value, ok := strings.Cut(line, ": ")
+				Expect(ok).To(BeTrue(), "line '%s' doesn't contain a field", line)
+				switch field {
+				case "event":
+					event.Kind = value
+				case "id":
+					event.ID = value
+				case "data":
+					err := json.Unmarshal([]byte(value), &event.Data)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+			result = append(result, event)
+		}
+		return
+	}
+
+	// dial opens a WebSocket connection to the events path with the given query and headers, sending the
+	// authorization header.
+	dial := func(query string, headers ...string) (conn *websocket.Conn, response *http.Response, err error) {
+		header := http.Header{}
+		header.Set("Authorization", "Bearer my-token")
+		for i := 0; i+1 < len(headers); i += 2 {
+			header.Set(headers[i], headers[i+1])
+		}
+		url := "ws" + strings.TrimPrefix(httpServer.URL, "http") + EventsPath + query
+		conn, response, err = websocket.DefaultDialer.Dial(url, header)
+		return
+	}
+
+	Context("Using the builder", func() {
+		It("Fails if the logger is not set", func() {
+			_, err := NewEventsMiddleware().
+				SetConnection(grpcConn).
+				Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+
+		It("Fails if the connection is not set", func() {
+			_, err := NewEventsMiddleware().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(MatchError("gRPC connection is mandatory"))
+		})
+	})
+
+	Context("Routing", func() {
+		BeforeEach(func() {
+			start()
+		})
+
+		It("Passes requests that don't ask for an event stream to the next handler", func() {
+			response, err := http.Get(httpServer.URL + EventsPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			data, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("next"))
+		})
+
+		It("Passes requests for other paths to the next handler", func() {
+			status, body := get("/api/fulfillment/v1/clusters")
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(Equal("next"))
+		})
+	})
+
+	Context("Server-Sent Events", func() {
+		BeforeEach(func() {
+			start()
+		})
+
+		It("Sends the events with their sequence numbers", func() {
+			status, body := get(EventsPath + "?since=10")
+			Expect(status).To(Equal(http.StatusOK))
+			events := parse(body)
+			Expect(events).To(HaveLen(2))
+			for i, expected := range []string{"11", "12"} {
+				Expect(events[i].Kind).To(BeEmpty())
+				Expect(events[i].ID).To(Equal(expected))
+				Expect(events[i].Data).To(HaveKeyWithValue("event", And(
+					HaveKeyWithValue("id", "my-event"),
+					HaveKeyWithValue("type", "EVENT_TYPE_OBJECT_CREATED"),
+					HaveKeyWithValue("sequence", expected),
+				)))
+			}
+		})
+
+		It("Passes the filter to the server", func() {
+			status, _ := get(EventsPath + "?filter=event.type%20%3D%3D%20EVENT_TYPE_OBJECT_CREATED")
+			Expect(status).To(Equal(http.StatusOK))
+			request := server.lastRequest()
+			Expect(request.HasFilter()).To(BeTrue())
+			Expect(request.GetFilter()).To(Equal("event.type == EVENT_TYPE_OBJECT_CREATED"))
+			Expect(request.HasSince()).To(BeFalse())
+		})
+
+		It("Continues from the last event identifier", func() {
+			status, body := get(EventsPath+"?since=10", "Last-Event-ID", "20")
+			Expect(status).To(Equal(http.StatusOK))
+			events := parse(body)
+			Expect(events).ToNot(BeEmpty())
+			Expect(events[0].ID).To(Equal("21"))
+		})
+
+		It("Sends bookmarks with their own type", func() {
+			status, body := get(EventsPath + "?bookmarks=true")
+			Expect(status).To(Equal(http.StatusOK))
+			events := parse(body)
+			Expect(events).To(HaveLen(3))
+			Expect(events[2].Kind).To(Equal("bookmark"))
+			Expect(events[2].ID).To(Equal("3"))
+			Expect(events[2].Data).To(Equal(map[string]any{
+				"bookmark": "3",
+			}))
+		})
+
+		It("Sends errors that happen after the stream started", func() {
+			status, body := get(EventsPath + "?filter=overflow")
+			Expect(status).To(Equal(http.StatusOK))
+			events := parse(body)
+			Expect(events).To(HaveLen(3))
+			Expect(events[2].Kind).To(Equal("error"))
+			Expect(events[2].Data).To(HaveKeyWithValue("message", "client is too slow"))
+		})
+
+		It("Returns bad request if the filter is invalid", func() {
+			status, body := get(EventsPath + "?filter=junk")
+			Expect(status).To(Equal(http.StatusBadRequest))
+			Expect(body).To(ContainSubstring("failed to compile filter 'junk'"))
+		})
+
+		It("Returns bad request if the sequence number is invalid", func() {
+			status, body := get(EventsPath + "?since=junk")
+			Expect(status).To(Equal(http.StatusBadRequest))
+			Expect(body).To(ContainSubstring("sequence number 'junk' isn't a valid integer"))
+		})
+
+		It("Returns unauthorized if the server rejects the credentials", func() {
+			status, _ := get(EventsPath, "Authorization", "")
+			Expect(status).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("WebSocket", func() {
+		It("Sends the events as text messages", func() {
+			start()
+			conn, _, err := dial("?since=10")
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			for _, expected := range []string{"11", "12"} {
+				kind, data, err := conn.ReadMessage()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kind).To(Equal(websocket.TextMessage))
+				var response map[string]any
+				err = json.Unmarshal(data, &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(HaveKeyWithValue("event", HaveKeyWithValue("sequence", expected)))
+			}
+			_, _, err = conn.ReadMessage()
+			Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+		})
+
+		It("Asks the client to try later when the server disconnects it", func() {
+			start()
+			conn, _, err := dial("?filter=overflow")
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			for {
+				_, _, err = conn.ReadMessage()
+				if err != nil {
+					break
+				}
+			}
+			Expect(websocket.IsCloseError(err, websocket.CloseTryAgainLater)).To(BeTrue())
+		})
+
+		It("Returns bad request if the filter is invalid", func() {
+			start()
+			_, response, err := dial("?filter=junk")
+			Expect(err).To(HaveOccurred())
+			Expect(response).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("Accepts allowed origins", func() {
+			start("http://my.com")
+			conn, _, err := dial("", "Origin", "http://my.com")
+			Expect(err).ToNot(HaveOccurred())
+			conn.Close()
+		})
+
+		It("Rejects origins that aren't allowed", func() {
+			start("http://my.com")
+			_, response, err := dial("", "Origin", "http://your.com")
+			Expect(err).To(HaveOccurred())
+			Expect(response).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+		})
+
+		It("Accepts the same origin if no origins are configured", func() {
+			start()
+			conn, _, err := dial("", "Origin", httpServer.URL)
+			Expect(err).ToNot(HaveOccurred())
+			conn.Close()
+		})
+
+		It("Rejects other origins if no origins are configured", func() {
+			start()
+			_, response, err := dial("", "Origin", "http://your.com")
+			Expect(err).To(HaveOccurred())
+			Expect(response).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+		})
+
+		It("Accepts all origins if configured with '*'", func() {
+			start("*")
+			conn, _, err := dial("", "Origin", "http://your.com")
+			Expect(err).ToNot(HaveOccurred())
+			conn.Close()
+		})
+	})
+})
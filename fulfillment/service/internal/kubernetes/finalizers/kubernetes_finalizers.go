@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package finalizers
+
+import (
+	"fmt"
+
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
+)
+
+// VirtualMachineSnapshots is the finalizer that the fulfillment API adds to a virtual machine when a snapshot is
+// created for it. It is removed by the virtual machine controller once all the snapshots have been deleted.
+var VirtualMachineSnapshots = fmt.Sprintf("%s/%s", gvks.VirtualMachine.Group, "virtualmachine-snapshots")
+
+// HubResources is the finalizer that the fulfillment API adds to objects that have related resources in a hub. It is
+// removed by the corresponding controller once those resources have been deleted.
+var HubResources = fmt.Sprintf("%s/%s", gvks.VirtualMachine.Group, "hub-resources")
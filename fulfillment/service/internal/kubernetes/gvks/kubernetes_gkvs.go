@@ -39,6 +39,14 @@ var VirtualMachine = schema.GroupVersionKind{
 
 var VirtualMachineList = listGVK(VirtualMachine)
 
+var VirtualMachineSnapshot = schema.GroupVersionKind{
+	Group:   "cloudkit.openshift.io",
+	Version: "v1alpha1",
+	Kind:    "VirtualMachineSnapshot",
+}
+
+var VirtualMachineSnapshotList = listGVK(VirtualMachineSnapshot)
+
 func listGVK(gvk schema.GroupVersionKind) schema.GroupVersionKind {
 	gvk.Kind = gvk.Kind + "List"
 	return gvk
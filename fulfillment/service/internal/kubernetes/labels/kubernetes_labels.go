@@ -22,5 +22,16 @@ import (
 // ClusterOrderUuid is the label where the fulfillment API will write the identifier of the order.
 var ClusterOrderUuid = fmt.Sprintf("%s/%s", gvks.ClusterOrder.Group, "clusterorder-uuid")
 
+// ClusterOrderName is the label where the fulfillment API will write the name of the cluster, when it has a name.
+var ClusterOrderName = fmt.Sprintf("%s/%s", gvks.ClusterOrder.Group, "clusterorder-name")
+
 // VirtualMachineUuid is the label where the fulfillment API will write the identifier of the virtual machine.
 var VirtualMachineUuid = fmt.Sprintf("%s/%s", gvks.VirtualMachine.Group, "virtualmachine-uuid")
+
+// VirtualMachineName is the label where the fulfillment API will write the name of the virtual machine, when it has a
+// name.
+var VirtualMachineName = fmt.Sprintf("%s/%s", gvks.VirtualMachine.Group, "virtualmachine-name")
+
+// VirtualMachineSnapshotUuid is the label where the fulfillment API will write the identifier of the virtual machine
+// snapshot.
+var VirtualMachineSnapshotUuid = fmt.Sprintf("%s/%s", gvks.VirtualMachineSnapshot.Group, "virtualmachinesnapshot-uuid")
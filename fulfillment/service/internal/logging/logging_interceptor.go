@@ -31,6 +31,8 @@ import (
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 )
 
 // InterceptorBuilder contains the data and logic needed to build an interceptor that writes to the log the details of
@@ -77,7 +79,8 @@ func (b *InterceptorBuilder) SetBodies(value bool) *InterceptorBuilder {
 	return b
 }
 
-// SetRedact indicates if security sensitive information should be redacted. The default is true.
+// SetRedact indicates if security sensitive information should be redacted. That includes the authorization header
+// and the sensitive fields of the request and response bodies. The default is true.
 func (b *InterceptorBuilder) SetRedact(value bool) *InterceptorBuilder {
 	b.redact = value
 	return b
@@ -305,6 +308,9 @@ func (i *Interceptor) redactMDAuthorization(value string) string {
 func (i *Interceptor) dumpMessage(ctx context.Context, key string, value any) (field any, ok bool) {
 	switch message := value.(type) {
 	case proto.Message:
+		if i.redact {
+			message = encryption.Redact(message)
+		}
 		bytes, err := protojson.Marshal(message)
 		if err != nil {
 			i.logger.ErrorContext(
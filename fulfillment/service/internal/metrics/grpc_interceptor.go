@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// GrpcInterceptorBuilder contains the data and logic needed to build an interceptor that updates the gRPC request
+// metrics. Don't create instances of this type directly, use the NewGrpcInterceptor function instead.
+type GrpcInterceptorBuilder struct {
+	logger *slog.Logger
+}
+
+// GrpcInterceptor contains the data needed by the interceptor.
+type GrpcInterceptor struct {
+	logger *slog.Logger
+}
+
+// NewGrpcInterceptor creates a builder that can then be used to configure and create a metrics interceptor.
+func NewGrpcInterceptor() *GrpcInterceptorBuilder {
+	return &GrpcInterceptorBuilder{}
+}
+
+// SetLogger sets the logger that will be used to write to the log. This is mandatory.
+func (b *GrpcInterceptorBuilder) SetLogger(value *slog.Logger) *GrpcInterceptorBuilder {
+	b.logger = value
+	return b
+}
+
+// Build uses the data stored in the builder to create and configure a new interceptor.
+func (b *GrpcInterceptorBuilder) Build() (result *GrpcInterceptor, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &GrpcInterceptor{
+		logger: b.logger,
+	}
+	return
+}
+
+// UnaryServer is the unary server interceptor function that updates the request metrics.
+func (i *GrpcInterceptor) UnaryServer(ctx context.Context, request any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (response any, err error) {
+	start := time.Now()
+	response, err = handler(ctx, request)
+	i.observe(info.FullMethod, start, err)
+	return
+}
+
+// StreamServer is the stream server interceptor function that updates the request metrics.
+func (i *GrpcInterceptor) StreamServer(server any, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+	err = handler(server, stream)
+	i.observe(info.FullMethod, start, err)
+	return
+}
+
+func (i *GrpcInterceptor) observe(method string, start time.Time, err error) {
+	code := grpcstatus.Code(err).String()
+	GrpcRequests.WithLabelValues(method, code).Inc()
+	GrpcRequestDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
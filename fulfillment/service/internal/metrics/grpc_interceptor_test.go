@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+var _ = Describe("gRPC interceptor", func() {
+	var interceptor *GrpcInterceptor
+
+	BeforeEach(func() {
+		var err error
+		interceptor, err = NewGrpcInterceptor().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Can't be created without a logger", func() {
+		_, err := NewGrpcInterceptor().Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+	})
+
+	It("Counts unary requests by method and code", func() {
+		info := &grpc.UnaryServerInfo{
+			FullMethod: "/my.v1.MyService/MyUnary",
+		}
+		ok := GrpcRequests.WithLabelValues(info.FullMethod, "OK")
+		notFound := GrpcRequests.WithLabelValues(info.FullMethod, "NotFound")
+		okBefore := testutil.ToFloat64(ok)
+		notFoundBefore := testutil.ToFloat64(notFound)
+		_, err := interceptor.UnaryServer(context.Background(), "request", info,
+			func(ctx context.Context, request any) (any, error) {
+				return "response", nil
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = interceptor.UnaryServer(context.Background(), "request", info,
+			func(ctx context.Context, request any) (any, error) {
+				return nil, grpcstatus.Error(grpccodes.NotFound, "not found")
+			},
+		)
+		Expect(grpcstatus.Code(err)).To(Equal(grpccodes.NotFound))
+		Expect(testutil.ToFloat64(ok)).To(Equal(okBefore + 1))
+		Expect(testutil.ToFloat64(notFound)).To(Equal(notFoundBefore + 1))
+	})
+
+	It("Counts stream requests by method and code", func() {
+		info := &grpc.StreamServerInfo{
+			FullMethod: "/my.v1.MyService/MyStream",
+		}
+		canceled := GrpcRequests.WithLabelValues(info.FullMethod, "Canceled")
+		before := testutil.ToFloat64(canceled)
+		err := interceptor.StreamServer(nil, nil, info, func(server any, stream grpc.ServerStream) error {
+			return grpcstatus.Error(grpccodes.Canceled, "canceled")
+		})
+		Expect(grpcstatus.Code(err)).To(Equal(grpccodes.Canceled))
+		Expect(testutil.ToFloat64(canceled)).To(Equal(before + 1))
+	})
+
+	It("Measures the duration of requests", func() {
+		info := &grpc.UnaryServerInfo{
+			FullMethod: "/my.v1.MyService/MyMeasured",
+		}
+		_, err := interceptor.UnaryServer(context.Background(), "request", info,
+			func(ctx context.Context, request any) (any, error) {
+				return "response", nil
+			},
+		)
+		Expect(err).ToNot(HaveOccurred())
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(GrpcRequestDuration)).To(Succeed())
+		count, err := testutil.GatherAndCount(registry, "fulfillment_grpc_request_duration_seconds")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(count).To(BeNumerically(">=", 1))
+	})
+})
+
+var _ = Describe("Registration", func() {
+	It("Can register the metrics multiple times", func() {
+		registry := prometheus.NewRegistry()
+		Expect(Register(registry)).To(Succeed())
+		Expect(Register(registry)).To(Succeed())
+	})
+})
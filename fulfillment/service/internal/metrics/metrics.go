@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+// Package metrics contains the Prometheus metrics of the service.
+//
+// The metrics that are updated when things happen, like requests or database operations, are package variables, so
+// that the code that updates them doesn't need to have them injected. They are always updated, but they are only
+// exposed once they have been added to a registry with the Register function. The metrics that are calculated when
+// they are collected, like the number of objects in each state, have their own collectors.
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the prefix used for the names of all the metrics of the service.
+const Namespace = "fulfillment"
+
+// GrpcRequests counts the gRPC requests that have finished, by method and response code.
+var GrpcRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests that have finished, by method and response code.",
+	},
+	[]string{"method", "code"},
+)
+
+// GrpcRequestDuration measures the duration of gRPC requests, by method and response code. For streaming methods this
+// is the time the stream was open.
+var GrpcRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of gRPC requests in seconds, by method and response code.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{"method", "code"},
+)
+
+// DaoOperationDuration measures the duration of the operations of the data access objects, by table and operation.
+var DaoOperationDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "dao",
+		Name:      "operation_duration_seconds",
+		Help:      "Duration of data access operations in seconds, by table and operation.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{"table", "operation"},
+)
+
+// NotifierNotifications counts the notifications sent, by channel.
+var NotifierNotifications = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "notifier",
+		Name:      "notifications_total",
+		Help:      "Number of notifications sent, by channel.",
+	},
+	[]string{"channel"},
+)
+
+// ListenerNotifications counts the notifications received, by channel.
+var ListenerNotifications = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "listener",
+		Name:      "notifications_total",
+		Help:      "Number of notifications received, by channel.",
+	},
+	[]string{"channel"},
+)
+
+// ListenerReconnects counts the number of times that listeners had to connect again to the database after a failure,
+// by channel.
+var ListenerReconnects = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "listener",
+		Name:      "reconnects_total",
+		Help:      "Number of times that listeners connected again to the database after a failure, by channel.",
+	},
+	[]string{"channel"},
+)
+
+// EventsWatchers is the number of clients that are currently watching events, by server. The server is `public` for
+// the events server of the public API and `private` for the events server of the private API.
+var EventsWatchers = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "events",
+		Name:      "watchers",
+		Help:      "Number of clients currently watching events, by server.",
+	},
+	[]string{"server"},
+)
+
+// EventsDisconnects counts the watchers that have been disconnected because they didn't receive events as fast as they
+// were generated, by server.
+var EventsDisconnects = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "events",
+		Name:      "disconnects_total",
+		Help:      "Number of watchers disconnected because they didn't receive events fast enough, by server.",
+	},
+	[]string{"server"},
+)
+
+// ReconcilerReconciliations counts the reconciliations that have finished, by object type.
+var ReconcilerReconciliations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "reconciler",
+		Name:      "reconciliations_total",
+		Help:      "Number of reconciliations that have finished, by object type.",
+	},
+	[]string{"type"},
+)
+
+// ReconcilerErrors counts the reconciliations that have failed, by object type.
+var ReconcilerErrors = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "reconciler",
+		Name:      "errors_total",
+		Help:      "Number of reconciliations that have failed, by object type.",
+	},
+	[]string{"type"},
+)
+
+// LeaderElectionLeader is one when this instance holds the lease and zero otherwise, by lease name.
+var LeaderElectionLeader = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "leader_election",
+		Name:      "leader",
+		Help:      "Indicates if this instance is the leader, by lease name.",
+	},
+	[]string{"lease"},
+)
+
+// Register adds the metrics of this package to the given registry. Metrics that are already registered are ignored,
+// so it is safe to call it multiple times.
+func Register(registerer prometheus.Registerer) error {
+	collectors := []prometheus.Collector{
+		GrpcRequests,
+		GrpcRequestDuration,
+		DaoOperationDuration,
+		NotifierNotifications,
+		ListenerNotifications,
+		ListenerReconnects,
+		EventsWatchers,
+		EventsDisconnects,
+		ReconcilerReconciliations,
+		ReconcilerErrors,
+		LeaderElectionLeader,
+	}
+	for _, collector := range collectors {
+		err := registerer.Register(collector)
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if err != nil && !errors.As(err, &alreadyRegistered) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ObjectsCollectorBuilder contains the data and logic needed to build an objects collector. Don't create instances of
+// this type directly, use the NewObjectsCollector function instead.
+type ObjectsCollectorBuilder struct {
+	logger  *slog.Logger
+	pool    *pgxpool.Pool
+	tables  []string
+	timeout time.Duration
+}
+
+// ObjectsCollector is a Prometheus collector that counts the objects of a set of tables by state. The state is taken
+// from the `status.state` field of the objects, and it is the empty string for objects that don't have it. The counts
+// are calculated with a database query each time that the metrics are collected.
+type ObjectsCollector struct {
+	logger  *slog.Logger
+	pool    *pgxpool.Pool
+	tables  []string
+	timeout time.Duration
+	desc    *prometheus.Desc
+}
+
+// NewObjectsCollector creates a builder that can then be used to configure and create an objects collector.
+func NewObjectsCollector() *ObjectsCollectorBuilder {
+	return &ObjectsCollectorBuilder{
+		timeout: objectsCollectorDefaultTimeout,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *ObjectsCollectorBuilder) SetLogger(value *slog.Logger) *ObjectsCollectorBuilder {
+	b.logger = value
+	return b
+}
+
+// SetPool sets the database connection pool. This is mandatory.
+func (b *ObjectsCollectorBuilder) SetPool(value *pgxpool.Pool) *ObjectsCollectorBuilder {
+	b.pool = value
+	return b
+}
+
+// AddTable adds a table, for example `clusters`. At least one is mandatory.
+func (b *ObjectsCollectorBuilder) AddTable(value string) *ObjectsCollectorBuilder {
+	b.tables = append(b.tables, value)
+	return b
+}
+
+// AddTables adds a set of tables.
+func (b *ObjectsCollectorBuilder) AddTables(values ...string) *ObjectsCollectorBuilder {
+	b.tables = append(b.tables, values...)
+	return b
+}
+
+// SetTimeout sets the maximum time that the query of each table can take. This is optional, and the default is ten
+// seconds.
+func (b *ObjectsCollectorBuilder) SetTimeout(value time.Duration) *ObjectsCollectorBuilder {
+	b.timeout = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new objects collector.
+func (b *ObjectsCollectorBuilder) Build() (result *ObjectsCollector, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.pool == nil {
+		err = errors.New("database connection pool is mandatory")
+		return
+	}
+	if len(b.tables) == 0 {
+		err = errors.New("at least one table is mandatory")
+		return
+	}
+	if b.timeout <= 0 {
+		err = fmt.Errorf("timeout should be positive, but it is %s", b.timeout)
+		return
+	}
+
+	// Create the metric description:
+	desc := prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "", "objects"),
+		"Number of objects, by table and state.",
+		[]string{"table", "state"},
+		nil,
+	)
+
+	// Create and populate the object:
+	result = &ObjectsCollector{
+		logger:  b.logger,
+		pool:    b.pool,
+		tables:  slices.Clone(b.tables),
+		timeout: b.timeout,
+		desc:    desc,
+	}
+	return
+}
+
+// Describe is part of the implementation of the prometheus.Collector interface.
+func (c *ObjectsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect is part of the implementation of the prometheus.Collector interface.
+func (c *ObjectsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, table := range c.tables {
+		err := c.collect(ch, table)
+		if err != nil {
+			c.logger.Error(
+				"Failed to count objects",
+				slog.String("table", table),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+func (c *ObjectsCollector) collect(ch chan<- prometheus.Metric, table string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	rows, err := c.pool.Query(
+		ctx,
+		fmt.Sprintf(
+			`select coalesce(data->'status'->>'state', ''), count(*) from %s group by 1`,
+			table,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			state string
+			count int64
+		)
+		err = rows.Scan(&state, &count)
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), table, state)
+	}
+	return rows.Err()
+}
+
+// objectsCollectorDefaultTimeout is the default maximum time that the query of each table can take.
+const objectsCollectorDefaultTimeout = 10 * time.Second
@@ -39,6 +39,14 @@ func AddCorsFlags(flags *pflag.FlagSet, name string) {
 	)
 }
 
+// GetCorsAllowedOrigins returns the allowed origins configured with the flags added by the AddCorsFlags function for
+// the given listener name. This is intended for handlers that need to check origins themselves, like WebSocket
+// handlers, because browsers don't apply the CORS rules to WebSocket connections.
+func GetCorsAllowedOrigins(flags *pflag.FlagSet, name string) (result []string, err error) {
+	result, err = flags.GetStringSlice(corsFlagName(name, corsAllowedOriginsFlagSuffix))
+	return
+}
+
 // Names of the flags:
 const (
 	corsAllowedOriginsFlagSuffix = "cors-allowed-origins"
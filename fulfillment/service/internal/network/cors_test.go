@@ -169,5 +169,18 @@ var _ = Describe("CORS middleware", func() {
 			verifyAllowedOrigin(middleware, "http://my.com", "http://my.com")
 			verifyAllowedOrigin(middleware, "http://your.com", "http://your.com")
 		})
+
+		It("Returns the allowed origins", func() {
+			// Prepare the flags:
+			err := flags.Parse([]string{
+				"--my-cors-allowed-origins=http://my.com,http://your.com",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// Verify the result:
+			origins, err := GetCorsAllowedOrigins(flags, "my")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(origins).To(ConsistOf("http://my.com", "http://your.com"))
+		})
 	})
 })
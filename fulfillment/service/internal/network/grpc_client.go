@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -283,6 +284,9 @@ func (b *GrpcClientBuilder) Build() (result *grpc.ClientConn, err error) {
 		}))
 	}
 
+	// Create the tracing spans for the calls and propagate the trace context to the server:
+	options = append(options, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+
 	// Create the client:
 	result, err = grpc.NewClient(endpoint, options...)
 	return
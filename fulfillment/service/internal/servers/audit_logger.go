@@ -0,0 +1,319 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
+	"github.com/jkary/osac/fulfillment/service/internal/json"
+)
+
+// AuditLoggerBuilder contains the data and logic needed to create an audit logger. Don't create instances of this
+// type directly, use the NewAuditLogger function instead.
+type AuditLoggerBuilder struct {
+	logger        *slog.Logger
+	retentionTime time.Duration
+	pruneInterval time.Duration
+}
+
+// AuditLogger writes to the audit log the changes made to objects. Entries are written using the transaction from the
+// context, so they are only saved if the change is also saved.
+type AuditLogger struct {
+	logger        *slog.Logger
+	retentionTime time.Duration
+	pruneInterval time.Duration
+	jsonEncoder   *json.Encoder
+	pruneLock     *sync.Mutex
+	pruneTime     time.Time
+}
+
+// NewAuditLogger creates a builder that can then be used to configure and create an audit logger.
+func NewAuditLogger() *AuditLoggerBuilder {
+	return &AuditLoggerBuilder{
+		retentionTime: auditLoggerDefaultRetentionTime,
+		pruneInterval: auditLoggerDefaultPruneInterval,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *AuditLoggerBuilder) SetLogger(value *slog.Logger) *AuditLoggerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetRetentionTime sets how long entries are kept. Zero means that entries are kept forever. The default is thirty
+// days.
+func (b *AuditLoggerBuilder) SetRetentionTime(value time.Duration) *AuditLoggerBuilder {
+	b.retentionTime = value
+	return b
+}
+
+// SetPruneInterval sets how often entries older than the retention time are deleted. The default is ten minutes.
+func (b *AuditLoggerBuilder) SetPruneInterval(value time.Duration) *AuditLoggerBuilder {
+	b.pruneInterval = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new audit logger.
+func (b *AuditLoggerBuilder) Build() (result *AuditLogger, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.retentionTime < 0 {
+		err = fmt.Errorf("retention time should be zero or positive, but it is %s", b.retentionTime)
+		return
+	}
+	if b.pruneInterval < 0 {
+		err = fmt.Errorf("prune interval should be zero or positive, but it is %s", b.pruneInterval)
+		return
+	}
+
+	// Create the JSON encoder. Note that the identifier and metadata are ignored because they are stored in separate
+	// columns, like the generic DAO does.
+	var entry *privatev1.AuditLogEntry
+	entryFields := entry.ProtoReflect().Descriptor().Fields()
+	jsonEncoder, err := json.NewEncoder().
+		SetLogger(b.logger).
+		AddIgnoredFields(
+			entryFields.ByName("id").FullName(),
+			entryFields.ByName("metadata").FullName(),
+		).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create JSON encoder: %w", err)
+		return
+	}
+
+	// Create and populate the object:
+	result = &AuditLogger{
+		logger:        b.logger,
+		retentionTime: b.retentionTime,
+		pruneInterval: b.pruneInterval,
+		jsonEncoder:   jsonEncoder,
+		pruneLock:     &sync.Mutex{},
+	}
+	return
+}
+
+// Record writes an entry to the audit log. The before object should be nil for creations, and the after object should
+// be nil for deletions. The paths are the paths of the field mask of update requests.
+//
+// Note that this method expects to find the subject and a transaction in the context.
+func (l *AuditLogger) Record(ctx context.Context, action privatev1.AuditLogAction, before, after proto.Message,
+	paths []string) (err error) {
+	// Get the transaction from the context:
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	defer tx.ReportError(&err)
+
+	// Populate the entry:
+	subject := auth.SubjectFromContext(ctx)
+	method, _ := grpc.Method(ctx)
+	entry := privatev1.AuditLogEntry_builder{
+		User:   subject.User,
+		Groups: subject.Groups,
+		Method: method,
+		Action: action,
+		Paths:  paths,
+	}.Build()
+	object := after
+	if object == nil {
+		object = before
+	}
+	type objectIface interface {
+		proto.Message
+		GetId() string
+	}
+	if object, ok := object.(objectIface); ok {
+		entry.SetObjectId(object.GetId())
+	}
+	entry.SetObjectType(string(object.ProtoReflect().Descriptor().FullName()))
+	if before != nil {
+		before = l.redact(before)
+		var wrapper *anypb.Any
+		wrapper, err = anypb.New(before)
+		if err != nil {
+			return
+		}
+		entry.SetBefore(wrapper)
+	}
+	if after != nil {
+		after = l.redact(after)
+		var wrapper *anypb.Any
+		wrapper, err = anypb.New(after)
+		if err != nil {
+			return
+		}
+		entry.SetAfter(wrapper)
+	}
+	if before != nil && after != nil {
+		var changes []string
+		l.diff("", before.ProtoReflect(), after.ProtoReflect(), &changes)
+		entry.SetChanges(changes)
+	}
+
+	// The tenants of the entry are the tenants of the object, so that users will only see the entries of the objects
+	// that they can see:
+	tenants := l.tenants(object)
+	if tenants == nil {
+		tenants = []string{}
+	}
+	creators := []string{subject.User}
+
+	// Save the entry:
+	data, err := l.jsonEncoder.Marshal(entry)
+	if err != nil {
+		return
+	}
+	id := uuid.NewString()
+	_, err = tx.Exec(
+		ctx,
+		"insert into audit_log (id, creators, tenants, data) values ($1, $2, $3, $4)",
+		id, creators, tenants, data,
+	)
+	if err != nil {
+		return
+	}
+	if l.logger.Enabled(ctx, slog.LevelDebug) {
+		l.logger.DebugContext(
+			ctx,
+			"Recorded audit log entry",
+			slog.String("id", id),
+			slog.String("user", entry.GetUser()),
+			slog.String("method", entry.GetMethod()),
+			slog.String("action", entry.GetAction().String()),
+			slog.String("object_id", entry.GetObjectId()),
+			slog.Any("changes", entry.GetChanges()),
+		)
+	}
+
+	// Delete old entries:
+	err = l.prune(ctx, tx)
+	return
+}
+
+// prune deletes the entries that are older than the retention time. To avoid running the deletion for every entry
+// this is done at most once per prune interval.
+func (l *AuditLogger) prune(ctx context.Context, tx database.Tx) error {
+	if l.retentionTime == 0 {
+		return nil
+	}
+	l.pruneLock.Lock()
+	now := time.Now()
+	if now.Sub(l.pruneTime) < l.pruneInterval {
+		l.pruneLock.Unlock()
+		return nil
+	}
+	l.pruneTime = now
+	l.pruneLock.Unlock()
+	tag, err := tx.Exec(
+		ctx,
+		"delete from audit_log where creation_timestamp < $1",
+		now.Add(-l.retentionTime),
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() > 0 {
+		l.logger.DebugContext(
+			ctx,
+			"Deleted old audit log entries",
+			slog.Int64("count", tag.RowsAffected()),
+		)
+	}
+	return nil
+}
+
+// redact returns a copy of the object without the sensitive fields, or the object itself if there are no such fields.
+func (l *AuditLogger) redact(object proto.Message) proto.Message {
+	return encryption.Redact(object)
+}
+
+// tenants returns the tenants from the metadata of the object.
+func (l *AuditLogger) tenants(object proto.Message) []string {
+	type metadataIface interface {
+		GetTenants() []string
+	}
+	objectReflect := object.ProtoReflect()
+	metadataField := objectReflect.Descriptor().Fields().ByName("metadata")
+	if metadataField == nil || metadataField.Kind() != protoreflect.MessageKind || !objectReflect.Has(metadataField) {
+		return nil
+	}
+	metadata, ok := objectReflect.Get(metadataField).Message().Interface().(metadataIface)
+	if !ok {
+		return nil
+	}
+	return metadata.GetTenants()
+}
+
+// diff adds to the result the paths of the fields that are different in the two messages, which must be of the same
+// type. Changes of the version of the metadata are ignored, because that changes with every update.
+func (l *AuditLogger) diff(prefix string, before, after protoreflect.Message, result *[]string) {
+	fields := before.Descriptor().Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		path := string(field.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if path == "metadata.version" {
+			continue
+		}
+		beforeHas := before.Has(field)
+		afterHas := after.Has(field)
+		if !beforeHas && !afterHas {
+			continue
+		}
+		if beforeHas && afterHas && field.Message() != nil && !field.IsList() && !field.IsMap() {
+			l.diff(path, before.Get(field).Message(), after.Get(field).Message(), result)
+			continue
+		}
+		beforeValue := before.New()
+		if beforeHas {
+			beforeValue.Set(field, before.Get(field))
+		}
+		afterValue := after.New()
+		if afterHas {
+			afterValue.Set(field, after.Get(field))
+		}
+		if !proto.Equal(beforeValue.Interface(), afterValue.Interface()) {
+			*result = append(*result, path)
+		}
+	}
+}
+
+// Defaults for the audit logger:
+const (
+	auditLoggerDefaultRetentionTime = 30 * 24 * time.Hour
+	auditLoggerDefaultPruneInterval = 10 * time.Minute
+)
@@ -15,10 +15,12 @@ package servers
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	grpccodes "google.golang.org/grpc/codes"
@@ -32,14 +34,17 @@ import (
 	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 	"github.com/jkary/osac/fulfillment/service/internal/jq"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
 	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
 type ClustersServerBuilder struct {
 	logger  *slog.Logger
 	private privatev1.ClustersServer
+	keyring *encryption.Keyring
 }
 
 var _ ffv1.ClustersServer = (*ClustersServer)(nil)
@@ -53,7 +58,7 @@ type ClustersServer struct {
 	outMapper       *GenericMapper[*privatev1.Cluster, *ffv1.Cluster]
 	jqTool          *jq.Tool
 	hubsDao         *dao.GenericDAO[*privatev1.Hub]
-	kubeClients     map[string]clnt.Client
+	kubeClients     map[string]*kubeClientEntry
 	kubeClientsLock *sync.Mutex
 }
 
@@ -73,6 +78,12 @@ func (b *ClustersServerBuilder) SetPrivate(value privatev1.ClustersServer) *Clus
 	return b
 }
 
+// SetKeyring sets the keyring used to decrypt the kubeconfigs of the hubs. This is optional.
+func (b *ClustersServerBuilder) SetKeyring(value *encryption.Keyring) *ClustersServerBuilder {
+	b.keyring = value
+	return b
+}
+
 func (b *ClustersServerBuilder) Build() (result *ClustersServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -96,6 +107,7 @@ func (b *ClustersServerBuilder) Build() (result *ClustersServer, err error) {
 	hubsDao, err := dao.NewGenericDAO[*privatev1.Hub]().
 		SetLogger(b.logger).
 		SetTable("hubs").
+		SetKeyring(b.keyring).
 		Build()
 	if err != nil {
 		return
@@ -134,7 +146,7 @@ func (b *ClustersServerBuilder) Build() (result *ClustersServer, err error) {
 		logger:          b.logger,
 		jqTool:          jqTool,
 		hubsDao:         hubsDao,
-		kubeClients:     map[string]clnt.Client{},
+		kubeClients:     map[string]*kubeClientEntry{},
 		kubeClientsLock: &sync.Mutex{},
 		private:         b.private,
 		inMapper:        inMapper,
@@ -150,6 +162,9 @@ func (s *ClustersServer) List(ctx context.Context,
 	privateRequest.SetOffset(request.GetOffset())
 	privateRequest.SetLimit(request.GetLimit())
 	privateRequest.SetFilter(request.GetFilter())
+	privateRequest.SetOrder(request.GetOrder())
+	privateRequest.SetPageToken(request.GetPageToken())
+	privateRequest.SetSkipTotal(request.GetSkipTotal())
 
 	// Delegate to private server:
 	privateResponse, err := s.private.List(ctx, privateRequest)
@@ -177,8 +192,13 @@ func (s *ClustersServer) List(ctx context.Context,
 	// Create the public response:
 	response = &ffv1.ClustersListResponse{}
 	response.SetSize(privateResponse.GetSize())
-	response.SetTotal(privateResponse.GetTotal())
+	if privateResponse.HasTotal() {
+		response.SetTotal(privateResponse.GetTotal())
+	}
 	response.SetItems(publicItems)
+	if privateResponse.HasNextPageToken() {
+		response.SetNextPageToken(privateResponse.GetNextPageToken())
+	}
 	return
 }
 
@@ -271,7 +291,10 @@ func (s *ClustersServer) Update(ctx context.Context,
 	}
 	id := publicCluster.GetId()
 	if id == "" {
-		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier is mandatory")
+		id = publicCluster.GetMetadata().GetName()
+	}
+	if id == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier or name is mandatory")
 		return
 	}
 
@@ -283,6 +306,7 @@ func (s *ClustersServer) Update(ctx context.Context,
 		return nil, err
 	}
 	existingPrivateCluster := getResponse.GetObject()
+	id = existingPrivateCluster.GetId()
 
 	// Map the public changes to the existing private object (preserving private data):
 	err = s.inMapper.Copy(ctx, publicCluster, existingPrivateCluster)
@@ -296,6 +320,10 @@ func (s *ClustersServer) Update(ctx context.Context,
 		return
 	}
 
+	// The public object may contain the name instead of the identifier, so make sure that the private object has the
+	// real identifier:
+	existingPrivateCluster.SetId(id)
+
 	// Delegate to the private server with the merged object:
 	privateRequest := &privatev1.ClustersUpdateRequest{}
 	privateRequest.SetObject(existingPrivateCluster)
@@ -581,18 +609,47 @@ func (s *ClustersServer) getHostedClusterSecret(ctx context.Context, clusterId s
 	return
 }
 
+// kubeClientEntry is an entry of the cache of clients for hubs.
+type kubeClientEntry struct {
+	client   clnt.Client
+	hash     [sha256.Size]byte
+	lastUsed time.Time
+}
+
+// kubeClientIdleTimeout is how long clients for hubs are kept in the cache when they aren't used.
+const kubeClientIdleTimeout = 30 * time.Minute
+
+// getKubeClient returns the client for the given hub. Clients are cached, and created again when the kubeconfig of the
+// hub changes.
 func (s *ClustersServer) getKubeClient(ctx context.Context, hub *privatev1.Hub) (result clnt.Client, err error) {
 	s.kubeClientsLock.Lock()
 	defer s.kubeClientsLock.Unlock()
-	result, ok := s.kubeClients[hub.Id]
-	if ok {
+
+	// Remove the entries that haven't been used for a while, including the entries of hubs that have been deleted:
+	now := time.Now()
+	for id, entry := range s.kubeClients {
+		if now.Sub(entry.lastUsed) > kubeClientIdleTimeout {
+			delete(s.kubeClients, id)
+		}
+	}
+
+	// Return the existing client if the kubeconfig hasn't changed, otherwise create a new one:
+	hash := sha256.Sum256(hub.GetKubeconfig())
+	entry, ok := s.kubeClients[hub.GetId()]
+	if ok && entry.hash == hash {
+		entry.lastUsed = now
+		result = entry.client
 		return
 	}
 	result, err = s.createKubeClient(ctx, hub)
 	if err != nil {
 		return
 	}
-	s.kubeClients[hub.Id] = result
+	s.kubeClients[hub.GetId()] = &kubeClientEntry{
+		client:   result,
+		hash:     hash,
+		lastUsed: now,
+	}
 	return
 }
 
@@ -601,6 +658,7 @@ func (s *ClustersServer) createKubeClient(ctx context.Context, hub *privatev1.Hu
 	if err != nil {
 		return
 	}
+	config.Wrap(tracing.Transport)
 	result, err = clnt.New(config, clnt.Options{})
 	return
 }
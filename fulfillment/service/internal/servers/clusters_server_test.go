@@ -29,6 +29,7 @@ import (
 
 	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	sharedv1 "github.com/jkary/osac/fulfillment/service/internal/api/shared/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
 )
@@ -79,6 +80,10 @@ var _ = Describe("Clusters server", func() {
 				finalizers text[] not null default array ['default'],
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -89,6 +94,10 @@ var _ = Describe("Clusters server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 
@@ -99,6 +108,10 @@ var _ = Describe("Clusters server", func() {
 				finalizers text[] not null default array ['default'],
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -109,6 +122,10 @@ var _ = Describe("Clusters server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
@@ -770,6 +787,59 @@ var _ = Describe("Clusters server", func() {
 			Expect(nodeSet.GetSize()).To(BeNumerically("==", 4))
 		})
 
+		It("Rejects update with stale version", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, ffv1.ClustersCreateRequest_builder{
+				Object: ffv1.Cluster_builder{
+					Spec: ffv1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+			Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 1))
+
+			// Update the object, so that the version changes:
+			updated := proto.Clone(object).(*ffv1.Cluster)
+			updated.GetSpec().SetNodeSets(map[string]*ffv1.ClusterNodeSet{
+				"compute": ffv1.ClusterNodeSet_builder{
+					HostClass: "acme_1tib",
+					Size:      4,
+				}.Build(),
+			})
+			updateResponse, err := server.Update(ctx, ffv1.ClustersUpdateRequest_builder{
+				Object: updated,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateResponse.GetObject().GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+
+			// Try to update again using the original version:
+			stale := proto.Clone(object).(*ffv1.Cluster)
+			stale.GetSpec().SetNodeSets(map[string]*ffv1.ClusterNodeSet{
+				"compute": ffv1.ClusterNodeSet_builder{
+					HostClass: "acme_1tib",
+					Size:      5,
+				}.Build(),
+			})
+			_, err = server.Update(ctx, ffv1.ClustersUpdateRequest_builder{
+				Object: stale,
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.Aborted))
+
+			// Verify that the object wasn't modified:
+			getResponse, err := server.Get(ctx, ffv1.ClustersGetRequest_builder{
+				Id: object.GetId(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object = getResponse.GetObject()
+			Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+			Expect(object.GetSpec().GetNodeSets()["compute"].GetSize()).To(BeNumerically("==", 4))
+		})
+
 		It("Ignores changes to the status when an object is updated", func() {
 			// Create the object:
 			createResponse, err := server.Create(ctx, ffv1.ClustersCreateRequest_builder{
@@ -1004,5 +1074,66 @@ var _ = Describe("Clusters server", func() {
 			object = getResponse.GetObject()
 			verify(object)
 		})
+
+		It("Updates labels using the field mask", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, ffv1.ClustersCreateRequest_builder{
+				Object: ffv1.Cluster_builder{
+					Metadata: sharedv1.Metadata_builder{
+						Labels: map[string]string{
+							"environment": "production",
+						},
+						Annotations: map[string]string{
+							"description": "My cluster",
+						},
+					}.Build(),
+					Spec: ffv1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+			Expect(object.GetMetadata().GetLabels()).To(Equal(map[string]string{
+				"environment": "production",
+			}))
+			Expect(object.GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				"description": "My cluster",
+			}))
+
+			// Add a label using the field mask:
+			updateResponse, err := server.Update(ctx, ffv1.ClustersUpdateRequest_builder{
+				Object: ffv1.Cluster_builder{
+					Id: object.GetId(),
+					Metadata: sharedv1.Metadata_builder{
+						Labels: map[string]string{
+							"owner": "joe",
+						},
+					}.Build(),
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{
+						"metadata.labels.owner",
+					},
+				},
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object = updateResponse.GetObject()
+			Expect(object.GetMetadata().GetLabels()).To(Equal(map[string]string{
+				"environment": "production",
+				"owner":       "joe",
+			}))
+			Expect(object.GetMetadata().GetAnnotations()).To(Equal(map[string]string{
+				"description": "My cluster",
+			}))
+
+			// Find it using a label filter:
+			listResponse, err := server.List(ctx, ffv1.ClustersListRequest_builder{
+				Filter: proto.String("this.metadata.labels['owner'] == 'joe'"),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listResponse.GetItems()).To(HaveLen(1))
+			Expect(listResponse.GetItems()[0].GetId()).To(Equal(object.GetId()))
+		})
 	})
 })
@@ -20,13 +20,18 @@ import (
 	"log/slog"
 	"slices"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
 	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -36,13 +41,17 @@ import (
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 )
 
 type EventsServerBuilder struct {
 	logger       *slog.Logger
 	flags        *pflag.FlagSet
 	dbUrl        string
+	dbPool       *pgxpool.Pool
+	bufferSize   int
 	tenancyLogic auth.TenancyLogic
+	registerer   prometheus.Registerer
 }
 
 var _ eventsv1.EventsServer = (*EventsServer)(nil)
@@ -50,13 +59,20 @@ var _ eventsv1.EventsServer = (*EventsServer)(nil)
 type EventsServer struct {
 	eventsv1.UnimplementedEventsServer
 
-	logger       *slog.Logger
-	listener     *database.Listener
-	subs         map[string]privateEventsServerSubInfo
-	subsLock     *sync.RWMutex
-	celEnv       *cel.Env
-	mapper       *GenericMapper[*privatev1.Event, *eventsv1.Event]
-	tenancyLogic auth.TenancyLogic
+	logger           *slog.Logger
+	listener         *database.Listener
+	replayer         *database.Replayer
+	subs             map[string]privateEventsServerSubInfo
+	subsLock         *sync.RWMutex
+	celEnv           *cel.Env
+	mapper           *GenericMapper[*privatev1.Event, *eventsv1.Event]
+	tenancyLogic     auth.TenancyLogic
+	bookmarkInterval time.Duration
+	statsInterval    time.Duration
+	bufferSize       int
+
+	// latest is the sequence number of the last event received from the database.
+	latest atomic.Int64
 }
 
 type privateEventsServerSubInfo struct {
@@ -64,10 +80,28 @@ type privateEventsServerSubInfo struct {
 	filterSrc  string
 	filterPrg  cel.Program
 	eventsChan chan *eventsv1.Event
+
+	// overflowChan is closed when the buffer of the subscription is full, to tell the goroutine that sends the events
+	// that it should disconnect the client.
+	overflowChan chan struct{}
+	overflowOnce *sync.Once
+
+	// visibleTenants are the tenants that the user that created the subscription has permission to see. These are
+	// calculated once, when the subscription is created, and used both for replayed and for new events.
+	visibleTenants []string
+
+	// seen is the sequence number of the last event processed for this subscription, including the events that
+	// were rejected by the filter or that the user doesn't have permission to see.
+	seen *atomic.Int64
+
+	// sent is the sequence number of the last event sent to the client, or replayed for the client.
+	sent *atomic.Int64
 }
 
 func NewEventsServer() *EventsServerBuilder {
-	return &EventsServerBuilder{}
+	return &EventsServerBuilder{
+		bufferSize: eventsServerBufferSize,
+	}
 }
 
 func (b *EventsServerBuilder) SetLogger(value *slog.Logger) *EventsServerBuilder {
@@ -85,6 +119,26 @@ func (b *EventsServerBuilder) SetDbUrl(value string) *EventsServerBuilder {
 	return b
 }
 
+func (b *EventsServerBuilder) SetDbPool(value *pgxpool.Pool) *EventsServerBuilder {
+	b.dbPool = value
+	return b
+}
+
+// SetBufferSize sets the number of events that can be waiting to be sent to each subscriber. Subscribers that don't
+// receive events fast enough to keep the buffer from filling up will be disconnected. The same limit applies to the
+// events that are kept waiting while past events are replayed. This is optional, and the default is 100.
+func (b *EventsServerBuilder) SetBufferSize(value int) *EventsServerBuilder {
+	b.bufferSize = value
+	return b
+}
+
+// SetRegisterer sets the Prometheus registry where the server will register the metrics that describe the state of
+// its subscriptions. This is optional, and by default those metrics aren't registered.
+func (b *EventsServerBuilder) SetRegisterer(value prometheus.Registerer) *EventsServerBuilder {
+	b.registerer = value
+	return b
+}
+
 func (b *EventsServerBuilder) SetTenancyLogic(value auth.TenancyLogic) *EventsServerBuilder {
 	b.tenancyLogic = value
 	return b
@@ -100,6 +154,14 @@ func (b *EventsServerBuilder) Build() (result *EventsServer, err error) {
 		err = errors.New("database connection URL is mandatory")
 		return
 	}
+	if b.dbPool == nil {
+		err = errors.New("database connection pool is mandatory")
+		return
+	}
+	if b.bufferSize <= 0 {
+		err = fmt.Errorf("buffer size should be positive, but it is %d", b.bufferSize)
+		return
+	}
 
 	// Create  the CEL environment:
 	celEnv, err := b.createCelEnv()
@@ -129,14 +191,28 @@ func (b *EventsServerBuilder) Build() (result *EventsServer, err error) {
 		}
 	}
 
+	// Create the replayer:
+	replayer, err := database.NewReplayer().
+		SetLogger(b.logger).
+		SetPool(b.dbPool).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create replayer: %w", err)
+		return
+	}
+
 	// Create the object early so that whe can use its methods as callback functions:
 	s := &EventsServer{
-		logger:       b.logger,
-		subs:         map[string]privateEventsServerSubInfo{},
-		subsLock:     &sync.RWMutex{},
-		celEnv:       celEnv,
-		mapper:       mapper,
-		tenancyLogic: tenancyLogic,
+		logger:           b.logger,
+		replayer:         replayer,
+		subs:             map[string]privateEventsServerSubInfo{},
+		subsLock:         &sync.RWMutex{},
+		celEnv:           celEnv,
+		mapper:           mapper,
+		tenancyLogic:     tenancyLogic,
+		bookmarkInterval: eventsServerBookmarkInterval,
+		statsInterval:    eventsServerStatsInterval,
+		bufferSize:       b.bufferSize,
 	}
 
 	// Create the notification listener:
@@ -151,6 +227,15 @@ func (b *EventsServerBuilder) Build() (result *EventsServer, err error) {
 		return
 	}
 
+	// Register the metrics:
+	if b.registerer != nil {
+		err = b.registerer.Register(newEventsServerCollector("public", s.Stats))
+		if err != nil {
+			err = fmt.Errorf("failed to register metrics: %w", err)
+			return
+		}
+	}
+
 	result = s
 	return
 }
@@ -197,6 +282,7 @@ func (b *EventsServerBuilder) createCelEnv() (result *cel.Env, err error) {
 // Starts starts the background components of the server, in particular the notification listener. This is a blocking
 // operation, and will return only when the context is canceled.
 func (s *EventsServer) Start(ctx context.Context) error {
+	go s.statsLoop(ctx)
 	return s.listener.Listen(ctx)
 }
 
@@ -230,48 +316,253 @@ func (s *EventsServer) Watch(request *eventsv1.EventsWatchRequest,
 		}
 	}
 
+	// Calculate the tenants that the user has permission to see:
+	visibleTenants, err := s.tenancyLogic.DetermineVisibleTenants(ctx)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to determine visible tenants",
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to determine visible tenants")
+	}
+
 	// Create a subscription and remember to remove it when done:
 	subId := uuid.NewString()
 	logger := s.logger.With(
 		slog.String("subscription", subId),
 	)
 	subInfo := privateEventsServerSubInfo{
-		stream:     stream,
-		filterSrc:  filterSrc,
-		filterPrg:  filterPrg,
-		eventsChan: make(chan *eventsv1.Event),
+		stream:         stream,
+		filterSrc:      filterSrc,
+		filterPrg:      filterPrg,
+		eventsChan:     make(chan *eventsv1.Event, s.bufferSize),
+		overflowChan:   make(chan struct{}),
+		overflowOnce:   &sync.Once{},
+		visibleTenants: visibleTenants,
+		seen:           &atomic.Int64{},
+		sent:           &atomic.Int64{},
 	}
 	s.subsLock.Lock()
 	s.subs[subId] = subInfo
 	s.subsLock.Unlock()
+	metrics.EventsWatchers.WithLabelValues("public").Inc()
 	logger.DebugContext(ctx, "Created subcription")
 	defer func() {
 		s.subsLock.Lock()
-		defer s.subsLock.Unlock()
 		delete(s.subs, subId)
-		close(subInfo.eventsChan)
+		s.subsLock.Unlock()
+		metrics.EventsWatchers.WithLabelValues("public").Dec()
 		logger.DebugContext(ctx, "Canceled subcription")
 	}()
 
+	// Send the headers, so that clients like the gateway know that the watch started successfully before they
+	// receive any event:
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	// Send the events that happened since the position requested by the client. Note that this is done in a
+	// separate goroutine after creating the subscription, so that events that happen while replaying aren't lost.
+	// Those events are kept pending till the replay finishes, and then the ones that weren't replayed are sent.
+	var (
+		replayChan chan eventsServerReplayResult
+		pending    []*eventsv1.Event
+	)
+	if request.HasSince() {
+		replayChan = make(chan eventsServerReplayResult, 1)
+		go func() {
+			last, replayed, err := s.replay(ctx, request.GetSince(), visibleTenants, filterPrg, stream)
+			replayChan <- eventsServerReplayResult{
+				last:     last,
+				replayed: replayed,
+				err:      err,
+			}
+		}()
+	}
+	defer func() {
+		if replayChan != nil {
+			<-replayChan
+		}
+	}()
+
+	// If the client requested bookmarks then we need to send them periodically:
+	bookmarks := request.GetBookmarks()
+	var bookmarksChan <-chan time.Time
+	if bookmarks {
+		bookmarksTicker := time.NewTicker(s.bookmarkInterval)
+		defer bookmarksTicker.Stop()
+		bookmarksChan = bookmarksTicker.C
+	}
+
+	// The last variable is the greatest sequence number of the events that have been processed for this client. The
+	// bookmarked variable is the last position sent to the client, either with an event or with a bookmark, and is
+	// used to avoid sending bookmarks that don't add anything. The replayed set contains the sequence numbers of the
+	// events that were replayed, and is used to avoid sending them twice. Note that sequence numbers aren't assigned
+	// in commit order, so live events with sequence numbers lower than the last one are still sent.
+	var (
+		last, bookmarked int64
+		replayed         map[int64]bool
+	)
+	sendEvent := func(event *eventsv1.Event) error {
+		if replayed[event.GetSequence()] {
+			delete(replayed, event.GetSequence())
+			return nil
+		}
+		err := stream.Send(eventsv1.EventsWatchResponse_builder{
+			Event: event,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		last = max(last, event.GetSequence())
+		bookmarked = last
+		subInfo.sent.Store(last)
+		return nil
+	}
+	sendBookmark := func() error {
+		// Note that the events that have been processed but are still in the buffer must be sent before we can
+		// send a bookmark that includes them. To avoid races the sequence number must be loaded before checking
+		// the buffer, as it is stored after adding the event to the buffer.
+		seen := subInfo.seen.Load()
+		if len(subInfo.eventsChan) > 0 {
+			return nil
+		}
+		last = max(last, seen)
+		if last <= bookmarked {
+			return nil
+		}
+		err := stream.Send(eventsv1.EventsWatchResponse_builder{
+			Bookmark: proto.Int64(last),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		bookmarked = last
+		return nil
+	}
+
 	// Wait to receive events on the channel of the subscription and forward them to the client:
 	for {
 		select {
-		case event, ok := <-subInfo.eventsChan:
-			if !ok {
-				logger.DebugContext(ctx, "Subscription channel closed")
-				return nil
+		case result := <-replayChan:
+			replayChan = nil
+			if result.err != nil {
+				return result.err
 			}
-			err = stream.Send(eventsv1.EventsWatchResponse_builder{
-				Event: event,
-			}.Build())
+			last = max(last, result.last)
+			replayed = result.replayed
+			subInfo.sent.Store(last)
+			for _, event := range pending {
+				err = sendEvent(event)
+				if err != nil {
+					return err
+				}
+			}
+			pending = nil
+			if bookmarks {
+				err = sendBookmark()
+				if err != nil {
+					return err
+				}
+			}
+		case event := <-subInfo.eventsChan:
+			if replayChan != nil {
+				// The events kept while replaying count against the same limit as the buffer of the
+				// subscription, otherwise a slow replay could make them grow without bound:
+				if len(pending) >= cap(subInfo.eventsChan) {
+					logger.WarnContext(
+						ctx,
+						"Pending events buffer is full",
+						slog.Int("size", len(pending)),
+					)
+					subInfo.overflowOnce.Do(func() {
+						close(subInfo.overflowChan)
+					})
+					continue
+				}
+				pending = append(pending, event)
+				continue
+			}
+			err = sendEvent(event)
 			if err != nil {
 				return err
 			}
-		case <-stream.Context().Done():
-			s.logger.DebugContext(ctx, "Subscription context canceled")
+		case <-bookmarksChan:
+			if replayChan != nil {
+				continue
+			}
+			err = sendBookmark()
+			if err != nil {
+				return err
+			}
+		case <-subInfo.overflowChan:
+			metrics.EventsDisconnects.WithLabelValues("public").Inc()
+			logger.WarnContext(
+				ctx,
+				"Disconnecting subscriber because it isn't receiving events fast enough",
+				slog.Int64("last", last),
+			)
+			return grpcstatus.Errorf(
+				grpccodes.ResourceExhausted,
+				"events are being generated faster than they are received, watch again with the 'since' "+
+					"parameter set to %d to continue from the last event received",
+				last,
+			)
+		case <-ctx.Done():
+			logger.DebugContext(ctx, "Subscription context canceled")
+			return nil
+		}
+	}
+}
+
+// replay sends to the stream the retained events that happened after the given sequence number, that are visible to
+// the user and that are accepted by the filter. It returns the greatest sequence number processed and the set of
+// sequence numbers that were replayed.
+func (s *EventsServer) replay(ctx context.Context, since int64, visibleTenants []string, filterPrg cel.Program,
+	stream grpc.ServerStreamingServer[eventsv1.EventsWatchResponse]) (last int64, replayed map[int64]bool,
+	err error) {
+	replayed = map[int64]bool{}
+	last, err = s.replayer.Replay(ctx, since, func(ctx context.Context, seq int64, payload proto.Message) error {
+		replayed[seq] = true
+		private, public, err := s.translatePayload(ctx, seq, payload)
+		if err != nil || public == nil {
+			return err
+		}
+		if !s.checkTenancy(ctx, visibleTenants, private) {
 			return nil
 		}
+		if filterPrg != nil {
+			accepted, err := s.evalFilter(ctx, filterPrg, public)
+			if err != nil || !accepted {
+				return nil
+			}
+		}
+		return stream.Send(eventsv1.EventsWatchResponse_builder{
+			Event: public,
+		}.Build())
+	})
+	var prunedErr *database.PrunedError
+	if errors.As(err, &prunedErr) {
+		err = grpcstatus.Errorf(
+			grpccodes.OutOfRange,
+			"events after %d are no longer available, retrieve the current state of the objects and watch "+
+				"again without the 'since' parameter",
+			since,
+		)
+		return
 	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to replay events",
+			slog.Int64("since", since),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to replay events")
+	}
+	return
 }
 
 func (s *EventsServer) compileFilter(ctx context.Context, filterSrc string) (result cel.Program, err error) {
@@ -304,7 +595,20 @@ func (s *EventsServer) evalFilter(ctx context.Context, filterPrg cel.Program, ev
 	return
 }
 
-func (s *EventsServer) processPayload(ctx context.Context, payload proto.Message) error {
+func (s *EventsServer) processPayload(ctx context.Context, seq int64, payload proto.Message) error {
+	private, public, err := s.translatePayload(ctx, seq, payload)
+	if err != nil || private == nil {
+		return err
+	}
+	storeMaxSequence(&s.latest, seq)
+	return s.processEvent(ctx, private, public)
+}
+
+// translatePayload extracts the private event from the payload of a notification and converts it into a public event.
+// The returned public event will be nil if it doesn't have a public representation. Note that this doesn't check if
+// the event is visible to the user, as that depends on the subscription.
+func (s *EventsServer) translatePayload(ctx context.Context, seq int64,
+	payload proto.Message) (private *privatev1.Event, public *eventsv1.Event, err error) {
 	// Get the object:
 	private, ok := payload.(*privatev1.Event)
 	if !ok {
@@ -314,43 +618,31 @@ func (s *EventsServer) processPayload(ctx context.Context, payload proto.Message
 			slog.String("expected", fmt.Sprintf("%T", private)),
 			slog.String("actual", fmt.Sprintf("%T", payload)),
 		)
-		return nil
+		return
 	}
+	private.SetSequence(seq)
 
 	// Skip object that don't have a public representtion:
-	if private.HasHub() {
-		return nil
-	}
-
-	// Check if the user has permission to see the event:
-	visible, err := s.checkTenancy(ctx, private)
-	if err != nil {
-		return fmt.Errorf("failed to check tenancy: %w", err)
-	}
-	if !visible {
-		return nil
+	if private.HasHub() || private.HasQuota() {
+		return
 	}
 
-	// Translate the private event to a public event and process it:
-	public := &eventsv1.Event{}
+	// Translate the private event to a public event:
+	public = &eventsv1.Event{}
 	err = s.mapper.Copy(ctx, private, public)
 	if err != nil {
-		return fmt.Errorf("failed to translate event: %w", err)
+		err = fmt.Errorf("failed to translate event: %w", err)
+		public = nil
+		return
 	}
-	return s.processEvent(ctx, public)
+	return
 }
 
-// checkTenancy checks if the object is visible to the current user.
-func (s *EventsServer) checkTenancy(ctx context.Context, event *privatev1.Event) (result bool, err error) {
-	// Get the visible tenants for the current user:
-	visibleTenants, err := s.tenancyLogic.DetermineVisibleTenants(ctx)
-	if err != nil {
-		err = fmt.Errorf("failed to determine visible tenants: %w", err)
-		return
-	}
+// checkTenancy checks if the object is visible to a user that has permission to see the given tenants. An empty list
+// of visible tenants means that the user can see all the objects.
+func (s *EventsServer) checkTenancy(ctx context.Context, visibleTenants []string, event *privatev1.Event) bool {
 	if len(visibleTenants) == 0 {
-		result = true
-		return
+		return true
 	}
 
 	// Get the tenants of the object:
@@ -361,26 +653,24 @@ func (s *EventsServer) checkTenancy(ctx context.Context, event *privatev1.Event)
 		if slices.Contains(visibleTenants, objectTenant) {
 			s.logger.DebugContext(
 				ctx,
-				"Event is visible to the current user",
+				"Event is visible to the user",
 				slog.Any("event", event),
 				slog.Any("visibible_tenants", visibleTenants),
 				slog.Any("object_tenants", objectTenants),
 			)
-			result = true
-			return
+			return true
 		}
 	}
 
 	// If we are here then none of the visibile tenants is in the object tenants, so the user can't see the object.
 	s.logger.DebugContext(
 		ctx,
-		"Event isn't visible to the current user",
+		"Event isn't visible to the user",
 		slog.Any("event", event),
 		slog.Any("visibible_tenants", visibleTenants),
 		slog.Any("object_tenants", objectTenants),
 	)
-	result = false
-	return
+	return false
 }
 
 func (s *EventsServer) extractTenants(ctx context.Context, event *privatev1.Event) []string {
@@ -403,6 +693,8 @@ func (s *EventsServer) extractMetadata(ctx context.Context, event *privatev1.Eve
 		return event.GetVirtualMachineTemplate().GetMetadata()
 	case event.HasVirtualMachine():
 		return event.GetVirtualMachine().GetMetadata()
+	case event.HasVirtualMachineSnapshot():
+		return event.GetVirtualMachineSnapshot().GetMetadata()
 	default:
 		s.logger.ErrorContext(
 			ctx,
@@ -413,21 +705,31 @@ func (s *EventsServer) extractMetadata(ctx context.Context, event *privatev1.Eve
 	}
 }
 
-func (s *EventsServer) processEvent(ctx context.Context, event *eventsv1.Event) error {
+func (s *EventsServer) processEvent(ctx context.Context, private *privatev1.Event, public *eventsv1.Event) error {
 	s.subsLock.RLock()
 	defer s.subsLock.RUnlock()
 	for subId, sub := range s.subs {
+		// Skip subscriptions that will be disconnected because their buffer is full:
+		select {
+		case <-sub.overflowChan:
+			continue
+		default:
+		}
+
 		logger := s.logger.With(
 			slog.String("filter", sub.filterSrc),
 			slog.String("sub", subId),
-			slog.Any("event", event),
+			slog.Any("event", public),
 		)
-		accepted := true
 
-		// Apply user-defined filter - tenant filtering is already done in processPayload
-		if sub.filterPrg != nil {
+		// Events without a public representation, or that the user that created the subscription can't see, are
+		// never sent:
+		accepted := public != nil && s.checkTenancy(ctx, sub.visibleTenants, private)
+
+		// Apply user-defined filter:
+		if accepted && sub.filterPrg != nil {
 			var err error
-			accepted, err = s.evalFilter(ctx, sub.filterPrg, event)
+			accepted, err = s.evalFilter(ctx, sub.filterPrg, public)
 			if err != nil {
 				logger.DebugContext(
 					ctx,
@@ -440,14 +742,63 @@ func (s *EventsServer) processEvent(ctx context.Context, event *eventsv1.Event)
 
 		if accepted {
 			logger.DebugContext(ctx, "Event accepted by filter")
-			sub.eventsChan <- event
+			select {
+			case sub.eventsChan <- public:
+			default:
+				logger.WarnContext(
+					ctx,
+					"Subscription buffer is full",
+					slog.Int("size", cap(sub.eventsChan)),
+				)
+				sub.overflowOnce.Do(func() {
+					close(sub.overflowChan)
+				})
+				continue
+			}
 		} else {
 			logger.DebugContext(ctx, "Event rejected by filter")
 		}
+		storeMaxSequence(sub.seen, private.GetSequence())
 	}
 	return nil
 }
 
+// Stats returns the statistics of the current subscriptions.
+func (s *EventsServer) Stats() []EventsServerSubStats {
+	latest := s.latest.Load()
+	s.subsLock.RLock()
+	defer s.subsLock.RUnlock()
+	result := make([]EventsServerSubStats, 0, len(s.subs))
+	for subId, sub := range s.subs {
+		result = append(result, makeEventsServerSubStats(subId, sub.filterSrc, sub.eventsChan, sub.seen, sub.sent,
+			latest))
+	}
+	return result
+}
+
+func (s *EventsServer) statsLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, stats := range s.Stats() {
+				s.logger.DebugContext(
+					ctx,
+					"Subscription stats",
+					slog.String("subscription", stats.Id),
+					slog.String("filter", stats.Filter),
+					slog.Int("buffered", stats.Buffered),
+					slog.Int("capacity", stats.Capacity),
+					slog.Int64("lag", stats.Lag),
+				)
+			}
+		}
+	}
+}
+
 // Names of the packages whose enums will be available in the filter expressions:
 var eventsServerPackages = map[protoreflect.FullName]bool{
 	"events.v1":      true,
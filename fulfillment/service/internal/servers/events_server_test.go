@@ -0,0 +1,285 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	eventsv1 "github.com/jkary/osac/fulfillment/service/internal/api/events/v1"
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+var _ = Describe("Events server", func() {
+	var (
+		ctx      context.Context
+		dbUrl    string
+		pool     *pgxpool.Pool
+		tm       database.TxManager
+		notifier *database.Notifier
+		events   *EventsServer
+		client   eventsv1.EventsClient
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context that will be canceled when the test finishes, so that the listener stops:
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		dbUrl = db.MakeURL()
+		pool, err = pgxpool.New(ctx, dbUrl)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the notifications tables:
+		_, err = pool.Exec(
+			ctx,
+			`
+			create table notifications (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone default now(),
+				payload bytea,
+				seq bigserial not null,
+				xid xid8 not null default pg_current_xact_id(),
+				horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot())
+			);
+
+			create table notifications_watermark (
+				pruned bigint not null
+			);
+
+			insert into notifications_watermark (pruned) values (0);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the transaction manager and the notifier:
+		tm, err = database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		notifier, err = database.NewNotifier().
+			SetLogger(logger).
+			SetChannel("events").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a tenancy logic that allows to see the objects of the tenants passed in the `tenant` metadata
+		// header, or the objects of `tenant_a` if there is no such header:
+		ctrl := gomock.NewController(GinkgoT())
+		DeferCleanup(ctrl.Finish)
+		tenancyLogic := auth.NewMockTenancyLogic(ctrl)
+		tenancyLogic.EXPECT().DetermineVisibleTenants(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) ([]string, error) {
+				md, _ := metadata.FromIncomingContext(ctx)
+				tenants := md.Get("tenant")
+				if len(tenants) == 0 {
+					tenants = []string{"tenant_a"}
+				}
+				return tenants, nil
+			}).
+			AnyTimes()
+
+		// Create the server and start the listener:
+		events, err = NewEventsServer().
+			SetLogger(logger).
+			SetDbUrl(dbUrl).
+			SetDbPool(pool).
+			SetTenancyLogic(tenancyLogic).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		events.bookmarkInterval = 10 * time.Millisecond
+		go events.Start(ctx)
+
+		// Start the gRPC server:
+		listener := bufconn.Listen(1024 * 1024)
+		grpcServer := grpc.NewServer()
+		DeferCleanup(grpcServer.Stop)
+		eventsv1.RegisterEventsServer(grpcServer, events)
+		go grpcServer.Serve(listener)
+
+		// Create the client:
+		conn, err := grpc.NewClient(
+			"passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+		client = eventsv1.NewEventsClient(conn)
+	})
+
+	// notify sends the given event and returns its sequence number.
+	notify := func(event *privatev1.Event) int64 {
+		tx, err := tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		event.SetId(uuid.NewString())
+		event.SetType(privatev1.EventType_EVENT_TYPE_OBJECT_CREATED)
+		err = notifier.Notify(database.TxIntoContext(ctx, tx), event)
+		Expect(err).ToNot(HaveOccurred())
+		err = tm.End(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+		row := pool.QueryRow(ctx, "select max(seq) from notifications")
+		var seq int64
+		err = row.Scan(&seq)
+		Expect(err).ToNot(HaveOccurred())
+		return seq
+	}
+
+	// notifyCluster sends an event for a cluster that belongs to the given tenant.
+	notifyCluster := func(id string, tenant string) int64 {
+		return notify(privatev1.Event_builder{
+			Cluster: privatev1.Cluster_builder{
+				Id: id,
+				Metadata: privatev1.Metadata_builder{
+					Tenants: []string{tenant},
+				}.Build(),
+			}.Build(),
+		}.Build())
+	}
+
+	It("Replays only the events that the user has permission to see", func() {
+		notifyCluster("123", "tenant_b")
+		visible := notifyCluster("456", "tenant_a")
+		stream, err := client.Watch(ctx, eventsv1.EventsWatchRequest_builder{
+			Since: proto.Int64(0),
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		response, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetEvent().GetSequence()).To(Equal(visible))
+		Expect(response.GetEvent().GetCluster().GetId()).To(Equal("456"))
+	})
+
+	It("Sends a bookmark for events that the user can't see", func() {
+		notifyCluster("123", "tenant_a")
+		notify(privatev1.Event_builder{
+			Hub: privatev1.Hub_builder{
+				Id: "my_hub",
+			}.Build(),
+		}.Build())
+		last := notifyCluster("456", "tenant_b")
+		stream, err := client.Watch(ctx, eventsv1.EventsWatchRequest_builder{
+			Since:     proto.Int64(0),
+			Bookmarks: proto.Bool(true),
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		response, err := stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetEvent().GetCluster().GetId()).To(Equal("123"))
+		response, err = stream.Recv()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.HasEvent()).To(BeFalse())
+		Expect(response.GetBookmark()).To(Equal(last))
+	})
+
+	Describe("Multiple tenants", func() {
+		// watch starts watching as a user that can see the given tenants, and waits till the subscription is
+		// created.
+		watch := func(tenants ...string) grpc.ServerStreamingClient[eventsv1.EventsWatchResponse] {
+			count := len(events.Stats())
+			watchCtx := ctx
+			for _, tenant := range tenants {
+				watchCtx = metadata.AppendToOutgoingContext(watchCtx, "tenant", tenant)
+			}
+			stream, err := client.Watch(watchCtx, eventsv1.EventsWatchRequest_builder{}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(events.Stats).Should(HaveLen(count + 1))
+			return stream
+		}
+
+		// process makes the server process an event for a cluster as if it had been received from the database.
+		process := func(seq int64, id string, tenant string) {
+			err := events.processPayload(ctx, seq, privatev1.Event_builder{
+				Id:   uuid.NewString(),
+				Type: privatev1.EventType_EVENT_TYPE_OBJECT_CREATED,
+				Cluster: privatev1.Cluster_builder{
+					Id: id,
+					Metadata: privatev1.Metadata_builder{
+						Tenants: []string{tenant},
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// receive returns the identifier of the cluster of the next event received from the stream.
+		receive := func(stream grpc.ServerStreamingClient[eventsv1.EventsWatchResponse]) string {
+			response, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			return response.GetEvent().GetCluster().GetId()
+		}
+
+		It("Sends to each subscriber only the events of the tenants that it can see", func() {
+			streamA := watch("tenant_a")
+			streamB := watch("tenant_b")
+			streamAB := watch("tenant_a", "tenant_b")
+			process(1, "cluster_a1", "tenant_a")
+			process(2, "cluster_b1", "tenant_b")
+			process(3, "cluster_c1", "tenant_c")
+			process(4, "cluster_a2", "tenant_a")
+			process(5, "cluster_b2", "tenant_b")
+			Expect(receive(streamA)).To(Equal("cluster_a1"))
+			Expect(receive(streamA)).To(Equal("cluster_a2"))
+			Expect(receive(streamB)).To(Equal("cluster_b1"))
+			Expect(receive(streamB)).To(Equal("cluster_b2"))
+			Expect(receive(streamAB)).To(Equal("cluster_a1"))
+			Expect(receive(streamAB)).To(Equal("cluster_b1"))
+			Expect(receive(streamAB)).To(Equal("cluster_a2"))
+			Expect(receive(streamAB)).To(Equal("cluster_b2"))
+		})
+
+		It("Replays to each subscriber only the events of the tenants that it can see", func() {
+			notifyCluster("cluster_a1", "tenant_a")
+			notifyCluster("cluster_b1", "tenant_b")
+			notifyCluster("cluster_a2", "tenant_a")
+			sinceCtxA := metadata.AppendToOutgoingContext(ctx, "tenant", "tenant_a")
+			streamA, err := client.Watch(sinceCtxA, eventsv1.EventsWatchRequest_builder{
+				Since: proto.Int64(0),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			sinceCtxB := metadata.AppendToOutgoingContext(ctx, "tenant", "tenant_b")
+			streamB, err := client.Watch(sinceCtxB, eventsv1.EventsWatchRequest_builder{
+				Since: proto.Int64(0),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receive(streamA)).To(Equal("cluster_a1"))
+			Expect(receive(streamA)).To(Equal("cluster_a2"))
+			Expect(receive(streamB)).To(Equal("cluster_b1"))
+		})
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"regexp"
 	"sync"
 
 	"github.com/google/uuid"
@@ -28,12 +29,15 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 	"github.com/jkary/osac/fulfillment/service/internal/masks"
+	"github.com/jkary/osac/fulfillment/service/internal/tracing"
 )
 
 // GenericServerBuilder contains the data and logic needed to create new generic servers.
@@ -45,6 +49,8 @@ type GenericServerBuilder[O dao.Object] struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+	keyring          *encryption.Keyring
 }
 
 // GenericServer is a gRPC server that knows how to implement the List, Get, Create, Update and Delete operators for
@@ -65,9 +71,15 @@ type GenericServer[O dao.Object] struct {
 	deleteRequest  proto.Message
 	deleteResponse proto.Message
 	notifier       *database.Notifier
+	auditLogger    *AuditLogger
 	pathCompiler   *masks.PathCompiler[O]
 	pathCache      map[string]*masks.Path[O]
 	pathCacheLock  *sync.Mutex
+
+	// These are only populated for services that support the ListArchived, GetArchived and Undelete methods:
+	listArchivedResponse proto.Message
+	getArchivedResponse  proto.Message
+	undeleteResponse     proto.Message
 }
 
 // NewGenericServer creates a builder that can then be used to configure and create a new generic server.
@@ -128,6 +140,20 @@ func (b *GenericServerBuilder[O]) SetTenancyLogic(value auth.TenancyLogic) *Gene
 	return b
 }
 
+// SetAuditLogger sets the audit logger that the server will use to record the changes made to objects. This is
+// optional, and if not set changes will not be recorded.
+func (b *GenericServerBuilder[O]) SetAuditLogger(value *AuditLogger) *GenericServerBuilder[O] {
+	b.auditLogger = value
+	return b
+}
+
+// SetKeyring sets the keyring that will be used to encrypt the sensitive fields of the objects stored in the database.
+// This is optional, and if not set sensitive fields will be stored without encryption.
+func (b *GenericServerBuilder[O]) SetKeyring(value *encryption.Keyring) *GenericServerBuilder[O] {
+	b.keyring = value
+	return b
+}
+
 // Build uses the configuration stored in the builder to create and configure a new generic server.
 func (b *GenericServerBuilder[O]) Build() (result *GenericServer[O], err error) {
 	// Check parameters:
@@ -158,6 +184,7 @@ func (b *GenericServerBuilder[O]) Build() (result *GenericServer[O], err error)
 		logger:        b.logger,
 		service:       b.service,
 		notifier:      b.notifier,
+		auditLogger:   b.auditLogger,
 		pathCompiler:  pathCompiler,
 		pathCache:     map[string]*masks.Path[O]{},
 		pathCacheLock: &sync.Mutex{},
@@ -176,6 +203,7 @@ func (b *GenericServerBuilder[O]) Build() (result *GenericServer[O], err error)
 	if b.tenancyLogic != nil {
 		daoBuilder.SetTenancyLogic(b.tenancyLogic)
 	}
+	daoBuilder.SetKeyring(b.keyring)
 	s.dao, err = daoBuilder.Build()
 	if err != nil {
 		err = fmt.Errorf("failed to create DAO: %w", err)
@@ -214,6 +242,24 @@ func (b *GenericServerBuilder[O]) Build() (result *GenericServer[O], err error)
 	if err != nil {
 		return
 	}
+	if service.Methods().ByName(listArchivedMethod) != nil {
+		_, s.listArchivedResponse, err = b.findRequestAndResponse(service, listArchivedMethod)
+		if err != nil {
+			return
+		}
+	}
+	if service.Methods().ByName(getArchivedMethod) != nil {
+		_, s.getArchivedResponse, err = b.findRequestAndResponse(service, getArchivedMethod)
+		if err != nil {
+			return
+		}
+	}
+	if service.Methods().ByName(undeleteMethod) != nil {
+		_, s.undeleteResponse, err = b.findRequestAndResponse(service, undeleteMethod)
+		if err != nil {
+			return
+		}
+	}
 
 	result = s
 	return
@@ -245,6 +291,10 @@ const (
 	createMethod = "Create"
 	updateMethod = "Update"
 	deleteMethod = "Delete"
+
+	listArchivedMethod = "ListArchived"
+	getArchivedMethod  = "GetArchived"
+	undeleteMethod     = "Undelete"
 )
 
 // findRequestAndResponse finds the request and response message types for the given method.
@@ -277,22 +327,47 @@ func (b *GenericServerBuilder[O]) findRequestAndResponse(service protoreflect.Se
 }
 
 func (s *GenericServer[O]) List(ctx context.Context, request any, response any) error {
+	return s.list(ctx, s.dao.List, s.listResponse, request, response)
+}
+
+// ListArchived is like List, but it returns the objects that have been completely deleted and moved to the archive.
+func (s *GenericServer[O]) ListArchived(ctx context.Context, request any, response any) error {
+	if s.listArchivedResponse == nil {
+		return grpcstatus.Errorf(grpccodes.Unimplemented, "service doesn't support listing archived objects")
+	}
+	return s.list(ctx, s.dao.ListArchived, s.listArchivedResponse, request, response)
+}
+
+func (s *GenericServer[O]) list(ctx context.Context,
+	query func(context.Context, dao.ListRequest) (dao.ListResponse[O], error), template proto.Message,
+	request any, response any) error {
 	type requestIface interface {
 		GetOffset() int32
 		GetLimit() int32
 		GetFilter() string
+		GetOrder() string
+		GetPageToken() string
+		GetSkipTotal() bool
 	}
 	type responseIface interface {
 		SetSize(int32)
 		SetTotal(int32)
 		SetItems([]O)
+		SetNextPageToken(string)
 	}
 	requestMsg := request.(requestIface)
 	daoRequest := dao.ListRequest{}
 	daoRequest.Offset = requestMsg.GetOffset()
 	daoRequest.Limit = requestMsg.GetLimit()
 	daoRequest.Filter = requestMsg.GetFilter()
-	daoResponse, err := s.dao.List(ctx, daoRequest)
+	daoRequest.Order = requestMsg.GetOrder()
+	daoRequest.PageToken = requestMsg.GetPageToken()
+	daoRequest.SkipTotal = requestMsg.GetSkipTotal()
+	daoResponse, err := query(ctx, daoRequest)
+	var requestErr *dao.RequestError
+	if errors.As(err, &requestErr) {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "%s", requestErr.Error())
+	}
 	if err != nil {
 		s.logger.ErrorContext(
 			ctx,
@@ -301,10 +376,15 @@ func (s *GenericServer[O]) List(ctx context.Context, request any, response any)
 		)
 		return grpcstatus.Errorf(grpccodes.Internal, "failed to list")
 	}
-	responseMsg := proto.Clone(s.listResponse).(responseIface)
+	responseMsg := proto.Clone(template).(responseIface)
 	responseMsg.SetSize(daoResponse.Size)
-	responseMsg.SetTotal(daoResponse.Total)
+	if !daoRequest.SkipTotal {
+		responseMsg.SetTotal(daoResponse.Total)
+	}
 	responseMsg.SetItems(daoResponse.Items)
+	if daoResponse.NextPageToken != "" {
+		responseMsg.SetNextPageToken(daoResponse.NextPageToken)
+	}
 	s.setPointer(response, responseMsg)
 	return nil
 }
@@ -321,20 +401,50 @@ func (s *GenericServer[O]) Get(ctx context.Context, request any, response any) e
 	if id == "" {
 		return grpcstatus.Errorf(grpccodes.InvalidArgument, "identifier is mandatory")
 	}
-	object, err := s.dao.Get(ctx, id)
+	object, err := s.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if s.isNil(object) {
+		return grpcstatus.Errorf(grpccodes.NotFound, "object with identifier '%s' doesn't exist", id)
+	}
+	responseMsg := proto.Clone(s.getResponse).(responseIface)
+	responseMsg.SetObject(object)
+	s.setPointer(response, responseMsg)
+	return nil
+}
+
+// GetArchived is like Get, but it returns an object that has been completely deleted and moved to the archive. Note
+// that archived objects can only be retrieved using the identifier, not the name.
+func (s *GenericServer[O]) GetArchived(ctx context.Context, request any, response any) error {
+	type requestIface interface {
+		GetId() string
+	}
+	type responseIface interface {
+		SetObject(O)
+	}
+	if s.getArchivedResponse == nil {
+		return grpcstatus.Errorf(grpccodes.Unimplemented, "service doesn't support getting archived objects")
+	}
+	requestMsg := request.(requestIface)
+	id := requestMsg.GetId()
+	if id == "" {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "identifier is mandatory")
+	}
+	object, err := s.dao.GetArchived(ctx, id)
 	if err != nil {
 		s.logger.ErrorContext(
 			ctx,
-			"Failed to get",
+			"Failed to get archived object",
 			slog.String("id", id),
 			slog.Any("error", err),
 		)
-		return grpcstatus.Errorf(grpccodes.Internal, "failed to get object with identifier '%s'", id)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to get archived object with identifier '%s'", id)
 	}
 	if s.isNil(object) {
-		return grpcstatus.Errorf(grpccodes.NotFound, "object with identifier '%s' doesn't exist", id)
+		return grpcstatus.Errorf(grpccodes.NotFound, "archived object with identifier '%s' doesn't exist", id)
 	}
-	responseMsg := proto.Clone(s.getResponse).(responseIface)
+	responseMsg := proto.Clone(s.getArchivedResponse).(responseIface)
 	responseMsg.SetObject(object)
 	s.setPointer(response, responseMsg)
 	return nil
@@ -352,15 +462,32 @@ func (s *GenericServer[O]) Create(ctx context.Context, request any, response any
 	if s.isNil(object) {
 		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
 	}
-	object, err := s.dao.Create(ctx, object)
+	err := s.validateName(object)
+	if err != nil {
+		return err
+	}
+	object, err = s.dao.Create(ctx, object)
+	var nameErr *dao.NameConflictError
+	if errors.As(err, &nameErr) {
+		return grpcstatus.Errorf(grpccodes.AlreadyExists, "%s", nameErr.Error())
+	}
 	if err != nil {
 		s.logger.ErrorContext(
 			ctx,
 			"Failed to create",
 			slog.Any("error", err),
 		)
+		// Errors that are already gRPC statuses, like those returned by the tenancy logic when the tenant can't be
+		// selected, are meant for the user, so return them as they are:
+		if _, ok := grpcstatus.FromError(err); ok {
+			return err
+		}
 		return grpcstatus.Errorf(grpccodes.Internal, "failed to create object")
 	}
+	err = s.audit(ctx, privatev1.AuditLogAction_AUDIT_LOG_ACTION_CREATE, *new(O), object, nil)
+	if err != nil {
+		return err
+	}
 	responseMsg := proto.Clone(s.createResponse).(responseIface)
 	responseMsg.SetObject(object)
 	s.setPointer(response, responseMsg)
@@ -380,25 +507,24 @@ func (s *GenericServer[O]) Update(ctx context.Context, request any, response any
 	if s.isNil(input) {
 		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
 	}
+	err := s.validateName(input)
+	if err != nil {
+		return err
+	}
+
+	// The object can be identified by the identifier or, if that is empty, by the name:
 	id := input.GetId()
 	if id == "" {
-		return grpcstatus.Errorf(grpccodes.Internal, "object identifier is mandatory")
+		id = s.getName(input)
+	}
+	if id == "" {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier or name is mandatory")
 	}
 
 	// Fetch the current representation of the object:
-	object, err := s.dao.Get(ctx, id)
+	object, err := s.find(ctx, id)
 	if err != nil {
-		s.logger.ErrorContext(
-			ctx,
-			"Failed to get object",
-			slog.String("id", id),
-			slog.Any("error", err),
-		)
-		return grpcstatus.Errorf(
-			grpccodes.Internal,
-			"failed to get object with identifier '%s'",
-			id,
-		)
+		return err
 	}
 	if s.isNil(object) {
 		return grpcstatus.Errorf(
@@ -407,6 +533,13 @@ func (s *GenericServer[O]) Update(ctx context.Context, request any, response any
 			id,
 		)
 	}
+	id = object.GetId()
+
+	// Remember the current representation, as the object will be modified in place:
+	var before O
+	if s.auditLogger != nil {
+		before = proto.Clone(object).(O)
+	}
 
 	// Update the fields indicated in the mask, or all the fields if there is no mask:
 	mask := requestMsg.GetUpdateMask()
@@ -423,12 +556,33 @@ func (s *GenericServer[O]) Update(ctx context.Context, request any, response any
 				fieldPath.Clear(object)
 			}
 		}
+		s.copyVersion(input, object)
 	} else {
 		object = input
+		object.SetId(id)
 	}
 
 	// Save the result:
 	object, err = s.dao.Update(ctx, object)
+	var nameErr *dao.NameConflictError
+	if errors.As(err, &nameErr) {
+		return grpcstatus.Errorf(grpccodes.AlreadyExists, "%s", nameErr.Error())
+	}
+	var conflictErr *dao.ConflictError
+	if errors.As(err, &conflictErr) {
+		s.logger.DebugContext(
+			ctx,
+			"Version conflict updating object",
+			slog.String("id", id),
+			slog.Int64("expected", conflictErr.Expected),
+			slog.Int64("actual", conflictErr.Actual),
+		)
+		return grpcstatus.Errorf(
+			grpccodes.Aborted,
+			"object with identifier '%s' has been modified, retrieve it again and retry the update",
+			id,
+		)
+	}
 	if err != nil {
 		s.logger.ErrorContext(
 			ctx,
@@ -442,6 +596,10 @@ func (s *GenericServer[O]) Update(ctx context.Context, request any, response any
 			id,
 		)
 	}
+	err = s.audit(ctx, privatev1.AuditLogAction_AUDIT_LOG_ACTION_UPDATE, before, object, mask.GetPaths())
+	if err != nil {
+		return err
+	}
 
 	responseMsg := proto.Clone(s.updateResponse).(responseIface)
 	responseMsg.SetObject(object)
@@ -449,6 +607,31 @@ func (s *GenericServer[O]) Update(ctx context.Context, request any, response any
 	return nil
 }
 
+// copyVersion copies the version from the metadata of the input object to the metadata of the output object. This is
+// used when updating with a field mask, so that the version that the client expects is checked even if the mask doesn't
+// include the metadata.
+func (s *GenericServer[O]) copyVersion(from, to O) {
+	type metadataIface interface {
+		GetVersion() int64
+		SetVersion(int64)
+	}
+	fromReflect := from.ProtoReflect()
+	toReflect := to.ProtoReflect()
+	metadataField := fromReflect.Descriptor().Fields().ByName("metadata")
+	if metadataField == nil || !fromReflect.Has(metadataField) {
+		return
+	}
+	fromMetadata, ok := fromReflect.Get(metadataField).Message().Interface().(metadataIface)
+	if !ok || fromMetadata.GetVersion() == 0 {
+		return
+	}
+	toMetadata, ok := toReflect.Mutable(metadataField).Message().Interface().(metadataIface)
+	if !ok {
+		return
+	}
+	toMetadata.SetVersion(fromMetadata.GetVersion())
+}
+
 func (s *GenericServer[O]) compilePaths(paths []string) (result []*masks.Path[O], err error) {
 	fieldPaths := make([]*masks.Path[O], len(paths))
 	for i, path := range paths {
@@ -487,7 +670,18 @@ func (s *GenericServer[O]) Delete(ctx context.Context, request any, response any
 	if id == "" {
 		return grpcstatus.Errorf(grpccodes.Internal, "object identifier is mandatory")
 	}
-	err := s.dao.Delete(ctx, id)
+
+	// Find the object, as we need the real identifier if the request contains the name, and we also need the
+	// representation of the object before deleting it to record it in the audit log:
+	before, err := s.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !s.isNil(before) {
+		id = before.GetId()
+	}
+
+	err = s.dao.Delete(ctx, id)
 	if err != nil {
 		s.logger.ErrorContext(
 			ctx,
@@ -497,11 +691,193 @@ func (s *GenericServer[O]) Delete(ctx context.Context, request any, response any
 		)
 		return grpcstatus.Errorf(grpccodes.Internal, "failed to delete object")
 	}
+	if !s.isNil(before) {
+		err = s.audit(ctx, privatev1.AuditLogAction_AUDIT_LOG_ACTION_DELETE, before, *new(O), nil)
+		if err != nil {
+			return err
+		}
+	}
 	responseMsg := proto.Clone(s.deleteResponse).(responseIface)
 	s.setPointer(response, responseMsg)
 	return nil
 }
 
+// Undelete cancels the deletion of an object that has been deleted but that still has pending finalizers.
+func (s *GenericServer[O]) Undelete(ctx context.Context, request any, response any) error {
+	type requestIface interface {
+		GetId() string
+	}
+	type responseIface interface {
+		SetObject(O)
+	}
+	type metadataIface interface {
+		GetDeletionTimestamp() *timestamppb.Timestamp
+	}
+	if s.undeleteResponse == nil {
+		return grpcstatus.Errorf(grpccodes.Unimplemented, "service doesn't support undeleting objects")
+	}
+	requestMsg := request.(requestIface)
+	id := requestMsg.GetId()
+	if id == "" {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier is mandatory")
+	}
+
+	// Find the object and check that it has been deleted. Note that objects that have been completely deleted have
+	// already been moved to the archive, so they will not be found.
+	before, err := s.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if s.isNil(before) {
+		return grpcstatus.Errorf(
+			grpccodes.NotFound,
+			"object with identifier '%s' doesn't exist or has already been completely deleted",
+			id,
+		)
+	}
+	id = before.GetId()
+	metadata, ok := s.getMetadata(before).(metadataIface)
+	if !ok || metadata.GetDeletionTimestamp() == nil {
+		return grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"object with identifier '%s' hasn't been deleted",
+			id,
+		)
+	}
+
+	// Clear the deletion timestamp:
+	after, err := s.dao.Undelete(ctx, id)
+	var conflictErr *dao.ConflictError
+	if errors.As(err, &conflictErr) {
+		return grpcstatus.Errorf(
+			grpccodes.Aborted,
+			"object with identifier '%s' has been modified, retry the operation",
+			id,
+		)
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to undelete object",
+			slog.String("id", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to undelete object with identifier '%s'", id)
+	}
+	if s.isNil(after) {
+		return grpcstatus.Errorf(
+			grpccodes.NotFound,
+			"object with identifier '%s' doesn't exist or has already been completely deleted",
+			id,
+		)
+	}
+	err = s.audit(ctx, privatev1.AuditLogAction_AUDIT_LOG_ACTION_UNDELETE, before, after, nil)
+	if err != nil {
+		return err
+	}
+	responseMsg := proto.Clone(s.undeleteResponse).(responseIface)
+	responseMsg.SetObject(after)
+	s.setPointer(response, responseMsg)
+	return nil
+}
+
+// find finds the object that has the given identifier or, if there is no such object, the object that has the given
+// name. Returns nil if there is no such object. Errors returned are already gRPC statuses.
+func (s *GenericServer[O]) find(ctx context.Context, key string) (result O, err error) {
+	result, err = s.dao.Get(ctx, key)
+	if err == nil && s.isNil(result) {
+		result, err = s.dao.GetByName(ctx, key)
+	}
+	var ambiguousErr *dao.AmbiguousNameError
+	if errors.As(err, &ambiguousErr) {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "%s", ambiguousErr.Error())
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to get object",
+			slog.String("key", key),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to get object with identifier '%s'", key)
+		return
+	}
+	return
+}
+
+// getName returns the name from the metadata of the object, or an empty string if there is no metadata.
+func (s *GenericServer[O]) getName(object O) string {
+	type metadataIface interface {
+		GetName() string
+	}
+	metadata, ok := s.getMetadata(object).(metadataIface)
+	if !ok {
+		return ""
+	}
+	return metadata.GetName()
+}
+
+// getMetadata returns the metadata of the object, or nil if the object has no metadata.
+func (s *GenericServer[O]) getMetadata(object O) proto.Message {
+	objectReflect := object.ProtoReflect()
+	metadataField := objectReflect.Descriptor().Fields().ByName("metadata")
+	if metadataField == nil || !objectReflect.Has(metadataField) {
+		return nil
+	}
+	return objectReflect.Get(metadataField).Message().Interface()
+}
+
+// validateName checks that the name of the object, if set, is a valid DNS label. That is required because the name is
+// also used as the value of labels of the Kubernetes objects created in the hubs.
+func (s *GenericServer[O]) validateName(object O) error {
+	name := s.getName(object)
+	if name == "" {
+		return nil
+	}
+	if len(name) > maxNameLength || !nameRegexp.MatchString(name) {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"name '%s' isn't valid, it should contain at most %d lower case alphanumeric characters or '-', "+
+				"and it should start and end with an alphanumeric character",
+			name, maxNameLength,
+		)
+	}
+	return nil
+}
+
+// maxNameLength is the maximum length of object names.
+const maxNameLength = 63
+
+// nameRegexp is the regular expression used to check object names.
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// audit records the change in the audit log, if there is an audit logger. Objects that are nil are not recorded.
+func (s *GenericServer[O]) audit(ctx context.Context, action privatev1.AuditLogAction, before, after O,
+	paths []string) error {
+	if s.auditLogger == nil {
+		return nil
+	}
+	var beforeMsg, afterMsg proto.Message
+	if !s.isNil(before) {
+		beforeMsg = before
+	}
+	if !s.isNil(after) {
+		afterMsg = after
+	}
+	err := s.auditLogger.Record(ctx, action, beforeMsg, afterMsg, paths)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to record audit log entry",
+			slog.String("action", action.String()),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to record audit log entry")
+	}
+	return nil
+}
+
 // notifyEvent converts the DAO event into an API event and publishes it using the PostgreSQL NOTIFY command.
 func (s *GenericServer[O]) notifyEvent(ctx context.Context, e dao.Event) error {
 	// TODO: This is the only part of the generic server that depends on specific object types. Is there a way
@@ -536,9 +912,17 @@ func (s *GenericServer[O]) notifyEvent(ctx context.Context, e dao.Event) error {
 		event.SetVirtualMachineTemplate(object)
 	case *privatev1.VirtualMachine:
 		event.SetVirtualMachine(object)
+	case *privatev1.VirtualMachineSnapshot:
+		event.SetVirtualMachineSnapshot(object)
+	case *privatev1.Quota:
+		event.SetQuota(object)
 	default:
 		return fmt.Errorf("unknown object type '%T'", object)
 	}
+
+	// Save the trace context, so that the consumers of the event can link their work to this request:
+	event.SetTraceContext(tracing.Inject(ctx))
+
 	return s.notifier.Notify(ctx, event)
 }
 
@@ -102,6 +102,8 @@ func (s *HostClassesServer) List(ctx context.Context,
 	privateRequest.SetLimit(request.GetLimit())
 	privateRequest.SetFilter(request.GetFilter())
 	privateRequest.SetOrder(request.GetOrder())
+	privateRequest.SetPageToken(request.GetPageToken())
+	privateRequest.SetSkipTotal(request.GetSkipTotal())
 
 	// Delegate to private server:
 	privateResponse, err := s.private.List(ctx, privateRequest)
@@ -129,8 +131,13 @@ func (s *HostClassesServer) List(ctx context.Context,
 	// Create the public response:
 	response = &ffv1.HostClassesListResponse{}
 	response.SetSize(privateResponse.GetSize())
-	response.SetTotal(privateResponse.GetTotal())
+	if privateResponse.HasTotal() {
+		response.SetTotal(privateResponse.GetTotal())
+	}
 	response.SetItems(publicItems)
+	if privateResponse.HasNextPageToken() {
+		response.SetNextPageToken(privateResponse.GetNextPageToken())
+	}
 	return
 }
 
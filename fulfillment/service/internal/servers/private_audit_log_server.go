@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+)
+
+type PrivateAuditLogServerBuilder struct {
+	logger       *slog.Logger
+	tenancyLogic auth.TenancyLogic
+}
+
+var _ privatev1.AuditLogServer = (*PrivateAuditLogServer)(nil)
+
+// PrivateAuditLogServer gives read access to the entries written by the audit logger. Entries are filtered by the
+// tenants of the changed objects, like the objects themselves.
+type PrivateAuditLogServer struct {
+	privatev1.UnimplementedAuditLogServer
+
+	logger *slog.Logger
+	dao    *dao.GenericDAO[*privatev1.AuditLogEntry]
+}
+
+func NewPrivateAuditLogServer() *PrivateAuditLogServerBuilder {
+	return &PrivateAuditLogServerBuilder{}
+}
+
+func (b *PrivateAuditLogServerBuilder) SetLogger(value *slog.Logger) *PrivateAuditLogServerBuilder {
+	b.logger = value
+	return b
+}
+
+func (b *PrivateAuditLogServerBuilder) SetTenancyLogic(value auth.TenancyLogic) *PrivateAuditLogServerBuilder {
+	b.tenancyLogic = value
+	return b
+}
+
+func (b *PrivateAuditLogServerBuilder) Build() (result *PrivateAuditLogServer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Create the DAO. Note that the default order is the reverse creation order, so that the most recent changes
+	// are returned first.
+	daoBuilder := dao.NewGenericDAO[*privatev1.AuditLogEntry]().
+		SetLogger(b.logger).
+		SetTable("audit_log").
+		SetDefaultOrder("this.metadata.creation_timestamp desc")
+	if b.tenancyLogic != nil {
+		daoBuilder.SetTenancyLogic(b.tenancyLogic)
+	}
+	entriesDao, err := daoBuilder.Build()
+	if err != nil {
+		return
+	}
+
+	// Create and populate the object:
+	result = &PrivateAuditLogServer{
+		logger: b.logger,
+		dao:    entriesDao,
+	}
+	return
+}
+
+func (s *PrivateAuditLogServer) List(ctx context.Context,
+	request *privatev1.AuditLogListRequest) (response *privatev1.AuditLogListResponse, err error) {
+	daoRequest := dao.ListRequest{
+		Offset:    request.GetOffset(),
+		Limit:     request.GetLimit(),
+		Filter:    request.GetFilter(),
+		Order:     request.GetOrder(),
+		PageToken: request.GetPageToken(),
+		SkipTotal: request.GetSkipTotal(),
+	}
+	daoResponse, err := s.dao.List(ctx, daoRequest)
+	var requestErr *dao.RequestError
+	if errors.As(err, &requestErr) {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "%s", requestErr.Error())
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to list audit log entries",
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to list")
+		return
+	}
+	response = &privatev1.AuditLogListResponse{}
+	response.SetSize(daoResponse.Size)
+	if !daoRequest.SkipTotal {
+		response.SetTotal(daoResponse.Total)
+	}
+	response.SetItems(daoResponse.Items)
+	if daoResponse.NextPageToken != "" {
+		response.SetNextPageToken(daoResponse.NextPageToken)
+	}
+	return
+}
+
+func (s *PrivateAuditLogServer) Get(ctx context.Context,
+	request *privatev1.AuditLogGetRequest) (response *privatev1.AuditLogGetResponse, err error) {
+	id := request.GetId()
+	if id == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "identifier is mandatory")
+		return
+	}
+	entry, err := s.dao.Get(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to get audit log entry",
+			slog.String("id", id),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to get object with identifier '%s'", id)
+		return
+	}
+	if entry == nil {
+		err = grpcstatus.Errorf(grpccodes.NotFound, "object with identifier '%s' doesn't exist", id)
+		return
+	}
+	response = &privatev1.AuditLogGetResponse{}
+	response.SetObject(entry)
+	return
+}
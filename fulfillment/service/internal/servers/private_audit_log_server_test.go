@@ -0,0 +1,350 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+var _ = Describe("Private audit log server", func() {
+	var (
+		ctx         context.Context
+		tx          database.Tx
+		auditLogger *AuditLogger
+		hubs        *PrivateHubsServer
+		entries     *PrivateAuditLogServer
+	)
+
+	// withMethod returns a context that simulates a call to the given gRPC method.
+	withMethod := func(ctx context.Context, method string) context.Context {
+		return grpc.NewContextWithServerTransportStream(ctx, &auditLogTestStream{
+			method: method,
+		})
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err := pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the transaction manager:
+		tm, err := database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start a transaction and add it to the context:
+		tx, err = tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		ctx = database.TxIntoContext(ctx, tx)
+
+		// Add the subject to the context:
+		ctx = auth.ContextWithSubject(ctx, &auth.Subject{
+			User:   "my_user",
+			Groups: []string{"my_group"},
+		})
+
+		// Create the tables:
+		_, err = tx.Exec(
+			ctx,
+			`
+			create table hubs (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table archived_hubs (
+				id text not null,
+				creation_timestamp timestamp with time zone not null,
+				deletion_timestamp timestamp with time zone not null,
+				archival_timestamp timestamp with time zone not null default now(),
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+
+			create table audit_log (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table clusters (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table virtual_machines (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the servers:
+		auditLogger, err = NewAuditLogger().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		hubs, err = NewPrivateHubsServer().
+			SetLogger(logger).
+			SetAuditLogger(auditLogger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		entries, err = NewPrivateAuditLogServer().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// createHub creates a hub and returns its identifier.
+	createHub := func() string {
+		response, err := hubs.Create(
+			withMethod(ctx, "/private.v1.Hubs/Create"),
+			privatev1.HubsCreateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Kubeconfig: []byte("my_config"),
+					Namespace:  "my_ns",
+				}.Build(),
+			}.Build(),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		return response.GetObject().GetId()
+	}
+
+	Describe("Building", func() {
+		It("Can't build the logger without a logger", func() {
+			_, err := NewAuditLogger().Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+
+		It("Can't build the logger with a negative retention time", func() {
+			_, err := NewAuditLogger().
+				SetLogger(logger).
+				SetRetentionTime(-time.Second).
+				Build()
+			Expect(err).To(MatchError("retention time should be zero or positive, but it is -1s"))
+		})
+
+		It("Can't build the server without a logger", func() {
+			_, err := NewPrivateAuditLogServer().Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+		})
+	})
+
+	It("Records creation", func() {
+		id := createHub()
+		response, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetItems()).To(HaveLen(1))
+		entry := response.GetItems()[0]
+		Expect(entry.GetId()).ToNot(BeEmpty())
+		Expect(entry.GetMetadata().GetCreationTimestamp()).ToNot(BeNil())
+		Expect(entry.GetUser()).To(Equal("my_user"))
+		Expect(entry.GetGroups()).To(ConsistOf("my_group"))
+		Expect(entry.GetMethod()).To(Equal("/private.v1.Hubs/Create"))
+		Expect(entry.GetAction()).To(Equal(privatev1.AuditLogAction_AUDIT_LOG_ACTION_CREATE))
+		Expect(entry.GetObjectId()).To(Equal(id))
+		Expect(entry.GetObjectType()).To(Equal("private.v1.Hub"))
+		Expect(entry.HasBefore()).To(BeFalse())
+		Expect(entry.HasAfter()).To(BeTrue())
+
+		// Check that the kubeconfig has been removed:
+		after := &privatev1.Hub{}
+		err = entry.GetAfter().UnmarshalTo(after)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after.GetNamespace()).To(Equal("my_ns"))
+		Expect(after.GetKubeconfig()).To(BeEmpty())
+	})
+
+	It("Records update with the mask and the changes", func() {
+		id := createHub()
+		_, err := hubs.Update(
+			withMethod(ctx, "/private.v1.Hubs/Update"),
+			privatev1.HubsUpdateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Id:        id,
+					Namespace: "your_ns",
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"namespace"},
+				},
+			}.Build(),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{
+			Filter: proto.String("this.method == '/private.v1.Hubs/Update'"),
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetItems()).To(HaveLen(1))
+		entry := response.GetItems()[0]
+		Expect(entry.GetPaths()).To(ConsistOf("namespace"))
+		Expect(entry.GetChanges()).To(ConsistOf("namespace"))
+		before := &privatev1.Hub{}
+		err = entry.GetBefore().UnmarshalTo(before)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(before.GetNamespace()).To(Equal("my_ns"))
+		after := &privatev1.Hub{}
+		err = entry.GetAfter().UnmarshalTo(after)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(after.GetNamespace()).To(Equal("your_ns"))
+	})
+
+	It("Records deletion", func() {
+		id := createHub()
+		_, err := hubs.Delete(
+			withMethod(ctx, "/private.v1.Hubs/Delete"),
+			privatev1.HubsDeleteRequest_builder{
+				Id: id,
+			}.Build(),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		response, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{
+			Filter: proto.String("this.method == '/private.v1.Hubs/Delete'"),
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetItems()).To(HaveLen(1))
+		entry := response.GetItems()[0]
+		Expect(entry.GetAction()).To(Equal(privatev1.AuditLogAction_AUDIT_LOG_ACTION_DELETE))
+		Expect(entry.GetObjectId()).To(Equal(id))
+		Expect(entry.HasBefore()).To(BeTrue())
+		Expect(entry.HasAfter()).To(BeFalse())
+	})
+
+	It("Returns the most recent entries first", func() {
+		first := createHub()
+		second := createHub()
+		response, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetItems()).To(HaveLen(2))
+		Expect(response.GetItems()[0].GetObjectId()).To(Equal(second))
+		Expect(response.GetItems()[1].GetObjectId()).To(Equal(first))
+	})
+
+	It("Gets an entry", func() {
+		createHub()
+		listResponse, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		id := listResponse.GetItems()[0].GetId()
+		getResponse, err := entries.Get(ctx, privatev1.AuditLogGetRequest_builder{
+			Id: id,
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getResponse.GetObject().GetId()).To(Equal(id))
+	})
+
+	It("Deletes entries older than the retention time", func() {
+		// Create an old entry:
+		_, err := tx.Exec(
+			ctx,
+			`insert into audit_log (id, creation_timestamp, data) values ('old', now() - interval '2 hours', '{}')`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a logger with a short retention time and use it to record a change:
+		auditLogger, err = NewAuditLogger().
+			SetLogger(logger).
+			SetRetentionTime(time.Hour).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		hubs, err = NewPrivateHubsServer().
+			SetLogger(logger).
+			SetAuditLogger(auditLogger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		createHub()
+
+		// Check that the old entry has been deleted:
+		response, err := entries.List(ctx, privatev1.AuditLogListRequest_builder{}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.GetItems()).To(HaveLen(1))
+		Expect(response.GetItems()[0].GetId()).ToNot(Equal("old"))
+	})
+})
+
+// auditLogTestStream is a server transport stream that only knows the name of the method, used to simulate gRPC calls
+// in tests.
+type auditLogTestStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *auditLogTestStream) Method() string {
+	return s.method
+}
@@ -28,6 +28,7 @@ type PrivateClusterTemplatesServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
 }
 
 var _ privatev1.ClusterTemplatesServer = (*PrivateClusterTemplatesServer)(nil)
@@ -63,6 +64,11 @@ func (b *PrivateClusterTemplatesServerBuilder) SetTenancyLogic(value auth.Tenanc
 	return b
 }
 
+func (b *PrivateClusterTemplatesServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateClusterTemplatesServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
 func (b *PrivateClusterTemplatesServerBuilder) Build() (result *PrivateClusterTemplatesServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -78,6 +84,7 @@ func (b *PrivateClusterTemplatesServerBuilder) Build() (result *PrivateClusterTe
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
 		Build()
 	if err != nil {
 		return
@@ -120,3 +127,21 @@ func (s *PrivateClusterTemplatesServer) Delete(ctx context.Context,
 	err = s.generic.Delete(ctx, request, &response)
 	return
 }
+
+func (s *PrivateClusterTemplatesServer) ListArchived(ctx context.Context,
+	request *privatev1.ClusterTemplatesListArchivedRequest) (response *privatev1.ClusterTemplatesListArchivedResponse, err error) {
+	err = s.generic.ListArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateClusterTemplatesServer) GetArchived(ctx context.Context,
+	request *privatev1.ClusterTemplatesGetArchivedRequest) (response *privatev1.ClusterTemplatesGetArchivedResponse, err error) {
+	err = s.generic.GetArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateClusterTemplatesServer) Undelete(ctx context.Context,
+	request *privatev1.ClusterTemplatesUndeleteRequest) (response *privatev1.ClusterTemplatesUndeleteResponse, err error) {
+	err = s.generic.Undelete(ctx, request, &response)
+	return
+}
@@ -73,6 +73,10 @@ var _ = Describe("Private cluster templates server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -83,6 +87,10 @@ var _ = Describe("Private cluster templates server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
@@ -18,18 +18,23 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/bits-and-blooms/bitset"
 	"github.com/dustin/go-humanize/english"
 	"golang.org/x/exp/maps"
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 	"github.com/jkary/osac/fulfillment/service/internal/utils"
 )
 
@@ -38,6 +43,9 @@ type PrivateClustersServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+	quotaChecker     *QuotaChecker
+	keyring          *encryption.Keyring
 }
 
 var _ privatev1.ClustersServer = (*PrivateClustersServer)(nil)
@@ -46,7 +54,10 @@ type PrivateClustersServer struct {
 	privatev1.UnimplementedClustersServer
 	logger       *slog.Logger
 	templatesDao *dao.GenericDAO[*privatev1.ClusterTemplate]
+	hubsDao      *dao.GenericDAO[*privatev1.Hub]
+	clustersDao  *dao.GenericDAO[*privatev1.Cluster]
 	generic      *GenericServer[*privatev1.Cluster]
+	quotaChecker *QuotaChecker
 }
 
 func NewPrivateClustersServer() *PrivateClustersServerBuilder {
@@ -73,6 +84,22 @@ func (b *PrivateClustersServerBuilder) SetTenancyLogic(value auth.TenancyLogic)
 	return b
 }
 
+func (b *PrivateClustersServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateClustersServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
+func (b *PrivateClustersServerBuilder) SetQuotaChecker(value *QuotaChecker) *PrivateClustersServerBuilder {
+	b.quotaChecker = value
+	return b
+}
+
+// SetKeyring sets the keyring used to decrypt the kubeconfigs of the hubs. This is optional.
+func (b *PrivateClustersServerBuilder) SetKeyring(value *encryption.Keyring) *PrivateClustersServerBuilder {
+	b.keyring = value
+	return b
+}
+
 func (b *PrivateClustersServerBuilder) Build() (result *PrivateClustersServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -91,6 +118,27 @@ func (b *PrivateClustersServerBuilder) Build() (result *PrivateClustersServer, e
 		return
 	}
 
+	// Create the hubs DAO, used to check the hubs that are assigned to clusters. Note that this doesn't have
+	// tenancy logic because hubs don't belong to tenants.
+	hubsDao, err := dao.NewGenericDAO[*privatev1.Hub]().
+		SetLogger(b.logger).
+		SetTable("hubs").
+		SetKeyring(b.keyring).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create the DAO used to count the clusters assigned to a hub. Note that this doesn't have tenancy logic because
+	// it needs to count the clusters of all the tenants.
+	clustersDao, err := dao.NewGenericDAO[*privatev1.Cluster]().
+		SetLogger(b.logger).
+		SetTable("clusters").
+		Build()
+	if err != nil {
+		return
+	}
+
 	// Create the generic server:
 	generic, err := NewGenericServer[*privatev1.Cluster]().
 		SetLogger(b.logger).
@@ -99,6 +147,7 @@ func (b *PrivateClustersServerBuilder) Build() (result *PrivateClustersServer, e
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
 		Build()
 	if err != nil {
 		return
@@ -108,7 +157,10 @@ func (b *PrivateClustersServerBuilder) Build() (result *PrivateClustersServer, e
 	result = &PrivateClustersServer{
 		logger:       b.logger,
 		templatesDao: templatesDao,
+		hubsDao:      hubsDao,
+		clustersDao:  clustersDao,
 		generic:      generic,
+		quotaChecker: b.quotaChecker,
 	}
 	return
 }
@@ -139,6 +191,12 @@ func (s *PrivateClustersServer) Create(ctx context.Context,
 		return
 	}
 
+	// Validate the placement constraints:
+	err = s.validatePlacement(request.GetObject())
+	if err != nil {
+		return
+	}
+
 	err = s.generic.Create(ctx, request, &response)
 	return
 }
@@ -149,16 +207,144 @@ func (s *PrivateClustersServer) Update(ctx context.Context,
 	if err != nil {
 		return
 	}
+	err = s.validatePlacement(request.GetObject())
+	if err != nil {
+		return
+	}
+	err = s.checkUpdateQuota(ctx, request)
+	if err != nil {
+		return
+	}
+	err = s.checkHubAssignment(ctx, request)
+	if err != nil {
+		return
+	}
 	err = s.generic.Update(ctx, request, &response)
 	return
 }
 
+// checkHubAssignment checks that the hub that the update assigns to the cluster exists and has capacity for another
+// cluster, and takes its lock so that it can't be deleted till the transaction finishes. The lock also serializes the
+// assignments to the same hub, even if they come from different instances of the controller.
+func (s *PrivateClustersServer) checkHubAssignment(ctx context.Context, request *privatev1.ClustersUpdateRequest) error {
+	object := request.GetObject()
+	hub := object.GetStatus().GetHub()
+	if hub == "" || !updatesHub(request.GetUpdateMask()) {
+		return nil
+	}
+	key := object.GetId()
+	if key == "" {
+		key = object.GetMetadata().GetName()
+	}
+	if key == "" {
+		return nil
+	}
+	current, err := s.generic.find(ctx, key)
+	if err != nil || current == nil {
+		return err
+	}
+	if current.GetStatus().GetHub() == hub {
+		return nil
+	}
+	err = lockAssignedHub(ctx, s.logger, s.hubsDao, hub)
+	if err != nil {
+		return err
+	}
+	return s.checkHubCapacity(ctx, hub)
+}
+
+// checkHubCapacity checks that the given hub can receive another cluster. It returns an `ABORTED` error if the hub
+// is full, as that usually means that other cluster was assigned to it after the scheduler made its decision, so the
+// scheduler should try again. Errors returned are already gRPC statuses.
+func (s *PrivateClustersServer) checkHubCapacity(ctx context.Context, id string) error {
+	hub, err := s.hubsDao.Get(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to get hub",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check capacity of hub '%s'", id)
+	}
+	limit := hub.GetMaxClusters()
+	if limit <= 0 {
+		return nil
+	}
+	clusters, err := s.clustersDao.List(ctx, dao.ListRequest{
+		Filter: fmt.Sprintf("this.status.hub == %s", strconv.Quote(id)),
+		Limit:  1,
+	})
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to count clusters of hub",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check capacity of hub '%s'", id)
+	}
+	if clusters.Total >= limit {
+		return grpcstatus.Errorf(
+			grpccodes.Aborted,
+			"hub '%s' already has %d clusters, which is its maximum",
+			id, clusters.Total,
+		)
+	}
+	return nil
+}
+
+// checkUpdateQuota checks that the changes to the node sets requested by the update don't exceed the quota of the
+// tenants of the cluster.
+func (s *PrivateClustersServer) checkUpdateQuota(ctx context.Context, request *privatev1.ClustersUpdateRequest) error {
+	if s.quotaChecker == nil {
+		return nil
+	}
+	mask := request.GetUpdateMask()
+	if mask != nil && !slices.ContainsFunc(mask.GetPaths(), func(path string) bool {
+		return path == "spec" || path == "spec.node_sets" || strings.HasPrefix(path, "spec.node_sets.")
+	}) {
+		return nil
+	}
+	object := request.GetObject()
+	key := object.GetId()
+	if key == "" {
+		key = object.GetMetadata().GetName()
+	}
+	if key == "" {
+		return nil
+	}
+	current, err := s.generic.find(ctx, key)
+	if err != nil || current == nil {
+		return err
+	}
+	return s.quotaChecker.CheckClusterUpdate(ctx, current, object.GetSpec().GetNodeSets())
+}
+
 func (s *PrivateClustersServer) Delete(ctx context.Context,
 	request *privatev1.ClustersDeleteRequest) (response *privatev1.ClustersDeleteResponse, err error) {
 	err = s.generic.Delete(ctx, request, &response)
 	return
 }
 
+func (s *PrivateClustersServer) ListArchived(ctx context.Context,
+	request *privatev1.ClustersListArchivedRequest) (response *privatev1.ClustersListArchivedResponse, err error) {
+	err = s.generic.ListArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateClustersServer) GetArchived(ctx context.Context,
+	request *privatev1.ClustersGetArchivedRequest) (response *privatev1.ClustersGetArchivedResponse, err error) {
+	err = s.generic.GetArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateClustersServer) Undelete(ctx context.Context,
+	request *privatev1.ClustersUndeleteRequest) (response *privatev1.ClustersUndeleteResponse, err error) {
+	err = s.generic.Undelete(ctx, request, &response)
+	return
+}
+
 func (s *PrivateClustersServer) validateNoDuplicateConditions(object *privatev1.Cluster) error {
 	conditions := object.GetStatus().GetConditions()
 	if conditions == nil {
@@ -179,6 +365,45 @@ func (s *PrivateClustersServer) validateNoDuplicateConditions(object *privatev1.
 	return nil
 }
 
+// validatePlacement checks that the zone and the hub selector, which are used by the scheduler to select the hub, have
+// the syntax of Kubernetes labels, as they are compared with the zone and the labels of the hubs.
+func (s *PrivateClustersServer) validatePlacement(object *privatev1.Cluster) error {
+	zone := object.GetSpec().GetZone()
+	if zone != "" {
+		errs := validation.IsValidLabelValue(zone)
+		if len(errs) > 0 {
+			return grpcstatus.Errorf(
+				grpccodes.InvalidArgument,
+				"zone '%s' isn't valid: %s",
+				zone, english.WordSeries(errs, "and"),
+			)
+		}
+	}
+	selector := object.GetSpec().GetHubSelector()
+	keys := maps.Keys(selector)
+	sort.Strings(keys)
+	for _, key := range keys {
+		errs := validation.IsQualifiedName(key)
+		if len(errs) > 0 {
+			return grpcstatus.Errorf(
+				grpccodes.InvalidArgument,
+				"hub selector key '%s' isn't valid: %s",
+				key, english.WordSeries(errs, "and"),
+			)
+		}
+		value := selector[key]
+		errs = validation.IsValidLabelValue(value)
+		if len(errs) > 0 {
+			return grpcstatus.Errorf(
+				grpccodes.InvalidArgument,
+				"hub selector value '%s' for key '%s' isn't valid: %s",
+				value, key, english.WordSeries(errs, "and"),
+			)
+		}
+	}
+	return nil
+}
+
 func (s *PrivateClustersServer) validateAndTransformCluster(ctx context.Context, cluster *privatev1.Cluster) error {
 	// Check that the template is specified and that refers to a existing template:
 	if cluster == nil {
@@ -286,5 +511,13 @@ func (s *PrivateClustersServer) validateAndTransformCluster(ctx context.Context,
 	)
 	cluster.GetSpec().SetTemplateParameters(actualClusterParameters)
 
+	// Check that the cluster doesn't exceed the quota of the tenant:
+	if s.quotaChecker != nil {
+		err = s.quotaChecker.CheckClusterCreate(ctx, cluster)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
@@ -75,9 +76,15 @@ var _ = Describe("Private clusters server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
+			create unique index clusters_by_name on clusters (name, tenants) where name != '';
+
 			create table archived_clusters (
 				id text not null,
 				creation_timestamp timestamp with time zone not null,
@@ -85,6 +92,10 @@ var _ = Describe("Private clusters server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 
@@ -95,9 +106,32 @@ var _ = Describe("Private clusters server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table hubs (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
+			insert into hubs (id, data) values
+				('my_hub', '{}'),
+				('your_hub', '{}'),
+				('full_hub', '{"max_clusters": 1}');
+
 			create table archived_cluster_templates (
 				id text not null,
 				creation_timestamp timestamp with time zone not null,
@@ -105,6 +139,10 @@ var _ = Describe("Private clusters server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
@@ -368,6 +406,146 @@ var _ = Describe("Private clusters server", func() {
 			Expect(getResponse.GetObject().GetStatus().GetHub()).To(Equal("your_hub"))
 		})
 
+		It("Rejects update that assigns a hub that doesn't exist", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+
+			// Try to assign the hub:
+			_, err = server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Id: object.GetId(),
+					Status: privatev1.ClusterStatus_builder{
+						Hub: "junk",
+					}.Build(),
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"status.hub"},
+				},
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+			Expect(status.Message()).To(Equal("hub 'junk' doesn't exist"))
+		})
+
+		It("Rejects update that assigns a hub that is full", func() {
+			// Create a cluster that already uses the hub, and another that doesn't:
+			_, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+					Status: privatev1.ClusterStatus_builder{
+						Hub: "full_hub",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+
+			// Try to assign the hub:
+			_, err = server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Id: object.GetId(),
+					Status: privatev1.ClusterStatus_builder{
+						Hub: "full_hub",
+					}.Build(),
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"status.hub"},
+				},
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.Aborted))
+			Expect(status.Message()).To(Equal("hub 'full_hub' already has 1 clusters, which is its maximum"))
+		})
+
+		It("Rejects update with stale version", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+			Expect(object.GetMetadata().GetVersion()).To(BeNumerically("==", 1))
+
+			// Update the object, so that the version changes:
+			updated := proto.Clone(object).(*privatev1.Cluster)
+			updated.GetSpec().SetTemplate("your_template")
+			updateResponse, err := server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: updated,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateResponse.GetObject().GetMetadata().GetVersion()).To(BeNumerically("==", 2))
+
+			// Try to update again using the original version:
+			stale := proto.Clone(object).(*privatev1.Cluster)
+			stale.GetSpec().SetTemplate("their_template")
+			_, err = server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: stale,
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.Aborted))
+		})
+
+		It("Checks version when updating with a field mask", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+
+			// Update the object with a mask that doesn't include the metadata, but with a wrong version:
+			_, err = server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Id: object.GetId(),
+					Metadata: privatev1.Metadata_builder{
+						Version: 42,
+					}.Build(),
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "your_template",
+					}.Build(),
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"spec.template"},
+				},
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.Aborted))
+		})
+
 		It("Delete object", func() {
 			// Create the object:
 			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
@@ -462,5 +640,281 @@ var _ = Describe("Private clusters server", func() {
 			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
 			Expect(status.Message()).To(Equal("condition 'CLUSTER_CONDITION_TYPE_READY' is duplicated"))
 		})
+
+		It("Accepts valid placement constraints", func() {
+			response, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+						Zone:     "us-east-1",
+						HubSelector: map[string]string{
+							"example.com/gpu": "true",
+						},
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := response.GetObject()
+			Expect(object.GetSpec().GetZone()).To(Equal("us-east-1"))
+			Expect(object.GetSpec().GetHubSelector()).To(HaveKeyWithValue("example.com/gpu", "true"))
+		})
+
+		It("Rejects creation with invalid zone", func() {
+			_, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+						Zone:     "us east",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(HavePrefix("zone 'us east' isn't valid"))
+		})
+
+		It("Rejects creation with invalid hub selector key", func() {
+			_, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+						HubSelector: map[string]string{
+							"-gpu": "true",
+						},
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(HavePrefix("hub selector key '-gpu' isn't valid"))
+		})
+
+		It("Rejects update with invalid hub selector value", func() {
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+			object.GetSpec().SetHubSelector(map[string]string{
+				"gpu": "yes please",
+			})
+			_, err = server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: object,
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(HavePrefix("hub selector value 'yes please' for key 'gpu' isn't valid"))
+		})
+
+		It("Gets, updates and deletes object by name", func() {
+			// Create the object:
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Metadata: privatev1.Metadata_builder{
+						Name: "my-cluster",
+					}.Build(),
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Get it by name:
+			getResponse, err := server.Get(ctx, privatev1.ClustersGetRequest_builder{
+				Id: "my-cluster",
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetId()).To(Equal(id))
+
+			// Update it using the name from the metadata:
+			updateResponse, err := server.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Metadata: privatev1.Metadata_builder{
+						Name: "my-cluster",
+					}.Build(),
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template_1",
+					}.Build(),
+				}.Build(),
+				UpdateMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"spec.template"},
+				},
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateResponse.GetObject().GetId()).To(Equal(id))
+			Expect(updateResponse.GetObject().GetSpec().GetTemplate()).To(Equal("my_template_1"))
+
+			// Delete it by name:
+			_, err = server.Delete(ctx, privatev1.ClustersDeleteRequest_builder{
+				Id: "my-cluster",
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = server.Get(ctx, privatev1.ClustersGetRequest_builder{
+				Id: id,
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Rejects creation with invalid name", func() {
+			_, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Metadata: privatev1.Metadata_builder{
+						Name: "My_Cluster",
+					}.Build(),
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(HavePrefix("name 'My_Cluster' isn't valid"))
+		})
+
+		It("Rejects creation with duplicated name", func() {
+			var err error
+			for range 2 {
+				_, err = server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+					Object: privatev1.Cluster_builder{
+						Metadata: privatev1.Metadata_builder{
+							Name: "my-cluster",
+						}.Build(),
+						Spec: privatev1.ClusterSpec_builder{
+							Template: "my_template",
+						}.Build(),
+					}.Build(),
+				}.Build())
+			}
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.AlreadyExists))
+		})
+
+		It("Lists and gets archived objects", func() {
+			// Create and delete the object. It has no finalizers, so it will be archived immediately.
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+			_, err = server.Delete(ctx, privatev1.ClustersDeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+
+			// Verify that it is in the archive:
+			listResponse, err := server.ListArchived(ctx, privatev1.ClustersListArchivedRequest_builder{
+				Filter: proto.String(fmt.Sprintf("this.id == '%s'", id)),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listResponse.GetItems()).To(HaveLen(1))
+			Expect(listResponse.GetItems()[0].GetSpec().GetTemplate()).To(Equal("my_template"))
+			getResponse, err := server.GetArchived(ctx, privatev1.ClustersGetArchivedRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetMetadata().GetDeletionTimestamp()).ToNot(BeNil())
+		})
+
+		It("Returns not found for archived object that doesn't exist", func() {
+			_, err := server.GetArchived(ctx, privatev1.ClustersGetArchivedRequest_builder{
+				Id: "does-not-exist",
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Undeletes object with pending finalizers", func() {
+			// Create and delete the object:
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Metadata: privatev1.Metadata_builder{
+						Finalizers: []string{"a"},
+					}.Build(),
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+			_, err = server.Delete(ctx, privatev1.ClustersDeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+
+			// Undelete it and verify that the deletion timestamp has been removed:
+			undeleteResponse, err := server.Undelete(ctx, privatev1.ClustersUndeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(undeleteResponse.GetObject().GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+			getResponse, err := server.Get(ctx, privatev1.ClustersGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+		})
+
+		It("Rejects undelete of object that hasn't been deleted", func() {
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = server.Undelete(ctx, privatev1.ClustersUndeleteRequest_builder{
+				Id: createResponse.GetObject().GetId(),
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+		})
+
+		It("Rejects undelete of object that has been archived", func() {
+			createResponse, err := server.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+			_, err = server.Delete(ctx, privatev1.ClustersDeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = server.Undelete(ctx, privatev1.ClustersUndeleteRequest_builder{
+				Id: id,
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
 	})
 })
@@ -19,10 +19,14 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
 	grpccodes "google.golang.org/grpc/codes"
@@ -33,12 +37,16 @@ import (
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/metrics"
 )
 
 type PrivateEventsServerBuilder struct {
-	logger *slog.Logger
-	flags  *pflag.FlagSet
-	dbUrl  string
+	logger     *slog.Logger
+	flags      *pflag.FlagSet
+	dbUrl      string
+	dbPool     *pgxpool.Pool
+	bufferSize int
+	registerer prometheus.Registerer
 }
 
 var _ privatev1.EventsServer = (*PrivateEventsServer)(nil)
@@ -46,11 +54,18 @@ var _ privatev1.EventsServer = (*PrivateEventsServer)(nil)
 type PrivateEventsServer struct {
 	privatev1.UnimplementedEventsServer
 
-	logger   *slog.Logger
-	listener *database.Listener
-	subs     map[string]eventsServerSubInfo
-	subsLock *sync.RWMutex
-	celEnv   *cel.Env
+	logger           *slog.Logger
+	listener         *database.Listener
+	replayer         *database.Replayer
+	subs             map[string]eventsServerSubInfo
+	subsLock         *sync.RWMutex
+	celEnv           *cel.Env
+	bookmarkInterval time.Duration
+	statsInterval    time.Duration
+	bufferSize       int
+
+	// latest is the sequence number of the last event received from the database.
+	latest atomic.Int64
 }
 
 type eventsServerSubInfo struct {
@@ -58,10 +73,140 @@ type eventsServerSubInfo struct {
 	filterSrc  string
 	filterPrg  cel.Program
 	eventsChan chan *privatev1.Event
+
+	// overflowChan is closed when the buffer of the subscription is full, to tell the goroutine that sends the events
+	// that it should disconnect the client.
+	overflowChan chan struct{}
+	overflowOnce *sync.Once
+
+	// seen is the sequence number of the last event processed for this subscription, including the events that
+	// were rejected by the filter.
+	seen *atomic.Int64
+
+	// sent is the sequence number of the last event sent to the client, or replayed for the client.
+	sent *atomic.Int64
+}
+
+// eventsServerReplayResult is used to report the result of replaying events from the goroutine that does it.
+type eventsServerReplayResult struct {
+	last     int64
+	replayed map[int64]bool
+	err      error
 }
 
+// storeMaxSequence stores the given sequence number if it is greater than the current one. This is needed because
+// sequence numbers are assigned when events are generated, but events are received in the order that their
+// transactions are committed.
+func storeMaxSequence(value *atomic.Int64, seq int64) {
+	for {
+		current := value.Load()
+		if seq <= current || value.CompareAndSwap(current, seq) {
+			return
+		}
+	}
+}
+
+// EventsServerSubStats contains statistics about a subscription to the events server.
+type EventsServerSubStats struct {
+	// Id is the identifier of the subscription.
+	Id string
+
+	// Filter is the filter expression of the subscription.
+	Filter string
+
+	// Buffered is the number of events that are waiting to be sent to the subscriber.
+	Buffered int
+
+	// Capacity is the maximum number of events that can be waiting to be sent to the subscriber before it is
+	// disconnected.
+	Capacity int
+
+	// Lag is the difference between the sequence number of the last event received from the database and the
+	// sequence number of the last event that the subscriber has received or that has been discarded by its filter.
+	Lag int64
+}
+
+// makeEventsServerSubStats calculates the statistics of a subscription.
+func makeEventsServerSubStats[E any](id, filter string, eventsChan chan E, seen, sent *atomic.Int64,
+	latest int64) EventsServerSubStats {
+	// Load the last seen sequence number before checking the buffer, as it is stored after adding the event to the
+	// buffer. If the buffer is empty then all the seen events have been either sent or discarded.
+	position := seen.Load()
+	buffered := len(eventsChan)
+	if buffered > 0 {
+		position = sent.Load()
+	}
+	return EventsServerSubStats{
+		Id:       id,
+		Filter:   filter,
+		Buffered: buffered,
+		Capacity: cap(eventsChan),
+		Lag:      max(latest-position, 0),
+	}
+}
+
+// eventsServerCollector is a Prometheus collector that reports the number of buffered events and the lag of each
+// subscription of an events server. The values are calculated when the metrics are collected, so subscriptions that
+// have finished don't leave metrics behind.
+type eventsServerCollector struct {
+	stats        func() []EventsServerSubStats
+	bufferedDesc *prometheus.Desc
+	lagDesc      *prometheus.Desc
+}
+
+// newEventsServerCollector creates a collector for the subscriptions of an events server. The server label should be
+// `public` or `private`, like in the rest of the events metrics.
+func newEventsServerCollector(server string, stats func() []EventsServerSubStats) *eventsServerCollector {
+	labels := prometheus.Labels{
+		"server": server,
+	}
+	return &eventsServerCollector{
+		stats: stats,
+		bufferedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "events", "subscription_buffered_events"),
+			"Number of events waiting to be sent to the subscriber, by server and subscription.",
+			[]string{"subscription"},
+			labels,
+		),
+		lagDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "events", "subscription_lag"),
+			"Difference between the sequence number of the last event received from the database and the last "+
+				"event sent to the subscriber, by server and subscription.",
+			[]string{"subscription"},
+			labels,
+		),
+	}
+}
+
+// Describe is part of the implementation of the prometheus.Collector interface.
+func (c *eventsServerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.bufferedDesc
+	ch <- c.lagDesc
+}
+
+// Collect is part of the implementation of the prometheus.Collector interface.
+func (c *eventsServerCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range c.stats() {
+		ch <- prometheus.MustNewConstMetric(c.bufferedDesc, prometheus.GaugeValue, float64(stats.Buffered), stats.Id)
+		ch <- prometheus.MustNewConstMetric(c.lagDesc, prometheus.GaugeValue, float64(stats.Lag), stats.Id)
+	}
+}
+
+const (
+	// eventsServerBookmarkInterval is the default interval between bookmark responses.
+	eventsServerBookmarkInterval = 30 * time.Second
+
+	// eventsServerStatsInterval is the interval between writes of subscription statistics to the log.
+	eventsServerStatsInterval = 1 * time.Minute
+
+	// eventsServerBufferSize is the default number of events that can be waiting to be sent to a subscriber.
+	eventsServerBufferSize = 100
+)
+
 func NewPrivateEventsServer() *PrivateEventsServerBuilder {
-	return &PrivateEventsServerBuilder{}
+	return &PrivateEventsServerBuilder{
+		bufferSize: eventsServerBufferSize,
+	}
 }
 
 func (b *PrivateEventsServerBuilder) SetLogger(value *slog.Logger) *PrivateEventsServerBuilder {
@@ -79,6 +224,26 @@ func (b *PrivateEventsServerBuilder) SetDbUrl(value string) *PrivateEventsServer
 	return b
 }
 
+func (b *PrivateEventsServerBuilder) SetDbPool(value *pgxpool.Pool) *PrivateEventsServerBuilder {
+	b.dbPool = value
+	return b
+}
+
+// SetBufferSize sets the number of events that can be waiting to be sent to each subscriber. Subscribers that don't
+// receive events fast enough to keep the buffer from filling up will be disconnected. The same limit applies to the
+// events that are kept waiting while past events are replayed. This is optional, and the default is 100.
+func (b *PrivateEventsServerBuilder) SetBufferSize(value int) *PrivateEventsServerBuilder {
+	b.bufferSize = value
+	return b
+}
+
+// SetRegisterer sets the Prometheus registry where the server will register the metrics that describe the state of
+// its subscriptions. This is optional, and by default those metrics aren't registered.
+func (b *PrivateEventsServerBuilder) SetRegisterer(value prometheus.Registerer) *PrivateEventsServerBuilder {
+	b.registerer = value
+	return b
+}
+
 func (b *PrivateEventsServerBuilder) Build() (result *PrivateEventsServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -89,6 +254,14 @@ func (b *PrivateEventsServerBuilder) Build() (result *PrivateEventsServer, err e
 		err = errors.New("database connection URL is mandatory")
 		return
 	}
+	if b.dbPool == nil {
+		err = errors.New("database connection pool is mandatory")
+		return
+	}
+	if b.bufferSize <= 0 {
+		err = fmt.Errorf("buffer size should be positive, but it is %d", b.bufferSize)
+		return
+	}
 
 	// Create  the CEL environment:
 	celEnv, err := b.createCelEnv()
@@ -97,12 +270,26 @@ func (b *PrivateEventsServerBuilder) Build() (result *PrivateEventsServer, err e
 		return
 	}
 
+	// Create the replayer:
+	replayer, err := database.NewReplayer().
+		SetLogger(b.logger).
+		SetPool(b.dbPool).
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create replayer: %w", err)
+		return
+	}
+
 	// Create the object early so that whe can use its methods as callback functions:
 	s := &PrivateEventsServer{
-		logger:   b.logger,
-		subs:     map[string]eventsServerSubInfo{},
-		subsLock: &sync.RWMutex{},
-		celEnv:   celEnv,
+		logger:           b.logger,
+		replayer:         replayer,
+		subs:             map[string]eventsServerSubInfo{},
+		subsLock:         &sync.RWMutex{},
+		celEnv:           celEnv,
+		bookmarkInterval: eventsServerBookmarkInterval,
+		statsInterval:    eventsServerStatsInterval,
+		bufferSize:       b.bufferSize,
 	}
 
 	// Create the notification listener:
@@ -117,6 +304,15 @@ func (b *PrivateEventsServerBuilder) Build() (result *PrivateEventsServer, err e
 		return
 	}
 
+	// Register the metrics:
+	if b.registerer != nil {
+		err = b.registerer.Register(newEventsServerCollector("private", s.Stats))
+		if err != nil {
+			err = fmt.Errorf("failed to register metrics: %w", err)
+			return
+		}
+	}
+
 	result = s
 	return
 }
@@ -163,6 +359,7 @@ func (b *PrivateEventsServerBuilder) createCelEnv() (result *cel.Env, err error)
 // Starts starts the background components of the server, in particular the notification listener. This is a blocking
 // operation, and will return only when the context is canceled.
 func (s *PrivateEventsServer) Start(ctx context.Context) error {
+	go s.statsLoop(ctx)
 	return s.listener.Listen(ctx)
 }
 
@@ -202,42 +399,225 @@ func (s *PrivateEventsServer) Watch(request *privatev1.EventsWatchRequest,
 		slog.String("subscription", subId),
 	)
 	subInfo := eventsServerSubInfo{
-		stream:     stream,
-		filterSrc:  filterSrc,
-		filterPrg:  filterPrg,
-		eventsChan: make(chan *privatev1.Event),
+		stream:       stream,
+		filterSrc:    filterSrc,
+		filterPrg:    filterPrg,
+		eventsChan:   make(chan *privatev1.Event, s.bufferSize),
+		overflowChan: make(chan struct{}),
+		overflowOnce: &sync.Once{},
+		seen:         &atomic.Int64{},
+		sent:         &atomic.Int64{},
 	}
 	s.subsLock.Lock()
 	s.subs[subId] = subInfo
 	s.subsLock.Unlock()
+	metrics.EventsWatchers.WithLabelValues("private").Inc()
 	logger.DebugContext(ctx, "Created subcription")
 	defer func() {
 		s.subsLock.Lock()
-		defer s.subsLock.Unlock()
 		delete(s.subs, subId)
-		close(subInfo.eventsChan)
+		s.subsLock.Unlock()
+		metrics.EventsWatchers.WithLabelValues("private").Dec()
 		logger.DebugContext(ctx, "Canceled subcription")
 	}()
 
+	// Send the events that happened since the position requested by the client. Note that this is done in a
+	// separate goroutine after creating the subscription, so that events that happen while replaying aren't lost.
+	// Those events are kept pending till the replay finishes, and then the ones that weren't replayed are sent.
+	var (
+		replayChan chan eventsServerReplayResult
+		pending    []*privatev1.Event
+	)
+	if request.HasSince() {
+		replayChan = make(chan eventsServerReplayResult, 1)
+		go func() {
+			last, replayed, err := s.replay(ctx, request.GetSince(), filterPrg, stream)
+			replayChan <- eventsServerReplayResult{
+				last:     last,
+				replayed: replayed,
+				err:      err,
+			}
+		}()
+	}
+	defer func() {
+		if replayChan != nil {
+			<-replayChan
+		}
+	}()
+
+	// If the client requested bookmarks then we need to send them periodically:
+	bookmarks := request.GetBookmarks()
+	var bookmarksChan <-chan time.Time
+	if bookmarks {
+		bookmarksTicker := time.NewTicker(s.bookmarkInterval)
+		defer bookmarksTicker.Stop()
+		bookmarksChan = bookmarksTicker.C
+	}
+
+	// The last variable is the greatest sequence number of the events that have been processed for this client. The
+	// bookmarked variable is the last position sent to the client, either with an event or with a bookmark, and is
+	// used to avoid sending bookmarks that don't add anything. The replayed set contains the sequence numbers of the
+	// events that were replayed, and is used to avoid sending them twice. Note that sequence numbers aren't assigned
+	// in commit order, so live events with sequence numbers lower than the last one are still sent.
+	var (
+		last, bookmarked int64
+		replayed         map[int64]bool
+	)
+	sendEvent := func(event *privatev1.Event) error {
+		if replayed[event.GetSequence()] {
+			delete(replayed, event.GetSequence())
+			return nil
+		}
+		err := stream.Send(privatev1.EventsWatchResponse_builder{
+			Event: event,
+		}.Build())
+		if err != nil {
+			return err
+		}
+		last = max(last, event.GetSequence())
+		bookmarked = last
+		subInfo.sent.Store(last)
+		return nil
+	}
+	sendBookmark := func() error {
+		// Note that the events that have been processed but are still in the buffer must be sent before we can
+		// send a bookmark that includes them. To avoid races the sequence number must be loaded before checking
+		// the buffer, as it is stored after adding the event to the buffer.
+		seen := subInfo.seen.Load()
+		if len(subInfo.eventsChan) > 0 {
+			return nil
+		}
+		last = max(last, seen)
+		if last <= bookmarked {
+			return nil
+		}
+		err := stream.Send(privatev1.EventsWatchResponse_builder{
+			Bookmark: proto.Int64(last),
+		}.Build())
+		if err != nil {
+			return err
+		}
+		bookmarked = last
+		return nil
+	}
+
 	// Wait to receive events on the channel of the subscription and forward them to the client:
 	for {
 		select {
-		case event, ok := <-subInfo.eventsChan:
-			if !ok {
-				logger.DebugContext(ctx, "Subscription channel closed")
-				return nil
+		case result := <-replayChan:
+			replayChan = nil
+			if result.err != nil {
+				return result.err
+			}
+			last = max(last, result.last)
+			replayed = result.replayed
+			subInfo.sent.Store(last)
+			for _, event := range pending {
+				err = sendEvent(event)
+				if err != nil {
+					return err
+				}
+			}
+			pending = nil
+			if bookmarks {
+				err = sendBookmark()
+				if err != nil {
+					return err
+				}
+			}
+		case event := <-subInfo.eventsChan:
+			if replayChan != nil {
+				// The events kept while replaying count against the same limit as the buffer of the
+				// subscription, otherwise a slow replay could make them grow without bound:
+				if len(pending) >= cap(subInfo.eventsChan) {
+					logger.WarnContext(
+						ctx,
+						"Pending events buffer is full",
+						slog.Int("size", len(pending)),
+					)
+					subInfo.overflowOnce.Do(func() {
+						close(subInfo.overflowChan)
+					})
+					continue
+				}
+				pending = append(pending, event)
+				continue
+			}
+			err = sendEvent(event)
+			if err != nil {
+				return err
+			}
+		case <-bookmarksChan:
+			if replayChan != nil {
+				continue
 			}
-			err = stream.Send(&privatev1.EventsWatchResponse{
-				Event: event,
-			})
+			err = sendBookmark()
 			if err != nil {
 				return err
 			}
-		case <-stream.Context().Done():
-			s.logger.DebugContext(ctx, "Subscription context canceled")
+		case <-subInfo.overflowChan:
+			metrics.EventsDisconnects.WithLabelValues("private").Inc()
+			logger.WarnContext(
+				ctx,
+				"Disconnecting subscriber because it isn't receiving events fast enough",
+				slog.Int64("last", last),
+			)
+			return grpcstatus.Errorf(
+				grpccodes.ResourceExhausted,
+				"events are being generated faster than they are received, watch again with the 'since' "+
+					"parameter set to %d to continue from the last event received",
+				last,
+			)
+		case <-ctx.Done():
+			logger.DebugContext(ctx, "Subscription context canceled")
+			return nil
+		}
+	}
+}
+
+// replay sends to the stream the retained events that happened after the given sequence number and that are accepted
+// by the filter. It returns the greatest sequence number processed and the set of sequence numbers that were replayed.
+func (s *PrivateEventsServer) replay(ctx context.Context, since int64, filterPrg cel.Program,
+	stream grpc.ServerStreamingServer[privatev1.EventsWatchResponse]) (last int64, replayed map[int64]bool,
+	err error) {
+	replayed = map[int64]bool{}
+	last, err = s.replayer.Replay(ctx, since, func(ctx context.Context, seq int64, payload proto.Message) error {
+		replayed[seq] = true
+		event, ok := payload.(*privatev1.Event)
+		if !ok {
 			return nil
 		}
+		event.SetSequence(seq)
+		if filterPrg != nil {
+			accepted, err := s.evalFilter(ctx, filterPrg, event)
+			if err != nil || !accepted {
+				return nil
+			}
+		}
+		return stream.Send(&privatev1.EventsWatchResponse{
+			Event: event,
+		})
+	})
+	var prunedErr *database.PrunedError
+	if errors.As(err, &prunedErr) {
+		err = grpcstatus.Errorf(
+			grpccodes.OutOfRange,
+			"events after %d are no longer available, retrieve the current state of the objects and watch "+
+				"again without the 'since' parameter",
+			since,
+		)
+		return
 	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to replay events",
+			slog.Int64("since", since),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to replay events")
+	}
+	return
 }
 
 func (s *PrivateEventsServer) compileFilter(ctx context.Context, filterSrc string) (result cel.Program, err error) {
@@ -270,7 +650,7 @@ func (s *PrivateEventsServer) evalFilter(ctx context.Context, filterPrg cel.Prog
 	return
 }
 
-func (s *PrivateEventsServer) processPayload(ctx context.Context, payload proto.Message) error {
+func (s *PrivateEventsServer) processPayload(ctx context.Context, seq int64, payload proto.Message) error {
 	event, ok := payload.(*privatev1.Event)
 	if !ok {
 		s.logger.ErrorContext(
@@ -281,6 +661,8 @@ func (s *PrivateEventsServer) processPayload(ctx context.Context, payload proto.
 		)
 		return nil
 	}
+	event.SetSequence(seq)
+	storeMaxSequence(&s.latest, seq)
 	return s.processEvent(ctx, event)
 }
 
@@ -288,6 +670,13 @@ func (s *PrivateEventsServer) processEvent(ctx context.Context, event *privatev1
 	s.subsLock.RLock()
 	defer s.subsLock.RUnlock()
 	for subId, sub := range s.subs {
+		// Skip subscriptions that will be disconnected because their buffer is full:
+		select {
+		case <-sub.overflowChan:
+			continue
+		default:
+		}
+
 		logger := s.logger.With(
 			slog.String("filter", sub.filterSrc),
 			slog.String("sub", subId),
@@ -308,14 +697,63 @@ func (s *PrivateEventsServer) processEvent(ctx context.Context, event *privatev1
 		}
 		if accepted {
 			logger.DebugContext(ctx, "Event accepted by filter")
-			sub.eventsChan <- event
+			select {
+			case sub.eventsChan <- event:
+			default:
+				logger.WarnContext(
+					ctx,
+					"Subscription buffer is full",
+					slog.Int("size", cap(sub.eventsChan)),
+				)
+				sub.overflowOnce.Do(func() {
+					close(sub.overflowChan)
+				})
+				continue
+			}
 		} else {
 			logger.DebugContext(ctx, "Event rejected by filter")
 		}
+		storeMaxSequence(sub.seen, event.GetSequence())
 	}
 	return nil
 }
 
+// Stats returns the statistics of the current subscriptions.
+func (s *PrivateEventsServer) Stats() []EventsServerSubStats {
+	latest := s.latest.Load()
+	s.subsLock.RLock()
+	defer s.subsLock.RUnlock()
+	result := make([]EventsServerSubStats, 0, len(s.subs))
+	for subId, sub := range s.subs {
+		result = append(result, makeEventsServerSubStats(subId, sub.filterSrc, sub.eventsChan, sub.seen, sub.sent,
+			latest))
+	}
+	return result
+}
+
+func (s *PrivateEventsServer) statsLoop(ctx context.Context) {
+	ticker := time.NewTicker(s.statsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, stats := range s.Stats() {
+				s.logger.DebugContext(
+					ctx,
+					"Subscription stats",
+					slog.String("subscription", stats.Id),
+					slog.String("filter", stats.Filter),
+					slog.Int("buffered", stats.Buffered),
+					slog.Int("capacity", stats.Capacity),
+					slog.Int64("lag", stats.Lag),
+				)
+			}
+		}
+	}
+}
+
 // Names of the packages whose enums will be available in the filter expressions:
 var privateEventsServerPackages = map[protoreflect.FullName]bool{
 	"private.v1": true,
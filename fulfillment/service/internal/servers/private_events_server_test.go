@@ -0,0 +1,336 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+var _ = Describe("Private events server", func() {
+	var (
+		ctx      context.Context
+		dbUrl    string
+		pool     *pgxpool.Pool
+		tm       database.TxManager
+		notifier *database.Notifier
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context that will be canceled when the test finishes, so that the listener stops:
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		dbUrl = db.MakeURL()
+		pool, err = pgxpool.New(ctx, dbUrl)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the notifications tables:
+		_, err = pool.Exec(
+			ctx,
+			`
+			create table notifications (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone default now(),
+				payload bytea,
+				seq bigserial not null,
+				xid xid8 not null default pg_current_xact_id(),
+				horizon xid8 not null default pg_snapshot_xmin(pg_current_snapshot())
+			);
+
+			create table notifications_watermark (
+				pruned bigint not null
+			);
+
+			insert into notifications_watermark (pruned) values (0);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create the transaction manager and the notifier:
+		tm, err = database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		notifier, err = database.NewNotifier().
+			SetLogger(logger).
+			SetChannel("events").
+			SetRetentionTime(0).
+			SetRetentionCount(3).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// notify sends an event for the given cluster and returns its sequence number.
+	notify := func(clusterId string) int64 {
+		tx, err := tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		err = notifier.Notify(database.TxIntoContext(ctx, tx), privatev1.Event_builder{
+			Id:   uuid.NewString(),
+			Type: privatev1.EventType_EVENT_TYPE_OBJECT_CREATED,
+			Cluster: privatev1.Cluster_builder{
+				Id: clusterId,
+			}.Build(),
+		}.Build())
+		Expect(err).ToNot(HaveOccurred())
+		err = tm.End(ctx, tx)
+		Expect(err).ToNot(HaveOccurred())
+		row := pool.QueryRow(ctx, "select max(seq) from notifications")
+		var seq int64
+		err = row.Scan(&seq)
+		Expect(err).ToNot(HaveOccurred())
+		return seq
+	}
+
+	Describe("Creation", func() {
+		It("Can be built if all the required parameters are set", func() {
+			eventsServer, err := NewPrivateEventsServer().
+				SetLogger(logger).
+				SetDbUrl(dbUrl).
+				SetDbPool(pool).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eventsServer).ToNot(BeNil())
+		})
+
+		It("Fails if the database connection pool is not set", func() {
+			eventsServer, err := NewPrivateEventsServer().
+				SetLogger(logger).
+				SetDbUrl(dbUrl).
+				Build()
+			Expect(err).To(MatchError("database connection pool is mandatory"))
+			Expect(eventsServer).To(BeNil())
+		})
+
+		It("Fails if the buffer size isn't positive", func() {
+			eventsServer, err := NewPrivateEventsServer().
+				SetLogger(logger).
+				SetDbUrl(dbUrl).
+				SetDbPool(pool).
+				SetBufferSize(0).
+				Build()
+			Expect(err).To(MatchError("buffer size should be positive, but it is 0"))
+			Expect(eventsServer).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		var (
+			eventsServer *PrivateEventsServer
+			client       privatev1.EventsClient
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the server and start the listener:
+			eventsServer, err = NewPrivateEventsServer().
+				SetLogger(logger).
+				SetDbUrl(dbUrl).
+				SetDbPool(pool).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			eventsServer.bookmarkInterval = 10 * time.Millisecond
+			go eventsServer.Start(ctx)
+
+			// Start the gRPC server:
+			listener := bufconn.Listen(1024 * 1024)
+			grpcServer := grpc.NewServer()
+			DeferCleanup(grpcServer.Stop)
+			privatev1.RegisterEventsServer(grpcServer, eventsServer)
+			go grpcServer.Serve(listener)
+
+			// Create the client:
+			conn, err := grpc.NewClient(
+				"passthrough:///bufconn",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(conn.Close)
+			client = privatev1.NewEventsClient(conn)
+		})
+
+		It("Replays the events that happened after the given position", func() {
+			first := notify("123")
+			second := notify("456")
+			third := notify("789")
+			stream, err := client.Watch(ctx, privatev1.EventsWatchRequest_builder{
+				Since: proto.Int64(first),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			response, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.GetEvent().GetSequence()).To(Equal(second))
+			Expect(response.GetEvent().GetCluster().GetId()).To(Equal("456"))
+			response, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.GetEvent().GetSequence()).To(Equal(third))
+			Expect(response.GetEvent().GetCluster().GetId()).To(Equal("789"))
+		})
+
+		It("Returns 'out of range' if the requested events have been deleted", func() {
+			first := notify("123")
+			for range 4 {
+				notify("456")
+			}
+			stream, err := client.Watch(ctx, privatev1.EventsWatchRequest_builder{
+				Since: proto.Int64(first),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = stream.Recv()
+			Expect(grpcstatus.Code(err)).To(Equal(grpccodes.OutOfRange))
+		})
+
+		It("Sends a bookmark for replayed events rejected by the filter", func() {
+			notify("123")
+			last := notify("456")
+			stream, err := client.Watch(ctx, privatev1.EventsWatchRequest_builder{
+				Filter:    proto.String("event.cluster.id == '789'"),
+				Since:     proto.Int64(0),
+				Bookmarks: proto.Bool(true),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			response, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.HasEvent()).To(BeFalse())
+			Expect(response.GetBookmark()).To(Equal(last))
+		})
+
+		It("Doesn't send bookmarks if not requested", func() {
+			notify("123")
+			notify("456")
+			stream, err := client.Watch(ctx, privatev1.EventsWatchRequest_builder{
+				Since: proto.Int64(0),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			for range 2 {
+				response, err := stream.Recv()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.HasEvent()).To(BeTrue())
+				Expect(response.HasBookmark()).To(BeFalse())
+			}
+		})
+
+		Describe("Slow subscribers", func() {
+			// addSub adds directly to the server a subscription with the given buffer size.
+			addSub := func(id string, size int) eventsServerSubInfo {
+				sub := eventsServerSubInfo{
+					eventsChan:   make(chan *privatev1.Event, size),
+					overflowChan: make(chan struct{}),
+					overflowOnce: &sync.Once{},
+					seen:         &atomic.Int64{},
+					sent:         &atomic.Int64{},
+				}
+				eventsServer.subsLock.Lock()
+				eventsServer.subs[id] = sub
+				eventsServer.subsLock.Unlock()
+				return sub
+			}
+
+			// process makes the server process an event as if it had been received from the database.
+			process := func(seq int64) {
+				err := eventsServer.processPayload(ctx, seq, privatev1.Event_builder{
+					Id:   uuid.NewString(),
+					Type: privatev1.EventType_EVENT_TYPE_OBJECT_CREATED,
+					Cluster: privatev1.Cluster_builder{
+						Id: "123",
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			It("Doesn't block other subscribers and disconnects the slow one", func() {
+				slow := addSub("slow", 1)
+				fast := addSub("fast", 10)
+				for seq := range int64(3) {
+					process(seq + 1)
+				}
+				Expect(slow.overflowChan).To(BeClosed())
+				Expect(fast.overflowChan).ToNot(BeClosed())
+				Expect(fast.eventsChan).To(HaveLen(3))
+			})
+
+			It("Reports the lag of each subscription", func() {
+				sub := addSub("my_sub", 10)
+				for seq := range int64(3) {
+					process(seq + 1)
+				}
+				sub.sent.Store(1)
+				Expect(eventsServer.Stats()).To(ConsistOf(EventsServerSubStats{
+					Id:       "my_sub",
+					Buffered: 3,
+					Capacity: 10,
+					Lag:      2,
+				}))
+			})
+
+			It("Exports the buffered events and lag of each subscription", func() {
+				registry := prometheus.NewRegistry()
+				registry.MustRegister(newEventsServerCollector("private", eventsServer.Stats))
+				sub := addSub("my_sub", 10)
+				for seq := range int64(3) {
+					process(seq + 1)
+				}
+				sub.sent.Store(1)
+				err := testutil.GatherAndCompare(
+					registry,
+					strings.NewReader(
+						"# HELP fulfillment_events_subscription_buffered_events Number of events waiting to be "+
+							"sent to the subscriber, by server and subscription.\n"+
+							"# TYPE fulfillment_events_subscription_buffered_events gauge\n"+
+							"fulfillment_events_subscription_buffered_events{server=\"private\","+
+							"subscription=\"my_sub\"} 3\n"+
+							"# HELP fulfillment_events_subscription_lag Difference between the sequence number of "+
+							"the last event received from the database and the last event sent to the "+
+							"subscriber, by server and subscription.\n"+
+							"# TYPE fulfillment_events_subscription_lag gauge\n"+
+							"fulfillment_events_subscription_lag{server=\"private\",subscription=\"my_sub\"} 2\n",
+					),
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
@@ -28,6 +28,7 @@ type PrivateHostClassesServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
 }
 
 var _ privatev1.HostClassesServer = (*PrivateHostClassesServer)(nil)
@@ -62,6 +63,11 @@ func (b *PrivateHostClassesServerBuilder) SetTenancyLogic(value auth.TenancyLogi
 	return b
 }
 
+func (b *PrivateHostClassesServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateHostClassesServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
 func (b *PrivateHostClassesServerBuilder) Build() (result *PrivateHostClassesServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -77,6 +83,7 @@ func (b *PrivateHostClassesServerBuilder) Build() (result *PrivateHostClassesSer
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
 		Build()
 	if err != nil {
 		return
@@ -16,11 +16,20 @@ package servers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
 	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
 )
 
 type PrivateHubsServerBuilder struct {
@@ -28,6 +37,8 @@ type PrivateHubsServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+	keyring          *encryption.Keyring
 }
 
 var _ privatev1.HubsServer = (*PrivateHubsServer)(nil)
@@ -35,8 +46,10 @@ var _ privatev1.HubsServer = (*PrivateHubsServer)(nil)
 type PrivateHubsServer struct {
 	privatev1.UnimplementedHubsServer
 
-	logger  *slog.Logger
-	generic *GenericServer[*privatev1.Hub]
+	logger      *slog.Logger
+	generic     *GenericServer[*privatev1.Hub]
+	clustersDao *dao.GenericDAO[*privatev1.Cluster]
+	vmsDao      *dao.GenericDAO[*privatev1.VirtualMachine]
 }
 
 func NewPrivateHubsServer() *PrivateHubsServerBuilder {
@@ -63,6 +76,18 @@ func (b *PrivateHubsServerBuilder) SetTenancyLogic(value auth.TenancyLogic) *Pri
 	return b
 }
 
+func (b *PrivateHubsServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateHubsServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
+// SetKeyring sets the keyring that will be used to encrypt the kubeconfigs of the hubs in the database. This is
+// optional.
+func (b *PrivateHubsServerBuilder) SetKeyring(value *encryption.Keyring) *PrivateHubsServerBuilder {
+	b.keyring = value
+	return b
+}
+
 func (b *PrivateHubsServerBuilder) Build() (result *PrivateHubsServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -78,6 +103,25 @@ func (b *PrivateHubsServerBuilder) Build() (result *PrivateHubsServer, err error
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
+		SetKeyring(b.keyring).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create the DAOs used to check if hubs are in use. Note that these don't have tenancy logic because they
+	// need to find the objects of all the tenants.
+	clustersDao, err := dao.NewGenericDAO[*privatev1.Cluster]().
+		SetLogger(b.logger).
+		SetTable("clusters").
+		Build()
+	if err != nil {
+		return
+	}
+	vmsDao, err := dao.NewGenericDAO[*privatev1.VirtualMachine]().
+		SetLogger(b.logger).
+		SetTable("virtual_machines").
 		Build()
 	if err != nil {
 		return
@@ -85,8 +129,10 @@ func (b *PrivateHubsServerBuilder) Build() (result *PrivateHubsServer, err error
 
 	// Create and populate the object:
 	result = &PrivateHubsServer{
-		logger:  b.logger,
-		generic: generic,
+		logger:      b.logger,
+		generic:     generic,
+		clustersDao: clustersDao,
+		vmsDao:      vmsDao,
 	}
 	return
 }
@@ -105,18 +151,162 @@ func (s *PrivateHubsServer) Get(ctx context.Context,
 
 func (s *PrivateHubsServer) Create(ctx context.Context,
 	request *privatev1.HubsCreateRequest) (response *privatev1.HubsCreateResponse, err error) {
+	err = s.checkNotEncrypted(request.GetObject())
+	if err != nil {
+		return
+	}
 	err = s.generic.Create(ctx, request, &response)
 	return
 }
 
 func (s *PrivateHubsServer) Update(ctx context.Context,
 	request *privatev1.HubsUpdateRequest) (response *privatev1.HubsUpdateResponse, err error) {
+	err = s.checkNotEncrypted(request.GetObject())
+	if err != nil {
+		return
+	}
 	err = s.generic.Update(ctx, request, &response)
 	return
 }
 
 func (s *PrivateHubsServer) Delete(ctx context.Context,
 	request *privatev1.HubsDeleteRequest) (response *privatev1.HubsDeleteResponse, err error) {
+	// Refuse to delete hubs that are still used by clusters or virtual machines, because those would be left without
+	// a way to manage their resources. Note that the request may contain the name instead of the identifier, and that
+	// the lock is needed to prevent objects from being assigned to the hub between the check and the deletion.
+	id := request.GetId()
+	if id != "" {
+		var hub *privatev1.Hub
+		hub, err = s.generic.find(ctx, id)
+		if err != nil {
+			return
+		}
+		if hub != nil {
+			id = hub.GetId()
+			err = lockHub(ctx, s.logger, id)
+			if err != nil {
+				return
+			}
+			err = s.checkNotUsed(ctx, id)
+			if err != nil {
+				return
+			}
+		}
+	}
 	err = s.generic.Delete(ctx, request, &response)
 	return
 }
+
+// checkNotUsed returns an error if there are clusters or virtual machines that have been assigned to the given hub,
+// including those that are being deleted. Errors returned are already gRPC statuses.
+// checkNotEncrypted checks that the sensitive fields of the hub don't contain values that have the format of encrypted
+// values. The DAO doesn't encrypt those values again, so accepting them would allow clients to store values encrypted
+// for other hubs.
+func (s *PrivateHubsServer) checkNotEncrypted(hub *privatev1.Hub) error {
+	if hub == nil {
+		return nil
+	}
+	field := encryption.FindEncrypted(hub)
+	if field != nil {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"value of field '%s' has the format of an encrypted value, which isn't allowed",
+			field.Name(),
+		)
+	}
+	return nil
+}
+
+func (s *PrivateHubsServer) checkNotUsed(ctx context.Context, id string) error {
+	filter := fmt.Sprintf("this.status.hub == %s", strconv.Quote(id))
+	clusters, err := s.clustersDao.List(ctx, dao.ListRequest{
+		Filter: filter,
+		Limit:  1,
+	})
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to count clusters of hub",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check if hub '%s' is in use", id)
+	}
+	vms, err := s.vmsDao.List(ctx, dao.ListRequest{
+		Filter: filter,
+		Limit:  1,
+	})
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to count virtual machines of hub",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check if hub '%s' is in use", id)
+	}
+	if clusters.Total > 0 || vms.Total > 0 {
+		return grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"hub '%s' can't be deleted because it is used by %d clusters and %d virtual machines",
+			id, clusters.Total, vms.Total,
+		)
+	}
+	return nil
+}
+
+// lockHub takes the advisory lock of the given hub. The lock is released when the transaction finishes. It is taken
+// when a hub is deleted and when a cluster or virtual machine is assigned to a hub, so that a hub can't be deleted
+// while objects are being assigned to it. Errors returned are already gRPC statuses.
+func lockHub(ctx context.Context, logger *slog.Logger, id string) error {
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext($1))`, hubLockPrefix+id)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"Failed to lock hub",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to lock hub '%s'", id)
+	}
+	return nil
+}
+
+// lockAssignedHub takes the lock of the hub that is being assigned to a cluster or virtual machine, and then checks
+// that it still exists. Errors returned are already gRPC statuses.
+func lockAssignedHub(ctx context.Context, logger *slog.Logger, hubsDao *dao.GenericDAO[*privatev1.Hub],
+	id string) error {
+	err := lockHub(ctx, logger, id)
+	if err != nil {
+		return err
+	}
+	exists, err := hubsDao.Exists(ctx, id)
+	if err != nil {
+		logger.ErrorContext(
+			ctx,
+			"Failed to check if hub exists",
+			slog.String("hub", id),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check if hub '%s' exists", id)
+	}
+	if !exists {
+		return grpcstatus.Errorf(grpccodes.FailedPrecondition, "hub '%s' doesn't exist", id)
+	}
+	return nil
+}
+
+// updatesHub checks if an update with the given mask changes the hub of the object.
+func updatesHub(mask *fieldmaskpb.FieldMask) bool {
+	return mask == nil || slices.ContainsFunc(mask.GetPaths(), func(path string) bool {
+		return path == "status" || path == "status.hub"
+	})
+}
+
+// hubLockPrefix is the prefix added to the identifier of the hub to calculate the advisory lock used to serialize
+// the deletion of the hub with the assignment of objects to it.
+const hubLockPrefix = "hub:"
@@ -20,6 +20,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
@@ -61,7 +63,7 @@ var _ = Describe("Private hubs server", func() {
 		})
 		ctx = database.TxIntoContext(ctx, tx)
 
-		// Create the templates table:
+		// Create the tables:
 		_, err = tx.Exec(
 			ctx,
 			`
@@ -72,6 +74,10 @@ var _ = Describe("Private hubs server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -82,6 +88,38 @@ var _ = Describe("Private hubs server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+
+			create table clusters (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table virtual_machines (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 			`,
@@ -133,6 +171,44 @@ var _ = Describe("Private hubs server", func() {
 			Expect(object.GetId()).ToNot(BeEmpty())
 		})
 
+		It("Rejects kubeconfig that is already encrypted", func() {
+			// Try to create the object:
+			createResponse, err := server.Create(ctx, privatev1.HubsCreateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Kubeconfig: []byte("enc:v1:key-1:junk:junk"),
+					Namespace:  "my_ns",
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(createResponse).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(Equal(
+				"value of field 'kubeconfig' has the format of an encrypted value, which isn't allowed",
+			))
+
+			// Create the object, and then try to update it:
+			createResponse, err = server.Create(ctx, privatev1.HubsCreateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Kubeconfig: []byte("my_config"),
+					Namespace:  "my_ns",
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			updateResponse, err := server.Update(ctx, privatev1.HubsUpdateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Id:         createResponse.GetObject().GetId(),
+					Kubeconfig: []byte("enc:v1:key-1:junk:junk"),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(updateResponse).To(BeNil())
+			status, ok = grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+
 		It("List objects", func() {
 			// Create a few objects:
 			const count = 10
@@ -299,5 +375,77 @@ var _ = Describe("Private hubs server", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(getResponse.GetObject().GetMetadata().GetDeletionTimestamp()).ToNot(BeNil())
 		})
+
+		It("Refuses to delete hub used by clusters or virtual machines", func() {
+			// Create the hub:
+			createResponse, err := server.Create(ctx, privatev1.HubsCreateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Kubeconfig: []byte("my_config"),
+					Namespace:  "my_ns",
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Create a cluster and a virtual machine that use the hub:
+			data := fmt.Sprintf(`{"status": {"hub": "%s"}}`, id)
+			_, err = tx.Exec(ctx, `insert into clusters (id, data) values ('my_cluster', $1)`, data)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = tx.Exec(ctx, `insert into virtual_machines (id, data) values ('my_vm', $1)`, data)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Try to delete the hub:
+			_, err = server.Delete(ctx, privatev1.HubsDeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+			Expect(status.Message()).To(Equal(fmt.Sprintf(
+				"hub '%s' can't be deleted because it is used by 1 clusters and 1 virtual machines",
+				id,
+			)))
+
+			// Verify that it hasn't been deleted:
+			getResponse, err := server.Get(ctx, privatev1.HubsGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+		})
+
+		It("Refuses to delete hub used by clusters when the request contains the name", func() {
+			// Create the hub:
+			createResponse, err := server.Create(ctx, privatev1.HubsCreateRequest_builder{
+				Object: privatev1.Hub_builder{
+					Metadata: privatev1.Metadata_builder{
+						Name: "my_hub",
+					}.Build(),
+					Kubeconfig: []byte("my_config"),
+					Namespace:  "my_ns",
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Create a cluster that uses the hub:
+			data := fmt.Sprintf(`{"status": {"hub": "%s"}}`, id)
+			_, err = tx.Exec(ctx, `insert into clusters (id, data) values ('my_cluster', $1)`, data)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Try to delete the hub using the name:
+			_, err = server.Delete(ctx, privatev1.HubsDeleteRequest_builder{
+				Id: "my_hub",
+			}.Build())
+			Expect(grpcstatus.Code(err)).To(Equal(grpccodes.FailedPrecondition))
+
+			// Verify that it hasn't been deleted:
+			getResponse, err := server.Get(ctx, privatev1.HubsGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetMetadata().HasDeletionTimestamp()).To(BeFalse())
+		})
 	})
 })
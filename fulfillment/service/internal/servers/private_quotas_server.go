@@ -0,0 +1,243 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+)
+
+type PrivateQuotasServerBuilder struct {
+	logger           *slog.Logger
+	notifier         *database.Notifier
+	attributionLogic auth.AttributionLogic
+	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+	quotaChecker     *QuotaChecker
+}
+
+var _ privatev1.QuotasServer = (*PrivateQuotasServer)(nil)
+
+type PrivateQuotasServer struct {
+	privatev1.UnimplementedQuotasServer
+	logger       *slog.Logger
+	generic      *GenericServer[*privatev1.Quota]
+	quotaChecker *QuotaChecker
+}
+
+func NewPrivateQuotasServer() *PrivateQuotasServerBuilder {
+	return &PrivateQuotasServerBuilder{}
+}
+
+func (b *PrivateQuotasServerBuilder) SetLogger(value *slog.Logger) *PrivateQuotasServerBuilder {
+	b.logger = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) SetNotifier(value *database.Notifier) *PrivateQuotasServerBuilder {
+	b.notifier = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) SetAttributionLogic(value auth.AttributionLogic) *PrivateQuotasServerBuilder {
+	b.attributionLogic = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) SetTenancyLogic(value auth.TenancyLogic) *PrivateQuotasServerBuilder {
+	b.tenancyLogic = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateQuotasServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) SetQuotaChecker(value *QuotaChecker) *PrivateQuotasServerBuilder {
+	b.quotaChecker = value
+	return b
+}
+
+func (b *PrivateQuotasServerBuilder) Build() (result *PrivateQuotasServer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.quotaChecker == nil {
+		err = errors.New("quota checker is mandatory")
+		return
+	}
+
+	// Create the generic server:
+	generic, err := NewGenericServer[*privatev1.Quota]().
+		SetLogger(b.logger).
+		SetService(privatev1.Quotas_ServiceDesc.ServiceName).
+		SetTable("quotas").
+		SetNotifier(b.notifier).
+		SetAttributionLogic(b.attributionLogic).
+		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create and populate the object:
+	result = &PrivateQuotasServer{
+		logger:       b.logger,
+		generic:      generic,
+		quotaChecker: b.quotaChecker,
+	}
+	return
+}
+
+func (s *PrivateQuotasServer) List(ctx context.Context,
+	request *privatev1.QuotasListRequest) (response *privatev1.QuotasListResponse, err error) {
+	err = s.generic.List(ctx, request, &response)
+	return
+}
+
+func (s *PrivateQuotasServer) Get(ctx context.Context,
+	request *privatev1.QuotasGetRequest) (response *privatev1.QuotasGetResponse, err error) {
+	err = s.generic.Get(ctx, request, &response)
+	return
+}
+
+func (s *PrivateQuotasServer) Create(ctx context.Context,
+	request *privatev1.QuotasCreateRequest) (response *privatev1.QuotasCreateResponse, err error) {
+	object := request.GetObject()
+	err = s.validateSpec(object, true)
+	if err != nil {
+		return
+	}
+	err = s.validateUniqueTenant(ctx, object, "")
+	if err != nil {
+		return
+	}
+	err = s.generic.Create(ctx, request, &response)
+	return
+}
+
+func (s *PrivateQuotasServer) Update(ctx context.Context,
+	request *privatev1.QuotasUpdateRequest) (response *privatev1.QuotasUpdateResponse, err error) {
+	// The tenant is only mandatory when the complete object is replaced, as otherwise it may not be included in the
+	// request:
+	object := request.GetObject()
+	err = s.validateSpec(object, request.GetUpdateMask() == nil)
+	if err != nil {
+		return
+	}
+	if object.GetSpec().GetTenant() != "" {
+		key := object.GetId()
+		if key == "" {
+			key = object.GetMetadata().GetName()
+		}
+		err = s.validateUniqueTenant(ctx, object, key)
+		if err != nil {
+			return
+		}
+	}
+	err = s.generic.Update(ctx, request, &response)
+	return
+}
+
+func (s *PrivateQuotasServer) Delete(ctx context.Context,
+	request *privatev1.QuotasDeleteRequest) (response *privatev1.QuotasDeleteResponse, err error) {
+	err = s.generic.Delete(ctx, request, &response)
+	return
+}
+
+func (s *PrivateQuotasServer) GetUsage(ctx context.Context,
+	request *privatev1.QuotasGetUsageRequest) (response *privatev1.QuotasGetUsageResponse, err error) {
+	tenant := request.GetTenant()
+	if tenant == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "tenant is mandatory")
+		return
+	}
+	usage, quota, err := s.quotaChecker.Usage(ctx, tenant)
+	if err != nil {
+		return
+	}
+	response = privatev1.QuotasGetUsageResponse_builder{
+		Usage: usage,
+		Quota: quota,
+	}.Build()
+	return
+}
+
+// validateSpec checks that the limits of the quota aren't negative and, optionally, that it has a tenant.
+func (s *PrivateQuotasServer) validateSpec(object *privatev1.Quota, requireTenant bool) error {
+	if object == nil {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
+	}
+	spec := object.GetSpec()
+	if requireTenant && spec.GetTenant() == "" {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "tenant is mandatory")
+	}
+	if spec.GetClusters() < 0 {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"clusters limit should be zero or positive, but it is %d",
+			spec.GetClusters(),
+		)
+	}
+	if spec.GetVirtualMachines() < 0 {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"virtual machines limit should be zero or positive, but it is %d",
+			spec.GetVirtualMachines(),
+		)
+	}
+	for hostClass, nodes := range spec.GetHostClassNodes() {
+		if nodes < 0 {
+			return grpcstatus.Errorf(
+				grpccodes.InvalidArgument,
+				"nodes limit for host class '%s' should be zero or positive, but it is %d",
+				hostClass, nodes,
+			)
+		}
+	}
+	return nil
+}
+
+// validateUniqueTenant checks that there is no other quota for the tenant of the given quota. The key is the
+// identifier or name of the quota being updated, and it is empty when creating a new quota.
+func (s *PrivateQuotasServer) validateUniqueTenant(ctx context.Context, object *privatev1.Quota, key string) error {
+	tenant := object.GetSpec().GetTenant()
+	existing, err := s.quotaChecker.findQuota(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return nil
+	}
+	if key != "" && (existing.GetId() == key || existing.GetMetadata().GetName() == key) {
+		return nil
+	}
+	return grpcstatus.Errorf(
+		grpccodes.AlreadyExists,
+		"tenant '%s' already has quota '%s'",
+		tenant, existing.GetId(),
+	)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+)
+
+var _ = Describe("Private quotas server", func() {
+	var (
+		ctx          context.Context
+		tx           database.Tx
+		tenancyLogic auth.TenancyLogic
+		quotaChecker *QuotaChecker
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err := pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the transaction manager:
+		tm, err := database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start a transaction and add it to the context:
+		tx, err = tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		ctx = database.TxIntoContext(ctx, tx)
+
+		// Create the tables:
+		for _, table := range []string{
+			"quotas",
+			"clusters",
+			"cluster_templates",
+			"virtual_machines",
+			"virtual_machine_templates",
+		} {
+			_, err = tx.Exec(
+				ctx,
+				fmt.Sprintf(
+					`
+					create table %[1]s (
+						id text not null primary key,
+						creation_timestamp timestamp with time zone not null default now(),
+						deletion_timestamp timestamp with time zone not null default 'epoch',
+						finalizers text[] not null default '{}',
+						creators text[] not null default '{}',
+						tenants text[] not null default '{}',
+						name text not null default '',
+						labels jsonb not null default '{}',
+						annotations jsonb not null default '{}',
+						version bigint not null default 1,
+						data jsonb not null
+					);
+
+					create table archived_%[1]s (
+						id text not null,
+						creation_timestamp timestamp with time zone not null,
+						deletion_timestamp timestamp with time zone not null,
+						archival_timestamp timestamp with time zone not null default now(),
+						creators text[] not null default '{}',
+						tenants text[] not null default '{}',
+						name text not null default '',
+						labels jsonb not null default '{}',
+						annotations jsonb not null default '{}',
+						version bigint not null default 0,
+						data jsonb not null
+					);
+					`,
+					table,
+				),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// Create a tenancy logic that assigns all objects to the same tenant:
+		ctrl := gomock.NewController(GinkgoT())
+		DeferCleanup(ctrl.Finish)
+		mockTenancyLogic := auth.NewMockTenancyLogic(ctrl)
+		mockTenancyLogic.EXPECT().DetermineAssignedTenants(gomock.Any()).
+			Return([]string{"my_tenant"}, nil).
+			AnyTimes()
+		mockTenancyLogic.EXPECT().DetermineVisibleTenants(gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+		tenancyLogic = mockTenancyLogic
+
+		// Create the quota checker:
+		quotaChecker, err = NewQuotaChecker().
+			SetLogger(logger).
+			SetTenancyLogic(tenancyLogic).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Creation", func() {
+		It("Can be built if all the required parameters are set", func() {
+			server, err := NewPrivateQuotasServer().
+				SetLogger(logger).
+				SetQuotaChecker(quotaChecker).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server).ToNot(BeNil())
+		})
+
+		It("Fails if logger is not set", func() {
+			server, err := NewPrivateQuotasServer().
+				SetQuotaChecker(quotaChecker).
+				Build()
+			Expect(err).To(MatchError("logger is mandatory"))
+			Expect(server).To(BeNil())
+		})
+
+		It("Fails if quota checker is not set", func() {
+			server, err := NewPrivateQuotasServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(MatchError("quota checker is mandatory"))
+			Expect(server).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		var (
+			quotasServer   *PrivateQuotasServer
+			clustersServer *PrivateClustersServer
+			vmsServer      *PrivateVirtualMachinesServer
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the servers:
+			quotasServer, err = NewPrivateQuotasServer().
+				SetLogger(logger).
+				SetTenancyLogic(tenancyLogic).
+				SetQuotaChecker(quotaChecker).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			clustersServer, err = NewPrivateClustersServer().
+				SetLogger(logger).
+				SetTenancyLogic(tenancyLogic).
+				SetQuotaChecker(quotaChecker).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			vmsServer, err = NewPrivateVirtualMachinesServer().
+				SetLogger(logger).
+				SetTenancyLogic(tenancyLogic).
+				SetQuotaChecker(quotaChecker).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// Create the templates:
+			clusterTemplatesDao, err := dao.NewGenericDAO[*privatev1.ClusterTemplate]().
+				SetLogger(logger).
+				SetTable("cluster_templates").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = clusterTemplatesDao.Create(ctx, privatev1.ClusterTemplate_builder{
+				Id: "my_template",
+				NodeSets: map[string]*privatev1.ClusterTemplateNodeSet{
+					"compute": privatev1.ClusterTemplateNodeSet_builder{
+						HostClass: "acme_1tib",
+						Size:      3,
+					}.Build(),
+				},
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			vmTemplatesDao, err := dao.NewGenericDAO[*privatev1.VirtualMachineTemplate]().
+				SetLogger(logger).
+				SetTable("virtual_machine_templates").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = vmTemplatesDao.Create(ctx, privatev1.VirtualMachineTemplate_builder{
+				Id: "my_template",
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// createQuota creates a quota for the tenant with the given spec.
+		createQuota := func(spec *privatev1.QuotaSpec) *privatev1.Quota {
+			response, err := quotasServer.Create(ctx, privatev1.QuotasCreateRequest_builder{
+				Object: privatev1.Quota_builder{
+					Spec: spec,
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			return response.GetObject()
+		}
+
+		// createCluster tries to create a cluster with the given size for the compute node set.
+		createCluster := func(size int32) (*privatev1.Cluster, error) {
+			response, err := clustersServer.Create(ctx, privatev1.ClustersCreateRequest_builder{
+				Object: privatev1.Cluster_builder{
+					Spec: privatev1.ClusterSpec_builder{
+						Template: "my_template",
+						NodeSets: map[string]*privatev1.ClusterNodeSet{
+							"compute": privatev1.ClusterNodeSet_builder{
+								Size: size,
+							}.Build(),
+						},
+					}.Build(),
+				}.Build(),
+			}.Build())
+			return response.GetObject(), err
+		}
+
+		// createVm tries to create a virtual machine.
+		createVm := func() error {
+			_, err := vmsServer.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template: "my_template",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			return err
+		}
+
+		// expectExhausted checks that the error is a resource exhausted error.
+		expectExhausted := func(err error, message string) {
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.ResourceExhausted))
+			Expect(status.Message()).To(Equal(message))
+		}
+
+		It("Rejects quota without tenant", func() {
+			_, err := quotasServer.Create(ctx, privatev1.QuotasCreateRequest_builder{
+				Object: privatev1.Quota_builder{
+					Spec: privatev1.QuotaSpec_builder{
+						Clusters: proto.Int32(1),
+					}.Build(),
+				}.Build(),
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(Equal("tenant is mandatory"))
+		})
+
+		It("Rejects quota with negative limit", func() {
+			_, err := quotasServer.Create(ctx, privatev1.QuotasCreateRequest_builder{
+				Object: privatev1.Quota_builder{
+					Spec: privatev1.QuotaSpec_builder{
+						Tenant:   "my_tenant",
+						Clusters: proto.Int32(-1),
+					}.Build(),
+				}.Build(),
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+
+		It("Rejects second quota for the same tenant", func() {
+			createQuota(privatev1.QuotaSpec_builder{
+				Tenant: "my_tenant",
+			}.Build())
+			_, err := quotasServer.Create(ctx, privatev1.QuotasCreateRequest_builder{
+				Object: privatev1.Quota_builder{
+					Spec: privatev1.QuotaSpec_builder{
+						Tenant: "my_tenant",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.AlreadyExists))
+		})
+
+		It("Doesn't limit tenants without quota", func() {
+			for range 3 {
+				_, err := createCluster(3)
+				Expect(err).ToNot(HaveOccurred())
+				err = createVm()
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("Enforces the number of clusters", func() {
+			createQuota(privatev1.QuotaSpec_builder{
+				Tenant:   "my_tenant",
+				Clusters: proto.Int32(2),
+			}.Build())
+			for range 2 {
+				_, err := createCluster(1)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err := createCluster(1)
+			expectExhausted(err, "quota of tenant 'my_tenant' allows 2 clusters and 2 are already in use")
+		})
+
+		It("Enforces the number of nodes per host class when creating", func() {
+			createQuota(privatev1.QuotaSpec_builder{
+				Tenant: "my_tenant",
+				HostClassNodes: map[string]int32{
+					"acme_1tib": 5,
+				},
+			}.Build())
+			_, err := createCluster(3)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = createCluster(3)
+			expectExhausted(
+				err,
+				"quota of tenant 'my_tenant' allows 5 nodes of host class 'acme_1tib', 3 are already in use "+
+					"and 3 were requested",
+			)
+			_, err = createCluster(2)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Enforces the number of nodes per host class when updating", func() {
+			createQuota(privatev1.QuotaSpec_builder{
+				Tenant: "my_tenant",
+				HostClassNodes: map[string]int32{
+					"acme_1tib": 5,
+				},
+			}.Build())
+			cluster, err := createCluster(3)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Growing within the limit should work:
+			cluster.GetSpec().GetNodeSets()["compute"].SetSize(5)
+			_, err = clustersServer.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: cluster,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+
+			// Growing beyond the limit should fail:
+			cluster.GetSpec().GetNodeSets()["compute"].SetSize(6)
+			cluster.GetMetadata().SetVersion(0)
+			_, err = clustersServer.Update(ctx, privatev1.ClustersUpdateRequest_builder{
+				Object: cluster,
+			}.Build())
+			expectExhausted(
+				err,
+				"quota of tenant 'my_tenant' allows 5 nodes of host class 'acme_1tib', 0 are already in use "+
+					"and 6 were requested",
+			)
+		})
+
+		It("Enforces the number of virtual machines", func() {
+			createQuota(privatev1.QuotaSpec_builder{
+				Tenant:          "my_tenant",
+				VirtualMachines: proto.Int32(1),
+			}.Build())
+			err := createVm()
+			Expect(err).ToNot(HaveOccurred())
+			err = createVm()
+			expectExhausted(err, "quota of tenant 'my_tenant' allows 1 virtual machines and 1 are already in use")
+		})
+
+		It("Returns usage", func() {
+			quota := createQuota(privatev1.QuotaSpec_builder{
+				Tenant:   "my_tenant",
+				Clusters: proto.Int32(10),
+			}.Build())
+			_, err := createCluster(2)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = createCluster(3)
+			Expect(err).ToNot(HaveOccurred())
+			err = createVm()
+			Expect(err).ToNot(HaveOccurred())
+			response, err := quotasServer.GetUsage(ctx, privatev1.QuotasGetUsageRequest_builder{
+				Tenant: "my_tenant",
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			usage := response.GetUsage()
+			Expect(usage.GetClusters()).To(BeNumerically("==", 2))
+			Expect(usage.GetHostClassNodes()).To(Equal(map[string]int32{
+				"acme_1tib": 5,
+			}))
+			Expect(usage.GetVirtualMachines()).To(BeNumerically("==", 1))
+			Expect(response.GetQuota().GetId()).To(Equal(quota.GetId()))
+		})
+
+		It("Returns empty usage for tenant without objects", func() {
+			response, err := quotasServer.GetUsage(ctx, privatev1.QuotasGetUsageRequest_builder{
+				Tenant: "other_tenant",
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.GetUsage().GetClusters()).To(BeZero())
+			Expect(response.HasQuota()).To(BeFalse())
+		})
+	})
+})
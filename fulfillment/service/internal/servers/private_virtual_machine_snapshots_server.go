@@ -0,0 +1,364 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/finalizers"
+)
+
+type PrivateVirtualMachineSnapshotsServerBuilder struct {
+	logger           *slog.Logger
+	notifier         *database.Notifier
+	attributionLogic auth.AttributionLogic
+	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+}
+
+var _ privatev1.VirtualMachineSnapshotsServer = (*PrivateVirtualMachineSnapshotsServer)(nil)
+
+type PrivateVirtualMachineSnapshotsServer struct {
+	privatev1.UnimplementedVirtualMachineSnapshotsServer
+
+	logger  *slog.Logger
+	generic *GenericServer[*privatev1.VirtualMachineSnapshot]
+	vmsDao  *dao.GenericDAO[*privatev1.VirtualMachine]
+}
+
+func NewPrivateVirtualMachineSnapshotsServer() *PrivateVirtualMachineSnapshotsServerBuilder {
+	return &PrivateVirtualMachineSnapshotsServerBuilder{}
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) SetLogger(value *slog.Logger) *PrivateVirtualMachineSnapshotsServerBuilder {
+	b.logger = value
+	return b
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) SetNotifier(value *database.Notifier) *PrivateVirtualMachineSnapshotsServerBuilder {
+	b.notifier = value
+	return b
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) SetAttributionLogic(value auth.AttributionLogic) *PrivateVirtualMachineSnapshotsServerBuilder {
+	b.attributionLogic = value
+	return b
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) SetTenancyLogic(value auth.TenancyLogic) *PrivateVirtualMachineSnapshotsServerBuilder {
+	b.tenancyLogic = value
+	return b
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateVirtualMachineSnapshotsServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
+func (b *PrivateVirtualMachineSnapshotsServerBuilder) Build() (result *PrivateVirtualMachineSnapshotsServer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+
+	// Create the virtual machines DAO:
+	vmsDao, err := dao.NewGenericDAO[*privatev1.VirtualMachine]().
+		SetLogger(b.logger).
+		SetTable("virtual_machines").
+		SetAttributionLogic(b.attributionLogic).
+		SetTenancyLogic(b.tenancyLogic).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create the generic server:
+	generic, err := NewGenericServer[*privatev1.VirtualMachineSnapshot]().
+		SetLogger(b.logger).
+		SetService(privatev1.VirtualMachineSnapshots_ServiceDesc.ServiceName).
+		SetTable("virtual_machine_snapshots").
+		SetNotifier(b.notifier).
+		SetAttributionLogic(b.attributionLogic).
+		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create and populate the object:
+	result = &PrivateVirtualMachineSnapshotsServer{
+		logger:  b.logger,
+		generic: generic,
+		vmsDao:  vmsDao,
+	}
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) List(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsListRequest) (response *privatev1.VirtualMachineSnapshotsListResponse, err error) {
+	err = s.generic.List(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Get(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsGetRequest) (response *privatev1.VirtualMachineSnapshotsGetResponse, err error) {
+	err = s.generic.Get(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Create(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsCreateRequest) (response *privatev1.VirtualMachineSnapshotsCreateResponse, err error) {
+	object := request.GetObject()
+	if object == nil {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
+		return
+	}
+
+	// Check that the virtual machine exists and make sure that it will not be completely deleted while it has
+	// snapshots:
+	vm, err := s.lockVirtualMachine(ctx, object.GetSpec().GetVirtualMachine())
+	if err != nil {
+		return
+	}
+	object.GetSpec().SetVirtualMachine(vm.GetId())
+
+	// The snapshot has resources in the hub, so make sure that it will not be completely deleted till the controller
+	// has deleted them:
+	if !object.HasMetadata() {
+		object.SetMetadata(&privatev1.Metadata{})
+	}
+	object.GetMetadata().SetFinalizers(s.addFinalizer(object.GetMetadata().GetFinalizers(), finalizers.HubResources))
+
+	err = s.generic.Create(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Update(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsUpdateRequest) (response *privatev1.VirtualMachineSnapshotsUpdateResponse, err error) {
+	err = s.validateUpdate(ctx, request)
+	if err != nil {
+		return
+	}
+	err = s.generic.Update(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Delete(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsDeleteRequest) (response *privatev1.VirtualMachineSnapshotsDeleteResponse, err error) {
+	err = s.generic.Delete(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) ListArchived(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsListArchivedRequest) (response *privatev1.VirtualMachineSnapshotsListArchivedResponse, err error) {
+	err = s.generic.ListArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) GetArchived(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsGetArchivedRequest) (response *privatev1.VirtualMachineSnapshotsGetArchivedResponse, err error) {
+	err = s.generic.GetArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Undelete(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsUndeleteRequest) (response *privatev1.VirtualMachineSnapshotsUndeleteResponse, err error) {
+	err = s.generic.Undelete(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineSnapshotsServer) Restore(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsRestoreRequest) (response *privatev1.VirtualMachineSnapshotsRestoreResponse, err error) {
+	id := request.GetId()
+	if id == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier is mandatory")
+		return
+	}
+
+	// Check that the snapshot exists, that it isn't being deleted and that it is ready:
+	current, err := s.generic.find(ctx, id)
+	if err != nil {
+		return
+	}
+	if current == nil {
+		err = grpcstatus.Errorf(grpccodes.NotFound, "virtual machine snapshot '%s' doesn't exist", id)
+		return
+	}
+	if current.GetMetadata().HasDeletionTimestamp() {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine snapshot '%s' is being deleted",
+			id,
+		)
+		return
+	}
+	if current.GetStatus().GetState() != privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine snapshot '%s' isn't ready",
+			id,
+		)
+		return
+	}
+
+	// Request the restore updating only the restore request time, so that changes made concurrently to other fields
+	// aren't lost:
+	updateRequest := privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+		Object: privatev1.VirtualMachineSnapshot_builder{
+			Id: current.GetId(),
+			Spec: privatev1.VirtualMachineSnapshotSpec_builder{
+				RestoreRequestTime: timestamppb.Now(),
+			}.Build(),
+		}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: []string{
+				"spec.restore_request_time",
+			},
+		},
+	}.Build()
+	var updateResponse *privatev1.VirtualMachineSnapshotsUpdateResponse
+	err = s.generic.Update(ctx, updateRequest, &updateResponse)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachineSnapshotsRestoreResponse_builder{
+		Object: updateResponse.GetObject(),
+	}.Build()
+	return
+}
+
+// lockVirtualMachine checks that the virtual machine with the given identifier or name exists and that it isn't being
+// deleted, and adds to it the finalizer that prevents it from being completely deleted while it has snapshots.
+func (s *PrivateVirtualMachineSnapshotsServer) lockVirtualMachine(ctx context.Context,
+	key string) (result *privatev1.VirtualMachine, err error) {
+	if key == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "virtual machine identifier is mandatory")
+		return
+	}
+	vm, err := s.vmsDao.Get(ctx, key)
+	if err == nil && vm == nil {
+		vm, err = s.vmsDao.GetByName(ctx, key)
+	}
+	var ambiguousErr *dao.AmbiguousNameError
+	if errors.As(err, &ambiguousErr) {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "%s", ambiguousErr.Error())
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to get virtual machine",
+			slog.String("key", key),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to get virtual machine '%s'", key)
+		return
+	}
+	if vm == nil {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "virtual machine '%s' doesn't exist", key)
+		return
+	}
+	if vm.GetMetadata().HasDeletionTimestamp() {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine '%s' is being deleted",
+			key,
+		)
+		return
+	}
+	if slices.Contains(vm.GetMetadata().GetFinalizers(), finalizers.VirtualMachineSnapshots) {
+		result = vm
+		return
+	}
+	vm.GetMetadata().SetFinalizers(s.addFinalizer(vm.GetMetadata().GetFinalizers(), finalizers.VirtualMachineSnapshots))
+	result, err = s.vmsDao.Update(ctx, vm)
+	var conflictErr *dao.ConflictError
+	if errors.As(err, &conflictErr) {
+		err = grpcstatus.Errorf(
+			grpccodes.Aborted,
+			"virtual machine '%s' has been modified, try again",
+			key,
+		)
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to add finalizer to virtual machine",
+			slog.String("id", vm.GetId()),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to update virtual machine '%s'", key)
+		return
+	}
+	return
+}
+
+// validateUpdate checks that the update doesn't try to change the virtual machine of the snapshot.
+func (s *PrivateVirtualMachineSnapshotsServer) validateUpdate(ctx context.Context,
+	request *privatev1.VirtualMachineSnapshotsUpdateRequest) error {
+	object := request.GetObject()
+	if object == nil {
+		return grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
+	}
+	mask := request.GetUpdateMask()
+	if mask != nil {
+		paths := mask.GetPaths()
+		if !slices.Contains(paths, "spec") && !slices.Contains(paths, "spec.virtual_machine") {
+			return nil
+		}
+	}
+	key := object.GetId()
+	if key == "" {
+		key = object.GetMetadata().GetName()
+	}
+	if key == "" {
+		// The generic server will report this.
+		return nil
+	}
+	current, err := s.generic.find(ctx, key)
+	if err != nil || current == nil {
+		return err
+	}
+	if object.GetSpec().GetVirtualMachine() != current.GetSpec().GetVirtualMachine() {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"virtual machine of snapshot '%s' can't be changed",
+			key,
+		)
+	}
+	return nil
+}
+
+// addFinalizer returns the list of finalizers with the given one added, if it wasn't already there.
+func (s *PrivateVirtualMachineSnapshotsServer) addFinalizer(list []string, value string) []string {
+	if slices.Contains(list, value) {
+		return list
+	}
+	return append(slices.Clone(list), value)
+}
@@ -0,0 +1,398 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/finalizers"
+)
+
+var _ = Describe("Private virtual machine snapshots server", func() {
+	var (
+		ctx context.Context
+		tx  database.Tx
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err := pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the transaction manager:
+		tm, err := database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start a transaction and add it to the context:
+		tx, err = tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		ctx = database.TxIntoContext(ctx, tx)
+
+		// Create the tables:
+		_, err = tx.Exec(
+			ctx,
+			`
+			create table virtual_machines (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table archived_virtual_machines (
+				id text not null,
+				creation_timestamp timestamp with time zone not null,
+				deletion_timestamp timestamp with time zone not null,
+				archival_timestamp timestamp with time zone not null default now(),
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+
+			create table virtual_machine_snapshots (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table archived_virtual_machine_snapshots (
+				id text not null,
+				creation_timestamp timestamp with time zone not null,
+				deletion_timestamp timestamp with time zone not null,
+				archival_timestamp timestamp with time zone not null default now(),
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Builder", func() {
+		It("Creates server with logger", func() {
+			server, err := NewPrivateVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server).ToNot(BeNil())
+		})
+
+		It("Doesn't create server without logger", func() {
+			server, err := NewPrivateVirtualMachineSnapshotsServer().
+				Build()
+			Expect(err).To(HaveOccurred())
+			Expect(server).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		var (
+			server *PrivateVirtualMachineSnapshotsServer
+			vmsDao *dao.GenericDAO[*privatev1.VirtualMachine]
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the server:
+			server, err = NewPrivateVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// Create the DAO used to prepare the virtual machines:
+			vmsDao, err = dao.NewGenericDAO[*privatev1.VirtualMachine]().
+				SetLogger(logger).
+				SetTable("virtual_machines").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// createVm creates a virtual machine with the given name and returns its identifier.
+		createVm := func(name string) string {
+			vm, err := vmsDao.Create(ctx, privatev1.VirtualMachine_builder{
+				Metadata: privatev1.Metadata_builder{
+					Name: name,
+				}.Build(),
+				Spec: privatev1.VirtualMachineSpec_builder{
+					Template: "my_template",
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			return vm.GetId()
+		}
+
+		// createSnapshot creates a snapshot for the given virtual machine and returns it.
+		createSnapshot := func(vmId string) *privatev1.VirtualMachineSnapshot {
+			response, err := server.Create(ctx, privatev1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: privatev1.VirtualMachineSnapshot_builder{
+					Spec: privatev1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: vmId,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			return response.GetObject()
+		}
+
+		It("Creates object with finalizers", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot(vmId)
+			Expect(snapshot.GetId()).ToNot(BeEmpty())
+			Expect(snapshot.GetSpec().GetVirtualMachine()).To(Equal(vmId))
+			Expect(snapshot.GetMetadata().GetFinalizers()).To(ConsistOf(finalizers.HubResources))
+
+			// The virtual machine should have the finalizer that prevents it from being archived:
+			vm, err := vmsDao.Get(ctx, vmId)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.GetMetadata().GetFinalizers()).To(ConsistOf(finalizers.VirtualMachineSnapshots))
+
+			// Creating a second snapshot shouldn't add the finalizer again:
+			createSnapshot(vmId)
+			vm, err = vmsDao.Get(ctx, vmId)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.GetMetadata().GetFinalizers()).To(ConsistOf(finalizers.VirtualMachineSnapshots))
+		})
+
+		It("Accepts the name of the virtual machine", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot("my_vm")
+			Expect(snapshot.GetSpec().GetVirtualMachine()).To(Equal(vmId))
+		})
+
+		It("Doesn't create object without virtual machine", func() {
+			response, err := server.Create(ctx, privatev1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: privatev1.VirtualMachineSnapshot_builder{}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(Equal("virtual machine identifier is mandatory"))
+		})
+
+		It("Doesn't create object for virtual machine that doesn't exist", func() {
+			response, err := server.Create(ctx, privatev1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: privatev1.VirtualMachineSnapshot_builder{
+					Spec: privatev1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: "junk",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+			Expect(status.Message()).To(Equal("virtual machine 'junk' doesn't exist"))
+		})
+
+		It("Doesn't create object for virtual machine that is being deleted", func() {
+			// Create a virtual machine with a finalizer, so that it isn't archived when deleted:
+			vm, err := vmsDao.Create(ctx, privatev1.VirtualMachine_builder{
+				Metadata: privatev1.Metadata_builder{
+					Finalizers: []string{"a"},
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			err = vmsDao.Delete(ctx, vm.GetId())
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := server.Create(ctx, privatev1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: privatev1.VirtualMachineSnapshot_builder{
+					Spec: privatev1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: vm.GetId(),
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+		})
+
+		It("Doesn't change the virtual machine of the object", func() {
+			vmId := createVm("my_vm")
+			otherVmId := createVm("your_vm")
+			snapshot := createSnapshot(vmId)
+
+			snapshot.GetSpec().SetVirtualMachine(otherVmId)
+			response, err := server.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+				Object: snapshot,
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+
+		It("Updates the status of the object", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot(vmId)
+
+			snapshot.SetStatus(privatev1.VirtualMachineSnapshotStatus_builder{
+				State: privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY,
+				Hub:   "my_hub",
+			}.Build())
+			response, err := server.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+				Object: snapshot,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := response.GetObject()
+			Expect(object.GetStatus().GetState()).To(Equal(
+				privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY,
+			))
+			Expect(object.GetStatus().GetHub()).To(Equal("my_hub"))
+		})
+
+		It("Keeps the object till the finalizer is removed", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot(vmId)
+
+			// Delete the object, and verify that it still exists because of the finalizer:
+			_, err := server.Delete(ctx, privatev1.VirtualMachineSnapshotsDeleteRequest_builder{
+				Id: snapshot.GetId(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			getResponse, err := server.Get(ctx, privatev1.VirtualMachineSnapshotsGetRequest_builder{
+				Id: snapshot.GetId(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			snapshot = getResponse.GetObject()
+			Expect(snapshot.GetMetadata().HasDeletionTimestamp()).To(BeTrue())
+
+			// Remove the finalizer, and verify that the object is gone:
+			snapshot.GetMetadata().SetFinalizers(nil)
+			_, err = server.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+				Object: snapshot,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			_, err = server.Get(ctx, privatev1.VirtualMachineSnapshotsGetRequest_builder{
+				Id: snapshot.GetId(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Restores object that is ready", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot(vmId)
+			snapshot.SetStatus(privatev1.VirtualMachineSnapshotStatus_builder{
+				State: privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY,
+			}.Build())
+			_, err := server.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+				Object: snapshot,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := server.Restore(ctx, privatev1.VirtualMachineSnapshotsRestoreRequest_builder{
+				Id: snapshot.GetId(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := response.GetObject()
+			Expect(object.GetSpec().HasRestoreRequestTime()).To(BeTrue())
+			Expect(object.GetSpec().GetVirtualMachine()).To(Equal(vmId))
+			Expect(object.GetStatus().GetState()).To(Equal(
+				privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY,
+			))
+		})
+
+		It("Doesn't restore object that isn't ready", func() {
+			vmId := createVm("my_vm")
+			snapshot := createSnapshot(vmId)
+
+			response, err := server.Restore(ctx, privatev1.VirtualMachineSnapshotsRestoreRequest_builder{
+				Id: snapshot.GetId(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+		})
+
+		It("Doesn't restore object that doesn't exist", func() {
+			response, err := server.Restore(ctx, privatev1.VirtualMachineSnapshotsRestoreRequest_builder{
+				Id: "junk",
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Doesn't restore object without identifier", func() {
+			response, err := server.Restore(ctx, privatev1.VirtualMachineSnapshotsRestoreRequest_builder{}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+	})
+})
@@ -28,6 +28,7 @@ type PrivateVirtualMachineTemplatesServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
 }
 
 var _ privatev1.VirtualMachineTemplatesServer = (*PrivateVirtualMachineTemplatesServer)(nil)
@@ -62,6 +63,11 @@ func (b *PrivateVirtualMachineTemplatesServerBuilder) SetTenancyLogic(value auth
 	return b
 }
 
+func (b *PrivateVirtualMachineTemplatesServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateVirtualMachineTemplatesServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
 func (b *PrivateVirtualMachineTemplatesServerBuilder) Build() (result *PrivateVirtualMachineTemplatesServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -77,6 +83,7 @@ func (b *PrivateVirtualMachineTemplatesServerBuilder) Build() (result *PrivateVi
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
 		Build()
 	if err != nil {
 		return
@@ -119,3 +126,21 @@ func (s *PrivateVirtualMachineTemplatesServer) Delete(ctx context.Context,
 	err = s.generic.Delete(ctx, request, &response)
 	return
 }
+
+func (s *PrivateVirtualMachineTemplatesServer) ListArchived(ctx context.Context,
+	request *privatev1.VirtualMachineTemplatesListArchivedRequest) (response *privatev1.VirtualMachineTemplatesListArchivedResponse, err error) {
+	err = s.generic.ListArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineTemplatesServer) GetArchived(ctx context.Context,
+	request *privatev1.VirtualMachineTemplatesGetArchivedRequest) (response *privatev1.VirtualMachineTemplatesGetArchivedResponse, err error) {
+	err = s.generic.GetArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachineTemplatesServer) Undelete(ctx context.Context,
+	request *privatev1.VirtualMachineTemplatesUndeleteRequest) (response *privatev1.VirtualMachineTemplatesUndeleteResponse, err error) {
+	err = s.generic.Undelete(ctx, request, &response)
+	return
+}
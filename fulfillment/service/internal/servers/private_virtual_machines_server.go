@@ -20,6 +20,8 @@ import (
 
 	grpccodes "google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
 	"github.com/jkary/osac/fulfillment/service/internal/auth"
@@ -33,6 +35,8 @@ type PrivateVirtualMachinesServerBuilder struct {
 	notifier         *database.Notifier
 	attributionLogic auth.AttributionLogic
 	tenancyLogic     auth.TenancyLogic
+	auditLogger      *AuditLogger
+	quotaChecker     *QuotaChecker
 }
 
 var _ privatev1.VirtualMachinesServer = (*PrivateVirtualMachinesServer)(nil)
@@ -43,6 +47,8 @@ type PrivateVirtualMachinesServer struct {
 	logger       *slog.Logger
 	generic      *GenericServer[*privatev1.VirtualMachine]
 	templatesDao *dao.GenericDAO[*privatev1.VirtualMachineTemplate]
+	hubsDao      *dao.GenericDAO[*privatev1.Hub]
+	quotaChecker *QuotaChecker
 }
 
 func NewPrivateVirtualMachinesServer() *PrivateVirtualMachinesServerBuilder {
@@ -69,6 +75,16 @@ func (b *PrivateVirtualMachinesServerBuilder) SetTenancyLogic(value auth.Tenancy
 	return b
 }
 
+func (b *PrivateVirtualMachinesServerBuilder) SetAuditLogger(value *AuditLogger) *PrivateVirtualMachinesServerBuilder {
+	b.auditLogger = value
+	return b
+}
+
+func (b *PrivateVirtualMachinesServerBuilder) SetQuotaChecker(value *QuotaChecker) *PrivateVirtualMachinesServerBuilder {
+	b.quotaChecker = value
+	return b
+}
+
 func (b *PrivateVirtualMachinesServerBuilder) Build() (result *PrivateVirtualMachinesServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -87,6 +103,16 @@ func (b *PrivateVirtualMachinesServerBuilder) Build() (result *PrivateVirtualMac
 		return
 	}
 
+	// Create the hubs DAO, used to check the hubs that are assigned to virtual machines. Note that this doesn't have
+	// tenancy logic because hubs don't belong to tenants.
+	hubsDao, err := dao.NewGenericDAO[*privatev1.Hub]().
+		SetLogger(b.logger).
+		SetTable("hubs").
+		Build()
+	if err != nil {
+		return
+	}
+
 	// Create the generic server:
 	generic, err := NewGenericServer[*privatev1.VirtualMachine]().
 		SetLogger(b.logger).
@@ -95,6 +121,7 @@ func (b *PrivateVirtualMachinesServerBuilder) Build() (result *PrivateVirtualMac
 		SetNotifier(b.notifier).
 		SetAttributionLogic(b.attributionLogic).
 		SetTenancyLogic(b.tenancyLogic).
+		SetAuditLogger(b.auditLogger).
 		Build()
 	if err != nil {
 		return
@@ -105,6 +132,8 @@ func (b *PrivateVirtualMachinesServerBuilder) Build() (result *PrivateVirtualMac
 		logger:       b.logger,
 		generic:      generic,
 		templatesDao: templatesDao,
+		hubsDao:      hubsDao,
+		quotaChecker: b.quotaChecker,
 	}
 	return
 }
@@ -129,6 +158,14 @@ func (s *PrivateVirtualMachinesServer) Create(ctx context.Context,
 		return
 	}
 
+	// Check that the virtual machine doesn't exceed the quota of the tenant:
+	if s.quotaChecker != nil {
+		err = s.quotaChecker.CheckVirtualMachineCreate(ctx)
+		if err != nil {
+			return
+		}
+	}
+
 	err = s.generic.Create(ctx, request, &response)
 	return
 }
@@ -141,16 +178,240 @@ func (s *PrivateVirtualMachinesServer) Update(ctx context.Context,
 		return
 	}
 
+	err = s.checkHubAssignment(ctx, request)
+	if err != nil {
+		return
+	}
+
 	err = s.generic.Update(ctx, request, &response)
 	return
 }
 
+// checkHubAssignment checks that the hub that the update assigns to the virtual machine exists, and takes its lock so that it
+// can't be deleted till the transaction finishes.
+func (s *PrivateVirtualMachinesServer) checkHubAssignment(ctx context.Context, request *privatev1.VirtualMachinesUpdateRequest) error {
+	object := request.GetObject()
+	hub := object.GetStatus().GetHub()
+	if hub == "" || !updatesHub(request.GetUpdateMask()) {
+		return nil
+	}
+	key := object.GetId()
+	if key == "" {
+		key = object.GetMetadata().GetName()
+	}
+	if key == "" {
+		return nil
+	}
+	current, err := s.generic.find(ctx, key)
+	if err != nil || current == nil {
+		return err
+	}
+	if current.GetStatus().GetHub() == hub {
+		return nil
+	}
+	return lockAssignedHub(ctx, s.logger, s.hubsDao, hub)
+}
+
 func (s *PrivateVirtualMachinesServer) Delete(ctx context.Context,
 	request *privatev1.VirtualMachinesDeleteRequest) (response *privatev1.VirtualMachinesDeleteResponse, err error) {
 	err = s.generic.Delete(ctx, request, &response)
 	return
 }
 
+func (s *PrivateVirtualMachinesServer) ListArchived(ctx context.Context,
+	request *privatev1.VirtualMachinesListArchivedRequest) (response *privatev1.VirtualMachinesListArchivedResponse, err error) {
+	err = s.generic.ListArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) GetArchived(ctx context.Context,
+	request *privatev1.VirtualMachinesGetArchivedRequest) (response *privatev1.VirtualMachinesGetArchivedResponse, err error) {
+	err = s.generic.GetArchived(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Undelete(ctx context.Context,
+	request *privatev1.VirtualMachinesUndeleteRequest) (response *privatev1.VirtualMachinesUndeleteResponse, err error) {
+	err = s.generic.Undelete(ctx, request, &response)
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Start(ctx context.Context,
+	request *privatev1.VirtualMachinesStartRequest) (response *privatev1.VirtualMachinesStartResponse, err error) {
+	object, err := s.changePower(
+		ctx,
+		request.GetId(),
+		privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+		false,
+	)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachinesStartResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Stop(ctx context.Context,
+	request *privatev1.VirtualMachinesStopRequest) (response *privatev1.VirtualMachinesStopResponse, err error) {
+	object, err := s.changePower(
+		ctx,
+		request.GetId(),
+		privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED,
+		false,
+	)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachinesStopResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Restart(ctx context.Context,
+	request *privatev1.VirtualMachinesRestartRequest) (response *privatev1.VirtualMachinesRestartResponse, err error) {
+	object, err := s.changePower(
+		ctx,
+		request.GetId(),
+		privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+		true,
+	)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachinesRestartResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Pause(ctx context.Context,
+	request *privatev1.VirtualMachinesPauseRequest) (response *privatev1.VirtualMachinesPauseResponse, err error) {
+	object, err := s.changePaused(ctx, request.GetId(), true)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachinesPauseResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+func (s *PrivateVirtualMachinesServer) Unpause(ctx context.Context,
+	request *privatev1.VirtualMachinesUnpauseRequest) (response *privatev1.VirtualMachinesUnpauseResponse, err error) {
+	object, err := s.changePaused(ctx, request.GetId(), false)
+	if err != nil {
+		return
+	}
+	response = privatev1.VirtualMachinesUnpauseResponse_builder{
+		Object: object,
+	}.Build()
+	return
+}
+
+// changePower changes the run strategy of the virtual machine and, optionally, requests a restart updating the restart
+// request time. It also clears the paused flag, as starting, stopping or restarting the virtual machine always results
+// in a virtual machine that isn't paused. Only those fields are updated, so that changes made concurrently to other
+// fields aren't lost.
+func (s *PrivateVirtualMachinesServer) changePower(ctx context.Context, id string,
+	runStrategy privatev1.VirtualMachineRunStrategy, restart bool) (result *privatev1.VirtualMachine, err error) {
+	current, err := s.findPowerTarget(ctx, id)
+	if err != nil {
+		return
+	}
+	spec := privatev1.VirtualMachineSpec_builder{
+		RunStrategy: runStrategy,
+	}.Build()
+	paths := []string{
+		"spec.run_strategy",
+		"spec.paused",
+	}
+	if restart {
+		spec.SetRestartRequestTime(timestamppb.Now())
+		paths = append(paths, "spec.restart_request_time")
+	}
+	result, err = s.updatePower(ctx, current.GetId(), spec, paths)
+	return
+}
+
+// changePaused changes the paused flag of the virtual machine. Only that field is updated, so that changes made
+// concurrently to other fields aren't lost.
+func (s *PrivateVirtualMachinesServer) changePaused(ctx context.Context, id string,
+	paused bool) (result *privatev1.VirtualMachine, err error) {
+	current, err := s.findPowerTarget(ctx, id)
+	if err != nil {
+		return
+	}
+	if paused && current.GetSpec().GetRunStrategy() ==
+		privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine '%s' is stopped, so it can't be paused",
+			id,
+		)
+		return
+	}
+	spec := privatev1.VirtualMachineSpec_builder{
+		Paused: paused,
+	}.Build()
+	paths := []string{
+		"spec.paused",
+	}
+	result, err = s.updatePower(ctx, current.GetId(), spec, paths)
+	return
+}
+
+// findPowerTarget finds the virtual machine whose power settings will be changed, and checks that it exists and that it
+// isn't being deleted.
+func (s *PrivateVirtualMachinesServer) findPowerTarget(ctx context.Context,
+	id string) (result *privatev1.VirtualMachine, err error) {
+	if id == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier is mandatory")
+		return
+	}
+	current, err := s.generic.find(ctx, id)
+	if err != nil {
+		return
+	}
+	if current == nil {
+		err = grpcstatus.Errorf(grpccodes.NotFound, "virtual machine '%s' doesn't exist", id)
+		return
+	}
+	if current.GetMetadata().HasDeletionTimestamp() {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine '%s' is being deleted",
+			id,
+		)
+		return
+	}
+	result = current
+	return
+}
+
+// updatePower updates only the given power fields of the spec of the virtual machine.
+func (s *PrivateVirtualMachinesServer) updatePower(ctx context.Context, id string, spec *privatev1.VirtualMachineSpec,
+	paths []string) (result *privatev1.VirtualMachine, err error) {
+	request := privatev1.VirtualMachinesUpdateRequest_builder{
+		Object: privatev1.VirtualMachine_builder{
+			Id:   id,
+			Spec: spec,
+		}.Build(),
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: paths,
+		},
+	}.Build()
+	var response *privatev1.VirtualMachinesUpdateResponse
+	err = s.generic.Update(ctx, request, &response)
+	if err != nil {
+		return
+	}
+	result = response.GetObject()
+	return
+}
+
 // validateTemplate validates the template ID and parameters in the virtual machine spec.
 func (s *PrivateVirtualMachinesServer) validateTemplate(ctx context.Context, vm *privatev1.VirtualMachine) error {
 	if vm == nil {
@@ -16,10 +16,13 @@ package servers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -76,6 +79,10 @@ var _ = Describe("Private virtual machines server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -86,6 +93,10 @@ var _ = Describe("Private virtual machines server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 
@@ -96,6 +107,10 @@ var _ = Describe("Private virtual machines server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -106,6 +121,10 @@ var _ = Describe("Private virtual machines server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
@@ -518,6 +537,164 @@ var _ = Describe("Private virtual machines server", func() {
 			Expect(updateResponse).To(BeNil())
 		})
 
+		It("Stops and starts object", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template: "general.small",
+					}.Build(),
+					Status: privatev1.VirtualMachineStatus_builder{
+						State: privatev1.VirtualMachineState_VIRTUAL_MACHINE_STATE_READY,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Stop the object:
+			stopResponse, err := server.Stop(ctx, privatev1.VirtualMachinesStopRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := stopResponse.GetObject()
+			Expect(object.GetSpec().GetRunStrategy()).To(Equal(
+				privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED,
+			))
+
+			// Check that the rest of the object hasn't changed:
+			Expect(object.GetSpec().GetTemplate()).To(Equal("general.small"))
+			Expect(object.GetStatus().GetState()).To(Equal(privatev1.VirtualMachineState_VIRTUAL_MACHINE_STATE_READY))
+
+			// Start the object:
+			startResponse, err := server.Start(ctx, privatev1.VirtualMachinesStartRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object = startResponse.GetObject()
+			Expect(object.GetSpec().GetRunStrategy()).To(Equal(
+				privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+			))
+			Expect(object.GetSpec().HasRestartRequestTime()).To(BeFalse())
+		})
+
+		It("Restarts object", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template:    "general.small",
+						RunStrategy: privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Restart the object, and check that the restart request time has been set and that the run strategy
+			// has been changed so that it runs after the restart:
+			before := time.Now()
+			restartResponse, err := server.Restart(ctx, privatev1.VirtualMachinesRestartRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := restartResponse.GetObject()
+			Expect(object.GetSpec().GetRunStrategy()).To(Equal(
+				privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+			))
+			Expect(object.GetSpec().HasRestartRequestTime()).To(BeTrue())
+			Expect(object.GetSpec().GetRestartRequestTime().AsTime()).To(BeTemporally(">=", before.Truncate(time.Microsecond)))
+		})
+
+		It("Pauses and unpauses object", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template: "general.small",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Pause the object:
+			pauseResponse, err := server.Pause(ctx, privatev1.VirtualMachinesPauseRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := pauseResponse.GetObject()
+			Expect(object.GetSpec().GetPaused()).To(BeTrue())
+			Expect(object.GetSpec().GetTemplate()).To(Equal("general.small"))
+
+			// Unpause the object:
+			unpauseResponse, err := server.Unpause(ctx, privatev1.VirtualMachinesUnpauseRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unpauseResponse.GetObject().GetSpec().GetPaused()).To(BeFalse())
+		})
+
+		It("Clears the paused flag when the object is stopped", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template: "general.small",
+						Paused:   true,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+			stopResponse, err := server.Stop(ctx, privatev1.VirtualMachinesStopRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stopResponse.GetObject().GetSpec().GetPaused()).To(BeFalse())
+		})
+
+		It("Doesn't pause object that is stopped", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
+				Object: privatev1.VirtualMachine_builder{
+					Spec: privatev1.VirtualMachineSpec_builder{
+						Template:    "general.small",
+						RunStrategy: privatev1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			response, err := server.Pause(ctx, privatev1.VirtualMachinesPauseRequest_builder{
+				Id: createResponse.GetObject().GetId(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+		})
+
+		It("Doesn't start object that doesn't exist", func() {
+			response, err := server.Start(ctx, privatev1.VirtualMachinesStartRequest_builder{
+				Id: "non-existent-id",
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Doesn't stop object without identifier", func() {
+			response, err := server.Stop(ctx, privatev1.VirtualMachinesStopRequest_builder{}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+
 		It("Validates template ID is not empty", func() {
 			// Try to create with empty template ID:
 			response, err := server.Create(ctx, privatev1.VirtualMachinesCreateRequest_builder{
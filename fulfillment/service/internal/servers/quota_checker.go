@@ -0,0 +1,391 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/auth"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+)
+
+// QuotaCheckerBuilder contains the data and logic needed to create a quota checker. Don't create instances of this
+// type directly, use the NewQuotaChecker function instead.
+type QuotaCheckerBuilder struct {
+	logger       *slog.Logger
+	tenancyLogic auth.TenancyLogic
+}
+
+// QuotaChecker checks that the creation or modification of clusters and virtual machines doesn't exceed the quotas
+// of the tenants. The checks use the transaction from the context, and they take a lock per tenant that is held till
+// that transaction finishes, so concurrent requests for the same tenant can't together exceed the quota.
+type QuotaChecker struct {
+	logger       *slog.Logger
+	tenancyLogic auth.TenancyLogic
+	quotasDao    *dao.GenericDAO[*privatev1.Quota]
+}
+
+// NewQuotaChecker creates a builder that can then be used to configure and create a quota checker.
+func NewQuotaChecker() *QuotaCheckerBuilder {
+	return &QuotaCheckerBuilder{}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *QuotaCheckerBuilder) SetLogger(value *slog.Logger) *QuotaCheckerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetTenancyLogic sets the tenancy logic used to determine the tenants that will be assigned to new objects. This is
+// mandatory.
+func (b *QuotaCheckerBuilder) SetTenancyLogic(value auth.TenancyLogic) *QuotaCheckerBuilder {
+	b.tenancyLogic = value
+	return b
+}
+
+// Build uses the data stored in the builder to create a new quota checker.
+func (b *QuotaCheckerBuilder) Build() (result *QuotaChecker, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.tenancyLogic == nil {
+		err = errors.New("tenancy logic is mandatory")
+		return
+	}
+
+	// Create the quotas DAO. Note that this doesn't use the tenancy logic because quotas are managed by the
+	// administrators, and the checks need to find them regardless of the tenants of the user.
+	quotasDao, err := dao.NewGenericDAO[*privatev1.Quota]().
+		SetLogger(b.logger).
+		SetTable("quotas").
+		Build()
+	if err != nil {
+		err = fmt.Errorf("failed to create quotas DAO: %w", err)
+		return
+	}
+
+	// Create and populate the object:
+	result = &QuotaChecker{
+		logger:       b.logger,
+		tenancyLogic: b.tenancyLogic,
+		quotasDao:    quotasDao,
+	}
+	return
+}
+
+// CheckClusterCreate checks that creating the given cluster doesn't exceed the quotas of the tenants that will be
+// assigned to it. The node sets of the cluster should already contain the host classes. Errors returned are already
+// gRPC statuses.
+func (c *QuotaChecker) CheckClusterCreate(ctx context.Context, cluster *privatev1.Cluster) error {
+	tenants, err := c.tenancyLogic.DetermineAssignedTenants(ctx)
+	if err != nil {
+		c.logger.ErrorContext(
+			ctx,
+			"Failed to determine tenants",
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check quota")
+	}
+	requested := c.countNodes(cluster.GetSpec().GetNodeSets(), nil)
+	return c.checkClusters(ctx, tenants, "", 1, requested)
+}
+
+// CheckClusterUpdate checks that changing the node sets of the current cluster to the given ones doesn't exceed the
+// quotas of the tenants of the cluster. Only increases of the number of nodes are checked, so that it is always
+// possible to reduce the size of a cluster. Errors returned are already gRPC statuses.
+func (c *QuotaChecker) CheckClusterUpdate(ctx context.Context, current *privatev1.Cluster,
+	nodeSets map[string]*privatev1.ClusterNodeSet) error {
+	currentNodeSets := current.GetSpec().GetNodeSets()
+	requested := c.countNodes(nodeSets, currentNodeSets)
+	for hostClass, nodes := range c.countNodes(currentNodeSets, nil) {
+		if requested[hostClass] <= nodes {
+			delete(requested, hostClass)
+		}
+	}
+	if len(requested) == 0 {
+		return nil
+	}
+	tenants := current.GetMetadata().GetTenants()
+	return c.checkClusters(ctx, tenants, current.GetId(), 0, requested)
+}
+
+// CheckVirtualMachineCreate checks that creating a virtual machine doesn't exceed the quotas of the tenants that will
+// be assigned to it. Errors returned are already gRPC statuses.
+func (c *QuotaChecker) CheckVirtualMachineCreate(ctx context.Context) error {
+	tenants, err := c.tenancyLogic.DetermineAssignedTenants(ctx)
+	if err != nil {
+		c.logger.ErrorContext(
+			ctx,
+			"Failed to determine tenants",
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Internal, "failed to check quota")
+	}
+	for _, tenant := range tenants {
+		quota, err := c.lockAndFindQuota(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if quota == nil || !quota.GetSpec().HasVirtualMachines() {
+			continue
+		}
+		usage, err := c.usage(ctx, tenant, "")
+		if err != nil {
+			return err
+		}
+		limit := quota.GetSpec().GetVirtualMachines()
+		used := usage.GetVirtualMachines()
+		if used+1 > limit {
+			return grpcstatus.Errorf(
+				grpccodes.ResourceExhausted,
+				"quota of tenant '%s' allows %d virtual machines and %d are already in use",
+				tenant, limit, used,
+			)
+		}
+	}
+	return nil
+}
+
+// Usage returns the resources currently used by the given tenant and its quota. The quota will be nil if the tenant
+// doesn't have one. Errors returned are already gRPC statuses.
+func (c *QuotaChecker) Usage(ctx context.Context, tenant string) (usage *privatev1.QuotaUsage,
+	quota *privatev1.Quota, err error) {
+	quota, err = c.findQuota(ctx, tenant)
+	if err != nil {
+		return
+	}
+	usage, err = c.usage(ctx, tenant, "")
+	return
+}
+
+// checkClusters checks that adding the given number of clusters and nodes doesn't exceed the quotas of the tenants.
+// The cluster with the excluded identifier, if any, isn't counted as used.
+func (c *QuotaChecker) checkClusters(ctx context.Context, tenants []string, excluded string, clusters int32,
+	nodes map[string]int32) error {
+	for _, tenant := range tenants {
+		quota, err := c.lockAndFindQuota(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if quota == nil {
+			continue
+		}
+		usage, err := c.usage(ctx, tenant, excluded)
+		if err != nil {
+			return err
+		}
+		spec := quota.GetSpec()
+		if clusters > 0 && spec.HasClusters() {
+			limit := spec.GetClusters()
+			used := usage.GetClusters()
+			if used+clusters > limit {
+				return grpcstatus.Errorf(
+					grpccodes.ResourceExhausted,
+					"quota of tenant '%s' allows %d clusters and %d are already in use",
+					tenant, limit, used,
+				)
+			}
+		}
+		hostClasses := make([]string, 0, len(nodes))
+		for hostClass := range nodes {
+			hostClasses = append(hostClasses, hostClass)
+		}
+		slices.Sort(hostClasses)
+		for _, hostClass := range hostClasses {
+			limit, ok := spec.GetHostClassNodes()[hostClass]
+			if !ok {
+				continue
+			}
+			used := usage.GetHostClassNodes()[hostClass]
+			requested := nodes[hostClass]
+			if used+requested > limit {
+				return grpcstatus.Errorf(
+					grpccodes.ResourceExhausted,
+					"quota of tenant '%s' allows %d nodes of host class '%s', %d are already in use "+
+						"and %d were requested",
+					tenant, limit, hostClass, used, requested,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// countNodes calculates the total number of nodes per host class of the given node sets. If a node set doesn't
+// specify the host class it is taken from the node set with the same name in the defaults.
+func (c *QuotaChecker) countNodes(nodeSets map[string]*privatev1.ClusterNodeSet,
+	defaults map[string]*privatev1.ClusterNodeSet) map[string]int32 {
+	result := map[string]int32{}
+	for name, nodeSet := range nodeSets {
+		hostClass := nodeSet.GetHostClass()
+		if hostClass == "" {
+			hostClass = defaults[name].GetHostClass()
+		}
+		if hostClass == "" {
+			continue
+		}
+		result[hostClass] += nodeSet.GetSize()
+	}
+	return result
+}
+
+// lockAndFindQuota takes the lock of the given tenant and then finds its quota. The lock is released when the
+// transaction finishes.
+func (c *QuotaChecker) lockAndFindQuota(ctx context.Context, tenant string) (result *privatev1.Quota, err error) {
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+	_, err = tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext($1))`, quotaLockPrefix+tenant)
+	if err != nil {
+		c.logger.ErrorContext(
+			ctx,
+			"Failed to lock quota",
+			slog.String("tenant", tenant),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to check quota")
+		return
+	}
+	result, err = c.findQuota(ctx, tenant)
+	return
+}
+
+// findQuota finds the quota of the given tenant. Returns nil if the tenant doesn't have a quota.
+func (c *QuotaChecker) findQuota(ctx context.Context, tenant string) (result *privatev1.Quota, err error) {
+	response, err := c.quotasDao.List(ctx, dao.ListRequest{
+		Filter:    fmt.Sprintf("this.spec.tenant == %q", tenant),
+		Limit:     1,
+		SkipTotal: true,
+	})
+	if err != nil {
+		c.logger.ErrorContext(
+			ctx,
+			"Failed to find quota",
+			slog.String("tenant", tenant),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to find quota of tenant '%s'", tenant)
+		return
+	}
+	if len(response.Items) > 0 {
+		result = response.Items[0]
+	}
+	return
+}
+
+// usage calculates the resources used by the given tenant. The cluster with the excluded identifier, if any, isn't
+// counted.
+func (c *QuotaChecker) usage(ctx context.Context, tenant string, excluded string) (result *privatev1.QuotaUsage,
+	err error) {
+	defer func() {
+		if err != nil {
+			c.logger.ErrorContext(
+				ctx,
+				"Failed to calculate quota usage",
+				slog.String("tenant", tenant),
+				slog.Any("error", err),
+			)
+			err = grpcstatus.Errorf(grpccodes.Internal, "failed to calculate usage of tenant '%s'", tenant)
+		}
+	}()
+	tx, err := database.TxFromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	// Count the clusters and virtual machines:
+	var clusters, virtualMachines int32
+	row := tx.QueryRow(
+		ctx,
+		`select count(*) from clusters where $1 = any(tenants) and id != $2`,
+		tenant, excluded,
+	)
+	err = row.Scan(&clusters)
+	if err != nil {
+		return
+	}
+	row = tx.QueryRow(
+		ctx,
+		`select count(*) from virtual_machines where $1 = any(tenants)`,
+		tenant,
+	)
+	err = row.Scan(&virtualMachines)
+	if err != nil {
+		return
+	}
+
+	// Sum the nodes of the node sets of the clusters:
+	rows, err := tx.Query(
+		ctx,
+		`
+		select
+			node_set.value->>'host_class',
+			sum(cast(node_set.value->>'size' as integer))
+		from
+			clusters,
+			jsonb_each(data->'spec'->'node_sets') as node_set
+		where
+			$1 = any(tenants) and
+			id != $2
+		group by
+			1
+		`,
+		tenant, excluded,
+	)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	hostClassNodes := map[string]int32{}
+	for rows.Next() {
+		var (
+			hostClass *string
+			nodes     int32
+		)
+		err = rows.Scan(&hostClass, &nodes)
+		if err != nil {
+			return
+		}
+		if hostClass != nil && *hostClass != "" {
+			hostClassNodes[*hostClass] = nodes
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return
+	}
+
+	result = privatev1.QuotaUsage_builder{
+		Clusters:        clusters,
+		HostClassNodes:  hostClassNodes,
+		VirtualMachines: virtualMachines,
+	}.Build()
+	return
+}
+
+// quotaLockPrefix is the prefix added to the name of the tenant to calculate the advisory lock used to serialize
+// quota checks.
+const quotaLockPrefix = "quota:"
@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+)
+
+type VirtualMachineSnapshotsServerBuilder struct {
+	logger  *slog.Logger
+	private privatev1.VirtualMachineSnapshotsServer
+}
+
+var _ ffv1.VirtualMachineSnapshotsServer = (*VirtualMachineSnapshotsServer)(nil)
+
+type VirtualMachineSnapshotsServer struct {
+	ffv1.UnimplementedVirtualMachineSnapshotsServer
+
+	logger    *slog.Logger
+	private   privatev1.VirtualMachineSnapshotsServer
+	inMapper  *GenericMapper[*ffv1.VirtualMachineSnapshot, *privatev1.VirtualMachineSnapshot]
+	outMapper *GenericMapper[*privatev1.VirtualMachineSnapshot, *ffv1.VirtualMachineSnapshot]
+}
+
+func NewVirtualMachineSnapshotsServer() *VirtualMachineSnapshotsServerBuilder {
+	return &VirtualMachineSnapshotsServerBuilder{}
+}
+
+// SetLogger sets the logger to use. This is mandatory.
+func (b *VirtualMachineSnapshotsServerBuilder) SetLogger(value *slog.Logger) *VirtualMachineSnapshotsServerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetPrivate sets the private server to use. This is mandatory.
+func (b *VirtualMachineSnapshotsServerBuilder) SetPrivate(
+	value privatev1.VirtualMachineSnapshotsServer) *VirtualMachineSnapshotsServerBuilder {
+	b.private = value
+	return b
+}
+
+func (b *VirtualMachineSnapshotsServerBuilder) Build() (result *VirtualMachineSnapshotsServer, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.private == nil {
+		err = errors.New("private server is mandatory")
+		return
+	}
+
+	// Create the mappers:
+	inMapper, err := NewGenericMapper[*ffv1.VirtualMachineSnapshot, *privatev1.VirtualMachineSnapshot]().
+		SetLogger(b.logger).
+		SetStrict(true).
+		Build()
+	if err != nil {
+		return
+	}
+	outMapper, err := NewGenericMapper[*privatev1.VirtualMachineSnapshot, *ffv1.VirtualMachineSnapshot]().
+		SetLogger(b.logger).
+		SetStrict(false).
+		Build()
+	if err != nil {
+		return
+	}
+
+	// Create and populate the object:
+	result = &VirtualMachineSnapshotsServer{
+		logger:    b.logger,
+		private:   b.private,
+		inMapper:  inMapper,
+		outMapper: outMapper,
+	}
+	return
+}
+
+func (s *VirtualMachineSnapshotsServer) List(ctx context.Context,
+	request *ffv1.VirtualMachineSnapshotsListRequest) (response *ffv1.VirtualMachineSnapshotsListResponse, err error) {
+	// Create private request with same parameters:
+	privateRequest := &privatev1.VirtualMachineSnapshotsListRequest{}
+	privateRequest.SetOffset(request.GetOffset())
+	privateRequest.SetLimit(request.GetLimit())
+	privateRequest.SetFilter(request.GetFilter())
+	privateRequest.SetOrder(request.GetOrder())
+	privateRequest.SetPageToken(request.GetPageToken())
+	privateRequest.SetSkipTotal(request.GetSkipTotal())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.List(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateItems := privateResponse.GetItems()
+	publicItems := make([]*ffv1.VirtualMachineSnapshot, len(privateItems))
+	for i, privateItem := range privateItems {
+		publicItem := &ffv1.VirtualMachineSnapshot{}
+		err = s.outMapper.Copy(ctx, privateItem, publicItem)
+		if err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Failed to map private virtual machine snapshot to public",
+				slog.Any("error", err),
+			)
+			return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine snapshots")
+		}
+		publicItems[i] = publicItem
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachineSnapshotsListResponse{}
+	response.SetSize(privateResponse.GetSize())
+	if privateResponse.HasTotal() {
+		response.SetTotal(privateResponse.GetTotal())
+	}
+	response.SetItems(publicItems)
+	if privateResponse.HasNextPageToken() {
+		response.SetNextPageToken(privateResponse.GetNextPageToken())
+	}
+	return
+}
+
+func (s *VirtualMachineSnapshotsServer) Get(ctx context.Context,
+	request *ffv1.VirtualMachineSnapshotsGetRequest) (response *ffv1.VirtualMachineSnapshotsGetResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachineSnapshotsGetRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Get(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	publicSnapshot, err := s.toPublic(ctx, privateResponse.GetObject())
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachineSnapshotsGetResponse{}
+	response.SetObject(publicSnapshot)
+	return
+}
+
+func (s *VirtualMachineSnapshotsServer) Create(ctx context.Context,
+	request *ffv1.VirtualMachineSnapshotsCreateRequest) (response *ffv1.VirtualMachineSnapshotsCreateResponse, err error) {
+	// Map the public snapshot to private format:
+	publicSnapshot := request.GetObject()
+	if publicSnapshot == nil {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object is mandatory")
+		return
+	}
+	privateSnapshot := &privatev1.VirtualMachineSnapshot{}
+	err = s.inMapper.Copy(ctx, publicSnapshot, privateSnapshot)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map public virtual machine snapshot to private",
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine snapshot")
+		return
+	}
+
+	// Delegate to the private server:
+	privateRequest := &privatev1.VirtualMachineSnapshotsCreateRequest{}
+	privateRequest.SetObject(privateSnapshot)
+	privateResponse, err := s.private.Create(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the private response back to public format:
+	createdPublicSnapshot, err := s.toPublic(ctx, privateResponse.GetObject())
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachineSnapshotsCreateResponse{}
+	response.SetObject(createdPublicSnapshot)
+	return
+}
+
+func (s *VirtualMachineSnapshotsServer) Delete(ctx context.Context,
+	request *ffv1.VirtualMachineSnapshotsDeleteRequest) (response *ffv1.VirtualMachineSnapshotsDeleteResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachineSnapshotsDeleteRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	_, err = s.private.Delete(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachineSnapshotsDeleteResponse{}
+	return
+}
+
+func (s *VirtualMachineSnapshotsServer) Restore(ctx context.Context,
+	request *ffv1.VirtualMachineSnapshotsRestoreRequest) (response *ffv1.VirtualMachineSnapshotsRestoreResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachineSnapshotsRestoreRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Restore(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	publicSnapshot, err := s.toPublic(ctx, privateResponse.GetObject())
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachineSnapshotsRestoreResponse{}
+	response.SetObject(publicSnapshot)
+	return
+}
+
+// toPublic converts the private representation of a snapshot to the public representation.
+func (s *VirtualMachineSnapshotsServer) toPublic(ctx context.Context,
+	privateSnapshot *privatev1.VirtualMachineSnapshot) (result *ffv1.VirtualMachineSnapshot, err error) {
+	publicSnapshot := &ffv1.VirtualMachineSnapshot{}
+	err = s.outMapper.Copy(ctx, privateSnapshot, publicSnapshot)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine snapshot to public",
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine snapshot")
+		return
+	}
+	result = publicSnapshot
+	return
+}
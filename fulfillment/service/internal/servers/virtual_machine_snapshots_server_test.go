@@ -0,0 +1,293 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package servers
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
+	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+)
+
+var _ = Describe("Virtual machine snapshots server", func() {
+	var (
+		ctx context.Context
+		tx  database.Tx
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		// Create a context:
+		ctx = context.Background()
+
+		// Prepare the database pool:
+		db := server.MakeDatabase()
+		DeferCleanup(db.Close)
+		pool, err := pgxpool.New(ctx, db.MakeURL())
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(pool.Close)
+
+		// Create the transaction manager:
+		tm, err := database.NewTxManager().
+			SetLogger(logger).
+			SetPool(pool).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Start a transaction and add it to the context:
+		tx, err = tm.Begin(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			err := tm.End(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		ctx = database.TxIntoContext(ctx, tx)
+
+		// Create the tables:
+		_, err = tx.Exec(
+			ctx,
+			`
+			create table virtual_machines (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table archived_virtual_machines (
+				id text not null,
+				creation_timestamp timestamp with time zone not null,
+				deletion_timestamp timestamp with time zone not null,
+				archival_timestamp timestamp with time zone not null default now(),
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+
+			create table virtual_machine_snapshots (
+				id text not null primary key,
+				creation_timestamp timestamp with time zone not null default now(),
+				deletion_timestamp timestamp with time zone not null default 'epoch',
+				finalizers text[] not null default '{}',
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
+				data jsonb not null
+			);
+
+			create table archived_virtual_machine_snapshots (
+				id text not null,
+				creation_timestamp timestamp with time zone not null,
+				deletion_timestamp timestamp with time zone not null,
+				archival_timestamp timestamp with time zone not null default now(),
+				creators text[] not null default '{}',
+				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
+				data jsonb not null
+			);
+			`,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Builder", func() {
+		It("Creates server with logger and private server", func() {
+			privateServer, err := NewPrivateVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			server, err := NewVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				SetPrivate(privateServer).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server).ToNot(BeNil())
+		})
+
+		It("Doesn't create server without logger", func() {
+			privateServer, err := NewPrivateVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			server, err := NewVirtualMachineSnapshotsServer().
+				SetPrivate(privateServer).
+				Build()
+			Expect(err).To(HaveOccurred())
+			Expect(server).To(BeNil())
+		})
+
+		It("Doesn't create server without private server", func() {
+			server, err := NewVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(HaveOccurred())
+			Expect(server).To(BeNil())
+		})
+	})
+
+	Describe("Behaviour", func() {
+		var (
+			server *VirtualMachineSnapshotsServer
+			vmId   string
+		)
+
+		BeforeEach(func() {
+			var err error
+
+			// Create the servers:
+			privateServer, err := NewPrivateVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			server, err = NewVirtualMachineSnapshotsServer().
+				SetLogger(logger).
+				SetPrivate(privateServer).
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+
+			// Create the virtual machine:
+			vmsDao, err := dao.NewGenericDAO[*privatev1.VirtualMachine]().
+				SetLogger(logger).
+				SetTable("virtual_machines").
+				Build()
+			Expect(err).ToNot(HaveOccurred())
+			vm, err := vmsDao.Create(ctx, privatev1.VirtualMachine_builder{
+				Spec: privatev1.VirtualMachineSpec_builder{
+					Template: "my_template",
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			vmId = vm.GetId()
+		})
+
+		It("Creates and gets object", func() {
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: ffv1.VirtualMachineSnapshot_builder{
+					Spec: ffv1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: vmId,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := createResponse.GetObject()
+			Expect(object.GetId()).ToNot(BeEmpty())
+			Expect(object.GetSpec().GetVirtualMachine()).To(Equal(vmId))
+
+			getResponse, err := server.Get(ctx, ffv1.VirtualMachineSnapshotsGetRequest_builder{
+				Id: object.GetId(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetId()).To(Equal(object.GetId()))
+		})
+
+		It("Lists objects of a virtual machine", func() {
+			for range 3 {
+				_, err := server.Create(ctx, ffv1.VirtualMachineSnapshotsCreateRequest_builder{
+					Object: ffv1.VirtualMachineSnapshot_builder{
+						Spec: ffv1.VirtualMachineSnapshotSpec_builder{
+							VirtualMachine: vmId,
+						}.Build(),
+					}.Build(),
+				}.Build())
+				Expect(err).ToNot(HaveOccurred())
+			}
+			filter := "this.spec.virtual_machine == '" + vmId + "'"
+			response, err := server.List(ctx, ffv1.VirtualMachineSnapshotsListRequest_builder{
+				Filter: &filter,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.GetSize()).To(BeNumerically("==", 3))
+			Expect(response.GetItems()).To(HaveLen(3))
+		})
+
+		It("Deletes object", func() {
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: ffv1.VirtualMachineSnapshot_builder{
+					Spec: ffv1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: vmId,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// The object isn't removed immediately because the controller needs to delete the resources from the
+			// hub first:
+			_, err = server.Delete(ctx, ffv1.VirtualMachineSnapshotsDeleteRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			getResponse, err := server.Get(ctx, ffv1.VirtualMachineSnapshotsGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetMetadata().HasDeletionTimestamp()).To(BeTrue())
+		})
+
+		It("Doesn't restore object that isn't ready", func() {
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachineSnapshotsCreateRequest_builder{
+				Object: ffv1.VirtualMachineSnapshot_builder{
+					Spec: ffv1.VirtualMachineSnapshotSpec_builder{
+						VirtualMachine: vmId,
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+
+			response, err := server.Restore(ctx, ffv1.VirtualMachineSnapshotsRestoreRequest_builder{
+				Id: createResponse.GetObject().GetId(),
+			}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.FailedPrecondition))
+		})
+
+		It("Handles empty object in create request", func() {
+			response, err := server.Create(ctx, ffv1.VirtualMachineSnapshotsCreateRequest_builder{}.Build())
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+			status, ok := grpcstatus.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(status.Code()).To(Equal(grpccodes.InvalidArgument))
+		})
+	})
+})
@@ -101,6 +101,9 @@ func (s *VirtualMachineTemplatesServer) List(ctx context.Context,
 	privateRequest.SetOffset(request.GetOffset())
 	privateRequest.SetLimit(request.GetLimit())
 	privateRequest.SetFilter(request.GetFilter())
+	privateRequest.SetOrder(request.GetOrder())
+	privateRequest.SetPageToken(request.GetPageToken())
+	privateRequest.SetSkipTotal(request.GetSkipTotal())
 
 	// Delegate to private server:
 	privateResponse, err := s.private.List(ctx, privateRequest)
@@ -128,8 +131,13 @@ func (s *VirtualMachineTemplatesServer) List(ctx context.Context,
 	// Create the public response:
 	response = &ffv1.VirtualMachineTemplatesListResponse{}
 	response.SetSize(privateResponse.GetSize())
-	response.SetTotal(privateResponse.GetTotal())
+	if privateResponse.HasTotal() {
+		response.SetTotal(privateResponse.GetTotal())
+	}
 	response.SetItems(publicItems)
+	if privateResponse.HasNextPageToken() {
+		response.SetNextPageToken(privateResponse.GetNextPageToken())
+	}
 	return
 }
 
@@ -16,18 +16,35 @@ package servers
 import (
 	"context"
 	"errors"
+	"io"
 	"log/slog"
+	"net/http"
+	"net/url"
 
+	"github.com/gorilla/websocket"
 	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	grpcstatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	k8swebsocket "k8s.io/client-go/transport/websocket"
+	clnt "sigs.k8s.io/controller-runtime/pkg/client"
 
 	ffv1 "github.com/jkary/osac/fulfillment/service/internal/api/fulfillment/v1"
 	privatev1 "github.com/jkary/osac/fulfillment/service/internal/api/private/v1"
+	"github.com/jkary/osac/fulfillment/service/internal/database"
+	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
+	"github.com/jkary/osac/fulfillment/service/internal/encryption"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/gvks"
+	"github.com/jkary/osac/fulfillment/service/internal/kubernetes/labels"
 )
 
 type VirtualMachinesServerBuilder struct {
-	logger  *slog.Logger
-	private privatev1.VirtualMachinesServer
+	logger    *slog.Logger
+	private   privatev1.VirtualMachinesServer
+	txManager database.TxManager
+	keyring   *encryption.Keyring
 }
 
 var _ ffv1.VirtualMachinesServer = (*VirtualMachinesServer)(nil)
@@ -35,12 +52,34 @@ var _ ffv1.VirtualMachinesServer = (*VirtualMachinesServer)(nil)
 type VirtualMachinesServer struct {
 	ffv1.UnimplementedVirtualMachinesServer
 
-	logger    *slog.Logger
-	private   privatev1.VirtualMachinesServer
-	inMapper  *GenericMapper[*ffv1.VirtualMachine, *privatev1.VirtualMachine]
-	outMapper *GenericMapper[*privatev1.VirtualMachine, *ffv1.VirtualMachine]
+	logger        *slog.Logger
+	private       privatev1.VirtualMachinesServer
+	inMapper      *GenericMapper[*ffv1.VirtualMachine, *privatev1.VirtualMachine]
+	outMapper     *GenericMapper[*privatev1.VirtualMachine, *ffv1.VirtualMachine]
+	txManager     database.TxManager
+	hubsDao       *dao.GenericDAO[*privatev1.Hub]
+	consoleDialer consoleDialer
 }
 
+// consoleTarget contains the details needed to connect to the console of a KubeVirt virtual machine instance.
+type consoleTarget struct {
+	config    *rest.Config
+	namespace string
+	name      string
+	kind      string
+}
+
+// consoleConnection is the connection to the console of a virtual machine. This is satisfied by the WebSocket
+// connection, and it is an interface so that it can be replaced in unit tests.
+type consoleConnection interface {
+	ReadMessage() (messageType int, data []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	Close() error
+}
+
+// consoleDialer is the type of the function that opens the connection to the console of a virtual machine.
+type consoleDialer func(ctx context.Context, target *consoleTarget) (consoleConnection, error)
+
 func NewVirtualMachinesServer() *VirtualMachinesServerBuilder {
 	return &VirtualMachinesServerBuilder{}
 }
@@ -57,6 +96,20 @@ func (b *VirtualMachinesServerBuilder) SetPrivate(value privatev1.VirtualMachine
 	return b
 }
 
+// SetTxManager sets the database transaction manager. This is needed by the streaming methods, as they don't run inside
+// the transaction created by the interceptor. It is optional, and when not set the streaming methods will use the
+// transaction from the context.
+func (b *VirtualMachinesServerBuilder) SetTxManager(value database.TxManager) *VirtualMachinesServerBuilder {
+	b.txManager = value
+	return b
+}
+
+// SetKeyring sets the keyring used to decrypt the kubeconfigs of the hubs. This is optional.
+func (b *VirtualMachinesServerBuilder) SetKeyring(value *encryption.Keyring) *VirtualMachinesServerBuilder {
+	b.keyring = value
+	return b
+}
+
 func (b *VirtualMachinesServerBuilder) Build() (result *VirtualMachinesServer, err error) {
 	// Check parameters:
 	if b.logger == nil {
@@ -84,13 +137,27 @@ func (b *VirtualMachinesServerBuilder) Build() (result *VirtualMachinesServer, e
 		return
 	}
 
+	// Create the DAOs:
+	hubsDao, err := dao.NewGenericDAO[*privatev1.Hub]().
+		SetLogger(b.logger).
+		SetTable("hubs").
+		SetKeyring(b.keyring).
+		Build()
+	if err != nil {
+		return
+	}
+
 	// Create and populate the object:
-	result = &VirtualMachinesServer{
+	object := &VirtualMachinesServer{
 		logger:    b.logger,
 		private:   b.private,
 		inMapper:  inMapper,
 		outMapper: outMapper,
+		txManager: b.txManager,
+		hubsDao:   hubsDao,
 	}
+	object.consoleDialer = object.dialConsole
+	result = object
 	return
 }
 
@@ -101,6 +168,9 @@ func (s *VirtualMachinesServer) List(ctx context.Context,
 	privateRequest.SetOffset(request.GetOffset())
 	privateRequest.SetLimit(request.GetLimit())
 	privateRequest.SetFilter(request.GetFilter())
+	privateRequest.SetOrder(request.GetOrder())
+	privateRequest.SetPageToken(request.GetPageToken())
+	privateRequest.SetSkipTotal(request.GetSkipTotal())
 
 	// Delegate to private server:
 	privateResponse, err := s.private.List(ctx, privateRequest)
@@ -128,8 +198,13 @@ func (s *VirtualMachinesServer) List(ctx context.Context,
 	// Create the public response:
 	response = &ffv1.VirtualMachinesListResponse{}
 	response.SetSize(privateResponse.GetSize())
-	response.SetTotal(privateResponse.GetTotal())
+	if privateResponse.HasTotal() {
+		response.SetTotal(privateResponse.GetTotal())
+	}
 	response.SetItems(publicItems)
+	if privateResponse.HasNextPageToken() {
+		response.SetNextPageToken(privateResponse.GetNextPageToken())
+	}
 	return
 }
 
@@ -222,7 +297,10 @@ func (s *VirtualMachinesServer) Update(ctx context.Context,
 	}
 	id := publicVirtualMachine.GetId()
 	if id == "" {
-		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier is mandatory")
+		id = publicVirtualMachine.GetMetadata().GetName()
+	}
+	if id == "" {
+		err = grpcstatus.Errorf(grpccodes.InvalidArgument, "object identifier or name is mandatory")
 		return
 	}
 
@@ -234,6 +312,7 @@ func (s *VirtualMachinesServer) Update(ctx context.Context,
 		return nil, err
 	}
 	existingPrivateVirtualMachine := getResponse.GetObject()
+	id = existingPrivateVirtualMachine.GetId()
 
 	// Map the public changes to the existing private object (preserving private data):
 	err = s.inMapper.Copy(ctx, publicVirtualMachine, existingPrivateVirtualMachine)
@@ -247,6 +326,10 @@ func (s *VirtualMachinesServer) Update(ctx context.Context,
 		return
 	}
 
+	// The public object may contain the name instead of the identifier, so make sure that the private object has the
+	// real identifier:
+	existingPrivateVirtualMachine.SetId(id)
+
 	// Delegate to the private server with the merged object:
 	privateRequest := &privatev1.VirtualMachinesUpdateRequest{}
 	privateRequest.SetObject(existingPrivateVirtualMachine)
@@ -291,3 +374,500 @@ func (s *VirtualMachinesServer) Delete(ctx context.Context,
 	response = &ffv1.VirtualMachinesDeleteResponse{}
 	return
 }
+
+func (s *VirtualMachinesServer) Start(ctx context.Context,
+	request *ffv1.VirtualMachinesStartRequest) (response *ffv1.VirtualMachinesStartResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachinesStartRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Start(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateVirtualMachine := privateResponse.GetObject()
+	publicVirtualMachine := &ffv1.VirtualMachine{}
+	err = s.outMapper.Copy(ctx, privateVirtualMachine, publicVirtualMachine)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine to public",
+			slog.Any("error", err),
+		)
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine")
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachinesStartResponse{}
+	response.SetObject(publicVirtualMachine)
+	return
+}
+
+func (s *VirtualMachinesServer) Stop(ctx context.Context,
+	request *ffv1.VirtualMachinesStopRequest) (response *ffv1.VirtualMachinesStopResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachinesStopRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Stop(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateVirtualMachine := privateResponse.GetObject()
+	publicVirtualMachine := &ffv1.VirtualMachine{}
+	err = s.outMapper.Copy(ctx, privateVirtualMachine, publicVirtualMachine)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine to public",
+			slog.Any("error", err),
+		)
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine")
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachinesStopResponse{}
+	response.SetObject(publicVirtualMachine)
+	return
+}
+
+func (s *VirtualMachinesServer) Restart(ctx context.Context,
+	request *ffv1.VirtualMachinesRestartRequest) (response *ffv1.VirtualMachinesRestartResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachinesRestartRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Restart(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateVirtualMachine := privateResponse.GetObject()
+	publicVirtualMachine := &ffv1.VirtualMachine{}
+	err = s.outMapper.Copy(ctx, privateVirtualMachine, publicVirtualMachine)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine to public",
+			slog.Any("error", err),
+		)
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine")
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachinesRestartResponse{}
+	response.SetObject(publicVirtualMachine)
+	return
+}
+
+func (s *VirtualMachinesServer) Pause(ctx context.Context,
+	request *ffv1.VirtualMachinesPauseRequest) (response *ffv1.VirtualMachinesPauseResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachinesPauseRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Pause(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateVirtualMachine := privateResponse.GetObject()
+	publicVirtualMachine := &ffv1.VirtualMachine{}
+	err = s.outMapper.Copy(ctx, privateVirtualMachine, publicVirtualMachine)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine to public",
+			slog.Any("error", err),
+		)
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine")
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachinesPauseResponse{}
+	response.SetObject(publicVirtualMachine)
+	return
+}
+
+func (s *VirtualMachinesServer) Unpause(ctx context.Context,
+	request *ffv1.VirtualMachinesUnpauseRequest) (response *ffv1.VirtualMachinesUnpauseResponse, err error) {
+	// Create private request:
+	privateRequest := &privatev1.VirtualMachinesUnpauseRequest{}
+	privateRequest.SetId(request.GetId())
+
+	// Delegate to private server:
+	privateResponse, err := s.private.Unpause(ctx, privateRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map private response to public format:
+	privateVirtualMachine := privateResponse.GetObject()
+	publicVirtualMachine := &ffv1.VirtualMachine{}
+	err = s.outMapper.Copy(ctx, privateVirtualMachine, publicVirtualMachine)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to map private virtual machine to public",
+			slog.Any("error", err),
+		)
+		return nil, grpcstatus.Errorf(grpccodes.Internal, "failed to process virtual machine")
+	}
+
+	// Create the public response:
+	response = &ffv1.VirtualMachinesUnpauseResponse{}
+	response.SetObject(publicVirtualMachine)
+	return
+}
+
+func (s *VirtualMachinesServer) Console(stream ffv1.VirtualMachines_ConsoleServer) error {
+	ctx := stream.Context()
+
+	// The first message must contain the identifier of the virtual machine and the type of console:
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	id := first.GetId()
+	if id == "" {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"virtual machine identifier is mandatory in the first message",
+		)
+	}
+	kind, ok := consoleKinds[first.GetType()]
+	if !ok {
+		return grpcstatus.Errorf(
+			grpccodes.InvalidArgument,
+			"console type '%s' isn't supported",
+			first.GetType(),
+		)
+	}
+
+	// Find the virtual machine instance and connect to its console:
+	target, err := s.findConsoleTarget(ctx, id)
+	if err != nil {
+		return err
+	}
+	target.kind = kind
+	connection, err := s.consoleDialer(ctx, target)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to connect to virtual machine console",
+			slog.String("id", id),
+			slog.String("namespace", target.namespace),
+			slog.String("name", target.name),
+			slog.String("kind", target.kind),
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(
+			grpccodes.Unavailable,
+			"failed to connect to the console of virtual machine '%s', check that it is running",
+			id,
+		)
+	}
+	defer func() {
+		err := connection.Close()
+		if err != nil {
+			s.logger.DebugContext(
+				ctx,
+				"Failed to close virtual machine console",
+				slog.String("id", id),
+				slog.Any("error", err),
+			)
+		}
+	}()
+	s.logger.InfoContext(
+		ctx,
+		"Connected to virtual machine console",
+		slog.String("id", id),
+		slog.String("kind", target.kind),
+	)
+
+	// Send the headers, so that clients like the gateway know that the connection succeeded before they receive any
+	// data:
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	// Copy the data in both directions till one of the sides finishes:
+	err = s.copyConsole(ctx, stream, connection, first.GetData())
+	s.logger.InfoContext(
+		ctx,
+		"Disconnected from virtual machine console",
+		slog.String("id", id),
+		slog.String("kind", target.kind),
+	)
+	return err
+}
+
+// copyConsole copies the data sent by the client to the console, and the data written by the console to the client. It
+// returns when the client closes the stream or when the console is disconnected.
+func (s *VirtualMachinesServer) copyConsole(ctx context.Context, stream ffv1.VirtualMachines_ConsoleServer,
+	connection consoleConnection, initial []byte) error {
+	// Copy from the console to the client:
+	consoleErrs := make(chan error, 1)
+	go func() {
+		for {
+			_, data, err := connection.ReadMessage()
+			if err != nil {
+				consoleErrs <- err
+				return
+			}
+			err = stream.Send(ffv1.VirtualMachinesConsoleResponse_builder{
+				Data: data,
+			}.Build())
+			if err != nil {
+				consoleErrs <- err
+				return
+			}
+		}
+	}()
+
+	// Copy from the client to the console:
+	clientErrs := make(chan error, 1)
+	go func() {
+		data := initial
+		for {
+			if len(data) > 0 {
+				err := connection.WriteMessage(websocket.BinaryMessage, data)
+				if err != nil {
+					clientErrs <- err
+					return
+				}
+			}
+			request, err := stream.Recv()
+			if err != nil {
+				clientErrs <- err
+				return
+			}
+			data = request.GetData()
+		}
+	}()
+
+	// Wait till one of the sides finishes:
+	select {
+	case err := <-clientErrs:
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	case err := <-consoleErrs:
+		if errors.Is(err, io.EOF) || websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil
+		}
+		s.logger.ErrorContext(
+			ctx,
+			"Virtual machine console failed",
+			slog.Any("error", err),
+		)
+		return grpcstatus.Errorf(grpccodes.Unavailable, "connection to the console was lost")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// findConsoleTarget finds the hub and the KubeVirt virtual machine instance that correspond to the virtual machine.
+// Errors returned are already gRPC statuses.
+func (s *VirtualMachinesServer) findConsoleTarget(ctx context.Context, id string) (result *consoleTarget, err error) {
+	// Find the virtual machine and the hub. Note that the virtual machine is retrieved using the private server so
+	// that the tenancy rules are applied.
+	vm, hub, err := s.findVirtualMachineHub(ctx, id)
+	if err != nil {
+		return
+	}
+	id = vm.GetId()
+
+	// Create a client for the hub:
+	internalErr := grpcstatus.Errorf(
+		grpccodes.Internal,
+		"failed to find the console of virtual machine '%s'",
+		id,
+	)
+	config, err := clientcmd.RESTConfigFromKubeConfig(hub.GetKubeconfig())
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to load hub kubeconfig",
+			slog.String("hub", hub.GetId()),
+			slog.Any("error", err),
+		)
+		err = internalErr
+		return
+	}
+	client, err := clnt.New(config, clnt.Options{})
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to create hub client",
+			slog.String("hub", hub.GetId()),
+			slog.Any("error", err),
+		)
+		err = internalErr
+		return
+	}
+
+	// Find the virtual machine object in the hub, as it contains the reference to the KubeVirt virtual machine:
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvks.VirtualMachineList)
+	err = client.List(
+		ctx, list,
+		clnt.InNamespace(hub.GetNamespace()),
+		clnt.MatchingLabels{
+			labels.VirtualMachineUuid: id,
+		},
+	)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to list hub virtual machines",
+			slog.String("hub", hub.GetId()),
+			slog.String("id", id),
+			slog.Any("error", err),
+		)
+		err = internalErr
+		return
+	}
+	var namespace, name string
+	if len(list.Items) == 1 {
+		object := list.Items[0].Object
+		namespace, _, _ = unstructured.NestedString(object, "status", "virtualMachineReference", "namespace")
+		name, _, _ = unstructured.NestedString(object, "status", "virtualMachineReference", "kubeVirtVirtalMachineName")
+	}
+	if namespace == "" || name == "" {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"console of virtual machine '%s' isn't available yet",
+			id,
+		)
+		return
+	}
+
+	result = &consoleTarget{
+		config:    config,
+		namespace: namespace,
+		name:      name,
+	}
+	return
+}
+
+// findVirtualMachineHub finds the virtual machine and the hub where it has been created. Streaming methods don't run
+// inside the transaction created by the interceptor, so this uses its own transaction when there is a transaction
+// manager. Errors returned are already gRPC statuses.
+func (s *VirtualMachinesServer) findVirtualMachineHub(ctx context.Context,
+	id string) (vm *privatev1.VirtualMachine, hub *privatev1.Hub, err error) {
+	if s.txManager != nil {
+		var tx database.Tx
+		tx, err = s.txManager.Begin(ctx)
+		if err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Failed to begin transaction",
+				slog.Any("error", err),
+			)
+			err = grpcstatus.Errorf(grpccodes.Internal, "failed to begin transaction")
+			return
+		}
+		defer func() {
+			txErr := s.txManager.End(ctx, tx)
+			if txErr != nil {
+				s.logger.ErrorContext(
+					ctx,
+					"Failed to end transaction",
+					slog.Any("error", txErr),
+				)
+				if err == nil {
+					err = grpcstatus.Errorf(grpccodes.Internal, "failed to end transaction")
+				}
+			}
+		}()
+		ctx = database.TxIntoContext(ctx, tx)
+	}
+
+	// Get the virtual machine:
+	getRequest := &privatev1.VirtualMachinesGetRequest{}
+	getRequest.SetId(id)
+	getResponse, err := s.private.Get(ctx, getRequest)
+	if err != nil {
+		return
+	}
+	vm = getResponse.GetObject()
+	hubId := vm.GetStatus().GetHub()
+	if hubId == "" {
+		err = grpcstatus.Errorf(
+			grpccodes.FailedPrecondition,
+			"virtual machine '%s' hasn't been assigned to a hub yet",
+			id,
+		)
+		return
+	}
+
+	// Get the hub:
+	hub, err = s.hubsDao.Get(ctx, hubId)
+	if err != nil || hub == nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Failed to get hub",
+			slog.String("hub", hubId),
+			slog.Any("error", err),
+		)
+		err = grpcstatus.Errorf(
+			grpccodes.Internal,
+			"failed to find the hub of virtual machine '%s'",
+			id,
+		)
+		return
+	}
+	return
+}
+
+// dialConsole opens a WebSocket connection to the console subresource of the KubeVirt virtual machine instance.
+func (s *VirtualMachinesServer) dialConsole(ctx context.Context, target *consoleTarget) (result consoleConnection,
+	err error) {
+	roundTripper, holder, err := k8swebsocket.RoundTripperFor(target.config)
+	if err != nil {
+		return
+	}
+	address, err := url.JoinPath(
+		target.config.Host,
+		"apis", "subresources.kubevirt.io", "v1",
+		"namespaces", target.namespace,
+		"virtualmachineinstances", target.name,
+		target.kind,
+	)
+	if err != nil {
+		return
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return
+	}
+	result, err = k8swebsocket.Negotiate(roundTripper, holder, request, consoleSubprotocol)
+	return
+}
+
+// consoleKinds contains the names of the KubeVirt subresources that correspond to the console types.
+var consoleKinds = map[ffv1.VirtualMachineConsoleType]string{
+	ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_UNSPECIFIED: "console",
+	ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_SERIAL:      "console",
+	ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_VNC:         "vnc",
+}
+
+// consoleSubprotocol is the WebSocket subprotocol used by KubeVirt for the console subresources, where the data is sent
+// as is in binary messages.
+const consoleSubprotocol = "plain.kubevirt.io"
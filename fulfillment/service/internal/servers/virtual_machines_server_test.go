@@ -16,10 +16,14 @@ package servers
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -31,6 +35,31 @@ import (
 	"github.com/jkary/osac/fulfillment/service/internal/database/dao"
 )
 
+// consoleStream is a fake console stream that returns the given requests and then EOF.
+type consoleStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*ffv1.VirtualMachinesConsoleRequest
+}
+
+func (s *consoleStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *consoleStream) Recv() (result *ffv1.VirtualMachinesConsoleRequest, err error) {
+	if len(s.requests) == 0 {
+		err = io.EOF
+		return
+	}
+	result = s.requests[0]
+	s.requests = s.requests[1:]
+	return
+}
+
+func (s *consoleStream) Send(*ffv1.VirtualMachinesConsoleResponse) error {
+	return nil
+}
+
 var _ = Describe("Virtual machines server", func() {
 	var (
 		ctx context.Context
@@ -77,6 +106,10 @@ var _ = Describe("Virtual machines server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -87,6 +120,10 @@ var _ = Describe("Virtual machines server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 
@@ -97,6 +134,10 @@ var _ = Describe("Virtual machines server", func() {
 				finalizers text[] not null default '{}',
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 1,
 				data jsonb not null
 			);
 
@@ -107,6 +148,10 @@ var _ = Describe("Virtual machines server", func() {
 				archival_timestamp timestamp with time zone not null default now(),
 				creators text[] not null default '{}',
 				tenants text[] not null default '{}',
+				name text not null default '',
+				labels jsonb not null default '{}',
+				annotations jsonb not null default '{}',
+				version bigint not null default 0,
 				data jsonb not null
 			);
 			`,
@@ -463,6 +508,136 @@ var _ = Describe("Virtual machines server", func() {
 			Expect(getResponse).To(BeNil())
 		})
 
+		It("Stops, starts and restarts object", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachinesCreateRequest_builder{
+				Object: ffv1.VirtualMachine_builder{
+					Spec: ffv1.VirtualMachineSpec_builder{
+						Template: "general.small",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Stop the object:
+			stopResponse, err := server.Stop(ctx, ffv1.VirtualMachinesStopRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stopResponse.GetObject().GetSpec().GetRunStrategy()).To(Equal(
+				ffv1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_HALTED,
+			))
+
+			// Start the object:
+			startResponse, err := server.Start(ctx, ffv1.VirtualMachinesStartRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(startResponse.GetObject().GetSpec().GetRunStrategy()).To(Equal(
+				ffv1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+			))
+
+			// Restart the object:
+			restartResponse, err := server.Restart(ctx, ffv1.VirtualMachinesRestartRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restartResponse.GetObject().GetSpec().HasRestartRequestTime()).To(BeTrue())
+
+			// Check that the changes have been saved:
+			getResponse, err := server.Get(ctx, ffv1.VirtualMachinesGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			object := getResponse.GetObject()
+			Expect(object.GetSpec().GetRunStrategy()).To(Equal(
+				ffv1.VirtualMachineRunStrategy_VIRTUAL_MACHINE_RUN_STRATEGY_ALWAYS,
+			))
+			Expect(object.GetSpec().HasRestartRequestTime()).To(BeTrue())
+		})
+
+		It("Pauses and unpauses object", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachinesCreateRequest_builder{
+				Object: ffv1.VirtualMachine_builder{
+					Spec: ffv1.VirtualMachineSpec_builder{
+						Template: "general.small",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			id := createResponse.GetObject().GetId()
+
+			// Pause the object:
+			pauseResponse, err := server.Pause(ctx, ffv1.VirtualMachinesPauseRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pauseResponse.GetObject().GetSpec().GetPaused()).To(BeTrue())
+
+			// Unpause the object:
+			unpauseResponse, err := server.Unpause(ctx, ffv1.VirtualMachinesUnpauseRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unpauseResponse.GetObject().GetSpec().GetPaused()).To(BeFalse())
+
+			// Check that the changes have been saved:
+			getResponse, err := server.Get(ctx, ffv1.VirtualMachinesGetRequest_builder{
+				Id: id,
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResponse.GetObject().GetSpec().GetPaused()).To(BeFalse())
+		})
+
+		It("Doesn't connect to console without identifier", func() {
+			err := server.Console(&consoleStream{
+				ctx: ctx,
+				requests: []*ffv1.VirtualMachinesConsoleRequest{
+					ffv1.VirtualMachinesConsoleRequest_builder{}.Build(),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(grpcstatus.Code(err)).To(Equal(grpccodes.InvalidArgument))
+		})
+
+		It("Doesn't connect to console of object that doesn't exist", func() {
+			err := server.Console(&consoleStream{
+				ctx: ctx,
+				requests: []*ffv1.VirtualMachinesConsoleRequest{
+					ffv1.VirtualMachinesConsoleRequest_builder{
+						Id: "does-not-exist",
+					}.Build(),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(grpcstatus.Code(err)).To(Equal(grpccodes.NotFound))
+		})
+
+		It("Doesn't connect to console of object without hub", func() {
+			createTemplate("general.small")
+			createResponse, err := server.Create(ctx, ffv1.VirtualMachinesCreateRequest_builder{
+				Object: ffv1.VirtualMachine_builder{
+					Spec: ffv1.VirtualMachineSpec_builder{
+						Template: "general.small",
+					}.Build(),
+				}.Build(),
+			}.Build())
+			Expect(err).ToNot(HaveOccurred())
+			err = server.Console(&consoleStream{
+				ctx: ctx,
+				requests: []*ffv1.VirtualMachinesConsoleRequest{
+					ffv1.VirtualMachinesConsoleRequest_builder{
+						Id:   createResponse.GetObject().GetId(),
+						Type: ffv1.VirtualMachineConsoleType_VIRTUAL_MACHINE_CONSOLE_TYPE_SERIAL,
+					}.Build(),
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(grpcstatus.Code(err)).To(Equal(grpccodes.FailedPrecondition))
+		})
+
 		It("Handles non-existent object", func() {
 			// Try to get a non-existent object:
 			getResponse, err := server.Get(ctx, ffv1.VirtualMachinesGetRequest_builder{
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+// Package tracing contains the support for OpenTelemetry distributed tracing.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the instrumentation scope of the spans created by the service.
+const InstrumentationName = "github.com/jkary/osac/fulfillment/service"
+
+// Tracer returns the tracer that should be used to create spans. It uses the global tracer provider, so it can be
+// called before the provider is configured.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Inject returns the trace context of the given context as a map, so that it can be stored together with data that
+// will be processed later, for example in the payload of an event. It returns nil if the context doesn't contain a
+// valid span.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Link returns a link to the span described by a trace context previously generated with the Inject function. The
+// boolean result will be false if the trace context doesn't contain a valid span.
+func Link(carrier map[string]string) (result trace.Link, ok bool) {
+	if len(carrier) == 0 {
+		return
+	}
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(carrier))
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return
+	}
+	result = trace.Link{
+		SpanContext: spanContext,
+	}
+	ok = true
+	return
+}
+
+// Transport wraps the given HTTP transport so that it creates a span for each request and propagates the trace
+// context to the server. It is intended for the clients of the hubs, for example:
+//
+//	config.Wrap(tracing.Transport)
+func Transport(transport http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(transport)
+}
+
+// Handler wraps the given HTTP handler so that it creates a span for each request, continuing the trace started by
+// the client if there is one.
+func Handler(handler http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(handler, operation)
+}
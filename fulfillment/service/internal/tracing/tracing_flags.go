@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+)
+
+// AddFlags adds to the given flag set the flags needed to configure tracing. For example:
+//
+//	tracing.AddFlags(flags)
+//
+// Will add the following flags:
+//
+//	--tracing-exporter string Where to send traces. (default "none")
+//	--tracing-otlp-endpoint string Address of the OTLP collector. (default "localhost:4317")
+//	--tracing-otlp-plaintext Don't use TLS to connect to the OTLP collector.
+//	--tracing-file string File where traces are written.
+//	--tracing-sample-ratio float Fraction of traces that are recorded. (default 1)
+func AddFlags(flags *pflag.FlagSet) {
+	_ = flags.String(
+		exporterFlagName,
+		NoneExporter,
+		fmt.Sprintf(
+			"Where to send traces. Valid values are \"%s\" to disable tracing, \"%s\" to send them to an "+
+				"OTLP collector using gRPC and \"%s\" to write them to a file.",
+			NoneExporter, OtlpExporter, FileExporter,
+		),
+	)
+	_ = flags.String(
+		otlpEndpointFlagName,
+		DefaultOtlpEndpoint,
+		"Address of the OTLP collector.",
+	)
+	_ = flags.Bool(
+		otlpPlaintextFlagName,
+		false,
+		"Don't use TLS to connect to the OTLP collector.",
+	)
+	_ = flags.String(
+		fileFlagName,
+		"",
+		"File where traces are written, one JSON document per span. This is mandatory when the exporter is "+
+			"\"file\".",
+	)
+	_ = flags.Float64(
+		sampleRatioFlagName,
+		1.0,
+		"Fraction of traces that are recorded, from zero to one. Traces that are part of a trace started by "+
+			"other component are recorded if that component recorded them.",
+	)
+}
+
+// Names of the flags:
+const (
+	exporterFlagName      = "tracing-exporter"
+	otlpEndpointFlagName  = "tracing-otlp-endpoint"
+	otlpPlaintextFlagName = "tracing-otlp-plaintext"
+	fileFlagName          = "tracing-file"
+	sampleRatioFlagName   = "tracing-sample-ratio"
+)
+
+// Types of exporters:
+const (
+	NoneExporter = "none"
+	OtlpExporter = "otlp"
+	FileExporter = "file"
+)
+
+// DefaultOtlpEndpoint is the default address of the OTLP collector, the one used by a collector running locally.
+const DefaultOtlpEndpoint = "localhost:4317"
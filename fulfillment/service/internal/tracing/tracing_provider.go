@@ -0,0 +1,302 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ProviderBuilder contains the data and logic needed to create a tracing provider. Don't create instances of this type
+// directly, use the NewProvider function instead.
+type ProviderBuilder struct {
+	logger        *slog.Logger
+	serviceName   string
+	exporter      string
+	otlpEndpoint  string
+	otlpPlaintext bool
+	file          string
+	sampleRatio   float64
+}
+
+// Provider configures the global OpenTelemetry tracer provider and propagator, so that the rest of the code, and the
+// instrumentation libraries, can create spans with the Tracer function and with the otel.GetTracerProvider function.
+//
+// The trace context is always propagated, even if the exporter is `none`, so that a component that doesn't record
+// traces doesn't break the traces of the components that call it or that it calls.
+type Provider struct {
+	logger   *slog.Logger
+	provider *sdktrace.TracerProvider
+	closer   io.Closer
+}
+
+// NewProvider creates a builder that can then be used to configure and create a tracing provider.
+func NewProvider() *ProviderBuilder {
+	return &ProviderBuilder{
+		exporter:     NoneExporter,
+		otlpEndpoint: DefaultOtlpEndpoint,
+		sampleRatio:  1.0,
+	}
+}
+
+// SetLogger sets the logger. This is mandatory.
+func (b *ProviderBuilder) SetLogger(value *slog.Logger) *ProviderBuilder {
+	b.logger = value
+	return b
+}
+
+// SetServiceName sets the name of the service that will be added to the spans, for example `fulfillment-server`.
+// This is mandatory.
+func (b *ProviderBuilder) SetServiceName(value string) *ProviderBuilder {
+	b.serviceName = value
+	return b
+}
+
+// SetExporter sets the type of exporter. Valid values are `none`, `otlp` and `file`. This is optional, and the
+// default is `none`.
+func (b *ProviderBuilder) SetExporter(value string) *ProviderBuilder {
+	b.exporter = value
+	return b
+}
+
+// SetOtlpEndpoint sets the address of the OTLP collector. This is optional, and the default is `localhost:4317`.
+func (b *ProviderBuilder) SetOtlpEndpoint(value string) *ProviderBuilder {
+	b.otlpEndpoint = value
+	return b
+}
+
+// SetOtlpPlaintext sets a flag that indicates that the connection to the OTLP collector shouldn't use TLS. This is
+// optional, and the default is to use TLS.
+func (b *ProviderBuilder) SetOtlpPlaintext(value bool) *ProviderBuilder {
+	b.otlpPlaintext = value
+	return b
+}
+
+// SetFile sets the file where the spans will be written when the exporter is `file`.
+func (b *ProviderBuilder) SetFile(value string) *ProviderBuilder {
+	b.file = value
+	return b
+}
+
+// SetSampleRatio sets the fraction of traces that will be recorded. This is optional, and the default is one, which
+// means that all traces are recorded.
+func (b *ProviderBuilder) SetSampleRatio(value float64) *ProviderBuilder {
+	b.sampleRatio = value
+	return b
+}
+
+// SetFlags sets the command line flags that should be used to configure the provider. This is optional.
+func (b *ProviderBuilder) SetFlags(flags *pflag.FlagSet) *ProviderBuilder {
+	if flags == nil {
+		return b
+	}
+
+	var (
+		flag string
+		err  error
+	)
+	failure := func() {
+		b.logger.Error(
+			"Failed to get flag value",
+			slog.String("flag", flag),
+			slog.Any("error", err),
+		)
+	}
+
+	// Exporter:
+	flag = exporterFlagName
+	exporterValue, err := flags.GetString(flag)
+	if err != nil {
+		failure()
+	} else {
+		b.SetExporter(exporterValue)
+	}
+
+	// OTLP endpoint:
+	flag = otlpEndpointFlagName
+	otlpEndpointValue, err := flags.GetString(flag)
+	if err != nil {
+		failure()
+	} else {
+		b.SetOtlpEndpoint(otlpEndpointValue)
+	}
+
+	// OTLP plaintext:
+	flag = otlpPlaintextFlagName
+	otlpPlaintextValue, err := flags.GetBool(flag)
+	if err != nil {
+		failure()
+	} else {
+		b.SetOtlpPlaintext(otlpPlaintextValue)
+	}
+
+	// File:
+	flag = fileFlagName
+	fileValue, err := flags.GetString(flag)
+	if err != nil {
+		failure()
+	} else {
+		b.SetFile(fileValue)
+	}
+
+	// Sample ratio:
+	flag = sampleRatioFlagName
+	sampleRatioValue, err := flags.GetFloat64(flag)
+	if err != nil {
+		failure()
+	} else {
+		b.SetSampleRatio(sampleRatioValue)
+	}
+
+	return b
+}
+
+// Build uses the data stored in the builder to create a new tracing provider, and configures it as the global
+// OpenTelemetry provider.
+func (b *ProviderBuilder) Build() (result *Provider, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.serviceName == "" {
+		err = errors.New("service name is mandatory")
+		return
+	}
+	if b.sampleRatio < 0 || b.sampleRatio > 1 {
+		err = fmt.Errorf("sample ratio should be between zero and one, but it is %g", b.sampleRatio)
+		return
+	}
+
+	// Create the exporter. Note that the OTLP exporter doesn't connect to the collector till spans are sent, so the
+	// context used here doesn't need to have a deadline.
+	ctx := context.Background()
+	var (
+		exporter sdktrace.SpanExporter
+		closer   io.Closer
+	)
+	switch strings.ToLower(b.exporter) {
+	case NoneExporter:
+	case OtlpExporter:
+		if b.otlpEndpoint == "" {
+			err = errors.New("OTLP endpoint is mandatory")
+			return
+		}
+		options := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(b.otlpEndpoint),
+		}
+		if b.otlpPlaintext {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, options...)
+		if err != nil {
+			err = fmt.Errorf("failed to create OTLP exporter: %w", err)
+			return
+		}
+	case FileExporter:
+		if b.file == "" {
+			err = errors.New("file is mandatory when the exporter is 'file'")
+			return
+		}
+		var file *os.File
+		file, err = os.OpenFile(b.file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			err = fmt.Errorf("failed to open traces file '%s': %w", b.file, err)
+			return
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			err = fmt.Errorf("failed to create file exporter: %w", err)
+			return
+		}
+		closer = file
+	default:
+		err = fmt.Errorf(
+			"unknown exporter '%s', valid values are '%s', '%s' and '%s'",
+			b.exporter, NoneExporter, OtlpExporter, FileExporter,
+		)
+		return
+	}
+
+	// Always propagate the trace context:
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	// Create and populate the object:
+	result = &Provider{
+		logger: b.logger,
+		closer: closer,
+	}
+	if exporter == nil {
+		b.logger.InfoContext(ctx, "Tracing is disabled")
+		return
+	}
+	res, err := resource.New(
+		ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			attribute.String("service.name", b.serviceName),
+		),
+	)
+	if err != nil {
+		err = fmt.Errorf("failed to create resource: %w", err)
+		return
+	}
+	result.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(b.sampleRatio))),
+	)
+	otel.SetTracerProvider(result.provider)
+	b.logger.InfoContext(
+		ctx,
+		"Tracing is enabled",
+		slog.String("service", b.serviceName),
+		slog.String("exporter", b.exporter),
+		slog.Float64("ratio", b.sampleRatio),
+	)
+	return
+}
+
+// Shutdown sends the spans that haven't been sent yet and releases the resources used by the provider.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p.provider != nil {
+		err := p.provider.Shutdown(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if p.closer != nil {
+		return p.closer.Close()
+	}
+	return nil
+}
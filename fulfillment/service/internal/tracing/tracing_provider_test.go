@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+)
+
+var _ = Describe("Provider", func() {
+	It("Can't be created without a logger", func() {
+		provider, err := NewProvider().
+			SetServiceName("my-service").
+			Build()
+		Expect(err).To(MatchError("logger is mandatory"))
+		Expect(provider).To(BeNil())
+	})
+
+	It("Can't be created without a service name", func() {
+		provider, err := NewProvider().
+			SetLogger(logger).
+			Build()
+		Expect(err).To(MatchError("service name is mandatory"))
+		Expect(provider).To(BeNil())
+	})
+
+	It("Can't be created with an unknown exporter", func() {
+		provider, err := NewProvider().
+			SetLogger(logger).
+			SetServiceName("my-service").
+			SetExporter("junk").
+			Build()
+		Expect(err).To(MatchError(ContainSubstring("unknown exporter 'junk'")))
+		Expect(provider).To(BeNil())
+	})
+
+	It("Can't be created with an invalid sample ratio", func() {
+		provider, err := NewProvider().
+			SetLogger(logger).
+			SetServiceName("my-service").
+			SetSampleRatio(2).
+			Build()
+		Expect(err).To(MatchError("sample ratio should be between zero and one, but it is 2"))
+		Expect(provider).To(BeNil())
+	})
+
+	It("Can't be created with the file exporter and no file", func() {
+		provider, err := NewProvider().
+			SetLogger(logger).
+			SetServiceName("my-service").
+			SetExporter(FileExporter).
+			Build()
+		Expect(err).To(MatchError("file is mandatory when the exporter is 'file'"))
+		Expect(provider).To(BeNil())
+	})
+
+	It("Writes spans to the file", func() {
+		ctx := context.Background()
+		file := filepath.Join(GinkgoT().TempDir(), "traces.json")
+		previous := otel.GetTracerProvider()
+		DeferCleanup(func() {
+			otel.SetTracerProvider(previous)
+		})
+		provider, err := NewProvider().
+			SetLogger(logger).
+			SetServiceName("my-service").
+			SetExporter(FileExporter).
+			SetFile(file).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		// Create a span and shutdown the provider, so that it is written:
+		_, span := Tracer().Start(ctx, "my-span")
+		span.End()
+		err = provider.Shutdown(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Check the content of the file:
+		data, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"Name":"my-span"`))
+		Expect(string(data)).To(ContainSubstring(`"Value":"my-service"`))
+	})
+})
@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Trace context", func() {
+	BeforeEach(func() {
+		// Building the provider configures the propagator even if tracing is disabled:
+		_, err := NewProvider().
+			SetLogger(logger).
+			SetServiceName("my-service").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Returns nil if there is no span", func() {
+		Expect(Inject(context.Background())).To(BeNil())
+	})
+
+	It("Returns no link for an empty trace context", func() {
+		_, ok := Link(nil)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Returns no link for an invalid trace context", func() {
+		_, ok := Link(map[string]string{
+			"traceparent": "junk",
+		})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Links to the span that injected the trace context", func() {
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3},
+			SpanID:     trace.SpanID{4, 5, 6},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+		carrier := Inject(ctx)
+		Expect(carrier).To(HaveKey("traceparent"))
+		link, ok := Link(carrier)
+		Expect(ok).To(BeTrue())
+		Expect(link.SpanContext.TraceID()).To(Equal(spanContext.TraceID()))
+		Expect(link.SpanContext.SpanID()).To(Equal(spanContext.SpanID()))
+	})
+})
@@ -33,6 +33,7 @@ func main() {
 		SetIn(os.Stdin).
 		SetOut(os.Stdout).
 		SetErr(os.Stderr).
+		AddCommand(cmd.NewDbCommand).
 		AddCommand(cmd.NewDevCommand).
 		AddCommand(cmd.NewStartCommand).
 		AddCommand(cmd.NewVersionCommand).
@@ -40,6 +40,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -62,6 +63,7 @@ func init() {
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 	utilruntime.Must(hypershiftv1beta1.AddToScheme(scheme))
 	utilruntime.Must(kubevirtv1.AddToScheme(scheme))
+	utilruntime.Must(snapshotv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -242,6 +244,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	kubeVirtSubresources, err := controller.NewKubeVirtSubresources(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create KubeVirt subresources client")
+		os.Exit(1)
+	}
+
 	if err = (controller.NewVirtualMachineReconciler(
 		mgr.GetClient(),
 		mgr.GetScheme(),
@@ -249,11 +257,21 @@ func main() {
 		os.Getenv("CLOUDKIT_VM_DELETE_WEBHOOK"),
 		os.Getenv("CLOUDKIT_VM_ORDER_NAMESPACE"),
 		interval,
+		kubeVirtSubresources,
 	)).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "VirtualMachine")
 		os.Exit(1)
 	}
 
+	if err = (controller.NewVirtualMachineSnapshotReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		os.Getenv("CLOUDKIT_VM_ORDER_NAMESPACE"),
+	)).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "VirtualMachineSnapshot")
+		os.Exit(1)
+	}
+
 	// Create the VirtualMachine feedback reconciler if gRPC connection is available:
 	if grpcConn != nil {
 		if err = (controller.NewVirtualMachineFeedbackReconciler(
@@ -268,6 +286,18 @@ func main() {
 			)
 			os.Exit(1)
 		}
+		if err = (controller.NewVirtualMachineSnapshotFeedbackReconciler(
+			mgr.GetClient(),
+			grpcConn,
+			os.Getenv("CLOUDKIT_VM_ORDER_NAMESPACE"),
+		)).SetupWithManager(mgr); err != nil {
+			setupLog.Error(
+				err,
+				"unable to create virtualmachinesnapshot feedback controller",
+				"controller", "VirtualMachineSnapshotFeedback",
+			)
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
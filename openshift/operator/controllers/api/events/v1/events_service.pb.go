@@ -51,7 +51,26 @@ type EventsWatchRequest struct {
 	//
 	// If this isn't provided, or if the value is empty, then all the events that the user has permission to see will be
 	// sent by the server.
-	Filter        *string `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Filter *string `protobuf:"bytes,1,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Greatest sequence number of the events received by the client, or the last bookmark received.
+	//
+	// If this is provided the server will first send the events that were committed after that one and that are still
+	// retained, including events with lower sequence numbers whose transactions were still running when that event was
+	// generated. Clients may therefore receive again some events that they already processed. After that the server will
+	// continue sending new events as they happen. If some of the requested events are no longer retained the server will
+	// return an `OUT_OF_RANGE` error. When that happens the client should retrieve the current state of the objects, for
+	// example using the list methods, and then start watching again without this parameter.
+	//
+	// If this isn't provided then the server will only send events that happen after the request.
+	Since *int64 `protobuf:"varint,2,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Request bookmark responses.
+	//
+	// If this is `true` the server will periodically send responses that don't contain an event, only the `bookmark`
+	// field with the sequence number of the last event that it has processed for this request, including events that
+	// weren't sent because they didn't match the filter or because the user doesn't have permission to see them. Clients
+	// should use that value in the `since` parameter when they connect again, so that they don't have to replay events
+	// that they would discard anyway.
+	Bookmarks     *bool `protobuf:"varint,3,opt,name=bookmarks,proto3,oneof" json:"bookmarks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -88,10 +107,32 @@ func (x *EventsWatchRequest) GetFilter() string {
 	return ""
 }
 
+func (x *EventsWatchRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+func (x *EventsWatchRequest) GetBookmarks() bool {
+	if x != nil && x.Bookmarks != nil {
+		return *x.Bookmarks
+	}
+	return false
+}
+
 func (x *EventsWatchRequest) SetFilter(v string) {
 	x.Filter = &v
 }
 
+func (x *EventsWatchRequest) SetSince(v int64) {
+	x.Since = &v
+}
+
+func (x *EventsWatchRequest) SetBookmarks(v bool) {
+	x.Bookmarks = &v
+}
+
 func (x *EventsWatchRequest) HasFilter() bool {
 	if x == nil {
 		return false
@@ -99,10 +140,32 @@ func (x *EventsWatchRequest) HasFilter() bool {
 	return x.Filter != nil
 }
 
+func (x *EventsWatchRequest) HasSince() bool {
+	if x == nil {
+		return false
+	}
+	return x.Since != nil
+}
+
+func (x *EventsWatchRequest) HasBookmarks() bool {
+	if x == nil {
+		return false
+	}
+	return x.Bookmarks != nil
+}
+
 func (x *EventsWatchRequest) ClearFilter() {
 	x.Filter = nil
 }
 
+func (x *EventsWatchRequest) ClearSince() {
+	x.Since = nil
+}
+
+func (x *EventsWatchRequest) ClearBookmarks() {
+	x.Bookmarks = nil
+}
+
 type EventsWatchRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
@@ -120,6 +183,25 @@ type EventsWatchRequest_builder struct {
 	// If this isn't provided, or if the value is empty, then all the events that the user has permission to see will be
 	// sent by the server.
 	Filter *string
+	// Greatest sequence number of the events received by the client, or the last bookmark received.
+	//
+	// If this is provided the server will first send the events that were committed after that one and that are still
+	// retained, including events with lower sequence numbers whose transactions were still running when that event was
+	// generated. Clients may therefore receive again some events that they already processed. After that the server will
+	// continue sending new events as they happen. If some of the requested events are no longer retained the server will
+	// return an `OUT_OF_RANGE` error. When that happens the client should retrieve the current state of the objects, for
+	// example using the list methods, and then start watching again without this parameter.
+	//
+	// If this isn't provided then the server will only send events that happen after the request.
+	Since *int64
+	// Request bookmark responses.
+	//
+	// If this is `true` the server will periodically send responses that don't contain an event, only the `bookmark`
+	// field with the sequence number of the last event that it has processed for this request, including events that
+	// weren't sent because they didn't match the filter or because the user doesn't have permission to see them. Clients
+	// should use that value in the `since` parameter when they connect again, so that they don't have to replay events
+	// that they would discard anyway.
+	Bookmarks *bool
 }
 
 func (b0 EventsWatchRequest_builder) Build() *EventsWatchRequest {
@@ -127,12 +209,18 @@ func (b0 EventsWatchRequest_builder) Build() *EventsWatchRequest {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Filter = b.Filter
+	x.Since = b.Since
+	x.Bookmarks = b.Bookmarks
 	return m0
 }
 
 type EventsWatchResponse struct {
-	state         protoimpl.MessageState `protogen:"hybrid.v1"`
-	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	state protoimpl.MessageState `protogen:"hybrid.v1"`
+	// Event that happened. This will be absent in bookmark responses.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Sequence number of the last event processed by the server for this request. This is only sent when bookmarks
+	// have been requested.
+	Bookmark      *int64 `protobuf:"varint,2,opt,name=bookmark,proto3,oneof" json:"bookmark,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,10 +257,21 @@ func (x *EventsWatchResponse) GetEvent() *Event {
 	return nil
 }
 
+func (x *EventsWatchResponse) GetBookmark() int64 {
+	if x != nil && x.Bookmark != nil {
+		return *x.Bookmark
+	}
+	return 0
+}
+
 func (x *EventsWatchResponse) SetEvent(v *Event) {
 	x.Event = v
 }
 
+func (x *EventsWatchResponse) SetBookmark(v int64) {
+	x.Bookmark = &v
+}
+
 func (x *EventsWatchResponse) HasEvent() bool {
 	if x == nil {
 		return false
@@ -180,14 +279,29 @@ func (x *EventsWatchResponse) HasEvent() bool {
 	return x.Event != nil
 }
 
+func (x *EventsWatchResponse) HasBookmark() bool {
+	if x == nil {
+		return false
+	}
+	return x.Bookmark != nil
+}
+
 func (x *EventsWatchResponse) ClearEvent() {
 	x.Event = nil
 }
 
+func (x *EventsWatchResponse) ClearBookmark() {
+	x.Bookmark = nil
+}
+
 type EventsWatchResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	// Event that happened. This will be absent in bookmark responses.
 	Event *Event
+	// Sequence number of the last event processed by the server for this request. This is only sent when bookmarks
+	// have been requested.
+	Bookmark *int64
 }
 
 func (b0 EventsWatchResponse_builder) Build() *EventsWatchResponse {
@@ -195,6 +309,7 @@ func (b0 EventsWatchResponse_builder) Build() *EventsWatchResponse {
 	b, x := &b0, m0
 	_, _ = b, x
 	x.Event = b.Event
+	x.Bookmark = b.Bookmark
 	return m0
 }
 
@@ -207,33 +322,42 @@ var file_events_v1_events_service_proto_rawDesc = string([]byte{
 	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x32, 0x71, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x05,
-	0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x30, 0x01, 0x42, 0xae, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x6e, 0x61, 0x62, 0x6f,
-	0x78, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x6b, 0x69, 0x74, 0x2d, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x62, 0x6f, 0x6f,
+	0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x32, 0x71, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x67, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x42, 0xae, 0x01, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
+	0x6e, 0x6e, 0x61, 0x62, 0x6f, 0x78, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x6b, 0x69, 0x74, 0x2d,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 })
 
 var file_events_v1_events_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
@@ -260,6 +384,7 @@ func file_events_v1_events_service_proto_init() {
 	}
 	file_events_v1_event_type_proto_init()
 	file_events_v1_events_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_events_v1_events_service_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,315 @@
+//
+// Copyright (c) 2025 Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with
+// the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on
+// an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations under the License.
+//
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: fulfillment/v1/virtual_machine_snapshots_service.proto
+
+package fulfillmentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VirtualMachineSnapshots_List_FullMethodName    = "/fulfillment.v1.VirtualMachineSnapshots/List"
+	VirtualMachineSnapshots_Get_FullMethodName     = "/fulfillment.v1.VirtualMachineSnapshots/Get"
+	VirtualMachineSnapshots_Create_FullMethodName  = "/fulfillment.v1.VirtualMachineSnapshots/Create"
+	VirtualMachineSnapshots_Delete_FullMethodName  = "/fulfillment.v1.VirtualMachineSnapshots/Delete"
+	VirtualMachineSnapshots_Restore_FullMethodName = "/fulfillment.v1.VirtualMachineSnapshots/Restore"
+)
+
+// VirtualMachineSnapshotsClient is the client API for VirtualMachineSnapshots service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VirtualMachineSnapshotsClient interface {
+	// Retrieves the list of virtual machine snapshots.
+	List(ctx context.Context, in *VirtualMachineSnapshotsListRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListResponse, error)
+	// Retrieves the details of one specific virtual machine snapshot.
+	Get(ctx context.Context, in *VirtualMachineSnapshotsGetRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetResponse, error)
+	// Creates a new snapshot of a virtual machine.
+	//
+	// The `spec.virtual_machine` field must contain the identifier of the virtual machine. The snapshot will be taken
+	// asynchronously, check the `status.state` field to find out when it is ready.
+	Create(ctx context.Context, in *VirtualMachineSnapshotsCreateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsCreateResponse, error)
+	// Deletes a virtual machine snapshot.
+	//
+	// Snapshots are also deleted automatically when the virtual machine is deleted.
+	Delete(ctx context.Context, in *VirtualMachineSnapshotsDeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsDeleteResponse, error)
+	// Reverts the virtual machine to the state captured by the snapshot.
+	//
+	// This changes the `spec.restore_request_time` field of the snapshot to the current time. The snapshot must be
+	// ready. The virtual machine will be stopped, reverted and started again asynchronously, check the
+	// `status.restore_state` field of the snapshot to find out when it has finished.
+	Restore(ctx context.Context, in *VirtualMachineSnapshotsRestoreRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsRestoreResponse, error)
+}
+
+type virtualMachineSnapshotsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVirtualMachineSnapshotsClient(cc grpc.ClientConnInterface) VirtualMachineSnapshotsClient {
+	return &virtualMachineSnapshotsClient{cc}
+}
+
+func (c *virtualMachineSnapshotsClient) List(ctx context.Context, in *VirtualMachineSnapshotsListRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsListResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Get(ctx context.Context, in *VirtualMachineSnapshotsGetRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsGetResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Create(ctx context.Context, in *VirtualMachineSnapshotsCreateRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsCreateResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Delete(ctx context.Context, in *VirtualMachineSnapshotsDeleteRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsDeleteResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachineSnapshotsClient) Restore(ctx context.Context, in *VirtualMachineSnapshotsRestoreRequest, opts ...grpc.CallOption) (*VirtualMachineSnapshotsRestoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachineSnapshotsRestoreResponse)
+	err := c.cc.Invoke(ctx, VirtualMachineSnapshots_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VirtualMachineSnapshotsServer is the server API for VirtualMachineSnapshots service.
+// All implementations must embed UnimplementedVirtualMachineSnapshotsServer
+// for forward compatibility.
+type VirtualMachineSnapshotsServer interface {
+	// Retrieves the list of virtual machine snapshots.
+	List(context.Context, *VirtualMachineSnapshotsListRequest) (*VirtualMachineSnapshotsListResponse, error)
+	// Retrieves the details of one specific virtual machine snapshot.
+	Get(context.Context, *VirtualMachineSnapshotsGetRequest) (*VirtualMachineSnapshotsGetResponse, error)
+	// Creates a new snapshot of a virtual machine.
+	//
+	// The `spec.virtual_machine` field must contain the identifier of the virtual machine. The snapshot will be taken
+	// asynchronously, check the `status.state` field to find out when it is ready.
+	Create(context.Context, *VirtualMachineSnapshotsCreateRequest) (*VirtualMachineSnapshotsCreateResponse, error)
+	// Deletes a virtual machine snapshot.
+	//
+	// Snapshots are also deleted automatically when the virtual machine is deleted.
+	Delete(context.Context, *VirtualMachineSnapshotsDeleteRequest) (*VirtualMachineSnapshotsDeleteResponse, error)
+	// Reverts the virtual machine to the state captured by the snapshot.
+	//
+	// This changes the `spec.restore_request_time` field of the snapshot to the current time. The snapshot must be
+	// ready. The virtual machine will be stopped, reverted and started again asynchronously, check the
+	// `status.restore_state` field of the snapshot to find out when it has finished.
+	Restore(context.Context, *VirtualMachineSnapshotsRestoreRequest) (*VirtualMachineSnapshotsRestoreResponse, error)
+	mustEmbedUnimplementedVirtualMachineSnapshotsServer()
+}
+
+// UnimplementedVirtualMachineSnapshotsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVirtualMachineSnapshotsServer struct{}
+
+func (UnimplementedVirtualMachineSnapshotsServer) List(context.Context, *VirtualMachineSnapshotsListRequest) (*VirtualMachineSnapshotsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Get(context.Context, *VirtualMachineSnapshotsGetRequest) (*VirtualMachineSnapshotsGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Create(context.Context, *VirtualMachineSnapshotsCreateRequest) (*VirtualMachineSnapshotsCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Delete(context.Context, *VirtualMachineSnapshotsDeleteRequest) (*VirtualMachineSnapshotsDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) Restore(context.Context, *VirtualMachineSnapshotsRestoreRequest) (*VirtualMachineSnapshotsRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedVirtualMachineSnapshotsServer) mustEmbedUnimplementedVirtualMachineSnapshotsServer() {
+}
+func (UnimplementedVirtualMachineSnapshotsServer) testEmbeddedByValue() {}
+
+// UnsafeVirtualMachineSnapshotsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VirtualMachineSnapshotsServer will
+// result in compilation errors.
+type UnsafeVirtualMachineSnapshotsServer interface {
+	mustEmbedUnimplementedVirtualMachineSnapshotsServer()
+}
+
+func RegisterVirtualMachineSnapshotsServer(s grpc.ServiceRegistrar, srv VirtualMachineSnapshotsServer) {
+	// If the following call pancis, it indicates UnimplementedVirtualMachineSnapshotsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VirtualMachineSnapshots_ServiceDesc, srv)
+}
+
+func _VirtualMachineSnapshots_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).List(ctx, req.(*VirtualMachineSnapshotsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Get(ctx, req.(*VirtualMachineSnapshotsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Create(ctx, req.(*VirtualMachineSnapshotsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Delete(ctx, req.(*VirtualMachineSnapshotsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachineSnapshots_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineSnapshotsRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachineSnapshotsServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachineSnapshots_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachineSnapshotsServer).Restore(ctx, req.(*VirtualMachineSnapshotsRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VirtualMachineSnapshots_ServiceDesc is the grpc.ServiceDesc for VirtualMachineSnapshots service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VirtualMachineSnapshots_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "fulfillment.v1.VirtualMachineSnapshots",
+	HandlerType: (*VirtualMachineSnapshotsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _VirtualMachineSnapshots_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _VirtualMachineSnapshots_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _VirtualMachineSnapshots_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VirtualMachineSnapshots_Delete_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _VirtualMachineSnapshots_Restore_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fulfillment/v1/virtual_machine_snapshots_service.proto",
+}
@@ -32,11 +32,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ClusterTemplates_List_FullMethodName   = "/private.v1.ClusterTemplates/List"
-	ClusterTemplates_Get_FullMethodName    = "/private.v1.ClusterTemplates/Get"
-	ClusterTemplates_Create_FullMethodName = "/private.v1.ClusterTemplates/Create"
-	ClusterTemplates_Delete_FullMethodName = "/private.v1.ClusterTemplates/Delete"
-	ClusterTemplates_Update_FullMethodName = "/private.v1.ClusterTemplates/Update"
+	ClusterTemplates_List_FullMethodName         = "/private.v1.ClusterTemplates/List"
+	ClusterTemplates_Get_FullMethodName          = "/private.v1.ClusterTemplates/Get"
+	ClusterTemplates_Create_FullMethodName       = "/private.v1.ClusterTemplates/Create"
+	ClusterTemplates_Delete_FullMethodName       = "/private.v1.ClusterTemplates/Delete"
+	ClusterTemplates_Update_FullMethodName       = "/private.v1.ClusterTemplates/Update"
+	ClusterTemplates_ListArchived_FullMethodName = "/private.v1.ClusterTemplates/ListArchived"
+	ClusterTemplates_GetArchived_FullMethodName  = "/private.v1.ClusterTemplates/GetArchived"
+	ClusterTemplates_Undelete_FullMethodName     = "/private.v1.ClusterTemplates/Undelete"
 )
 
 // ClusterTemplatesClient is the client API for ClusterTemplates service.
@@ -48,6 +51,14 @@ type ClusterTemplatesClient interface {
 	Create(ctx context.Context, in *ClusterTemplatesCreateRequest, opts ...grpc.CallOption) (*ClusterTemplatesCreateResponse, error)
 	Delete(ctx context.Context, in *ClusterTemplatesDeleteRequest, opts ...grpc.CallOption) (*ClusterTemplatesDeleteResponse, error)
 	Update(ctx context.Context, in *ClusterTemplatesUpdateRequest, opts ...grpc.CallOption) (*ClusterTemplatesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(ctx context.Context, in *ClusterTemplatesListArchivedRequest, opts ...grpc.CallOption) (*ClusterTemplatesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(ctx context.Context, in *ClusterTemplatesGetArchivedRequest, opts ...grpc.CallOption) (*ClusterTemplatesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(ctx context.Context, in *ClusterTemplatesUndeleteRequest, opts ...grpc.CallOption) (*ClusterTemplatesUndeleteResponse, error)
 }
 
 type clusterTemplatesClient struct {
@@ -108,6 +119,36 @@ func (c *clusterTemplatesClient) Update(ctx context.Context, in *ClusterTemplate
 	return out, nil
 }
 
+func (c *clusterTemplatesClient) ListArchived(ctx context.Context, in *ClusterTemplatesListArchivedRequest, opts ...grpc.CallOption) (*ClusterTemplatesListArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterTemplatesListArchivedResponse)
+	err := c.cc.Invoke(ctx, ClusterTemplates_ListArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterTemplatesClient) GetArchived(ctx context.Context, in *ClusterTemplatesGetArchivedRequest, opts ...grpc.CallOption) (*ClusterTemplatesGetArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterTemplatesGetArchivedResponse)
+	err := c.cc.Invoke(ctx, ClusterTemplates_GetArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterTemplatesClient) Undelete(ctx context.Context, in *ClusterTemplatesUndeleteRequest, opts ...grpc.CallOption) (*ClusterTemplatesUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterTemplatesUndeleteResponse)
+	err := c.cc.Invoke(ctx, ClusterTemplates_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterTemplatesServer is the server API for ClusterTemplates service.
 // All implementations must embed UnimplementedClusterTemplatesServer
 // for forward compatibility.
@@ -117,6 +158,14 @@ type ClusterTemplatesServer interface {
 	Create(context.Context, *ClusterTemplatesCreateRequest) (*ClusterTemplatesCreateResponse, error)
 	Delete(context.Context, *ClusterTemplatesDeleteRequest) (*ClusterTemplatesDeleteResponse, error)
 	Update(context.Context, *ClusterTemplatesUpdateRequest) (*ClusterTemplatesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(context.Context, *ClusterTemplatesListArchivedRequest) (*ClusterTemplatesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(context.Context, *ClusterTemplatesGetArchivedRequest) (*ClusterTemplatesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(context.Context, *ClusterTemplatesUndeleteRequest) (*ClusterTemplatesUndeleteResponse, error)
 	mustEmbedUnimplementedClusterTemplatesServer()
 }
 
@@ -142,6 +191,15 @@ func (UnimplementedClusterTemplatesServer) Delete(context.Context, *ClusterTempl
 func (UnimplementedClusterTemplatesServer) Update(context.Context, *ClusterTemplatesUpdateRequest) (*ClusterTemplatesUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedClusterTemplatesServer) ListArchived(context.Context, *ClusterTemplatesListArchivedRequest) (*ClusterTemplatesListArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchived not implemented")
+}
+func (UnimplementedClusterTemplatesServer) GetArchived(context.Context, *ClusterTemplatesGetArchivedRequest) (*ClusterTemplatesGetArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchived not implemented")
+}
+func (UnimplementedClusterTemplatesServer) Undelete(context.Context, *ClusterTemplatesUndeleteRequest) (*ClusterTemplatesUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
 func (UnimplementedClusterTemplatesServer) mustEmbedUnimplementedClusterTemplatesServer() {}
 func (UnimplementedClusterTemplatesServer) testEmbeddedByValue()                          {}
 
@@ -253,6 +311,60 @@ func _ClusterTemplates_Update_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterTemplates_ListArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterTemplatesListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterTemplatesServer).ListArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterTemplates_ListArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterTemplatesServer).ListArchived(ctx, req.(*ClusterTemplatesListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterTemplates_GetArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterTemplatesGetArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterTemplatesServer).GetArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterTemplates_GetArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterTemplatesServer).GetArchived(ctx, req.(*ClusterTemplatesGetArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterTemplates_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterTemplatesUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterTemplatesServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterTemplates_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterTemplatesServer).Undelete(ctx, req.(*ClusterTemplatesUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterTemplates_ServiceDesc is the grpc.ServiceDesc for ClusterTemplates service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -280,6 +392,18 @@ var ClusterTemplates_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _ClusterTemplates_Update_Handler,
 		},
+		{
+			MethodName: "ListArchived",
+			Handler:    _ClusterTemplates_ListArchived_Handler,
+		},
+		{
+			MethodName: "GetArchived",
+			Handler:    _ClusterTemplates_GetArchived_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _ClusterTemplates_Undelete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "private/v1/cluster_templates_service.proto",
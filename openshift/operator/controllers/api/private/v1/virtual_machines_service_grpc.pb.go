@@ -32,11 +32,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VirtualMachines_List_FullMethodName   = "/private.v1.VirtualMachines/List"
-	VirtualMachines_Get_FullMethodName    = "/private.v1.VirtualMachines/Get"
-	VirtualMachines_Create_FullMethodName = "/private.v1.VirtualMachines/Create"
-	VirtualMachines_Delete_FullMethodName = "/private.v1.VirtualMachines/Delete"
-	VirtualMachines_Update_FullMethodName = "/private.v1.VirtualMachines/Update"
+	VirtualMachines_List_FullMethodName         = "/private.v1.VirtualMachines/List"
+	VirtualMachines_Get_FullMethodName          = "/private.v1.VirtualMachines/Get"
+	VirtualMachines_Create_FullMethodName       = "/private.v1.VirtualMachines/Create"
+	VirtualMachines_Delete_FullMethodName       = "/private.v1.VirtualMachines/Delete"
+	VirtualMachines_Update_FullMethodName       = "/private.v1.VirtualMachines/Update"
+	VirtualMachines_ListArchived_FullMethodName = "/private.v1.VirtualMachines/ListArchived"
+	VirtualMachines_GetArchived_FullMethodName  = "/private.v1.VirtualMachines/GetArchived"
+	VirtualMachines_Undelete_FullMethodName     = "/private.v1.VirtualMachines/Undelete"
+	VirtualMachines_Start_FullMethodName        = "/private.v1.VirtualMachines/Start"
+	VirtualMachines_Stop_FullMethodName         = "/private.v1.VirtualMachines/Stop"
+	VirtualMachines_Restart_FullMethodName      = "/private.v1.VirtualMachines/Restart"
+	VirtualMachines_Pause_FullMethodName        = "/private.v1.VirtualMachines/Pause"
+	VirtualMachines_Unpause_FullMethodName      = "/private.v1.VirtualMachines/Unpause"
 )
 
 // VirtualMachinesClient is the client API for VirtualMachines service.
@@ -48,6 +56,25 @@ type VirtualMachinesClient interface {
 	Create(ctx context.Context, in *VirtualMachinesCreateRequest, opts ...grpc.CallOption) (*VirtualMachinesCreateResponse, error)
 	Delete(ctx context.Context, in *VirtualMachinesDeleteRequest, opts ...grpc.CallOption) (*VirtualMachinesDeleteResponse, error)
 	Update(ctx context.Context, in *VirtualMachinesUpdateRequest, opts ...grpc.CallOption) (*VirtualMachinesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(ctx context.Context, in *VirtualMachinesListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachinesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(ctx context.Context, in *VirtualMachinesGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachinesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(ctx context.Context, in *VirtualMachinesUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachinesUndeleteResponse, error)
+	// Sets the run strategy of the virtual machine to 'ALWAYS' and clears the paused flag.
+	Start(ctx context.Context, in *VirtualMachinesStartRequest, opts ...grpc.CallOption) (*VirtualMachinesStartResponse, error)
+	// Sets the run strategy of the virtual machine to 'HALTED' and clears the paused flag.
+	Stop(ctx context.Context, in *VirtualMachinesStopRequest, opts ...grpc.CallOption) (*VirtualMachinesStopResponse, error)
+	// Sets the run strategy of the virtual machine to 'ALWAYS', the restart request time to the current time and clears
+	// the paused flag.
+	Restart(ctx context.Context, in *VirtualMachinesRestartRequest, opts ...grpc.CallOption) (*VirtualMachinesRestartResponse, error)
+	// Sets the paused flag of the virtual machine. Virtual machines whose run strategy is 'HALTED' can't be paused.
+	Pause(ctx context.Context, in *VirtualMachinesPauseRequest, opts ...grpc.CallOption) (*VirtualMachinesPauseResponse, error)
+	// Clears the paused flag of the virtual machine.
+	Unpause(ctx context.Context, in *VirtualMachinesUnpauseRequest, opts ...grpc.CallOption) (*VirtualMachinesUnpauseResponse, error)
 }
 
 type virtualMachinesClient struct {
@@ -108,6 +135,86 @@ func (c *virtualMachinesClient) Update(ctx context.Context, in *VirtualMachinesU
 	return out, nil
 }
 
+func (c *virtualMachinesClient) ListArchived(ctx context.Context, in *VirtualMachinesListArchivedRequest, opts ...grpc.CallOption) (*VirtualMachinesListArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesListArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_ListArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) GetArchived(ctx context.Context, in *VirtualMachinesGetArchivedRequest, opts ...grpc.CallOption) (*VirtualMachinesGetArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesGetArchivedResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_GetArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Undelete(ctx context.Context, in *VirtualMachinesUndeleteRequest, opts ...grpc.CallOption) (*VirtualMachinesUndeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesUndeleteResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Undelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Start(ctx context.Context, in *VirtualMachinesStartRequest, opts ...grpc.CallOption) (*VirtualMachinesStartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesStartResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Start_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Stop(ctx context.Context, in *VirtualMachinesStopRequest, opts ...grpc.CallOption) (*VirtualMachinesStopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesStopResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Stop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Restart(ctx context.Context, in *VirtualMachinesRestartRequest, opts ...grpc.CallOption) (*VirtualMachinesRestartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesRestartResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Restart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Pause(ctx context.Context, in *VirtualMachinesPauseRequest, opts ...grpc.CallOption) (*VirtualMachinesPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesPauseResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *virtualMachinesClient) Unpause(ctx context.Context, in *VirtualMachinesUnpauseRequest, opts ...grpc.CallOption) (*VirtualMachinesUnpauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VirtualMachinesUnpauseResponse)
+	err := c.cc.Invoke(ctx, VirtualMachines_Unpause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VirtualMachinesServer is the server API for VirtualMachines service.
 // All implementations must embed UnimplementedVirtualMachinesServer
 // for forward compatibility.
@@ -117,6 +224,25 @@ type VirtualMachinesServer interface {
 	Create(context.Context, *VirtualMachinesCreateRequest) (*VirtualMachinesCreateResponse, error)
 	Delete(context.Context, *VirtualMachinesDeleteRequest) (*VirtualMachinesDeleteResponse, error)
 	Update(context.Context, *VirtualMachinesUpdateRequest) (*VirtualMachinesUpdateResponse, error)
+	// Lists the objects that have been completely deleted and moved to the archive. The filter and order use the same
+	// syntax as the 'List' method.
+	ListArchived(context.Context, *VirtualMachinesListArchivedRequest) (*VirtualMachinesListArchivedResponse, error)
+	// Retrieves an object that has been completely deleted and moved to the archive.
+	GetArchived(context.Context, *VirtualMachinesGetArchivedRequest) (*VirtualMachinesGetArchivedResponse, error)
+	// Cancels the deletion of an object that has been deleted but that still has pending finalizers. Objects that have
+	// already been moved to the archive can't be restored.
+	Undelete(context.Context, *VirtualMachinesUndeleteRequest) (*VirtualMachinesUndeleteResponse, error)
+	// Sets the run strategy of the virtual machine to 'ALWAYS' and clears the paused flag.
+	Start(context.Context, *VirtualMachinesStartRequest) (*VirtualMachinesStartResponse, error)
+	// Sets the run strategy of the virtual machine to 'HALTED' and clears the paused flag.
+	Stop(context.Context, *VirtualMachinesStopRequest) (*VirtualMachinesStopResponse, error)
+	// Sets the run strategy of the virtual machine to 'ALWAYS', the restart request time to the current time and clears
+	// the paused flag.
+	Restart(context.Context, *VirtualMachinesRestartRequest) (*VirtualMachinesRestartResponse, error)
+	// Sets the paused flag of the virtual machine. Virtual machines whose run strategy is 'HALTED' can't be paused.
+	Pause(context.Context, *VirtualMachinesPauseRequest) (*VirtualMachinesPauseResponse, error)
+	// Clears the paused flag of the virtual machine.
+	Unpause(context.Context, *VirtualMachinesUnpauseRequest) (*VirtualMachinesUnpauseResponse, error)
 	mustEmbedUnimplementedVirtualMachinesServer()
 }
 
@@ -142,6 +268,30 @@ func (UnimplementedVirtualMachinesServer) Delete(context.Context, *VirtualMachin
 func (UnimplementedVirtualMachinesServer) Update(context.Context, *VirtualMachinesUpdateRequest) (*VirtualMachinesUpdateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedVirtualMachinesServer) ListArchived(context.Context, *VirtualMachinesListArchivedRequest) (*VirtualMachinesListArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchived not implemented")
+}
+func (UnimplementedVirtualMachinesServer) GetArchived(context.Context, *VirtualMachinesGetArchivedRequest) (*VirtualMachinesGetArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchived not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Undelete(context.Context, *VirtualMachinesUndeleteRequest) (*VirtualMachinesUndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Start(context.Context, *VirtualMachinesStartRequest) (*VirtualMachinesStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Stop(context.Context, *VirtualMachinesStopRequest) (*VirtualMachinesStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Restart(context.Context, *VirtualMachinesRestartRequest) (*VirtualMachinesRestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Pause(context.Context, *VirtualMachinesPauseRequest) (*VirtualMachinesPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedVirtualMachinesServer) Unpause(context.Context, *VirtualMachinesUnpauseRequest) (*VirtualMachinesUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
 func (UnimplementedVirtualMachinesServer) mustEmbedUnimplementedVirtualMachinesServer() {}
 func (UnimplementedVirtualMachinesServer) testEmbeddedByValue()                         {}
 
@@ -253,6 +403,150 @@ func _VirtualMachines_Update_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VirtualMachines_ListArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesListArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).ListArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_ListArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).ListArchived(ctx, req.(*VirtualMachinesListArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_GetArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesGetArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).GetArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_GetArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).GetArchived(ctx, req.(*VirtualMachinesGetArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesUndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Undelete(ctx, req.(*VirtualMachinesUndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Start_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Start(ctx, req.(*VirtualMachinesStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Stop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Stop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Stop(ctx, req.(*VirtualMachinesStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesRestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Restart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Restart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Restart(ctx, req.(*VirtualMachinesRestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Pause(ctx, req.(*VirtualMachinesPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VirtualMachines_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachinesUnpauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VirtualMachinesServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VirtualMachines_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VirtualMachinesServer).Unpause(ctx, req.(*VirtualMachinesUnpauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VirtualMachines_ServiceDesc is the grpc.ServiceDesc for VirtualMachines service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -280,6 +574,38 @@ var VirtualMachines_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _VirtualMachines_Update_Handler,
 		},
+		{
+			MethodName: "ListArchived",
+			Handler:    _VirtualMachines_ListArchived_Handler,
+		},
+		{
+			MethodName: "GetArchived",
+			Handler:    _VirtualMachines_GetArchived_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _VirtualMachines_Undelete_Handler,
+		},
+		{
+			MethodName: "Start",
+			Handler:    _VirtualMachines_Start_Handler,
+		},
+		{
+			MethodName: "Stop",
+			Handler:    _VirtualMachines_Stop_Handler,
+		},
+		{
+			MethodName: "Restart",
+			Handler:    _VirtualMachines_Restart_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _VirtualMachines_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _VirtualMachines_Unpause_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "private/v1/virtual_machines_service.proto",
@@ -20,6 +20,8 @@ import (
 	"github.com/go-logr/logr"
 	hypershiftv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -111,8 +113,18 @@ func (r *FeedbackReconciler) Reconcile(ctx context.Context, request ctrl.Request
 		return
 	}
 
-	// Save the objects that have changed:
+	// Save the objects that have changed. If the cluster was modified by some other process since we fetched it we
+	// need to start again with the latest version:
 	err = r.saveCluster(ctx, cluster, t.cluster)
+	if isConflict(err) {
+		r.logger.Info(
+			"Cluster was modified concurrently, will retry",
+			"id", clusterID,
+		)
+		result = ctrl.Result{Requeue: true}
+		err = nil
+		return
+	}
 	if err != nil {
 		return
 	}
@@ -435,3 +447,9 @@ func clone[M proto.Message](message M) M {
 func equal[M proto.Message](x, y M) bool {
 	return proto.Equal(x, y)
 }
+
+// isConflict checks if the given error is the one returned by the fulfillment service when an object can't be updated
+// because it was modified concurrently.
+func isConflict(err error) bool {
+	return err != nil && grpcstatus.Code(err) == grpccodes.Aborted
+}
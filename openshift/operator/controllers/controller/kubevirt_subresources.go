@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// KubeVirtSubresources calls the subresources of KubeVirt virtual machine instances. These subresources, like pause
+// and unpause, are served by the KubeVirt API server in a separate API group and can't be called with the controller
+// runtime client.
+type KubeVirtSubresources interface {
+	// Pause pauses the execution of the virtual machine instance.
+	Pause(ctx context.Context, namespace, name string) error
+
+	// Unpause resumes the execution of the virtual machine instance.
+	Unpause(ctx context.Context, namespace, name string) error
+}
+
+// kubeVirtSubresourcesGroupVersion is the API group and version of the KubeVirt subresources.
+var kubeVirtSubresourcesGroupVersion = schema.GroupVersion{
+	Group:   "subresources.kubevirt.io",
+	Version: "v1",
+}
+
+type kubeVirtSubresources struct {
+	client rest.Interface
+}
+
+// NewKubeVirtSubresources creates an object that calls the KubeVirt subresources using the given configuration.
+func NewKubeVirtSubresources(config *rest.Config) (KubeVirtSubresources, error) {
+	config = rest.CopyConfig(config)
+	config.GroupVersion = &kubeVirtSubresourcesGroupVersion
+	config.APIPath = "/apis"
+	config.ContentType = "application/json"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+	client, err := rest.RESTClientFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeVirtSubresources{
+		client: client,
+	}, nil
+}
+
+func (s *kubeVirtSubresources) Pause(ctx context.Context, namespace, name string) error {
+	return s.put(ctx, namespace, name, "pause")
+}
+
+func (s *kubeVirtSubresources) Unpause(ctx context.Context, namespace, name string) error {
+	return s.put(ctx, namespace, name, "unpause")
+}
+
+func (s *kubeVirtSubresources) put(ctx context.Context, namespace, name, subresource string) error {
+	return s.client.Put().
+		Namespace(namespace).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource(subresource).
+		Body([]byte("{}")).
+		Do(ctx).
+		Error()
+}
@@ -23,6 +23,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -59,6 +60,7 @@ type VirtualMachineReconciler struct {
 	CreateVMWebhook         string
 	DeleteVMWebhook         string
 	VirtualMachineNamespace string
+	KubeVirtSubresources    KubeVirtSubresources
 	webhookClient           *WebhookClient
 }
 
@@ -69,6 +71,7 @@ func NewVirtualMachineReconciler(
 	deleteVMWebhook string,
 	virtualMachineNamespace string,
 	minimumRequestInterval time.Duration,
+	kubeVirtSubresources KubeVirtSubresources,
 ) *VirtualMachineReconciler {
 
 	if virtualMachineNamespace == "" {
@@ -81,6 +84,7 @@ func NewVirtualMachineReconciler(
 		CreateVMWebhook:         createVMWebhook,
 		DeleteVMWebhook:         deleteVMWebhook,
 		VirtualMachineNamespace: virtualMachineNamespace,
+		KubeVirtSubresources:    kubeVirtSubresources,
 		webhookClient:           NewWebhookClient(10*time.Second, minimumRequestInterval),
 	}
 }
@@ -89,7 +93,9 @@ func NewVirtualMachineReconciler(
 // +kubebuilder:rbac:groups=cloudkit.openshift.io,resources=virtualmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cloudkit.openshift.io,resources=virtualmachines/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachines,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachineinstances,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=subresources.kubevirt.io,resources=virtualmachineinstances/pause;virtualmachineinstances/unpause,verbs=update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -422,15 +428,152 @@ func (r *VirtualMachineReconciler) handleKubeVirtVM(ctx context.Context, instanc
 	instance.SetVirtualMachineReferenceKubeVirtVirtalMachineName(name)
 	instance.SetStatusCondition(v1alpha1.VirtualMachineConditionAccepted, metav1.ConditionTrue, v1alpha1.ReasonAsExpected, "")
 
+	if err := r.handleKubeVirtRunStrategy(ctx, instance, kv); err != nil {
+		return err
+	}
+	if err := r.handleKubeVirtPause(ctx, instance, kv); err != nil {
+		return err
+	}
+	if err := r.handleKubeVirtRestart(ctx, instance, kv); err != nil {
+		return err
+	}
+	instance.Status.PowerState = kvVMPowerState(kv)
+
 	if kvVMHasConditionWithStatus(kv, kubevirtv1.VirtualMachineReady, corev1.ConditionTrue) {
 		log.Info("KubeVirt virtual machine is ready", "virtualmachine", instance.GetName())
 		instance.SetStatusCondition(v1alpha1.VirtualMachineConditionAvailable, metav1.ConditionTrue, v1alpha1.ReasonAsExpected, "")
 		instance.Status.Phase = v1alpha1.VirtualMachinePhaseReady
+	} else if instance.Status.PowerState == v1alpha1.VirtualMachinePowerStateStopped &&
+		instance.Spec.RunStrategy == v1alpha1.VirtualMachineRunStrategyHalted {
+		// The virtual machine has been stopped on purpose, so it isn't available but there is nothing in progress
+		// either, and the create webhook shouldn't be triggered again.
+		log.Info("KubeVirt virtual machine is stopped", "virtualmachine", instance.GetName())
+		instance.SetStatusCondition(v1alpha1.VirtualMachineConditionAvailable, metav1.ConditionFalse, v1alpha1.ReasonStopped, "")
+		instance.Status.Phase = v1alpha1.VirtualMachinePhaseReady
+	}
+
+	return nil
+}
+
+// handleKubeVirtRunStrategy updates the run strategy of the KubeVirt virtual machine if it is different to the one
+// requested in the spec.
+func (r *VirtualMachineReconciler) handleKubeVirtRunStrategy(ctx context.Context, instance *v1alpha1.VirtualMachine,
+	kv *kubevirtv1.VirtualMachine) error {
+	log := ctrllog.FromContext(ctx)
+
+	if instance.Spec.RunStrategy == "" {
+		return nil
+	}
+	desired := kubevirtv1.VirtualMachineRunStrategy(instance.Spec.RunStrategy)
+	current, err := kv.RunStrategy()
+	if err == nil && current == desired && kv.Spec.Running == nil {
+		return nil
 	}
 
+	// The deprecated running field is mutually exclusive with the run strategy, so it needs to be removed:
+	update := kv.DeepCopy()
+	update.Spec.Running = nil
+	update.Spec.RunStrategy = &desired
+	if err := r.Patch(ctx, update, client.MergeFrom(kv)); err != nil {
+		log.Error(err, "failed to update run strategy of KubeVirt virtual machine", "virtualmachine", kv.GetName())
+		return err
+	}
+	log.Info("updated run strategy of KubeVirt virtual machine", "virtualmachine", kv.GetName(),
+		"from", current, "to", desired)
+	*kv = *update
 	return nil
 }
 
+// handleKubeVirtPause pauses or unpauses the KubeVirt virtual machine instance when its power state doesn't match the
+// paused flag of the spec. Virtual machines that aren't running are left alone, as only running instances can be paused,
+// and they will be checked again when their status changes.
+func (r *VirtualMachineReconciler) handleKubeVirtPause(ctx context.Context, instance *v1alpha1.VirtualMachine,
+	kv *kubevirtv1.VirtualMachine) error {
+	log := ctrllog.FromContext(ctx)
+
+	state := kvVMPowerState(kv)
+	pause := instance.Spec.Paused && state == v1alpha1.VirtualMachinePowerStateRunning
+	unpause := !instance.Spec.Paused && state == v1alpha1.VirtualMachinePowerStatePaused
+	if !pause && !unpause {
+		return nil
+	}
+	if r.KubeVirtSubresources == nil {
+		log.Info("can't change paused state of KubeVirt virtual machine because subresources aren't available",
+			"virtualmachine", kv.GetName(), "paused", instance.Spec.Paused)
+		return nil
+	}
+
+	var err error
+	if pause {
+		err = r.KubeVirtSubresources.Pause(ctx, kv.GetNamespace(), kv.GetName())
+	} else {
+		err = r.KubeVirtSubresources.Unpause(ctx, kv.GetNamespace(), kv.GetName())
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "failed to change paused state of KubeVirt virtual machine", "virtualmachine", kv.GetName(),
+			"paused", instance.Spec.Paused)
+		return err
+	}
+	log.Info("changed paused state of KubeVirt virtual machine", "virtualmachine", kv.GetName(),
+		"paused", instance.Spec.Paused)
+	return nil
+}
+
+// handleKubeVirtRestart restarts the KubeVirt virtual machine, deleting its virtual machine instance, when the restart
+// request time in the spec is later than the time of the last restart. The virtual machine instance will then be
+// created again by KubeVirt according to the run strategy.
+func (r *VirtualMachineReconciler) handleKubeVirtRestart(ctx context.Context, instance *v1alpha1.VirtualMachine,
+	kv *kubevirtv1.VirtualMachine) error {
+	log := ctrllog.FromContext(ctx)
+
+	requested := instance.Spec.RestartRequestTime
+	if requested == nil {
+		return nil
+	}
+	last := instance.Status.LastRestartTime
+	if last != nil && !last.Before(requested) {
+		return nil
+	}
+
+	vmi := &kubevirtv1.VirtualMachineInstance{}
+	vmi.SetNamespace(kv.GetNamespace())
+	vmi.SetName(kv.GetName())
+	err := r.Delete(ctx, vmi)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "failed to delete KubeVirt virtual machine instance", "virtualmachine", kv.GetName())
+		return err
+	}
+	log.Info("restarted KubeVirt virtual machine", "virtualmachine", kv.GetName(), "requested", requested)
+	instance.Status.LastRestartTime = requested.DeepCopy()
+	return nil
+}
+
+// kvVMPowerState calculates the power state from the printable status of the KubeVirt virtual machine. Returns an
+// empty string if the status doesn't correspond to any of the power states.
+func kvVMPowerState(vm *kubevirtv1.VirtualMachine) v1alpha1.VirtualMachinePowerStateType {
+	switch vm.Status.PrintableStatus {
+	case kubevirtv1.VirtualMachineStatusProvisioning,
+		kubevirtv1.VirtualMachineStatusWaitingForVolumeBinding,
+		kubevirtv1.VirtualMachineStatusStarting:
+		return v1alpha1.VirtualMachinePowerStateStarting
+	case kubevirtv1.VirtualMachineStatusRunning,
+		kubevirtv1.VirtualMachineStatusMigrating:
+		return v1alpha1.VirtualMachinePowerStateRunning
+	case kubevirtv1.VirtualMachineStatusPaused:
+		return v1alpha1.VirtualMachinePowerStatePaused
+	case kubevirtv1.VirtualMachineStatusStopping,
+		kubevirtv1.VirtualMachineStatusTerminating:
+		return v1alpha1.VirtualMachinePowerStateStopping
+	case kubevirtv1.VirtualMachineStatusStopped:
+		return v1alpha1.VirtualMachinePowerStateStopped
+	default:
+		return ""
+	}
+}
+
 func kvVMGetCondition(vm *kubevirtv1.VirtualMachine, cond kubevirtv1.VirtualMachineConditionType) *kubevirtv1.VirtualMachineCondition {
 	if vm == nil {
 		return nil
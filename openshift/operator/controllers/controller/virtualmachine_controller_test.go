@@ -28,8 +28,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cloudkitv1alpha1 "github.com/jkary/osac/openshift/operator/crds/v1alpha1"
+	kubevirtv1 "kubevirt.io/api/core/v1"
 )
 
+// fakeKubeVirtSubresources records the calls to the KubeVirt subresources.
+type fakeKubeVirtSubresources struct {
+	calls []string
+}
+
+func (s *fakeKubeVirtSubresources) Pause(ctx context.Context, namespace, name string) error {
+	s.calls = append(s.calls, "pause "+namespace+"/"+name)
+	return nil
+}
+
+func (s *fakeKubeVirtSubresources) Unpause(ctx context.Context, namespace, name string) error {
+	s.calls = append(s.calls, "unpause "+namespace+"/"+name)
+	return nil
+}
+
 var _ = Describe("VirtualMachine Controller", func() {
 	Context("When reconciling a resource", func() {
 		const resourceName = "test-resource"
@@ -84,3 +100,70 @@ var _ = Describe("VirtualMachine Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("VirtualMachine pause", func() {
+	var (
+		ctx          context.Context
+		subresources *fakeKubeVirtSubresources
+		reconciler   *VirtualMachineReconciler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		subresources = &fakeKubeVirtSubresources{}
+		reconciler = &VirtualMachineReconciler{
+			KubeVirtSubresources: subresources,
+		}
+	})
+
+	makeKubeVirtVM := func(status kubevirtv1.VirtualMachinePrintableStatus) *kubevirtv1.VirtualMachine {
+		return &kubevirtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "my-ns",
+				Name:      "my-vm",
+			},
+			Status: kubevirtv1.VirtualMachineStatus{
+				PrintableStatus: status,
+			},
+		}
+	}
+
+	makeInstance := func(paused bool) *cloudkitv1alpha1.VirtualMachine {
+		return &cloudkitv1alpha1.VirtualMachine{
+			Spec: cloudkitv1alpha1.VirtualMachineSpec{
+				Paused: paused,
+			},
+		}
+	}
+
+	It("Pauses running virtual machine", func() {
+		kv := makeKubeVirtVM(kubevirtv1.VirtualMachineStatusRunning)
+		err := reconciler.handleKubeVirtPause(ctx, makeInstance(true), kv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subresources.calls).To(Equal([]string{"pause my-ns/my-vm"}))
+	})
+
+	It("Unpauses paused virtual machine", func() {
+		kv := makeKubeVirtVM(kubevirtv1.VirtualMachineStatusPaused)
+		err := reconciler.handleKubeVirtPause(ctx, makeInstance(false), kv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subresources.calls).To(Equal([]string{"unpause my-ns/my-vm"}))
+	})
+
+	It("Does nothing if the virtual machine is already in the requested state", func() {
+		kv := makeKubeVirtVM(kubevirtv1.VirtualMachineStatusPaused)
+		err := reconciler.handleKubeVirtPause(ctx, makeInstance(true), kv)
+		Expect(err).NotTo(HaveOccurred())
+		kv = makeKubeVirtVM(kubevirtv1.VirtualMachineStatusRunning)
+		err = reconciler.handleKubeVirtPause(ctx, makeInstance(false), kv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subresources.calls).To(BeEmpty())
+	})
+
+	It("Doesn't pause virtual machine that isn't running", func() {
+		kv := makeKubeVirtVM(kubevirtv1.VirtualMachineStatusStopped)
+		err := reconciler.handleKubeVirtPause(ctx, makeInstance(true), kv)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subresources.calls).To(BeEmpty())
+	})
+})
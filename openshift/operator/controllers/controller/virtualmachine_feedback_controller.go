@@ -110,8 +110,18 @@ func (r *VirtualMachineFeedbackReconciler) Reconcile(ctx context.Context, reques
 	if err != nil {
 		return
 	}
-	// Save the objects that have changed:
+	// Save the objects that have changed. If the virtual machine was modified by some other process since we fetched
+	// it we need to start again with the latest version:
 	err = r.saveVirtualMachine(ctx, vm, t.vm)
+	if isConflict(err) {
+		log.Info(
+			"Virtual machine was modified concurrently, will retry",
+			"id", vmID,
+		)
+		result = ctrl.Result{Requeue: true}
+		err = nil
+		return
+	}
 	if err != nil {
 		return
 	}
@@ -156,6 +166,10 @@ func (t *virtualMachineFeedbackReconcilerTask) handleUpdate(ctx context.Context)
 	if err != nil {
 		return
 	}
+	err = t.syncPowerState(ctx)
+	if err != nil {
+		return
+	}
 	return
 }
 
@@ -291,6 +305,31 @@ func (t *virtualMachineFeedbackReconcilerTask) syncPhaseReady(ctx context.Contex
 	return nil
 }
 
+func (t *virtualMachineFeedbackReconcilerTask) syncPowerState(ctx context.Context) error {
+	vmStatus := t.vm.GetStatus()
+	switch t.object.Status.PowerState {
+	case ckv1alpha1.VirtualMachinePowerStateStarting:
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STARTING)
+	case ckv1alpha1.VirtualMachinePowerStateRunning:
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_RUNNING)
+	case ckv1alpha1.VirtualMachinePowerStatePaused:
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_PAUSED)
+	case ckv1alpha1.VirtualMachinePowerStateStopping:
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPING)
+	case ckv1alpha1.VirtualMachinePowerStateStopped:
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_STOPPED)
+	case "":
+		vmStatus.SetPowerState(privatev1.VirtualMachinePowerState_VIRTUAL_MACHINE_POWER_STATE_UNSPECIFIED)
+	default:
+		log := ctrllog.FromContext(ctx)
+		log.Info(
+			"Unknown power state, will ignore it",
+			"power_state", t.object.Status.PowerState,
+		)
+	}
+	return nil
+}
+
 func (t *virtualMachineFeedbackReconcilerTask) findVirtualMachineCondition(kind privatev1.VirtualMachineConditionType) *privatev1.VirtualMachineCondition {
 	var condition *privatev1.VirtualMachineCondition
 	for _, current := range t.vm.Status.Conditions {
@@ -0,0 +1,429 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	controllerutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/jkary/osac/openshift/operator/crds/v1alpha1"
+	snapshotv1beta1 "kubevirt.io/api/snapshot/v1beta1"
+)
+
+const (
+	// virtualMachineSnapshotPollInterval is the time to wait before checking again the virtual machine when it
+	// doesn't yet have a KubeVirt virtual machine that can be snapshotted, or the KubeVirt snapshot while it is
+	// being deleted.
+	virtualMachineSnapshotPollInterval = 10 * time.Second
+)
+
+// VirtualMachineSnapshotReconciler reconciles a VirtualMachineSnapshot object
+type VirtualMachineSnapshotReconciler struct {
+	client.Client
+	Scheme                  *runtime.Scheme
+	VirtualMachineNamespace string
+}
+
+func NewVirtualMachineSnapshotReconciler(
+	client client.Client,
+	scheme *runtime.Scheme,
+	virtualMachineNamespace string,
+) *VirtualMachineSnapshotReconciler {
+
+	if virtualMachineNamespace == "" {
+		virtualMachineNamespace = defaultVirtualMachineNamespace
+	}
+
+	return &VirtualMachineSnapshotReconciler{
+		Client:                  client,
+		Scheme:                  scheme,
+		VirtualMachineNamespace: virtualMachineNamespace,
+	}
+}
+
+// +kubebuilder:rbac:groups=cloudkit.openshift.io,resources=virtualmachinesnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cloudkit.openshift.io,resources=virtualmachinesnapshots/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cloudkit.openshift.io,resources=virtualmachinesnapshots/finalizers,verbs=update
+// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinesnapshots,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=snapshot.kubevirt.io,resources=virtualmachinerestores,verbs=get;list;watch;create;delete
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *VirtualMachineSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrllog.FromContext(ctx)
+
+	instance := &v1alpha1.VirtualMachineSnapshot{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	log.Info("start reconcile")
+
+	oldstatus := instance.Status.DeepCopy()
+
+	var res ctrl.Result
+	if instance.ObjectMeta.DeletionTimestamp.IsZero() {
+		res, err = r.handleUpdate(ctx, instance)
+	} else {
+		res, err = r.handleDelete(ctx, instance)
+	}
+
+	if err == nil {
+		if !equality.Semantic.DeepEqual(instance.Status, oldstatus) {
+			log.Info("status requires update")
+			if err := r.Status().Update(ctx, instance); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	log.Info("end reconcile")
+	return res, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VirtualMachineSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	labelPredicate, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      cloudkitVirtualMachineSnapshotNameLabel,
+				Operator: metav1.LabelSelectorOpExists,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.VirtualMachineSnapshot{}, builder.WithPredicates(VirtualMachineNamespacePredicate(r.VirtualMachineNamespace))).
+		Watches(
+			&snapshotv1beta1.VirtualMachineSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.mapObjectToVirtualMachineSnapshot),
+			builder.WithPredicates(labelPredicate),
+		).
+		Watches(
+			&snapshotv1beta1.VirtualMachineRestore{},
+			handler.EnqueueRequestsFromMapFunc(r.mapObjectToVirtualMachineSnapshot),
+			builder.WithPredicates(labelPredicate),
+		).
+		Complete(r)
+}
+
+// mapObjectToVirtualMachineSnapshot maps an event for a watched KubeVirt object to the associated
+// VirtualMachineSnapshot resource.
+func (r *VirtualMachineSnapshotReconciler) mapObjectToVirtualMachineSnapshot(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := ctrllog.FromContext(ctx)
+
+	snapshotName, exists := obj.GetLabels()[cloudkitVirtualMachineSnapshotNameLabel]
+	if !exists {
+		return nil
+	}
+
+	// Verify that the referenced VirtualMachineSnapshot exists in this controller's namespace
+	// to filter out notifications for resources managed by other controller instances
+	snapshot := &v1alpha1.VirtualMachineSnapshot{}
+	key := client.ObjectKey{
+		Name:      snapshotName,
+		Namespace: r.VirtualMachineNamespace,
+	}
+	if err := r.Get(ctx, key, snapshot); err != nil {
+		log.V(1).Info("ignoring notification for resource not managed by this controller instance",
+			"kind", obj.GetObjectKind().GroupVersionKind().Kind,
+			"namespace", obj.GetNamespace(),
+			"name", obj.GetName(),
+			"virtualmachinesnapshot", snapshotName,
+			"controller_namespace", r.VirtualMachineNamespace,
+		)
+		return nil
+	}
+
+	log.Info("mapped change notification",
+		"kind", obj.GetObjectKind().GroupVersionKind().Kind,
+		"namespace", obj.GetNamespace(),
+		"name", obj.GetName(),
+		"virtualmachinesnapshot", snapshotName,
+	)
+
+	return []reconcile.Request{
+		{
+			NamespacedName: key,
+		},
+	}
+}
+
+func (r *VirtualMachineSnapshotReconciler) handleUpdate(ctx context.Context, instance *v1alpha1.VirtualMachineSnapshot) (ctrl.Result, error) {
+	log := ctrllog.FromContext(ctx)
+
+	if instance.Status.Phase == "" {
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseProgressing
+	}
+
+	if controllerutil.AddFinalizer(instance, cloudkitVirtualMachineSnapshotFinalizer) {
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The KubeVirt snapshot can only be created once the KubeVirt virtual machine exists, so until then we need to
+	// check the virtual machine periodically:
+	vm := &v1alpha1.VirtualMachine{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: instance.GetNamespace(), Name: instance.Spec.VirtualMachineName}, vm)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err != nil || vm.Status.VirtualMachineReference == nil ||
+		vm.Status.VirtualMachineReference.Namespace == "" ||
+		vm.Status.VirtualMachineReference.KubeVirtVirtalMachineName == "" {
+		log.Info("waiting for KubeVirt virtual machine", "virtualmachine", instance.Spec.VirtualMachineName)
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseProgressing
+		return ctrl.Result{RequeueAfter: virtualMachineSnapshotPollInterval}, nil
+	}
+	reference := vm.Status.VirtualMachineReference
+
+	kvSnapshot, err := r.findOrCreateKubeVirtSnapshot(ctx, instance, reference.Namespace, reference.KubeVirtVirtalMachineName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	instance.Status.SnapshotReference = &v1alpha1.VirtualMachineSnapshotReferenceType{
+		Namespace:                          kvSnapshot.GetNamespace(),
+		KubeVirtVirtualMachineSnapshotName: kvSnapshot.GetName(),
+	}
+	r.handleKubeVirtSnapshotStatus(instance, kvSnapshot)
+
+	if instance.Status.Phase != v1alpha1.VirtualMachineSnapshotPhaseReady {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.handleKubeVirtRestore(ctx, instance, reference.KubeVirtVirtalMachineName); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// findOrCreateKubeVirtSnapshot returns the KubeVirt snapshot associated to the instance, creating it if it doesn't
+// exist yet. The KubeVirt snapshot has the same name than the instance, and is created in the namespace of the KubeVirt
+// virtual machine.
+func (r *VirtualMachineSnapshotReconciler) findOrCreateKubeVirtSnapshot(ctx context.Context,
+	instance *v1alpha1.VirtualMachineSnapshot, namespace string, vmName string) (*snapshotv1beta1.VirtualMachineSnapshot, error) {
+	log := ctrllog.FromContext(ctx)
+
+	kvSnapshot := &snapshotv1beta1.VirtualMachineSnapshot{}
+	key := client.ObjectKey{Namespace: namespace, Name: instance.GetName()}
+	err := r.Get(ctx, key, kvSnapshot)
+	if err == nil {
+		return kvSnapshot, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	apiGroup := "kubevirt.io"
+	kvSnapshot = &snapshotv1beta1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      instance.GetName(),
+			Labels: map[string]string{
+				cloudkitVirtualMachineSnapshotNameLabel: instance.GetName(),
+			},
+		},
+		Spec: snapshotv1beta1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     vmName,
+			},
+		},
+	}
+	if err := r.Create(ctx, kvSnapshot); err != nil {
+		log.Error(err, "failed to create KubeVirt snapshot", "virtualmachine", vmName)
+		return nil, err
+	}
+	log.Info("created KubeVirt snapshot", "namespace", namespace, "name", kvSnapshot.GetName())
+	return kvSnapshot, nil
+}
+
+// handleKubeVirtSnapshotStatus calculates the phase of the instance from the status of the KubeVirt snapshot.
+func (r *VirtualMachineSnapshotReconciler) handleKubeVirtSnapshotStatus(instance *v1alpha1.VirtualMachineSnapshot,
+	kvSnapshot *snapshotv1beta1.VirtualMachineSnapshot) {
+	status := kvSnapshot.Status
+	if status == nil {
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseProgressing
+		return
+	}
+	switch {
+	case status.Phase == snapshotv1beta1.Succeeded && status.ReadyToUse != nil && *status.ReadyToUse:
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseReady
+		instance.Status.Message = ""
+		if status.CreationTime != nil {
+			instance.Status.CreationTime = status.CreationTime.DeepCopy()
+		}
+	case status.Phase == snapshotv1beta1.Failed:
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseFailed
+		if status.Error != nil && status.Error.Message != nil {
+			instance.Status.Message = *status.Error.Message
+		}
+	default:
+		instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseProgressing
+	}
+}
+
+// handleKubeVirtRestore starts a restore of the KubeVirt virtual machine when the restore request time in the spec is
+// later than the time of the last restore, and then tracks the progress of that restore. The target virtual machine is
+// stopped by KubeVirt during the restore.
+func (r *VirtualMachineSnapshotReconciler) handleKubeVirtRestore(ctx context.Context,
+	instance *v1alpha1.VirtualMachineSnapshot, vmName string) error {
+	log := ctrllog.FromContext(ctx)
+
+	namespace := instance.Status.SnapshotReference.Namespace
+	requested := instance.Spec.RestoreRequestTime
+	last := instance.Status.LastRestoreTime
+	if requested != nil && (last == nil || last.Before(requested)) {
+		apiGroup := "kubevirt.io"
+		policy := snapshotv1beta1.VirtualMachineRestoreStopTarget
+		restore := &snapshotv1beta1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      fmt.Sprintf("%s-%d", instance.GetName(), requested.Unix()),
+				Labels: map[string]string{
+					cloudkitVirtualMachineSnapshotNameLabel: instance.GetName(),
+				},
+			},
+			Spec: snapshotv1beta1.VirtualMachineRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				VirtualMachineSnapshotName: instance.Status.SnapshotReference.KubeVirtVirtualMachineSnapshotName,
+				TargetReadinessPolicy:      &policy,
+			},
+		}
+		err := r.Create(ctx, restore)
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			log.Error(err, "failed to create KubeVirt restore", "virtualmachine", vmName)
+			return err
+		}
+		log.Info("restoring KubeVirt virtual machine", "virtualmachine", vmName, "requested", requested)
+		instance.Status.LastRestoreTime = requested.DeepCopy()
+		instance.Status.RestoreName = restore.GetName()
+		instance.Status.RestorePhase = v1alpha1.VirtualMachineSnapshotRestorePhaseProgressing
+		return nil
+	}
+
+	if instance.Status.RestorePhase != v1alpha1.VirtualMachineSnapshotRestorePhaseProgressing ||
+		instance.Status.RestoreName == "" {
+		return nil
+	}
+	restore := &snapshotv1beta1.VirtualMachineRestore{}
+	err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: instance.Status.RestoreName}, restore)
+	if apierrors.IsNotFound(err) {
+		instance.Status.RestorePhase = v1alpha1.VirtualMachineSnapshotRestorePhaseFailed
+		instance.Status.Message = fmt.Sprintf("restore '%s' doesn't exist", instance.Status.RestoreName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if restore.Status == nil {
+		return nil
+	}
+	if restore.Status.Complete != nil && *restore.Status.Complete {
+		instance.Status.RestorePhase = v1alpha1.VirtualMachineSnapshotRestorePhaseSucceeded
+		return nil
+	}
+	for _, condition := range restore.Status.Conditions {
+		if condition.Type == snapshotv1beta1.ConditionFailure && condition.Status == corev1.ConditionTrue {
+			instance.Status.RestorePhase = v1alpha1.VirtualMachineSnapshotRestorePhaseFailed
+			instance.Status.Message = condition.Message
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *VirtualMachineSnapshotReconciler) handleDelete(ctx context.Context, instance *v1alpha1.VirtualMachineSnapshot) (ctrl.Result, error) {
+	log := ctrllog.FromContext(ctx)
+	log.Info("deleting virtualmachinesnapshot")
+
+	instance.Status.Phase = v1alpha1.VirtualMachineSnapshotPhaseDeleting
+
+	if !controllerutil.ContainsFinalizer(instance, cloudkitVirtualMachineSnapshotFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if reference := instance.Status.SnapshotReference; reference != nil {
+		// Restores reference the snapshot, so they need to be removed first:
+		var restoreList snapshotv1beta1.VirtualMachineRestoreList
+		err := r.List(ctx, &restoreList, client.InNamespace(reference.Namespace), client.MatchingLabels{
+			cloudkitVirtualMachineSnapshotNameLabel: instance.GetName(),
+		})
+		if err != nil {
+			log.Error(err, "failed to list KubeVirt restores")
+			return ctrl.Result{}, err
+		}
+		for i := range restoreList.Items {
+			err := r.Delete(ctx, &restoreList.Items[i])
+			if err != nil && !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+		}
+
+		kvSnapshot := &snapshotv1beta1.VirtualMachineSnapshot{}
+		key := client.ObjectKey{Namespace: reference.Namespace, Name: reference.KubeVirtVirtualMachineSnapshotName}
+		err = r.Get(ctx, key, kvSnapshot)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if err == nil {
+			if kvSnapshot.GetDeletionTimestamp().IsZero() {
+				if err := r.Delete(ctx, kvSnapshot); err != nil && !apierrors.IsNotFound(err) {
+					return ctrl.Result{}, err
+				}
+			}
+			log.Info("waiting for KubeVirt snapshot to delete", "namespace", reference.Namespace,
+				"name", reference.KubeVirtVirtualMachineSnapshotName)
+			return ctrl.Result{RequeueAfter: virtualMachineSnapshotPollInterval}, nil
+		}
+	}
+
+	// Allow kubernetes to delete the virtualmachinesnapshot
+	if controllerutil.RemoveFinalizer(instance, cloudkitVirtualMachineSnapshotFinalizer) {
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cloudkitv1alpha1 "github.com/jkary/osac/openshift/operator/crds/v1alpha1"
+)
+
+var _ = Describe("VirtualMachineSnapshot Controller", func() {
+	Context("When reconciling a resource", func() {
+		const resourceName = "test-snapshot"
+
+		ctx := context.Background()
+
+		typeNamespacedName := types.NamespacedName{
+			Name:      resourceName,
+			Namespace: "default",
+		}
+		snapshot := &cloudkitv1alpha1.VirtualMachineSnapshot{}
+
+		BeforeEach(func() {
+			By("creating the custom resource for the Kind VirtualMachineSnapshot")
+			err := k8sClient.Get(ctx, typeNamespacedName, snapshot)
+			if err != nil && errors.IsNotFound(err) {
+				resource := &cloudkitv1alpha1.VirtualMachineSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resourceName,
+						Namespace: "default",
+					},
+					Spec: cloudkitv1alpha1.VirtualMachineSnapshotSpec{
+						VirtualMachineName: "test-vm",
+					},
+				}
+				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
+			}
+		})
+
+		AfterEach(func() {
+			resource := &cloudkitv1alpha1.VirtualMachineSnapshot{}
+			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Cleanup the specific resource instance VirtualMachineSnapshot")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		})
+
+		It("should wait for the virtual machine", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := NewVirtualMachineSnapshotReconciler(k8sClient, k8sClient.Scheme(), "default")
+
+			result, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(virtualMachineSnapshotPollInterval))
+
+			resource := &cloudkitv1alpha1.VirtualMachineSnapshot{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.Finalizers).To(ContainElement(cloudkitVirtualMachineSnapshotFinalizer))
+			Expect(resource.Status.Phase).To(Equal(cloudkitv1alpha1.VirtualMachineSnapshotPhaseProgressing))
+			Expect(resource.Status.SnapshotReference).To(BeNil())
+		})
+	})
+})
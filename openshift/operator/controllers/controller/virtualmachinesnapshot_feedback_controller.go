@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2025 Red Hat Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance with the
+License. You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software distributed under the License is distributed on an
+"AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for the specific
+language governing permissions and limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	clnt "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	privatev1 "github.com/jkary/osac/openshift/operator/controllers/api/private/v1"
+	ckv1alpha1 "github.com/jkary/osac/openshift/operator/crds/v1alpha1"
+)
+
+// VirtualMachineSnapshotFeedbackReconciler sends updates to the fulfillment service.
+type VirtualMachineSnapshotFeedbackReconciler struct {
+	hubClient               clnt.Client
+	snapshotsClient         privatev1.VirtualMachineSnapshotsClient
+	virtualMachineNamespace string
+}
+
+// virtualMachineSnapshotFeedbackReconcilerTask contains data that is used for the reconciliation of a specific
+// virtual machine snapshot, so there is less need to pass around as function parameters that and other related
+// objects.
+type virtualMachineSnapshotFeedbackReconcilerTask struct {
+	r        *VirtualMachineSnapshotFeedbackReconciler
+	object   *ckv1alpha1.VirtualMachineSnapshot
+	snapshot *privatev1.VirtualMachineSnapshot
+}
+
+// NewVirtualMachineSnapshotFeedbackReconciler creates a reconciler that sends to the fulfillment service updates about
+// virtual machine snapshots.
+func NewVirtualMachineSnapshotFeedbackReconciler(hubClient clnt.Client, grpcConn *grpc.ClientConn, virtualMachineNamespace string) *VirtualMachineSnapshotFeedbackReconciler {
+	return &VirtualMachineSnapshotFeedbackReconciler{
+		hubClient:               hubClient,
+		snapshotsClient:         privatev1.NewVirtualMachineSnapshotsClient(grpcConn),
+		virtualMachineNamespace: virtualMachineNamespace,
+	}
+}
+
+// SetupWithManager adds the reconciler to the controller manager.
+func (r *VirtualMachineSnapshotFeedbackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("virtualmachinesnapshot-feedback").
+		For(&ckv1alpha1.VirtualMachineSnapshot{}, builder.WithPredicates(VirtualMachineNamespacePredicate(r.virtualMachineNamespace))).
+		Complete(r)
+}
+
+// Reconcile is the implementation of the reconciler interface.
+func (r *VirtualMachineSnapshotFeedbackReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
+	log := ctrllog.FromContext(ctx)
+
+	// Fetch the object to reconcile, and do nothing if it no longer exists:
+	object := &ckv1alpha1.VirtualMachineSnapshot{}
+	err = r.hubClient.Get(ctx, request.NamespacedName, object)
+	if err != nil {
+		err = clnt.IgnoreNotFound(err)
+		return //nolint:nakedret
+	}
+
+	// Get the identifier of the snapshot from the labels. If this isn't present it means that the object wasn't
+	// created by the fulfillment service, so we ignore it.
+	snapshotID, ok := object.Labels[cloudkitVirtualMachineSnapshotIDLabel]
+	if !ok {
+		log.Info(
+			"There is no label containing the virtual machine snapshot identifier, will ignore it",
+			"label", cloudkitVirtualMachineSnapshotIDLabel,
+		)
+		return
+	}
+
+	// Check if the snapshot is being deleted before fetching from fulfillment service
+	if !object.ObjectMeta.DeletionTimestamp.IsZero() {
+		log.Info(
+			"VirtualMachineSnapshot is being deleted, skipping feedback reconciliation",
+		)
+		return
+	}
+
+	// Fetch the snapshot:
+	snapshot, err := r.fetchSnapshot(ctx, snapshotID)
+	if err != nil {
+		return
+	}
+
+	// Create a task to do the rest of the job, but using copies of the objects, so that we can later compare the
+	// before and after values and save only the objects that have changed.
+	t := &virtualMachineSnapshotFeedbackReconcilerTask{
+		r:        r,
+		object:   object,
+		snapshot: clone(snapshot),
+	}
+
+	result, err = t.handleUpdate(ctx)
+	if err != nil {
+		return
+	}
+
+	// Save the objects that have changed. If the snapshot was modified by some other process since we fetched it we
+	// need to start again with the latest version:
+	err = r.saveSnapshot(ctx, snapshot, t.snapshot)
+	if isConflict(err) {
+		log.Info(
+			"Virtual machine snapshot was modified concurrently, will retry",
+			"id", snapshotID,
+		)
+		result = ctrl.Result{Requeue: true}
+		err = nil
+		return
+	}
+	return
+}
+
+func (r *VirtualMachineSnapshotFeedbackReconciler) fetchSnapshot(ctx context.Context, id string) (snapshot *privatev1.VirtualMachineSnapshot, err error) {
+	response, err := r.snapshotsClient.Get(ctx, privatev1.VirtualMachineSnapshotsGetRequest_builder{
+		Id: id,
+	}.Build())
+	if err != nil {
+		return
+	}
+	snapshot = response.GetObject()
+	if !snapshot.HasSpec() {
+		snapshot.SetSpec(&privatev1.VirtualMachineSnapshotSpec{})
+	}
+	if !snapshot.HasStatus() {
+		snapshot.SetStatus(&privatev1.VirtualMachineSnapshotStatus{})
+	}
+	return
+}
+
+func (r *VirtualMachineSnapshotFeedbackReconciler) saveSnapshot(ctx context.Context, before, after *privatev1.VirtualMachineSnapshot) error {
+	if !equal(after, before) {
+		_, err := r.snapshotsClient.Update(ctx, privatev1.VirtualMachineSnapshotsUpdateRequest_builder{
+			Object: after,
+		}.Build())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *virtualMachineSnapshotFeedbackReconcilerTask) handleUpdate(ctx context.Context) (result ctrl.Result, err error) {
+	t.syncPhase(ctx)
+	t.syncRestorePhase(ctx)
+	status := t.snapshot.GetStatus()
+	if t.object.Status.CreationTime != nil {
+		status.SetCreationTime(timestamppb.New(t.object.Status.CreationTime.Time))
+	}
+	if t.object.Status.LastRestoreTime != nil {
+		status.SetLastRestoreTime(timestamppb.New(t.object.Status.LastRestoreTime.Time))
+	}
+	status.SetMessage(t.object.Status.Message)
+	return
+}
+
+func (t *virtualMachineSnapshotFeedbackReconcilerTask) syncPhase(ctx context.Context) {
+	status := t.snapshot.GetStatus()
+	switch t.object.Status.Phase {
+	case ckv1alpha1.VirtualMachineSnapshotPhaseProgressing:
+		status.SetState(privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_PROGRESSING)
+	case ckv1alpha1.VirtualMachineSnapshotPhaseFailed:
+		status.SetState(privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_FAILED)
+	case ckv1alpha1.VirtualMachineSnapshotPhaseReady:
+		status.SetState(privatev1.VirtualMachineSnapshotState_VIRTUAL_MACHINE_SNAPSHOT_STATE_READY)
+	case ckv1alpha1.VirtualMachineSnapshotPhaseDeleting, "":
+		// There is no equivalent state in the fulfillment service.
+	default:
+		log := ctrllog.FromContext(ctx)
+		log.Info(
+			"Unknown phase, will ignore it",
+			"phase", t.object.Status.Phase,
+		)
+	}
+}
+
+func (t *virtualMachineSnapshotFeedbackReconcilerTask) syncRestorePhase(ctx context.Context) {
+	status := t.snapshot.GetStatus()
+	switch t.object.Status.RestorePhase {
+	case ckv1alpha1.VirtualMachineSnapshotRestorePhaseProgressing:
+		status.SetRestoreState(privatev1.VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_PROGRESSING)
+	case ckv1alpha1.VirtualMachineSnapshotRestorePhaseSucceeded:
+		status.SetRestoreState(privatev1.VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_SUCCEEDED)
+	case ckv1alpha1.VirtualMachineSnapshotRestorePhaseFailed:
+		status.SetRestoreState(privatev1.VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_FAILED)
+	case "":
+		status.SetRestoreState(privatev1.VirtualMachineSnapshotRestoreState_VIRTUAL_MACHINE_SNAPSHOT_RESTORE_STATE_UNSPECIFIED)
+	default:
+		log := ctrllog.FromContext(ctx)
+		log.Info(
+			"Unknown restore phase, will ignore it",
+			"restore_phase", t.object.Status.RestorePhase,
+		)
+	}
+}
@@ -39,4 +39,5 @@ const (
 	ReasonDeleting         = "Deleting"
 	ReasonWebhookTriggered = "WebhookTriggered"
 	ReasonWebhookFailed    = "WebhookFailed"
+	ReasonStopped          = "Stopped"
 )
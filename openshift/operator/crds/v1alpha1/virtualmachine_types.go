@@ -34,8 +34,58 @@ type VirtualMachineSpec struct {
 	// selected virtual machine template.
 	// +kubebuilder:validation:Optional
 	TemplateParameters string `json:"templateParameters,omitempty"`
+	// RunStrategy is the run strategy that will be set in the KubeVirt virtual machine. When empty the run
+	// strategy of the KubeVirt virtual machine isn't changed.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Always;Halted;Manual;RerunOnFailure
+	RunStrategy VirtualMachineRunStrategyType `json:"runStrategy,omitempty"`
+	// RestartRequestTime is the time of the last restart requested for the virtual machine. When this is later
+	// than the time of the last restart the KubeVirt virtual machine instance will be restarted.
+	// +kubebuilder:validation:Optional
+	RestartRequestTime *metav1.Time `json:"restartRequestTime,omitempty"`
+	// Paused indicates if the execution of the KubeVirt virtual machine instance should be paused. This only has
+	// effect while the virtual machine is running.
+	// +kubebuilder:validation:Optional
+	Paused bool `json:"paused,omitempty"`
 }
 
+// VirtualMachineRunStrategyType is a valid value for .spec.runStrategy
+type VirtualMachineRunStrategyType string
+
+const (
+	// VirtualMachineRunStrategyAlways means the virtual machine should always be running
+	VirtualMachineRunStrategyAlways VirtualMachineRunStrategyType = "Always"
+
+	// VirtualMachineRunStrategyHalted means the virtual machine should be stopped
+	VirtualMachineRunStrategyHalted VirtualMachineRunStrategyType = "Halted"
+
+	// VirtualMachineRunStrategyManual means the virtual machine will not be started or stopped automatically
+	VirtualMachineRunStrategyManual VirtualMachineRunStrategyType = "Manual"
+
+	// VirtualMachineRunStrategyRerunOnFailure means the virtual machine should be started again if it fails
+	VirtualMachineRunStrategyRerunOnFailure VirtualMachineRunStrategyType = "RerunOnFailure"
+)
+
+// VirtualMachinePowerStateType is a valid value for .status.powerState
+type VirtualMachinePowerStateType string
+
+const (
+	// VirtualMachinePowerStateStarting means the virtual machine is being started
+	VirtualMachinePowerStateStarting VirtualMachinePowerStateType = "Starting"
+
+	// VirtualMachinePowerStateRunning means the virtual machine is running
+	VirtualMachinePowerStateRunning VirtualMachinePowerStateType = "Running"
+
+	// VirtualMachinePowerStatePaused means the virtual machine is running but its execution is paused
+	VirtualMachinePowerStatePaused VirtualMachinePowerStateType = "Paused"
+
+	// VirtualMachinePowerStateStopping means the virtual machine is being stopped
+	VirtualMachinePowerStateStopping VirtualMachinePowerStateType = "Stopping"
+
+	// VirtualMachinePowerStateStopped means the virtual machine is stopped
+	VirtualMachinePowerStateStopped VirtualMachinePowerStateType = "Stopped"
+)
+
 // VirtualMachinePhaseType is a valid value for .status.phase
 type VirtualMachinePhaseType string
 
@@ -94,6 +144,15 @@ type VirtualMachineStatus struct {
 	// Reference to the namespace that contains VirtualMachine resources
 	// +kubebuilder:validation:Optional
 	VirtualMachineReference *VirtualMachineReferenceType `json:"virtualMachineReference,omitempty"`
+
+	// PowerState is the power state of the KubeVirt virtual machine
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Starting;Running;Paused;Stopping;Stopped
+	PowerState VirtualMachinePowerStateType `json:"powerState,omitempty"`
+
+	// LastRestartTime is the value of .spec.restartRequestTime when the virtual machine was last restarted
+	// +kubebuilder:validation:Optional
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -101,6 +160,7 @@ type VirtualMachineStatus struct {
 // +kubebuilder:resource:shortName=vm
 // +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.templateID`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Power",type=string,JSONPath=`.status.powerState`
 
 // VirtualMachine is the Schema for the virtualmachines API
 type VirtualMachine struct {
@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineSnapshotSpec defines the desired state of VirtualMachineSnapshot
+type VirtualMachineSnapshotSpec struct {
+	// VirtualMachineName is the name of the VirtualMachine, in the same namespace, that will be snapshotted
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="virtualMachineName is immutable"
+	VirtualMachineName string `json:"virtualMachineName"`
+	// RestoreRequestTime is the time of the last restore requested for the snapshot. When this is later than the
+	// time of the last restore the KubeVirt virtual machine will be restored from the snapshot.
+	// +kubebuilder:validation:Optional
+	RestoreRequestTime *metav1.Time `json:"restoreRequestTime,omitempty"`
+}
+
+// VirtualMachineSnapshotPhaseType is a valid value for .status.phase
+type VirtualMachineSnapshotPhaseType string
+
+const (
+	// VirtualMachineSnapshotPhaseProgressing means the snapshot is being taken
+	VirtualMachineSnapshotPhaseProgressing VirtualMachineSnapshotPhaseType = "Progressing"
+
+	// VirtualMachineSnapshotPhaseFailed means the snapshot has failed
+	VirtualMachineSnapshotPhaseFailed VirtualMachineSnapshotPhaseType = "Failed"
+
+	// VirtualMachineSnapshotPhaseReady means the snapshot is ready to be used for restores
+	VirtualMachineSnapshotPhaseReady VirtualMachineSnapshotPhaseType = "Ready"
+
+	// VirtualMachineSnapshotPhaseDeleting means there has been a request to delete the VirtualMachineSnapshot
+	VirtualMachineSnapshotPhaseDeleting VirtualMachineSnapshotPhaseType = "Deleting"
+)
+
+// VirtualMachineSnapshotRestorePhaseType is a valid value for .status.restorePhase
+type VirtualMachineSnapshotRestorePhaseType string
+
+const (
+	// VirtualMachineSnapshotRestorePhaseProgressing means the last requested restore is in progress
+	VirtualMachineSnapshotRestorePhaseProgressing VirtualMachineSnapshotRestorePhaseType = "Progressing"
+
+	// VirtualMachineSnapshotRestorePhaseSucceeded means the last requested restore has finished successfully
+	VirtualMachineSnapshotRestorePhaseSucceeded VirtualMachineSnapshotRestorePhaseType = "Succeeded"
+
+	// VirtualMachineSnapshotRestorePhaseFailed means the last requested restore has failed
+	VirtualMachineSnapshotRestorePhaseFailed VirtualMachineSnapshotRestorePhaseType = "Failed"
+)
+
+// VirtualMachineSnapshotReferenceType contains a reference to the resources created by this VirtualMachineSnapshot
+type VirtualMachineSnapshotReferenceType struct {
+	// Namespace that contains the KubeVirt snapshot, which is the namespace of the KubeVirt virtual machine
+	Namespace                          string `json:"namespace"`
+	KubeVirtVirtualMachineSnapshotName string `json:"kubeVirtVirtualMachineSnapshotName"`
+}
+
+// VirtualMachineSnapshotStatus defines the observed state of VirtualMachineSnapshot.
+type VirtualMachineSnapshotStatus struct {
+	// Phase provides a single-value overview of the state of the VirtualMachineSnapshot
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Enum=Progressing;Failed;Ready;Deleting
+	Phase VirtualMachineSnapshotPhaseType `json:"phase,omitempty"`
+
+	// Message contains details about the last error, if any
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// Reference to the KubeVirt snapshot
+	// +kubebuilder:validation:Optional
+	SnapshotReference *VirtualMachineSnapshotReferenceType `json:"snapshotReference,omitempty"`
+
+	// CreationTime is the time when the KubeVirt snapshot was taken
+	// +kubebuilder:validation:Optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// RestorePhase is the state of the last requested restore
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Progressing;Succeeded;Failed
+	RestorePhase VirtualMachineSnapshotRestorePhaseType `json:"restorePhase,omitempty"`
+
+	// RestoreName is the name of the KubeVirt restore created for the last requested restore
+	// +kubebuilder:validation:Optional
+	RestoreName string `json:"restoreName,omitempty"`
+
+	// LastRestoreTime is the value of .spec.restoreRequestTime when the last restore was started
+	// +kubebuilder:validation:Optional
+	LastRestoreTime *metav1.Time `json:"lastRestoreTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=vmsnap
+// +kubebuilder:printcolumn:name="VirtualMachine",type=string,JSONPath=`.spec.virtualMachineName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Restore",type=string,JSONPath=`.status.restorePhase`
+
+// VirtualMachineSnapshot is the Schema for the virtualmachinesnapshots API
+type VirtualMachineSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the desired state of VirtualMachineSnapshot
+	// +required
+	Spec VirtualMachineSnapshotSpec `json:"spec"`
+
+	// status defines the observed state of VirtualMachineSnapshot
+	// +optional
+	Status VirtualMachineSnapshotStatus `json:"status,omitempty,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMachineSnapshotList contains a list of VirtualMachineSnapshot
+type VirtualMachineSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VirtualMachineSnapshot{}, &VirtualMachineSnapshotList{})
+}